@@ -0,0 +1,36 @@
+package app
+
+import (
+	"fmt"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// the acl keys of the acl and of the dao owner, their changes are recorded in the acl history
+var (
+	aclACLKey      = govTypes.NewACLKey(govTypes.ModuleName, string(govTypes.ACLKey))
+	daoOwnerACLKey = govTypes.NewACLKey(govTypes.ModuleName, string(govTypes.DAOOwnerKey))
+)
+
+// "aclHistoryHandler" - Wraps the handler of the gov messages: the successful changes of the acl and of the dao owner
+// are recorded in the acl history of the state
+func (app *PocketCoreApp) aclHistoryHandler(h sdk.Handler) sdk.Handler {
+	return func(ctx sdk.Ctx, msg sdk.Msg) sdk.Result {
+		res := h(ctx, msg)
+		change, ok := msg.(govTypes.MsgChangeParam)
+		if !ok || !res.IsOK() || (change.ParamKey != aclACLKey && change.ParamKey != daoOwnerACLKey) {
+			return res
+		}
+		app.pocketKeeper.SetACLChange(ctx, pocketTypes.ACLChange{
+			ParamKey: change.ParamKey,
+			Value:    change.ParamVal,
+			Sender:   change.FromAddress.String(),
+			Height:   ctx.BlockHeight(),
+			TxHash:   fmt.Sprintf("%X", tmTypes.Tx(ctx.TxBytes()).Hash()),
+		})
+		return res
+	}
+}
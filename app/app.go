@@ -83,6 +83,8 @@ func NewPocketCoreApp(genState cfg.GenesisState, keybase keys.Keybase, tmClient
 	app.pocketKeeper.TmNode = tmClient
 	// give pocket keeper to nodes module for easy cache clearing
 	app.nodesKeeper.PocketKeeper = app.pocketKeeper
+	// give pocket keeper to apps module for the settled relays of the stake adjustment
+	app.appsKeeper.PocketKeeper = app.pocketKeeper
 	// setup module manager
 	app.mm = module.NewManager(
 		auth.NewAppModule(app.accountKeeper),
@@ -103,14 +105,15 @@ func NewPocketCoreApp(genState cfg.GenesisState, keybase keys.Keybase, tmClient
 		gov.ModuleName,
 	)
 	// register all module routes and module queriers
-	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	// the message handlers are wrapped by the post handler refunding the fees
+	app.mm.RegisterRoutes(postHandlerRouter{Router: app.Router(), app: app}, app.QueryRouter())
 	// The initChainer handles translating the genesis.json file into initial state for the network
 	if genState == nil {
 		app.SetInitChainer(app.InitChainer)
 	} else {
 		app.SetInitChainer(app.InitChainerWithGenesis)
 	}
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper))
+	app.SetAnteHandler(nodes.NewAnteHandler(auth.NewAnteHandler(app.accountKeeper), app.nodesKeeper))
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	// initialize stores
@@ -124,3 +127,18 @@ func NewPocketCoreApp(genState cfg.GenesisState, keybase keys.Keybase, tmClient
 	}
 	return app
 }
+
+// the router wrapping the message handlers of the modules with the post handler
+type postHandlerRouter struct {
+	sdk.Router
+	app *PocketCoreApp
+}
+
+func (r postHandlerRouter) AddRoute(path string, h sdk.Handler) sdk.Router {
+	// the param changes are checked against the param bounds, the acl changes are recorded
+	if path == govTypes.RouterKey {
+		h = r.app.aclHistoryHandler(r.app.paramBoundsHandler(h))
+	}
+	r.Router.AddRoute(path, nodes.NewPostHandler(h, r.app.accountKeeper, r.app.nodesKeeper, auth.DefaultTxDecoder(r.app.cdc)))
+	return r
+}
@@ -0,0 +1,223 @@
+package app
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	log2 "log"
+	"os"
+	"time"
+)
+
+const (
+	authTokenLength = 32
+	// the roles of the private rpc routes, the auth token of the node has all of them
+	AuthRoleRead   = "read"   // read only operations (e.g. local evidence, migrations dry run)
+	AuthRoleConfig = "config" // updates of the local config of the running node (e.g. blocklist, webhooks)
+)
+
+// the supported roles of the role tokens
+var AuthRoles = []string{AuthRoleRead, AuthRoleConfig}
+
+// "AuthToken" - The token used to authenticate calls to the private (operator only) rpc routes
+type AuthToken struct {
+	Value  string    `json:"value"`
+	Issued time.Time `json:"issued"`
+}
+
+// "RoleToken" - A token restricted to some of the private rpc routes (e.g. a read only token for monitoring)
+type RoleToken struct {
+	Name   string    `json:"name"`
+	Value  string    `json:"value"`
+	Roles  []string  `json:"roles"`
+	Issued time.Time `json:"issued"`
+}
+
+var (
+	authToken  AuthToken
+	roleTokens []RoleToken
+)
+
+// "InitAuthToken" - Loads the auth token from the config directory, generating one if it does not exist, and the role
+// tokens if any
+func InitAuthToken() {
+	var authTokenPath = GlobalConfig.PocketConfig.DataDir + FS + ConfigDirName + FS + GlobalConfig.PocketConfig.AuthTokenName
+	tokens, err := readRoleTokens(roleTokensPath())
+	if err != nil {
+		log2.Fatalf(err.Error())
+	}
+	roleTokens = tokens
+	if _, err := os.Stat(authTokenPath); os.IsNotExist(err) {
+		authToken = generateAuthToken(authTokenPath)
+		return
+	}
+	bz, err := ioutil.ReadFile(authTokenPath)
+	if err != nil {
+		log2.Fatalf("cannot read auth token file: " + err.Error())
+	}
+	err = json.Unmarshal(bz, &authToken)
+	if err != nil {
+		log2.Fatalf("cannot read auth token file into json: " + err.Error())
+	}
+	if authToken.Value == "" {
+		log2.Fatalf("the auth token in %s is empty; delete the file to generate a new one", authTokenPath)
+	}
+}
+
+// "generateAuthToken" - Creates a new random auth token and writes it to the file path (owner read/write only)
+func generateAuthToken(path string) AuthToken {
+	value, err := newTokenValue()
+	if err != nil {
+		log2.Fatalf("cannot generate auth token: " + err.Error())
+	}
+	token := AuthToken{
+		Value:  value,
+		Issued: time.Now().UTC(),
+	}
+	bz, err := json.MarshalIndent(token, "", "    ")
+	if err != nil {
+		log2.Fatalf("cannot marshal auth token into json: " + err.Error())
+	}
+	err = ioutil.WriteFile(path, bz, 0600)
+	if err != nil {
+		log2.Fatalf("cannot write auth token file: " + err.Error())
+	}
+	return token
+}
+
+// a random hex token value
+func newTokenValue() (string, error) {
+	b := make([]byte, authTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// "GetAuthToken" - Returns the auth token of this node
+func GetAuthToken() AuthToken {
+	return authToken
+}
+
+// "SetAuthToken" - Overrides the auth token of this node (used when not initializing from the data directory)
+func SetAuthToken(token AuthToken) {
+	authToken = token
+}
+
+// "IsValidAuthToken" - Constant time comparison of a candidate token against the auth token of this node
+func IsValidAuthToken(candidate string) bool {
+	if authToken.Value == "" || candidate == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(candidate), []byte(authToken.Value)) == 1
+}
+
+// "IsAuthorized" - Returns whether the candidate is the auth token of this node or a role token with the role, and
+// whether it is any of the tokens at all (to tell an unauthenticated call from a forbidden one)
+func IsAuthorized(candidate, role string) (authorized, authenticated bool) {
+	if IsValidAuthToken(candidate) {
+		return true, true
+	}
+	if candidate == "" {
+		return false, false
+	}
+	for _, token := range roleTokens {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(token.Value)) == 1 {
+			return token.HasRole(role), true
+		}
+	}
+	return false, false
+}
+
+// "HasRole" - Returns whether the token has the role
+func (t RoleToken) HasRole(role string) bool {
+	for _, r := range t.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// "GetRoleTokens" - Returns the role tokens of this node
+func GetRoleTokens() []RoleToken {
+	return roleTokens
+}
+
+// "SetRoleTokens" - Overrides the role tokens of this node (used when not initializing from the data directory)
+func SetRoleTokens(tokens []RoleToken) {
+	roleTokens = tokens
+}
+
+// "GenerateRoleToken" - Creates a new random token with the roles and adds it to the role tokens file of the config
+// directory (read on start, the running node must be restarted to accept it)
+func GenerateRoleToken(name string, roles []string) (RoleToken, error) {
+	if name == "" {
+		return RoleToken{}, fmt.Errorf("the name of the role token is empty")
+	}
+	if len(roles) == 0 {
+		return RoleToken{}, fmt.Errorf("the role token has no roles, expected any of %v", AuthRoles)
+	}
+	for _, role := range roles {
+		if !isAuthRole(role) {
+			return RoleToken{}, fmt.Errorf("invalid role %s, expected any of %v", role, AuthRoles)
+		}
+	}
+	path := roleTokensPath()
+	tokens, err := readRoleTokens(path)
+	if err != nil {
+		return RoleToken{}, err
+	}
+	for _, token := range tokens {
+		if token.Name == name {
+			return RoleToken{}, fmt.Errorf("a role token named %s already exists in %s", name, path)
+		}
+	}
+	value, err := newTokenValue()
+	if err != nil {
+		return RoleToken{}, err
+	}
+	token := RoleToken{Name: name, Value: value, Roles: roles, Issued: time.Now().UTC()}
+	bz, err := json.MarshalIndent(append(tokens, token), "", "    ")
+	if err != nil {
+		return RoleToken{}, err
+	}
+	return token, ioutil.WriteFile(path, bz, 0600)
+}
+
+func roleTokensPath() string {
+	return GlobalConfig.PocketConfig.DataDir + FS + ConfigDirName + FS + GlobalConfig.PocketConfig.RoleTokensName
+}
+
+// reads the role tokens file (none if it does not exist)
+func readRoleTokens(path string) ([]RoleToken, error) {
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read role tokens file: %s", err.Error())
+	}
+	var tokens []RoleToken
+	if err := json.Unmarshal(bz, &tokens); err != nil {
+		return nil, fmt.Errorf("cannot read role tokens file into json: %s", err.Error())
+	}
+	for _, token := range tokens {
+		if token.Value == "" {
+			return nil, fmt.Errorf("the role token %s in %s is empty", token.Name, path)
+		}
+	}
+	return tokens, nil
+}
+
+func isAuthRole(role string) bool {
+	for _, r := range AuthRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"fmt"
+	log2 "log"
+	"time"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "StartChainHealthChecker" - Probes the hosted chains every chain health interval until the node stops, warning about
+// the chains the node is staked for that are unreachable
+func StartChainHealthChecker() {
+	interval := time.Duration(GlobalConfig.PocketConfig.ChainHealthInterval) * time.Millisecond
+	if interval <= 0 {
+		return
+	}
+	for {
+		PCA.CheckChainsHealth()
+		time.Sleep(interval)
+	}
+}
+
+// "CheckChainsHealth" - Probes the hosted chains, logs a warning for every unhealthy chain the node is staked for and
+// returns the results
+func (app *PocketCoreApp) CheckChainsHealth() []pocketTypes.ChainHealth {
+	hostedChains := app.pocketKeeper.GetHostedBlockchains()
+	if hostedChains == nil {
+		hostedChains = &pocketTypes.HostedBlockchains{}
+	}
+	results := pocketTypes.CheckChainsHealth(hostedChains, app.stakedChains())
+	for _, warning := range chainHealthWarnings(results) {
+		log2.Println("WARNING: " + warning)
+	}
+	return results
+}
+
+// "QueryChainsHealth" - Returns the latest health of the hosted chains and of the chains the node is staked for
+func (app *PocketCoreApp) QueryChainsHealth() []pocketTypes.ChainHealth {
+	return pocketTypes.ChainsHealth()
+}
+
+// the chains the node is staked for, none if not staked
+func (app *PocketCoreApp) stakedChains() []string {
+	ctx, err := app.NewContext(0)
+	if err != nil {
+		return nil
+	}
+	self, er := app.pocketKeeper.GetSelfNode(ctx)
+	if er != nil || !self.IsStaked() {
+		return nil
+	}
+	return self.GetChains()
+}
+
+// the warnings of the unhealthy staked chains: their relays fail, so the node risks challenges and slashing
+func chainHealthWarnings(results []pocketTypes.ChainHealth) (warnings []string) {
+	for _, h := range results {
+		if !h.Staked || h.Healthy {
+			continue
+		}
+		since := "never seen healthy"
+		if !h.LastHealthy.IsZero() {
+			since = "last healthy " + h.LastHealthy.Format(time.RFC3339)
+		}
+		warnings = append(warnings, fmt.Sprintf("the chain %s the node is staked for is unreachable (%s, %s): its relays fail and the node risks challenges and slashing until it is fixed or unstaked",
+			h.ID, h.Error, since))
+	}
+	return
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainHealthWarnings(t *testing.T) {
+	lastHealthy := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	warnings := chainHealthWarnings([]pocketTypes.ChainHealth{
+		{ID: "0001", Hosted: true, Staked: true, Healthy: true},
+		{ID: "0002", Hosted: true, Staked: false, Error: "connection refused"},
+		{ID: "0003", Hosted: true, Staked: true, Error: "connection refused", LastHealthy: lastHealthy},
+		{ID: "0004", Staked: true, Error: "the chain is not hosted by the node"},
+	})
+	assert.Len(t, warnings, 2)
+	assert.Contains(t, warnings[0], "0003")
+	assert.Contains(t, warnings[0], "connection refused")
+	assert.Contains(t, warnings[0], lastHealthy.Format(time.RFC3339))
+	assert.Contains(t, warnings[1], "0004")
+	assert.Contains(t, warnings[1], "never seen healthy")
+}
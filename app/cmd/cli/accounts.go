@@ -9,10 +9,12 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pokt-network/pocket-core/app/cmd/rpc"
 
 	"github.com/pokt-network/pocket-core/app"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
 	"github.com/pokt-network/posmint/crypto/keys"
 	"github.com/pokt-network/posmint/types"
@@ -31,10 +33,20 @@ func init() {
 	accountsCmd.AddCommand(signCmd)
 	accountsCmd.AddCommand(importArmoredCmd)
 	accountsCmd.AddCommand(importCmd)
+	accountsCmd.AddCommand(importCosmosCmd)
+	accountsCmd.AddCommand(importPrivValCmd)
+	accountsCmd.AddCommand(importSeedCmd)
+	accountsCmd.AddCommand(importMnemonicCmd)
+	importSeedCmd.Flags().StringVar(&keyAlgo, "algo", app.KeyAlgoEd25519, "the algorithm of the key (ed25519 or secp256k1)")
+	importMnemonicCmd.Flags().StringVar(&keyAlgo, "algo", app.KeyAlgoEd25519, "the algorithm of the key (ed25519 or secp256k1)")
+	importMnemonicCmd.Flags().StringVar(&hdPath, "hd-path", "", "the bip-32 path of the key, m/44'/635'/0'/0'/0' for ed25519 (hardened only) and m/44'/118'/0'/0/0 (cosmos) for secp256k1 if empty")
 	accountsCmd.AddCommand(exportCmd)
 	accountsCmd.AddCommand(exportRawCmd)
 	accountsCmd.AddCommand(sendTxCmd)
 	accountsCmd.AddCommand(sendRawTxCmd)
+	accountsCmd.AddCommand(grantAllowanceCmd)
+	accountsCmd.AddCommand(revokeAllowanceCmd)
+	sendTxCmd.Flags().StringVar(&spenderAddr, "spender", "", "sign with the key of the spender address, charging the allowance granted by <fromAddr>")
 	accountsCmd.AddCommand(newMultiPublicKey)
 	accountsCmd.AddCommand(signMS)
 	accountsCmd.AddCommand(signNexMS)
@@ -64,7 +76,7 @@ Will prompt the user for a passphrase to encrypt the generated keypair.`,
 			fmt.Printf("Account generation Failed, %s", err)
 			return
 		}
-		fmt.Printf("Account generated successfully:\nAddress: %s\n", kp.GetAddress())
+		fmt.Printf("Account generated successfully:\nAddress: %s\n", app.FormatAddress(kp.GetAddress()))
 	},
 }
 
@@ -91,7 +103,7 @@ var setValidator = &cobra.Command{
 	Args:  cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
-		addr, err := types.AddressFromHex(args[0])
+		addr, err := pocketTypes.ParseAddress(args[0])
 		if err != nil {
 			fmt.Printf("Address Error %s", err)
 			return
@@ -116,7 +128,7 @@ Will prompt the user for the account passphrase`,
 			return
 
 		}
-		addr, err := types.AddressFromHex(args[0])
+		addr, err := pocketTypes.ParseAddress(args[0])
 		if err != nil {
 			fmt.Printf("Address Error %s", err)
 			return
@@ -151,7 +163,7 @@ Example output:
 			return
 		}
 		for i, key := range kp {
-			fmt.Printf("(%d) %s\n", i, key.GetAddress().String())
+			fmt.Printf("(%d) %s\n", i, app.FormatAddress(key.GetAddress()))
 		}
 	},
 }
@@ -172,7 +184,7 @@ Example output:
 			fmt.Println(app.UninitializedKeybaseError.Error())
 			return
 		}
-		addr, err := types.AddressFromHex(args[0])
+		addr, err := pocketTypes.ParseAddress(args[0])
 		if err != nil {
 			fmt.Printf("Address Error, %s", err)
 			return
@@ -183,7 +195,7 @@ Example output:
 			return
 		}
 		fmt.Printf("Address:\t%s\nPublic Key:\t%s\n",
-			kp.GetAddress().String(),
+			app.FormatAddress(kp.GetAddress()),
 			hex.EncodeToString(kp.PublicKey.RawBytes()))
 	},
 }
@@ -202,7 +214,7 @@ Will prompt the user for the current account passphrase and the new account pass
 			fmt.Println(app.UninitializedKeybaseError.Error())
 			return
 		}
-		addr, err := types.AddressFromHex(args[0])
+		addr, err := pocketTypes.ParseAddress(args[0])
 		if err != nil {
 			fmt.Printf("Address Error, %s", err)
 			return
@@ -216,7 +228,7 @@ Will prompt the user for the current account passphrase and the new account pass
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Successfully updated account: " + addr.String())
+		fmt.Println("Successfully updated account: " + app.FormatAddress(addr))
 	},
 }
 
@@ -234,7 +246,7 @@ Will prompt the user for the account passphrase.`,
 			fmt.Println(app.UninitializedKeybaseError.Error())
 			return
 		}
-		addr, err := types.AddressFromHex(args[0])
+		addr, err := pocketTypes.ParseAddress(args[0])
 		if err != nil {
 			fmt.Printf("Address Error %s", err)
 			return
@@ -279,7 +291,7 @@ Will prompt the user for a decryption passphrase of the armored ASCII file and f
 			fmt.Println(err)
 			return
 		}
-		fmt.Printf("Account imported successfully:\n%s", kp.GetAddress().String())
+		fmt.Printf("Account imported successfully:\n%s", app.FormatAddress(kp.GetAddress()))
 	},
 }
 var filePath string
@@ -301,7 +313,7 @@ Will prompt the user for the account passphrase and for an encryption passphrase
 			fmt.Println(app.UninitializedKeybaseError.Error())
 			return
 		}
-		addr, err := types.AddressFromHex(args[0])
+		addr, err := pocketTypes.ParseAddress(args[0])
 		if err != nil {
 			fmt.Printf("Address Error %s", err)
 			return
@@ -361,7 +373,7 @@ NOTE: THIS METHOD IS NOT RECOMMENDED FOR SECURITY REASONS, USE AT YOUR OWN RISK.
 			fmt.Println(app.UninitializedKeybaseError.Error())
 			return
 		}
-		addr, err := types.AddressFromHex(args[0])
+		addr, err := pocketTypes.ParseAddress(args[0])
 		if err != nil {
 			fmt.Printf("Address Error %s", err)
 			return
@@ -378,11 +390,14 @@ NOTE: THIS METHOD IS NOT RECOMMENDED FOR SECURITY REASONS, USE AT YOUR OWN RISK.
 }
 
 // sendTxCmd represents the sendTx command
+// the spender signing the transaction with an allowance of the sender
+var spenderAddr string
+
 var sendTxCmd = &cobra.Command{
 	Use:   "send-tx <fromAddr> <toAddr> <amount> <chainID> <fee>",
 	Short: "Send uPOKT",
 	Long: `Sends <amount> uPOKT <fromAddr> to <toAddr>.
-Prompts the user for <fromAddr> account passphrase.`,
+Prompts the user for <fromAddr> account passphrase (the --spender account passphrase if set).`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
@@ -396,8 +411,83 @@ Prompts the user for <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter passphrase: ")
-		res, err := SendTransaction(args[0], args[1], app.Credentials(), args[3], types.NewInt(int64(amount)), int64(fees))
+		res, err := SendTransaction(args[0], args[1], spenderAddr, txCredentials(), args[3], types.NewInt(int64(amount)), int64(fees))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var grantAllowanceCmd = &cobra.Command{
+	Use:   "grant-allowance <fromAddr> <spenderAddr> <spendLimit> <duration> <msgs> <chainID> <fees>",
+	Short: "Grant a spending allowance to a spender key",
+	Long: `Allows <spenderAddr> to sign the <msgs> (comma separated message types, e.g. send,claim,proof) of <fromAddr>
+for <duration> (e.g. 720h), spending up to <spendLimit> uPOKT of fees and sends, replacing its previous allowance.
+Automated services (e.g. claim bots and payout services) then never hold the key of <fromAddr>.
+Prompts the user for <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(7),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		spendLimit, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		duration, err := time.ParseDuration(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[6])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := GrantAllowance(args[0], args[1], txCredentials(), args[5], types.NewInt(int64(spendLimit)), time.Now().Add(duration).UTC(), strings.Split(args[4], ","), int64(fees))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var revokeAllowanceCmd = &cobra.Command{
+	Use:   "revoke-allowance <fromAddr> <spenderAddr> <chainID> <fees>",
+	Short: "Revoke the spending allowance of a spender key",
+	Long: `Revokes the allowance granted by <fromAddr> to <spenderAddr>, the transactions signed by <spenderAddr> for <fromAddr> are rejected right away.
+Prompts the user for <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := RevokeAllowance(args[0], args[1], txCredentials(), args[2], int64(fees))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -472,10 +562,106 @@ Will prompt the user for a passphrase to encrypt the generated keypair.`,
 			return
 		}
 
-		fmt.Printf("Account imported successfully:\n%s\n", kp.GetAddress().String())
+		fmt.Printf("Account imported successfully:\n%s\n", app.FormatAddress(kp.GetAddress()))
+	},
+}
+
+var keyAlgo, hdPath string
+
+var importCosmosCmd = &cobra.Command{
+	Use:   "import-cosmos <path/to/armored-key>",
+	Short: "Import a cosmos sdk key",
+	Long: `Imports an account using the ASCII armored private key exported by a cosmos sdk or tendermint keybase (keys export).
+Will prompt the user for the decryption passphrase of the armored key and for an encryption passphrase to store in the Keybase.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		b, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter decrypt pass")
+		pk, err := app.PrivateKeyFromCosmosArmor(string(b), app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		importKey(pk)
+	},
+}
+
+var importPrivValCmd = &cobra.Command{
+	Use:   "import-priv-val <path/to/priv_validator_key.json>",
+	Short: "Import a tendermint validator key",
+	Long: `Imports an account using the private key of a tendermint priv validator key file (or the legacy priv_validator.json).
+Will prompt the user for a passphrase to encrypt the imported keypair.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		pk, err := app.PrivateKeyFromPrivValFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		importKey(pk)
+	},
+}
+
+var importSeedCmd = &cobra.Command{
+	Use:   "import-seed <seed-hex> --algo <ed25519|secp256k1>",
+	Short: "Import a raw key seed",
+	Long: `Imports an account using the 32 bytes <seed-hex>: the seed of an ed25519 key or a raw secp256k1 private key.
+Will prompt the user for a passphrase to encrypt the imported keypair.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		seed, err := hex.DecodeString(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		pk, err := app.PrivateKeyFromSeed(seed, keyAlgo)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		importKey(pk)
+	},
+}
+
+var importMnemonicCmd = &cobra.Command{
+	Use:   "import-mnemonic --algo <ed25519|secp256k1> --hd-path <path>",
+	Short: "Import a key from a mnemonic",
+	Long: `Imports an account derived from a bip-39 mnemonic at the --hd-path: slip-10 for ed25519 and bip-32 for secp256k1.
+Will prompt the user for the mnemonic, its optional bip-39 passphrase and for a passphrase to encrypt the imported keypair.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		fmt.Println("Enter mnemonic")
+		mnemonic := app.Credentials()
+		fmt.Println("Enter bip-39 passphrase (empty if none)")
+		pk, err := app.PrivateKeyFromMnemonic(mnemonic, app.Credentials(), hdPath, keyAlgo)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		importKey(pk)
 	},
 }
 
+// encrypts the private key into the keybase, prompting for the passphrase
+func importKey(pk crypto.PrivateKey) {
+	kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+	fmt.Println("Enter Encrypt Passphrase")
+	kp, err := app.ImportKey(kb, pk, app.Credentials())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Account imported successfully:\n%s\n", app.FormatAddress(kp.GetAddress()))
+}
+
 // importCmd represents the import command
 var newMultiPublicKey = &cobra.Command{
 	Use:   "create-multi-public <ordered-comma-separated-hex-pubkeys>",
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/pokt-network/pocket-core/app"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto/keys/mintkey"
 	"github.com/pokt-network/posmint/types"
 	"github.com/spf13/cobra"
@@ -20,6 +21,9 @@ func init() {
 	appCmd.AddCommand(appStakeCmd)
 	appCmd.AddCommand(appUnstakeCmd)
 	appCmd.AddCommand(createAATCmd)
+	appCmd.AddCommand(appDelegateGatewayCmd)
+	appCmd.AddCommand(appRevokeGatewayCmd)
+	appCmd.AddCommand(createGatewayAATCmd)
 }
 
 var appCmd = &cobra.Command{
@@ -54,8 +58,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 		}
 		rawChains := reg.ReplaceAllString(args[2], "")
 		chains := strings.Split(rawChains, ",")
-		fmt.Println("Enter passphrase: ")
-		res, err := StakeApp(chains, fromAddr, app.Credentials(), args[3], types.NewInt(int64(amount)), int64(fees))
+		res, err := StakeApp(chains, fromAddr, txCredentials(), args[3], types.NewInt(int64(amount)), int64(fees))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -87,8 +90,7 @@ Prompts the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnstakeApp(args[0], app.Credentials(), args[1], int64(fees))
+		res, err := UnstakeApp(args[0], txCredentials(), args[1], int64(fees))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -122,7 +124,7 @@ NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES
 			fmt.Println(app.UninitializedKeybaseError)
 			return
 		}
-		addr, err := types.AddressFromHex(args[0])
+		addr, err := pocketTypes.ParseAddress(args[0])
 		if err != nil {
 			fmt.Printf("Address Error %s", err)
 			return
@@ -146,3 +148,108 @@ NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES
 		fmt.Println(string(aatBytes))
 	},
 }
+
+var appDelegateGatewayCmd = &cobra.Command{
+	Use:   "delegate-gateway <appAddr> <gatewayPubKey> <chainID> <fees>",
+	Short: "Delegates a gateway key to sign AATs for the app",
+	Long: `Authorizes the <gatewayPubKey> to sign application authentication tokens on behalf of the app,
+so the gateway can service clients without holding the app's stake key.
+Will prompt the user for the <appAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := DelegateGateway(args[0], args[1], txCredentials(), args[2], int64(fees))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var appRevokeGatewayCmd = &cobra.Command{
+	Use:   "revoke-gateway <appAddr> <gatewayPubKey> <chainID> <fees>",
+	Short: "Revokes a gateway key delegated by the app",
+	Long: `Revokes the <gatewayPubKey> delegation, relays with AATs signed by the gateway are rejected from the next session on.
+Will prompt the user for the <appAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := RevokeGateway(args[0], args[1], txCredentials(), args[2], int64(fees))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var createGatewayAATCmd = &cobra.Command{
+	Use:   "create-gateway-aat <gatewayAddr> <appPubKey> <clientPubKey>",
+	Short: "Creates an application authentication token signed by a gateway",
+	Long: `Creates an application authentication token for the <appPubKey>, signed by the <gatewayAddr> key.
+The token is only valid for relays while the app delegates the gateway key (see apps delegate-gateway).
+Will prompt the user for the <gatewayAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		kb := app.MustGetKeybase()
+		if kb == nil {
+			fmt.Println(app.UninitializedKeybaseError)
+			return
+		}
+		addr, err := pocketTypes.ParseAddress(args[0])
+		if err != nil {
+			fmt.Printf("Address Error %s", err)
+			return
+		}
+		kp, err := kb.Get(addr)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		cred := app.Credentials()
+		privkey, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, cred)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		aatBytes, err := app.PCA.GenerateGatewayAAT(args[1], args[2], privkey)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(aatBytes))
+	},
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/pokt-network/posmint/types"
+	"github.com/spf13/cobra"
+)
+
+var genesisPath string
+
+func init() {
+	rootCmd.AddCommand(genesisCmd)
+	genesisCmd.PersistentFlags().StringVar(&genesisPath, "genesis", "", "the genesis file to mutate (default is the genesis file in the data directory)")
+	genesisCmd.AddCommand(genesisAddAccountCmd)
+	genesisCmd.AddCommand(genesisAddValidatorCmd)
+	genesisCmd.AddCommand(genesisAddAppCmd)
+	genesisCmd.AddCommand(genesisImportStateCmd)
+	genesisImportStateCmd.Flags().StringVar(&importValidators, "validators", "", "comma separated public keys of the staked nodes kept in the validator set, the other staked nodes are jailed (all of them are kept by default)")
+}
+
+var genesisCmd = &cobra.Command{
+	Use:   "genesis",
+	Short: "genesis file management",
+	Long: `The genesis namespace handles all genesis file mutations,
+from adding funded accounts; to adding pre-staked validators and applications.
+Every mutation is validated against the module genesis logic before the file is written.`,
+}
+
+func getGenesisPath() string {
+	app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+	if genesisPath != "" {
+		return genesisPath
+	}
+	return app.GenesisFilePath()
+}
+
+func parseChains(arg string) []string {
+	reg, err := regexp.Compile("[^,a-fA-F0-9]+")
+	if err != nil {
+		log.Fatal(err)
+	}
+	rawChains := reg.ReplaceAllString(arg, "")
+	return strings.Split(rawChains, ",")
+}
+
+var genesisAddAccountCmd = &cobra.Command{
+	Use:   "add-account <publicKey> <amount>",
+	Short: "Add a funded account to genesis",
+	Long:  `Adds an account with the public key, funded with <amount> uPOKT, to the genesis file.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		path := getGenesisPath()
+		pk, err := crypto.NewPublicKey(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		err = app.GenesisAddAccount(path, pk, types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("successfully added account %s to %s\n", pk.Address().String(), path)
+	},
+}
+
+var genesisAddValidatorCmd = &cobra.Command{
+	Use:   "add-validator <publicKey> <amount> <chains> <serviceURI>",
+	Short: "Add a pre-staked validator to genesis",
+	Long:  `Adds a validator with the public key, staked with <amount> uPOKT for <chains> at <serviceURI>, to the genesis file.`,
+	Args:  cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		path := getGenesisPath()
+		pk, err := crypto.NewPublicKey(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		err = app.GenesisAddValidator(path, pk, parseChains(args[2]), args[3], types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("successfully added validator %s to %s\n", pk.Address().String(), path)
+	},
+}
+
+var genesisAddAppCmd = &cobra.Command{
+	Use:   "add-app <publicKey> <amount> <chains>",
+	Short: "Add a pre-staked application to genesis",
+	Long:  `Adds an application with the public key, staked with <amount> uPOKT for <chains>, to the genesis file.`,
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		path := getGenesisPath()
+		pk, err := crypto.NewPublicKey(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		err = app.GenesisAddApp(path, pk, parseChains(args[2]), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("successfully added application %s to %s\n", pk.Address().String(), path)
+	},
+}
+
+var importValidators string
+
+var genesisImportStateCmd = &cobra.Command{
+	Use:   "import-state <exported genesis file> <chain id> [--validators <publicKey,...>]",
+	Short: "Boot a new chain from an exported state",
+	Long: `Writes to the genesis file a new chain with the chain id booting from the state exported with util export-genesis,
+e.g. a testnet forked from mainnet. The new chain starts at height 1: the outstanding claims and the liveness tracking of
+the exported chain are dropped, and the validator set is re-derived from the staked nodes. Use --validators to keep only
+the keys of the new operators in the validator set (add them first with genesis add-validator --genesis <exported genesis file>).`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		path := getGenesisPath()
+		var validators []crypto.PublicKey
+		if importValidators != "" {
+			for _, v := range strings.Split(importValidators, ",") {
+				pk, err := crypto.NewPublicKey(strings.TrimSpace(v))
+				if err != nil {
+					fmt.Println(err)
+					return
+				}
+				validators = append(validators, pk)
+			}
+		}
+		err := app.GenesisImportState(args[0], path, args[1], validators)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("successfully imported the state of %s to %s\n", args[0], path)
+	},
+}
@@ -47,8 +47,7 @@ Actions: [burn, transfer]`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		pass := app.Credentials()
+		pass := txCredentials()
 		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_transfer", args[2], int64(fees))
 		if err != nil {
 			fmt.Println(err)
@@ -91,8 +90,7 @@ Actions: [burn, transfer]`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		pass := app.Credentials()
+		pass := txCredentials()
 		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_burn", args[2], int64(fees))
 		if err != nil {
 			fmt.Println(err)
@@ -119,13 +117,12 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
-		fmt.Println("Enter Password: ")
 		fees, err := strconv.Atoi(args[4])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		res, err := ChangeParam(args[0], args[2], []byte(args[3]), app.Credentials(), args[1], int64(fees))
+		res, err := ChangeParam(args[0], args[2], []byte(args[3]), txCredentials(), args[1], int64(fees))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -165,8 +162,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := Upgrade(args[0], u, app.Credentials(), args[3], int64(fees))
+		res, err := Upgrade(args[0], u, txCredentials(), args[3], int64(fees))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -18,8 +18,13 @@ func init() {
 	nodesCmd.AddCommand(nodeStakeCmd)
 	nodesCmd.AddCommand(nodeUnstakeCmd)
 	nodesCmd.AddCommand(nodeUnjailCmd)
+	nodeStakeCmd.Flags().StringVar(&outputAddr, "output", "", "the address receiving the rewards and the unstaked tokens (default is the <fromAddr>)")
+	nodeStakeCmd.Flags().StringVar(&rewardShares, "reward-shares", "", "the percentages of the rewards routed to delegate addresses, as comma separated <address>:<percentage> (e.g. a hosting provider)")
 }
 
+// the output address and the reward shares of the staked node
+var outputAddr, rewardShares string
+
 var nodesCmd = &cobra.Command{
 	Use:   "nodes",
 	Short: "node management",
@@ -53,8 +58,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Passphrase: ")
-		res, err := StakeNode(chains, serviceURI, fromAddr, app.Credentials(), args[4], types.NewInt(int64(amount)), int64(fees))
+		res, err := StakeNode(chains, serviceURI, fromAddr, outputAddr, rewardShares, txCredentials(), args[4], types.NewInt(int64(amount)), int64(fees))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -86,8 +90,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnstakeNode(args[0], app.Credentials(), args[1], int64(fees))
+		res, err := UnstakeNode(args[0], txCredentials(), args[1], int64(fees))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -119,8 +122,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnjailNode(args[0], app.Credentials(), args[1], int64(fees))
+		res, err := UnjailNode(args[0], txCredentials(), args[1], int64(fees))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -19,29 +19,54 @@ func init() {
 	rootCmd.AddCommand(queryCmd)
 	queryCmd.AddCommand(queryBlock)
 	queryCmd.AddCommand(queryHeight)
+	queryCmd.AddCommand(queryNodeStatus)
+	queryCmd.AddCommand(queryPruningInfo)
 	queryCmd.AddCommand(queryTx)
+	queryCmd.AddCommand(queryDecodedTx)
 	queryCmd.AddCommand(queryAccountTxs)
+	queryCmd.AddCommand(queryAllAccountTxs)
 	queryCmd.AddCommand(queryBlockTxs)
 	queryCmd.AddCommand(queryNodes)
 	queryCmd.AddCommand(queryBalance)
+	queryCmd.AddCommand(queryBalances)
 	queryCmd.AddCommand(queryAccount)
+	queryCmd.AddCommand(queryAllowances)
 	queryCmd.AddCommand(queryNode)
+	queryCmd.AddCommand(queryOperatorOverview)
+	queryCmd.AddCommand(queryClaimsSummary)
+	queryCmd.AddCommand(queryClaimMaturity)
 	queryCmd.AddCommand(queryApps)
 	queryCmd.AddCommand(queryApp)
 	queryCmd.AddCommand(queryNodeParams)
+	queryCmd.AddCommand(querySessionValidators)
+	queryCmd.AddCommand(querySession)
 	queryCmd.AddCommand(queryAppParams)
+	queryCmd.AddCommand(queryAppStakeAdjustment)
+	queryCmd.AddCommand(querySimulateParamChange)
 	queryCmd.AddCommand(queryNodeReceipts)
 	queryCmd.AddCommand(queryNodeReceipt)
 	queryCmd.AddCommand(queryNodeClaims)
 	queryCmd.AddCommand(queryNodeClaim)
+	queryCmd.AddCommand(queryOpenChallenges)
+	queryCmd.AddCommand(queryChallengesAgainst)
+	queryCmd.AddCommand(queryChallengeRewards)
 	queryCmd.AddCommand(queryPocketParams)
 	queryCmd.AddCommand(queryPocketSupportedChains)
+	queryCmd.AddCommand(queryPocketSupportedChainsMetadata)
 	queryCmd.AddCommand(querySupply)
 	queryCmd.AddCommand(queryUpgrade)
 	queryCmd.AddCommand(queryACL)
+	queryCmd.AddCommand(queryParamBounds)
+	queryCmd.AddCommand(queryACLHistory)
 	queryCmd.AddCommand(queryAllParams)
 	queryCmd.AddCommand(queryParam)
 	queryCmd.AddCommand(queryDAOOwner)
+	queryCmd.AddCommand(queryDAOHistory)
+	queryCmd.AddCommand(queryEmissionSchedule)
+	queryCmd.AddCommand(queryLocalEvidence)
+	queryCmd.AddCommand(queryNodeConfig)
+	queryCmd.AddCommand(queryChainsHealth)
+	queryCmd.AddCommand(queryKeyAudit)
 }
 
 var queryCmd = &cobra.Command{
@@ -114,6 +139,28 @@ var queryTx = &cobra.Command{
 	},
 }
 
+var queryDecodedTx = &cobra.Command{
+	Use:   "decoded-tx <hash>",
+	Short: "Get the decoded transaction by the hash",
+	Long:  `Retrieves the decoded transaction by the hash, along with its result and its effective fee (the fee paid minus the fee refunded)`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		params := rpc.HashAndProveParams{Hash: args[0]}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetDecodedTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 func validatePagePerPageProveReceivedArgs(args []string) (page int, perPage int, prove bool, received bool) {
 	page = 0
 	perPage = 0
@@ -176,6 +223,40 @@ var queryAccountTxs = &cobra.Command{
 	},
 }
 
+var queryAllAccountTxs = &cobra.Command{
+	Use:   "all-account-txs <address> <page> <per_page> <prove> <order>",
+	Short: "Get the transactions sent and received by the address, paginated by page and per_page",
+	Long: `Retrieves the transactions sent and received by the address, sorted by height in <order>:
+desc (newest first, the default) or asc (oldest first)`,
+	Args: cobra.RangeArgs(1, 5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		page, perPage, prove, _ := validatePagePerPageProveReceivedArgs(args)
+		var order string
+		if len(args) == 5 {
+			order = args[4]
+		}
+		params := rpc.PaginateAddrOrderParams{
+			Address: args[0],
+			Page:    page,
+			PerPage: perPage,
+			Prove:   prove,
+			Order:   order,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetAllAccountTxsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryBlockTxs = &cobra.Command{
 	Use:   "block-txs <height> <page> <per_page> <prove>",
 	Short: "Get the transactions at a certain block height, paginated by page and per_page",
@@ -224,6 +305,36 @@ var queryHeight = &cobra.Command{
 	},
 }
 
+var queryNodeStatus = &cobra.Command{
+	Use:   "node-status",
+	Short: "Get the status of the node",
+	Long:  `Retrieves the status of the node (sync info, validator info) and whether it is an archive node keeping the full state history`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		res, err := QueryRPC(GetNodeStatusPath, []byte{})
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryPruningInfo = &cobra.Command{
+	Use:   "pruning-info",
+	Short: "Get the pruning info of the node",
+	Long:  `Retrieves the pruning strategy of the node (everything, nothing for the archive nodes, or custom) and the earliest height of its queryable state history`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		res, err := QueryRPC(GetPruningInfoPath, []byte{})
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryBalance = &cobra.Command{
 	Use:   "balance <accAddr> <height>",
 	Short: "Gets account balance",
@@ -260,6 +371,49 @@ var queryBalance = &cobra.Command{
 	},
 }
 
+var balancesDenom string
+
+func init() {
+	queryBalances.Flags().StringVar(&balancesDenom, "denom", "", "only the balance of the denomination")
+}
+
+var queryBalances = &cobra.Command{
+	Use:   "balances <accAddr> <height>",
+	Short: "Gets account balances of every denomination",
+	Long:  `Retrieves the balances of every coin denomination of the specified <accAddr> at the specified <height>, only the --denom one if set.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAddrAndDenomParams{
+			Height:  int64(height),
+			Address: args[0],
+			Denom:   balancesDenom,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetBalancesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryAccount = &cobra.Command{
 	Use:   "account <accAddr> <height>",
 	Short: "Gets an account",
@@ -296,6 +450,42 @@ var queryAccount = &cobra.Command{
 	},
 }
 
+var queryAllowances = &cobra.Command{
+	Use:   "allowances <accAddr> <height>",
+	Short: "Gets the allowances granted by an account",
+	Long:  `Retrieves the spending allowances granted by a specific address, with their remaining spend limit.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetAllowancesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var nodeStakingStatus string
 var nodeJailedStatus string
 var blockchain string
@@ -408,6 +598,114 @@ var queryNode = &cobra.Command{
 	},
 }
 
+var queryOperatorOverview = &cobra.Command{
+	Use:   "operator-overview <address> <height>",
+	Short: "Gets the operator overview of an address",
+	Long:  `Retrieves the validator state, signing info, balance, pending/mature claims, recent rewards and jail history of the address at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetOperatorOverviewPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryClaimsSummary = &cobra.Command{
+	Use:   "claims-summary <nodeAddr> <height>",
+	Short: "Gets the claims summary of a node",
+	Long:  `Retrieves the count and total relays of the pending claims, mature claims, submitted proofs and expired claims of the node at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetClaimsSummaryPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryClaimMaturity = &cobra.Command{
+	Use:   "claim-maturity <nodeAddr> <height>",
+	Short: "Gets the maturity of the claims of a node",
+	Long:  `Retrieves each claim of the node awaiting its proof, with the height it is mature (may be proved) at and the blocks until then, at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetClaimMaturityPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryNodeParams = &cobra.Command{
 	Use:   "node-params <height>",
 	Short: "Gets node parameters",
@@ -447,14 +745,15 @@ var appPage, appLimit int
 
 func init() {
 	queryApps.Flags().StringVar(&nodeStakingStatus, "staking-status", "", "the staking status of the node")
+	queryApps.Flags().StringVar(&blockchain, "blockchain", "", "the network identifier these apps are staked for")
 	queryApps.Flags().IntVar(&nodePage, "appPage", 1, "mark the page you want")
 	queryApps.Flags().IntVar(&nodeLimit, "appLimit", 10000, "reduce the amount of results")
 }
 
 var queryApps = &cobra.Command{
-	Use:   "apps --staking-status=<nodeStakingStatus> --nodePage=<nodePage> --nodeLimit=<nodeLimit> <height>",
+	Use:   "apps --staking-status=<nodeStakingStatus> --blockchain=<network id> --nodePage=<nodePage> --nodeLimit=<nodeLimit> <height>",
 	Short: "Gets apps",
-	Long:  `Retrieves the list of all applications known at the specified <height>`,
+	Long:  `Retrieves the list of all applications known at the specified <height>, the staked applications of a <network id> are indexed`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
 		var height int
@@ -571,34 +870,33 @@ var queryAppParams = &cobra.Command{
 	},
 }
 
-var queryNodeClaims = &cobra.Command{
-	Use:   "node-claims <nodeAddr> <height>",
-	Short: "Gets node pending claims for work completed",
-	Long:  `Retrieves the list of all pending proof of work submitted by <nodeAddr> at <height>.`,
-	Args:  cobra.MinimumNArgs(1),
+var queryAppStakeAdjustment = &cobra.Command{
+	Use:   "app-stake-adjustment <height>",
+	Short: "Gets the app stake adjustment",
+	Long: `Retrieves the adjustment of the app minimum stake and the base relays per POKT by the network usage at <height>,
+with the next adjustment projected with the relays settled so far in the era`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
 		var height int
-		if len(args) == 1 {
+		if len(args) == 0 {
 			height = 0 // latest
 		} else {
 			var err error
-			height, err = strconv.Atoi(args[1])
+			height, err = strconv.Atoi(args[0])
 			if err != nil {
 				fmt.Println(err)
 				return
 			}
 		}
-		params := rpc.PaginatedHeightAndAddrParams{
+		params := rpc.HeightParams{
 			Height: int64(height),
-			Addr:   args[0],
 		}
 		j, err := json.Marshal(params)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		res, err := QueryRPC(GetNodeClaimsPath, j)
+		res, err := QueryRPC(GetAppStakeAdjustmentPath, j)
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -607,43 +905,279 @@ var queryNodeClaims = &cobra.Command{
 	},
 }
 
-var queryNodeClaim = &cobra.Command{
-	Use:   "node-claim <nodeAddr> <appPubKey> <claimType> <networkId> <sessionHeight> <height>`",
-	Short: "Gets node pending claim for work completed",
-	Long:  `Gets node pending claim for verified proof of work submitted for a specific session`,
-	Args:  cobra.MinimumNArgs(5),
+var evidenceType, appPubKey string
+
+func init() {
+	queryNodeClaims.Flags().StringVar(&evidenceType, "evidence-type", "", "only the claims of the evidence type (relay or challenge)")
+	queryNodeClaims.Flags().StringVar(&blockchain, "blockchain", "", "only the claims of the network id")
+	queryNodeClaims.Flags().StringVar(&appPubKey, "app-pubkey", "", "only the claims of the app public key")
+	queryNodeReceipts.Flags().StringVar(&evidenceType, "evidence-type", "", "only the receipts of the evidence type (relay or challenge)")
+	queryNodeReceipts.Flags().StringVar(&blockchain, "blockchain", "", "only the receipts of the network id")
+	queryNodeReceipts.Flags().StringVar(&appPubKey, "app-pubkey", "", "only the receipts of the app public key")
+}
+
+var queryNodeClaims = &cobra.Command{
+	Use:   "node-claims <nodeAddr> <height>",
+	Short: "Gets node pending claims for work completed",
+	Long: `Retrieves the list of all pending proof of work submitted by <nodeAddr> at <height>.
+The claims can be filtered by --evidence-type, --blockchain and --app-pubkey.`,
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
 		var height int
-		if len(args) == 5 {
+		if len(args) == 1 {
 			height = 0 // latest
 		} else {
 			var err error
-			height, err = strconv.Atoi(args[4])
+			height, err = strconv.Atoi(args[1])
 			if err != nil {
 				fmt.Println(err)
 				return
 			}
 		}
-		sessionheight, err := strconv.Atoi(args[4])
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		params := rpc.QueryNodeReceiptParam{
-			Address:      args[0],
-			Blockchain:   args[3],
-			AppPubKey:    args[1],
-			SBlockHeight: int64(sessionheight),
+		params := rpc.PaginatedEvidenceParams{
 			Height:       int64(height),
-			ReceiptType:  args[2],
+			Addr:         args[0],
+			EvidenceType: evidenceType,
+			Blockchain:   blockchain,
+			AppPubKey:    appPubKey,
 		}
 		j, err := json.Marshal(params)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		res, err := QueryRPC(GetNodeClaimPath, j)
+		res, err := QueryRPC(GetNodeClaimsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var querySessionValidators = &cobra.Command{
+	Use:   "session-validators <sessionHeight> <chain>",
+	Short: "Gets the validators of a session",
+	Long: `Retrieves the snapshot of the staked validators and relay reward params of the session that starts at <sessionHeight>.
+If <chain> is provided only the validators staked for the chain are returned.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		sessionHeight, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		params := rpc.SessionValidatorsParams{
+			SessionHeight: int64(sessionHeight),
+		}
+		if len(args) > 1 {
+			params.Chain = args[1]
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetSessionValidatorsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var querySession = &cobra.Command{
+	Use:   "session <appPubKey> <chain> <height>",
+	Short: "Gets the session of an app",
+	Long: `Retrieves the session key, the session block height and the session nodes of the <appPubKey> for the <chain> at <height>,
+as they are dispatched (to verify the dispatch results independently).`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 2 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[2])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.SessionParams{
+			AppPubKey: args[0],
+			Chain:     args[1],
+			Height:    int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetSessionPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryOpenChallenges = &cobra.Command{
+	Use:   "open-challenges <height> <page> <per_page>",
+	Short: "Gets the open challenges",
+	Long:  `Retrieves the list of all open (pending) challenges reported by session nodes at <height>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height, page, perPage int
+		var err error
+		for i, v := range []*int{&height, &page, &perPage} {
+			if len(args) > i {
+				*v, err = strconv.Atoi(args[i])
+				if err != nil {
+					fmt.Println(err)
+					return
+				}
+			}
+		}
+		params := rpc.PaginatedHeightParams{
+			Height:  int64(height),
+			Page:    page,
+			PerPage: perPage,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetOpenChallengesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryChallengesAgainst = &cobra.Command{
+	Use:   "challenges-against <nodeAddr> <height> <page> <per_page>",
+	Short: "Gets the challenges against a node",
+	Long: `Retrieves the open challenges of the sessions <nodeAddr> is part of,
+followed by the settled challenges against <nodeAddr> at <height>.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height, page, perPage int
+		var err error
+		for i, v := range []*int{&height, &page, &perPage} {
+			if len(args) > i+1 {
+				*v, err = strconv.Atoi(args[i+1])
+				if err != nil {
+					fmt.Println(err)
+					return
+				}
+			}
+		}
+		params := rpc.PaginatedHeightAndAddrParams{
+			Height:  int64(height),
+			Addr:    args[0],
+			Page:    page,
+			PerPage: perPage,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetChallengesAgainstPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryChallengeRewards = &cobra.Command{
+	Use:   "challenge-rewards <appAddr> <height> <page> <per_page>",
+	Short: "Gets the challenge rewards of a client",
+	Long: `Retrieves the settled challenges reported by <appAddr> at <height>,
+along with the share of the burned tokens awarded for each one.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height, page, perPage int
+		var err error
+		for i, v := range []*int{&height, &page, &perPage} {
+			if len(args) > i+1 {
+				*v, err = strconv.Atoi(args[i+1])
+				if err != nil {
+					fmt.Println(err)
+					return
+				}
+			}
+		}
+		params := rpc.PaginatedHeightAndAddrParams{
+			Height:  int64(height),
+			Addr:    args[0],
+			Page:    page,
+			PerPage: perPage,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetChallengeRewardsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryNodeClaim = &cobra.Command{
+	Use:   "node-claim <nodeAddr> <appPubKey> <claimType> <networkId> <sessionHeight> <height>`",
+	Short: "Gets node pending claim for work completed",
+	Long:  `Gets node pending claim for verified proof of work submitted for a specific session`,
+	Args:  cobra.MinimumNArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 5 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[4])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		sessionheight, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		params := rpc.QueryNodeReceiptParam{
+			Address:      args[0],
+			Blockchain:   args[3],
+			AppPubKey:    args[1],
+			SBlockHeight: int64(sessionheight),
+			Height:       int64(height),
+			ReceiptType:  args[2],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetNodeClaimPath, j)
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -655,8 +1189,9 @@ var queryNodeClaim = &cobra.Command{
 var queryNodeReceipts = &cobra.Command{
 	Use:   "node-receipts <nodeAddr> <height>",
 	Short: "Gets node receipts for work completed",
-	Long:  `Retrieves the list of all verified proof of work submitted by <nodeAddr> at <height>.`,
-	Args:  cobra.MinimumNArgs(1),
+	Long: `Retrieves the list of all verified proof of work submitted by <nodeAddr> at <height>.
+The receipts can be filtered by --evidence-type, --blockchain and --app-pubkey.`,
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
 		var height int
@@ -670,9 +1205,12 @@ var queryNodeReceipts = &cobra.Command{
 				return
 			}
 		}
-		params := rpc.PaginatedHeightAndAddrParams{
-			Height: int64(height),
-			Addr:   args[0],
+		params := rpc.PaginatedEvidenceParams{
+			Height:       int64(height),
+			Addr:         args[0],
+			EvidenceType: evidenceType,
+			Blockchain:   blockchain,
+			AppPubKey:    appPubKey,
 		}
 		j, err := json.Marshal(params)
 		if err != nil {
@@ -801,6 +1339,41 @@ var queryPocketSupportedChains = &cobra.Command{
 	},
 }
 
+var queryPocketSupportedChainsMetadata = &cobra.Command{
+	Use:   "supported-networks-metadata <height>",
+	Short: "Gets pocket supported networks with their metadata",
+	Long: `Retrieves the list Network Identifiers supported by the network at the specified <height>, along with their
+name and description in the governance managed chain registry`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetSupportedChainsMetadataPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var querySupply = &cobra.Command{
 	Use:   "supply <height>",
 	Short: "Gets the supply at <height>",
@@ -869,6 +1442,118 @@ var queryDAOOwner = &cobra.Command{
 	},
 }
 
+var queryDAOHistory = &cobra.Command{
+	Use:   "dao-history <page> <per_page> <order>",
+	Short: "Gets the transfers and the burns of the dao",
+	Long: `Retrieves the transfers and the burns of the DAO coins (action, amount, sender, recipient, height and tx hash),
+sorted by height in <order>: desc (newest first, the default) or asc (oldest first)`,
+	Args: cobra.MaximumNArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		params := rpc.PaginateOrderParams{}
+		var err error
+		if len(args) > 0 {
+			if params.Page, err = strconv.Atoi(args[0]); err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		if len(args) > 1 {
+			if params.PerPage, err = strconv.Atoi(args[1]); err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		if len(args) > 2 {
+			params.Order = args[2]
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetDAOHistoryPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryACLHistory = &cobra.Command{
+	Use:   "acl-history <page> <per_page> <order>",
+	Short: "Gets the changes of the ACL and of the dao owner",
+	Long: `Retrieves the changes of the ACL and of the DAO owner (param key, value, sender, height and tx hash),
+sorted by height in <order>: desc (newest first, the default) or asc (oldest first)`,
+	Args: cobra.MaximumNArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		params := rpc.PaginateOrderParams{}
+		var err error
+		if len(args) > 0 {
+			if params.Page, err = strconv.Atoi(args[0]); err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		if len(args) > 1 {
+			if params.PerPage, err = strconv.Atoi(args[1]); err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		if len(args) > 2 {
+			params.Order = args[2]
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetACLHistoryPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryEmissionSchedule = &cobra.Command{
+	Use:   "emission-schedule <height>",
+	Short: "Gets the dao emission schedule",
+	Long:  `Retrieves the schedule of the coins minted to the DAO at <height>, with the hard cap, the total minted and the next emission`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetEmissionSchedulePath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryACL = &cobra.Command{
 	Use:   "acl <height>",
 	Short: "Gets the gov acl",
@@ -903,10 +1588,11 @@ var queryACL = &cobra.Command{
 	},
 }
 
-var queryAllParams = &cobra.Command{
-	Use:   "params <height>",
-	Short: "Gets all parameters",
-	Long:  `Retrieves the parameters at the specified <height>.`,
+var queryParamBounds = &cobra.Command{
+	Use:   "param-bounds <height>",
+	Short: "Gets the bounds of the params",
+	Long: `Retrieves the bounds (min, max and step) of the params enforced on the param changes at <height>, even the ones
+of an authorized acl key. The bounds are changed by the DAO owner only (the pocketcore/ParamBounds param)`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
 		var height int
@@ -928,7 +1614,53 @@ var queryAllParams = &cobra.Command{
 			fmt.Println(err)
 			return
 		}
-		res, err := QueryRPC(GetAllParamsPath, j)
+		res, err := QueryRPC(GetParamBoundsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var paramPrefix string
+
+func init() {
+	queryAllParams.Flags().StringVar(&paramPrefix, "prefix", "", "only the params of the module (e.g. pos) or with the acl key prefix, case insensitive")
+}
+
+var queryAllParams = &cobra.Command{
+	Use:   "params <height>",
+	Short: "Gets all parameters",
+	Long: `Retrieves the parameters at the specified <height>.
+The parameters can be scoped to a module or an acl key prefix by --prefix, sorted by key with their typed values.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		var j []byte
+		var err error
+		path := GetAllParamsPath
+		if paramPrefix != "" {
+			path = GetParamsPath
+			j, err = json.Marshal(rpc.HeightAndPrefixParams{Height: int64(height), Prefix: paramPrefix})
+		} else {
+			j, err = json.Marshal(rpc.HeightParams{Height: int64(height)})
+		}
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(path, j)
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -940,7 +1672,7 @@ var queryAllParams = &cobra.Command{
 var queryParam = &cobra.Command{
 	Use:   "param <key> <height> ",
 	Short: "Get a parameter with the given key",
-	Long:  `Retrieves the parameter at the specified <height>.`,
+	Long:  `Retrieves the parameter at the specified <height>, the <key> is matched case insensitively.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
 		var height int
@@ -972,6 +1704,51 @@ var queryParam = &cobra.Command{
 	},
 }
 
+var simulateFromAddr string
+
+func init() {
+	querySimulateParamChange.Flags().StringVar(&simulateFromAddr, "from", "", "the address submitting the change, the owner of the param in the acl if empty")
+}
+
+var querySimulateParamChange = &cobra.Command{
+	Use:   "simulate-param-change <paramKey module/param> <paramValue (jsonObj)> <height>",
+	Short: "Simulates a param change",
+	Long: `Applies the change of the param to a copy of the state at <height>, without submitting it.
+Retrieves whether the change would be applied, the param before and after and the effects on the protocol
+(e.g. the session node count or the blocks to claim and prove).`,
+	Args: cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		var height int
+		if len(args) == 2 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[2])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.SimulateParamChangesParams{
+			Height:  int64(height),
+			Address: simulateFromAddr,
+			Changes: []app.ParamChange{{Key: args[0], Value: json.RawMessage(args[1])}},
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetSimulateParamChangesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryUpgrade = &cobra.Command{
 	Use:   "upgrade <height>",
 	Short: "Gets the latest gov upgrade",
@@ -1005,3 +1782,95 @@ var queryUpgrade = &cobra.Command{
 		fmt.Println(res)
 	},
 }
+
+var queryNodeConfig = &cobra.Command{
+	Use:   "node-config",
+	Short: "Gets the runtime configuration of this node",
+	Long: `Retrieves the effective runtime configuration of this node: role, listeners, pruning, hosted chains and config,
+with the secrets redacted. Authenticated with the auth token in the config directory.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		res, err := QuerySecuredRPC(GetNodeConfigPath, []byte{}, app.GetAuthToken())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryLocalEvidence = &cobra.Command{
+	Use:   "local-evidence",
+	Short: "Gets the unclaimed evidence of this node",
+	Long: `Retrieves a summary (per application/chain: number of proofs, first/last proof time) of the evidence
+recorded by this node that has not been claimed/proven yet. Authenticated with the auth token in the config directory.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		res, err := QuerySecuredRPC(GetLocalEvidencePath, []byte{}, app.GetAuthToken())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryChainsHealth = &cobra.Command{
+	Use:   "chains-health",
+	Short: "Gets the health of the hosted chains of this node",
+	Long: `Retrieves the latest health check (healthy, height, latency, error) of every chain hosted by this node and of
+every chain it is staked for. Authenticated with the auth token in the config directory.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		res, err := QuerySecuredRPC(GetChainsHealthPath, []byte{}, app.GetAuthToken())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryKeyAudit = &cobra.Command{
+	Use:   "key-audit <page> <per_page> <order>",
+	Short: "Gets the uses of the node and account keys",
+	Long: `Retrieves the transactions signed with the node key or an account key (time, key, address, message type, tx hash,
+source: the auto claims, proofs and restakes of the node or the cli) recorded in the key audit log of this node, sorted
+by time in <order>: desc (newest first, the default) or asc. Authenticated with the auth token in the config directory.`,
+	Args: cobra.MaximumNArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		params := rpc.PaginateOrderParams{}
+		var err error
+		if len(args) > 0 {
+			if params.Page, err = strconv.Atoi(args[0]); err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		if len(args) > 1 {
+			if params.PerPage, err = strconv.Atoi(args[1]); err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		if len(args) > 2 {
+			params.Order = args[2]
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(GetKeyAuditPath, j, app.GetAuthToken())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
@@ -14,31 +14,65 @@ import (
 
 var (
 	SendRawTxPath,
+	GetDispatchPath,
+	GetRelayPath,
 	GetNodePath,
+	GetOperatorOverviewPath,
+	GetClaimsSummaryPath,
+	GetClaimMaturityPath,
 	GetACLPath,
+	GetACLHistoryPath,
+	GetParamBoundsPath,
 	GetUpgradePath,
 	GetDAOOwnerPath,
+	GetDAOHistoryPath,
+	GetEmissionSchedulePath,
 	GetHeightPath,
+	GetNodeStatusPath,
+	GetPruningInfoPath,
+	GetStatePath,
 	GetAccountPath,
+	GetAllowancesPath,
 	GetAppPath,
 	GetTxPath,
+	GetDecodedTxPath,
 	GetBlockPath,
 	GetSupportedChainsPath,
+	GetSupportedChainsMetadataPath,
 	GetBalancePath,
+	GetBalancesPath,
 	GetAccountTxsPath,
+	GetAllAccountTxsPath,
 	GetNodeParamsPath,
+	GetSessionValidatorsPath,
+	GetSessionPath,
 	GetNodesPath,
 	GetAppsPath,
 	GetAppParamsPath,
+	GetAppStakeAdjustmentPath,
 	GetPocketParamsPath,
 	GetNodeReceiptPath,
 	GetNodeReceiptsPath,
 	GetNodeClaimsPath,
 	GetNodeClaimPath,
+	GetOpenChallengesPath,
+	GetChallengesAgainstPath,
+	GetChallengeRewardsPath,
 	GetBlockTxsPath,
 	GetSupplyPath,
 	GetAllParamsPath,
-	GetParamPath string
+	GetParamPath,
+	GetParamsPath,
+	GetSimulateParamChangesPath,
+	GetLocalEvidencePath,
+	GetNodeConfigPath,
+	GetChainsHealthPath,
+	GetKeyAuditPath,
+	GetMigrationsDryRunPath,
+	GetBlocklistPath string
+	GetSessionWebhooksPath string
+	GetScheduledTxsPath    string
+	GetReplayEventsPath    string
 )
 
 func init() {
@@ -47,30 +81,64 @@ func init() {
 		switch route.Name {
 		case "SendRawTx":
 			SendRawTxPath = route.Path
+		case "HandleDispatch":
+			GetDispatchPath = route.Path
+		case "Service":
+			GetRelayPath = route.Path
 		case "QueryNode":
 			GetNodePath = route.Path
+		case "QueryOperatorOverview":
+			GetOperatorOverviewPath = route.Path
+		case "QueryClaimsSummary":
+			GetClaimsSummaryPath = route.Path
+		case "QueryClaimMaturity":
+			GetClaimMaturityPath = route.Path
 		case "QueryACL":
 			GetACLPath = route.Path
+		case "QueryACLHistory":
+			GetACLHistoryPath = route.Path
+		case "QueryParamBounds":
+			GetParamBoundsPath = route.Path
 		case "QueryUpgrade":
 			GetUpgradePath = route.Path
 		case "QueryDAO":
 			GetDAOOwnerPath = route.Path
+		case "QueryDAOHistory":
+			GetDAOHistoryPath = route.Path
+		case "QueryEmissionSchedule":
+			GetEmissionSchedulePath = route.Path
 		case "QueryHeight":
 			GetHeightPath = route.Path
+		case "QueryNodeStatus":
+			GetNodeStatusPath = route.Path
+		case "QueryPruningInfo":
+			GetPruningInfoPath = route.Path
+		case "QueryState":
+			GetStatePath = route.Path
 		case "QueryAccount":
 			GetAccountPath = route.Path
+		case "QueryAllowances":
+			GetAllowancesPath = route.Path
 		case "QueryApp":
 			GetAppPath = route.Path
 		case "QueryTX":
 			GetTxPath = route.Path
+		case "QueryDecodedTX":
+			GetDecodedTxPath = route.Path
 		case "QueryBlock":
 			GetBlockPath = route.Path
 		case "QuerySupportedChains":
 			GetSupportedChainsPath = route.Path
+		case "QuerySupportedChainsMetadata":
+			GetSupportedChainsMetadataPath = route.Path
 		case "QueryBalance":
 			GetBalancePath = route.Path
+		case "QueryBalances":
+			GetBalancesPath = route.Path
 		case "QueryAccountTxs":
 			GetAccountTxsPath = route.Path
+		case "QueryAllAccountTxs":
+			GetAllAccountTxsPath = route.Path
 		case "QueryNodeParams":
 			GetNodeParamsPath = route.Path
 		case "QueryNodes":
@@ -79,6 +147,8 @@ func init() {
 			GetAppsPath = route.Path
 		case "QueryAppParams":
 			GetAppParamsPath = route.Path
+		case "QueryAppStakeAdjustment":
+			GetAppStakeAdjustmentPath = route.Path
 		case "QueryPocketParams":
 			GetPocketParamsPath = route.Path
 		case "QueryNodeReceipt":
@@ -93,10 +163,42 @@ func init() {
 			GetNodeClaimPath = route.Path
 		case "QueryNodeClaims":
 			GetNodeClaimsPath = route.Path
+		case "QuerySessionValidators":
+			GetSessionValidatorsPath = route.Path
+		case "QuerySession":
+			GetSessionPath = route.Path
+		case "QueryOpenChallenges":
+			GetOpenChallengesPath = route.Path
+		case "QueryChallengesAgainst":
+			GetChallengesAgainstPath = route.Path
+		case "QueryChallengeRewards":
+			GetChallengeRewardsPath = route.Path
 		case "QueryAllParams":
 			GetAllParamsPath = route.Path
 		case "QueryParam":
 			GetParamPath = route.Path
+		case "QueryParams":
+			GetParamsPath = route.Path
+		case "QuerySimulateParamChanges":
+			GetSimulateParamChangesPath = route.Path
+		case "LocalEvidence":
+			GetLocalEvidencePath = route.Path
+		case "NodeConfig":
+			GetNodeConfigPath = route.Path
+		case "ChainsHealth":
+			GetChainsHealthPath = route.Path
+		case "KeyAudit":
+			GetKeyAuditPath = route.Path
+		case "MigrationsDryRun":
+			GetMigrationsDryRunPath = route.Path
+		case "Blocklist":
+			GetBlocklistPath = route.Path
+		case "SessionWebhooks":
+			GetSessionWebhooksPath = route.Path
+		case "ScheduledTxs":
+			GetScheduledTxsPath = route.Path
+		case "ReplayEvents":
+			GetReplayEventsPath = route.Path
 		default:
 			continue
 		}
@@ -104,6 +206,15 @@ func init() {
 }
 
 func QueryRPC(path string, jsonArgs []byte) (string, error) {
+	return queryRPC(path, jsonArgs, "")
+}
+
+// "QuerySecuredRPC" - Queries a private rpc route, authenticating with the auth token of the node
+func QuerySecuredRPC(path string, jsonArgs []byte, token app.AuthToken) (string, error) {
+	return queryRPC(path, jsonArgs, token.Value)
+}
+
+func queryRPC(path string, jsonArgs []byte, authToken string) (string, error) {
 	//cliURL := app.GlobalConfig.PocketConfig.RemoteCLIURL + ":" + app.GlobalConfig.PocketConfig.RPCPort + path
 	cliURL := app.GlobalConfig.PocketConfig.RemoteCLIURL + path
 	fmt.Println(cliURL)
@@ -112,6 +223,9 @@ func QueryRPC(path string, jsonArgs []byte) (string, error) {
 		return "", err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if authToken != "" {
+		req.Header.Set(rpc.AuthHeader, authToken)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/app/cmd/rpc"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/spf13/cobra"
 )
 
@@ -25,6 +26,7 @@ var (
 	testnet         bool
 	simulateRelay   bool
 	keybase         bool
+	genesisHash     string
 )
 
 var CLIVersion = app.AppVersion
@@ -65,6 +67,9 @@ func init() {
 	rootCmd.AddCommand(startCmd)
 	rootCmd.AddCommand(resetCmd)
 	rootCmd.AddCommand(version)
+	doctorCmd.Flags().StringVar(&genesisHash, "genesis-hash", "", "the expected sha256 (hex) of the genesis file of the network")
+	rootCmd.AddCommand(doctorCmd)
+	rootCmd.AddCommand(superviseCmd)
 }
 
 // startCmd represents the start command
@@ -75,6 +80,10 @@ var startCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		tmNode := app.InitApp(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL, keybase)
 		go rpc.StartRPC(app.GlobalConfig.PocketConfig.RPCPort, simulateRelay)
+		go app.ServiceURLSelfCheck()
+		go app.StartStreamPublisher()
+		go pocketTypes.StartEvidenceReplication()
+		go app.StartChainHealthChecker()
 		// trap kill signals (2,3,15,9)
 		signalChannel := make(chan os.Signal, 1)
 		signal.Notify(signalChannel,
@@ -115,3 +124,41 @@ var version = &cobra.Command{
 		fmt.Printf("AppVersion: %s\n", CLIVersion)
 	},
 }
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor --genesis-hash=<hash>",
+	Short: "Runs the preflight checks of the node",
+	Long: `Validates the config, chains and genesis files, the key files permissions, the ports availability, the disk space
+and the reachability of the chains of the node in the <datadir>, without starting it.
+Example output:
+	[ok]   config: /home/pocket/.pocket/config/config.json
+	[fail] ports: cannot listen on the p2p address 0.0.0.0:26656 (in use by another process?)`,
+	Run: func(cmd *cobra.Command, args []string) {
+		findings := app.Doctor(datadir, genesisHash)
+		for _, f := range findings {
+			fmt.Printf("%-6s %s: %s\n", "["+f.Status+"]", f.Check, f.Detail)
+		}
+		if app.DoctorFailed(findings) {
+			os.Exit(1)
+		}
+	},
+}
+
+var superviseCmd = &cobra.Command{
+	Use:   "supervise [-- <start flags>]",
+	Short: "starts pocket-core under the upgrade supervisor",
+	Long: `Starts the Pocket node in the <datadir> as a child process and restarts it with the binary of the upgrade when it
+halts at the gov upgrade height. The binaries are read from <datadir>/upgrades/<version>/bin/pocket, or downloaded ahead
+of the upgrade height from the upgrade_url_template of the config (validated against the <url>.sha256 checksum).
+The flags after -- are passed to the start command, e.g.: pocket supervise --datadir /pocket -- --simulateRelay`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		supervisor := app.NewSupervisor(app.GlobalConfig.PocketConfig.DataDir, app.GlobalConfig.PocketConfig.UpgradeURLTemplate)
+		stop := make(chan struct{})
+		go supervisor.Watch("http://localhost:"+app.GlobalConfig.PocketConfig.RPCPort, app.DefaultUpgradeWatchInterval, stop)
+		startArgs := append([]string{"start", "--datadir", app.GlobalConfig.PocketConfig.DataDir}, args...)
+		code := supervisor.Run(startArgs, os.Stdout, os.Stderr)
+		close(stop)
+		os.Exit(code)
+	},
+}
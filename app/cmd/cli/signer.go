@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/pokt-network/posmint/crypto/keys"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// the external signer command (--signer), the keybase signs the transactions when empty
+var signerCmd string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&signerCmd, "signer", "", `an external command signing the transactions instead of the keybase (e.g. a hardware wallet bridge), without the passphrase:
+'<signer> pubkey <address>' prints the hex public key of the address and '<signer> sign <address>' reads the hex sign bytes of the transaction on stdin and prints their hex signature`)
+}
+
+// TxSigner - The backend signing the transactions built by the cli
+type TxSigner interface {
+	// returns the public key of the address
+	PublicKey(addr sdk.Address) (crypto.PublicKey, error)
+	// signs the sign bytes with the key of the address
+	Sign(addr sdk.Address, signBytes []byte) ([]byte, crypto.PublicKey, error)
+}
+
+// returns the external signer if any, the keybase signer with the passphrase otherwise
+func newTxSigner(passphrase string) (TxSigner, error) {
+	if signerCmd != "" {
+		return externalSigner{command: strings.Fields(signerCmd)}, nil
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	return keybaseSigner{kb: kb, passphrase: passphrase}, nil
+}
+
+// prompts for the passphrase of the keybase, unless the transactions are signed by an external signer
+func txCredentials() string {
+	if signerCmd != "" {
+		return ""
+	}
+	fmt.Println("Enter Passphrase: ")
+	return app.Credentials()
+}
+
+// signs with the keys of the keybase
+type keybaseSigner struct {
+	kb         keys.Keybase
+	passphrase string
+}
+
+func (s keybaseSigner) PublicKey(addr sdk.Address) (crypto.PublicKey, error) {
+	kp, err := s.kb.Get(addr)
+	if err != nil {
+		return nil, err
+	}
+	return kp.PublicKey, nil
+}
+
+func (s keybaseSigner) Sign(addr sdk.Address, signBytes []byte) ([]byte, crypto.PublicKey, error) {
+	return s.kb.Sign(addr, s.passphrase, signBytes)
+}
+
+// signs with an external command, the private key never leaves the signer (e.g. a hardware wallet)
+type externalSigner struct {
+	command []string
+}
+
+func (s externalSigner) PublicKey(addr sdk.Address) (crypto.PublicKey, error) {
+	out, err := s.run(nil, "pubkey", addr.String())
+	if err != nil {
+		return nil, err
+	}
+	pk, err := crypto.NewPublicKey(out)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key from the signer: %s", err.Error())
+	}
+	if !bytes.Equal(pk.Address(), addr) {
+		return nil, fmt.Errorf("the public key from the signer is not the key of %s", addr.String())
+	}
+	return pk, nil
+}
+
+func (s externalSigner) Sign(addr sdk.Address, signBytes []byte) ([]byte, crypto.PublicKey, error) {
+	pk, err := s.PublicKey(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err := s.run([]byte(hex.EncodeToString(signBytes)), "sign", addr.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	sig, err := hex.DecodeString(out)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid signature from the signer: %s", err.Error())
+	}
+	// the signature is rejected by the chain anyway, report it here
+	if !pk.VerifyBytes(signBytes, sig) {
+		return nil, nil, fmt.Errorf("the signature from the signer doesn't match the transaction")
+	}
+	return sig, pk, nil
+}
+
+// runs the signer with the args, returning its trimmed output
+func (s externalSigner) run(stdin []byte, args ...string) (string, error) {
+	cmd := exec.Command(s.command[0], append(s.command[1:], args...)...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stderr = os.Stderr // the device prompts
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("the signer %s %s failed: %s", s.command[0], args[0], err.Error())
+	}
+	return strings.TrimSpace(string(out)), nil
+}
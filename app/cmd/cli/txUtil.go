@@ -10,29 +10,39 @@ import (
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/codec"
-	"github.com/pokt-network/posmint/crypto/keys"
+	"github.com/pokt-network/posmint/crypto"
 	//"github.com/pokt-network/posmint/crypto/keys/mintkey"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/auth"
 	authTypes "github.com/pokt-network/posmint/x/auth/types"
 	govTypes "github.com/pokt-network/posmint/x/gov/types"
 	"github.com/tendermint/tendermint/libs/common"
+	"strconv"
+	"strings"
+	"time"
 )
 
-// SendTransaction - Deliver Transaction to node
-func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.Int, fees int64) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+// SendTransaction - Deliver Transaction to node, signed by the spender key with an allowance of fromAddr if not empty
+func SendTransaction(fromAddr, toAddr, spenderAddr, passphrase, chainID string, amount sdk.Int, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	ta, err := sdk.AddressFromHex(toAddr)
+	signerAddr := fa
+	if spenderAddr != "" {
+		signerAddr, err = pocketTypes.ParseAddress(spenderAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ta, err := pocketTypes.ParseAddress(toAddr)
 	if err != nil {
 		return nil, err
 	}
 	if amount.LTE(sdk.ZeroInt()) {
 		return nil, sdk.ErrInternal("must send above 0")
 	}
-	kb, err := app.GetKeybase()
+	signer, err := newTxSigner(passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +55,75 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.In
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees)
+	txBz, err := newTxBz(app.Codec(), msg, signerAddr, chainID, signer, fees)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// GrantAllowance - Deliver a grant allowance message, the spender may spend up to spendLimit (fees and sends) signing
+// the msgs of fromAddr until the expiration
+func GrantAllowance(fromAddr, spenderAddr, passphrase, chainID string, spendLimit sdk.Int, expiration time.Time, msgs []string, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := pocketTypes.ParseAddress(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	sa, err := pocketTypes.ParseAddress(spenderAddr)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := newTxSigner(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	msg := nodeTypes.MsgGrantAllowance{
+		Granter:    fa,
+		Spender:    sa,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+		Msgs:       msgs,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// RevokeAllowance - Deliver a revoke allowance message
+func RevokeAllowance(fromAddr, spenderAddr, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := pocketTypes.ParseAddress(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	sa, err := pocketTypes.ParseAddress(spenderAddr)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := newTxSigner(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	msg := nodeTypes.MsgRevokeAllowance{
+		Granter: fa,
+		Spender: sa,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -56,16 +134,16 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.In
 }
 
 // StakeNode - Deliver Stake message to node
-func StakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID string, amount sdk.Int, fees int64) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+func StakeNode(chains []string, serviceURL, fromAddr, outputAddr, rewardShares, passphrase, chainID string, amount sdk.Int, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	signer, err := newTxSigner(passphrase)
 	if err != nil {
 		return nil, err
 	}
-	kp, err := kb.Get(fa)
+	pk, err := signer.PublicKey(fa)
 	if err != nil {
 		return nil, err
 	}
@@ -82,17 +160,30 @@ func StakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID string
 	if err != nil {
 		return nil, err
 	}
+	var output sdk.Address
+	if outputAddr != "" {
+		output, err = pocketTypes.ParseAddress(outputAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	shares, err := parseRewardShares(rewardShares)
+	if err != nil {
+		return nil, err
+	}
 	msg := nodeTypes.MsgStake{
-		PublicKey:  kp.PublicKey,
-		Chains:     chains,
-		Value:      amount,
-		ServiceURL: serviceURL,
+		PublicKey:     pk,
+		Chains:        chains,
+		Value:         amount,
+		ServiceURL:    serviceURL,
+		OutputAddress: output,
+		RewardShares:  shares,
 	}
 	err = msg.ValidateBasic()
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -104,14 +195,14 @@ func StakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID string
 
 // UnstakeNode - start unstaking message to node
 func UnstakeNode(fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
 	msg := nodeTypes.MsgBeginUnstake{
 		Address: fa,
 	}
-	kb, err := app.GetKeybase()
+	signer, err := newTxSigner(passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +210,7 @@ func UnstakeNode(fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRaw
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -131,14 +222,14 @@ func UnstakeNode(fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRaw
 
 // UnjailNode - Remove node from jail
 func UnjailNode(fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
 	msg := nodeTypes.MsgUnjail{
 		ValidatorAddr: fa,
 	}
-	kb, err := app.GetKeybase()
+	signer, err := newTxSigner(passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +237,7 @@ func UnjailNode(fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRawT
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -157,15 +248,15 @@ func UnjailNode(fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRawT
 }
 
 func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.Int, fees int64) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	signer, err := newTxSigner(passphrase)
 	if err != nil {
 		return nil, err
 	}
-	kp, err := kb.Get(fa)
+	pk, err := signer.PublicKey(fa)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +271,7 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 		return nil, sdk.ErrInternal("must stake above zero")
 	}
 	msg := appsType.MsgAppStake{
-		PubKey: kp.PublicKey,
+		PubKey: pk,
 		Chains: chains,
 		Value:  amount,
 	}
@@ -188,7 +279,7 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -199,11 +290,11 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 }
 
 func UnstakeApp(fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	signer, err := newTxSigner(passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +305,69 @@ func UnstakeApp(fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRawT
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func DelegateGateway(fromAddr, gatewayPubKey, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := pocketTypes.ParseAddress(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	gpk, err := crypto.NewPublicKey(gatewayPubKey)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := newTxSigner(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	msg := appsType.MsgAppDelegateGateway{
+		AppAddr:       fa,
+		GatewayPubKey: gpk,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func RevokeGateway(fromAddr, gatewayPubKey, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := pocketTypes.ParseAddress(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	gpk, err := crypto.NewPublicKey(gatewayPubKey)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := newTxSigner(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	msg := appsType.MsgAppRevokeGateway{
+		AppAddr:       fa,
+		GatewayPubKey: gpk,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -225,15 +378,15 @@ func UnstakeApp(fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRawT
 }
 
 func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.Int, action, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	ta, err := sdk.AddressFromHex(toAddr)
+	ta, err := pocketTypes.ParseAddress(toAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	signer, err := newTxSigner(passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +400,7 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.Int, action, chainID
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -258,11 +411,11 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.Int, action, chainID
 }
 
 func ChangeParam(fromAddr, paramACLKey string, paramValue []byte, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	signer, err := newTxSigner(passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -282,7 +435,7 @@ func ChangeParam(fromAddr, paramACLKey string, paramValue []byte, passphrase, ch
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -293,11 +446,11 @@ func ChangeParam(fromAddr, paramACLKey string, paramValue []byte, passphrase, ch
 }
 
 func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	signer, err := newTxSigner(passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -309,7 +462,7 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, signer, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -319,7 +472,7 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	}, nil
 }
 
-func newTxBz(cdc *codec.Codec, msg sdk.Msg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64) (transactionBz []byte, err error) {
+func newTxBz(cdc *codec.Codec, msg sdk.Msg, fromAddr sdk.Address, chainID string, signer TxSigner, fee int64) (transactionBz []byte, err error) {
 	// fees
 	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee)))
 	// entroyp
@@ -328,11 +481,37 @@ func newTxBz(cdc *codec.Codec, msg sdk.Msg, fromAddr sdk.Address, chainID string
 	if err != nil {
 		return nil, err
 	}
-	sig, pubKey, err := keybase.Sign(fromAddr, passphrase, signBytes)
+	sig, pubKey, err := signer.Sign(fromAddr, signBytes)
 	if err != nil {
+		app.RecordCLIKeyUse(fromAddr, msg.Type(), nil, err)
 		return nil, err
 	}
 	s := authTypes.StdSignature{PublicKey: pubKey, Signature: sig}
 	tx := authTypes.NewStdTx(msg, fees, s, "", entropy)
-	return auth.DefaultTxEncoder(cdc)(tx)
+	transactionBz, err = auth.DefaultTxEncoder(cdc)(tx)
+	app.RecordCLIKeyUse(fromAddr, msg.Type(), transactionBz, err)
+	return transactionBz, err
+}
+
+// parseRewardShares - Parses the comma separated <address>:<percentage> reward shares of a node
+func parseRewardShares(s string) (shares []nodeTypes.RewardShare, err error) {
+	if s == "" {
+		return nil, nil
+	}
+	for _, share := range strings.Split(s, ",") {
+		parts := strings.Split(share, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid reward share %s, expected <address>:<percentage>", share)
+		}
+		addr, err := pocketTypes.ParseAddress(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		percentage, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, nodeTypes.RewardShare{Address: addr, Percentage: percentage})
+	}
+	return shares, nil
 }
@@ -1,8 +1,16 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/spf13/cobra"
 )
 
@@ -10,6 +18,37 @@ func init() {
 	rootCmd.AddCommand(utilCmd)
 	utilCmd.AddCommand(chainsGenCmd)
 	utilCmd.AddCommand(chainsDelCmd)
+	utilCmd.AddCommand(relayBenchCmd)
+	utilCmd.AddCommand(migrationsDryRunCmd)
+	utilCmd.AddCommand(blocklistCmd)
+	utilCmd.AddCommand(sessionWebhooksCmd)
+	utilCmd.AddCommand(scheduledTxsCmd)
+	utilCmd.AddCommand(generateRoleTokenCmd)
+	utilCmd.AddCommand(convertAddressCmd)
+	utilCmd.AddCommand(generateGenesisCmd)
+	utilCmd.AddCommand(privValValidateCmd)
+	utilCmd.AddCommand(privValMigrateCmd)
+	utilCmd.AddCommand(privValExportRawCmd)
+	utilCmd.AddCommand(replayCmd)
+	utilCmd.AddCommand(replayEventsCmd)
+	utilCmd.AddCommand(exportGenesisCmd)
+	exportGenesisCmd.Flags().Int64Var(&exportHeight, "height", 0, "the height of the exported state (the latest by default)")
+	exportGenesisCmd.Flags().StringVar(&exportChainID, "chain-id", "", "the chain id of the genesis (the chain id of the network by default)")
+	replayCmd.Flags().Int64Var(&replayFrom, "from", 0, "the first block to replay")
+	replayCmd.Flags().Int64Var(&replayTo, "to", 0, "the last block to replay (the --from block by default)")
+	replayEventsCmd.Flags().Int64Var(&replayEventsFrom, "from", 1, "the first block whose events are replayed")
+	replayEventsCmd.Flags().Int64Var(&replayEventsTo, "to", 0, "the last block whose events are replayed (the --from block by default)")
+	replayEventsCmd.Flags().StringVar(&replayEventsSink, "sink", app.StreamSinkStdout, "the sink of the events: stdout, webhook, kafka-rest or nats")
+	replayEventsCmd.Flags().StringVar(&replayEventsURL, "url", "", "the url of the sink (unused by stdout)")
+	replayEventsCmd.Flags().StringVar(&replayEventsTopic, "topic", app.DefaultStreamTopic, "the topic/subject of the events")
+	privValMigrateCmd.Flags().StringVar(&privValSourceState, "state", "", "the sign state file of a tendermint source key file")
+	privValMigrateCmd.Flags().BoolVar(&privValResetState, "reset-state", false, "start from an empty sign state when the source has none (only for a new chain or a key that never signed)")
+	privValMigrateCmd.Flags().BoolVar(&privValDryRun, "dry-run", false, "validate and report the migration without writing anything")
+	relayBenchCmd.Flags().IntVar(&relayBenchRate, "rate", app.DefaultRelayBenchRate, "the relays sent per second")
+	relayBenchCmd.Flags().Int64Var(&relayBenchDuration, "duration", app.DefaultRelayBenchDuration, "the milliseconds of the bench")
+	relayBenchCmd.Flags().IntVar(&relayBenchConcurrency, "concurrency", app.DefaultRelayBenchConcurrency, "the max relays in flight")
+	relayBenchCmd.Flags().StringVar(&relayBenchBackend, "backend", "", "the listen address of a fake chain backend (e.g. 127.0.0.1:8082), the url of the chain in the chains file of the node")
+	relayBenchCmd.Flags().StringVar(&relayBenchServicer, "servicer", "", "the public key of the node (the key file of the data directory by default)")
 }
 
 var utilCmd = &cobra.Command{
@@ -43,3 +82,505 @@ var chainsDelCmd = &cobra.Command{
 		fmt.Println("successfully deleted " + app.GlobalConfig.PocketConfig.ChainsName)
 	},
 }
+
+var (
+	relayBenchRate        int
+	relayBenchDuration    int64
+	relayBenchConcurrency int
+	relayBenchBackend     string
+	relayBenchServicer    string
+)
+
+var relayBenchCmd = &cobra.Command{
+	Use:   "relay-bench <appAddr> <chainID> [--rate <relays/s>] [--duration <ms>] [--concurrency <n>] [--backend <address>]",
+	Short: "Stress tests the relays of the running node",
+	Long: `Sends signed synthetic relays of <appAddr> (a valid aat, the app being its own client) for <chainID> to the running
+node at --rate relays per second for --duration milliseconds, with at most --concurrency relays in flight, then reports the
+throughput, the latency percentiles and the growth of the evidence store (the relay proofs of the app). With --backend, the
+bench serves a fake chain answering every relay at the address, which the chain must point to in the chains file of the
+node. The node must be in the current session of the app for the chain. For capacity planning and regression testing on a
+local or test network. Prompts the user for the <appAddr> account passphrase.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		kb := app.MustGetKeybase()
+		if kb == nil {
+			fmt.Println(app.UninitializedKeybaseError)
+			return
+		}
+		addr, err := types.ParseAddress(args[0])
+		if err != nil {
+			fmt.Printf("Address Error %s", err)
+			return
+		}
+		servicer := relayBenchServicer
+		if servicer == "" {
+			if servicer, err = app.NodePublicKey(); err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		fmt.Println("Enter passphrase: ")
+		pk, err := kb.ExportPrivateKeyObject(addr, app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		nodeURL := app.GlobalConfig.PocketConfig.RemoteCLIURL
+		report, err := app.RelayBench(pk, servicer, app.RelayBenchConfig{
+			DispatchURL: nodeURL + GetDispatchPath,
+			RelayURL:    nodeURL + GetRelayPath,
+			EvidenceURL: nodeURL + GetLocalEvidencePath,
+			AuthToken:   app.GetAuthToken().Value,
+			Chain:       args[1],
+			Rate:        relayBenchRate,
+			Duration:    relayBenchDuration,
+			Concurrency: relayBenchConcurrency,
+			Backend:     relayBenchBackend,
+		})
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(j))
+	},
+}
+
+var generateRoleTokenCmd = &cobra.Command{
+	Use:   "generate-role-token <name> <role>...",
+	Short: "Generates a token restricted to some of the private rpc routes",
+	Long: fmt.Sprintf(`Generates a token named <name> with the roles (any of %s) and adds it to the role tokens file of the config
+directory, e.g. a read only token for the monitoring systems. The auth token of the node has all of the roles. The running
+node must be restarted to accept the new token.`, strings.Join(app.AuthRoles, ", ")),
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		token, err := app.GenerateRoleToken(args[0], args[1:])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Role token %s (%s): %s\n", token.Name, strings.Join(token.Roles, ", "), token.Value)
+	},
+}
+
+var sessionWebhooksCmd = &cobra.Command{
+	Use:   "session-webhooks [register|unregister] [<appPubKey> <url>]",
+	Short: "Gets or updates the local session webhooks",
+	Long: `Retrieves the callback urls notified by the running node when a new session of their app includes it, after
+registering/unregistering <url> for <appPubKey> if an action is given (unregistering without <url> removes all of the urls
+of the app). The notification (session header, session nodes and servicer) is posted as json on the session block. The
+webhooks are lost on restart. Authenticated with the auth token in the config directory.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 || (args[0] == "unregister" && len(args) == 2) || len(args) == 3 {
+			return nil
+		}
+		return fmt.Errorf("expected no arguments or an action, an app public key and a url")
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		var params types.SessionWebhooksUpdate
+		if len(args) != 0 {
+			hook := types.SessionWebhook{ApplicationPubKey: args[1]}
+			if len(args) == 3 {
+				hook.URL = args[2]
+			}
+			switch args[0] {
+			case "register":
+				params.Register = []types.SessionWebhook{hook}
+			case "unregister":
+				params.Unregister = []types.SessionWebhook{hook}
+			default:
+				fmt.Println("unknown action " + args[0] + ", expected register or unregister")
+				return
+			}
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(GetSessionWebhooksPath, j, app.GetAuthToken())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var scheduledTxsCmd = &cobra.Command{
+	Use:   "scheduled-txs [schedule <height> <signedTxHex>|cancel <txHash>]",
+	Short: "Gets or updates the transactions scheduled for a future height",
+	Long: `Retrieves the signed transactions the running node broadcasts once the chain reaches their height (e.g. an unstake
+or a param change), after scheduling <signedTxHex> (the output of an offline signing) at <height> or cancelling the
+scheduled transaction <txHash> if an action is given. A transaction that can't be broadcast is re-attempted at the next
+block. The schedule is local (not part of the protocol) and persisted across restarts. Authenticated with the auth token
+in the config directory.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 || (args[0] == "cancel" && len(args) == 2) || (args[0] == "schedule" && len(args) == 3) {
+			return nil
+		}
+		return fmt.Errorf("expected no arguments, schedule <height> <signedTxHex> or cancel <txHash>")
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		var params types.ScheduledTxsUpdate
+		if len(args) != 0 {
+			switch args[0] {
+			case "schedule":
+				height, err := strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					fmt.Println("invalid height " + args[1])
+					return
+				}
+				params.Schedule = []types.ScheduledTx{{Height: height, Tx: args[2]}}
+			case "cancel":
+				params.Cancel = []string{args[1]}
+			}
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(GetScheduledTxsPath, j, app.GetAuthToken())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var generateGenesisCmd = &cobra.Command{
+	Use:   "generate-genesis <template.yaml> <genesis.json>",
+	Short: "Generates a genesis file from a template of the economics",
+	Long: `Generates a genesis file with every module param populated from a yaml template of the intended economics,
+the params not in the template keep their defaults. The params are cross validated and validated against the module
+genesis logic. Add the accounts, validators and applications with the genesis commands. Example template:
+	chain_id: pocket-testnet
+	dao_owner: 4920ce1d787c60e2eaeff366c79e8aa2b82525f1
+	session_blocks: 4
+	session_node_count: 5
+	supported_chains: ["0001", "0021"]
+	relays_to_tokens_multiplier: 1000
+	dao_allocation: 10
+	proposer_allocation: 1
+	node_stake_minimum: 15000000000
+	app_stake_minimum: 1000000
+	node_unstaking_time: 504h`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := app.GenerateGenesisFile(args[0], args[1]); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("successfully generated %s\n", args[1])
+	},
+}
+
+var convertAddressCmd = &cobra.Command{
+	Use:   "convert-address <address>",
+	Short: "Converts an address between the hex and bech32 formats",
+	Long: `Converts a hex address to the bech32 format and a bech32 address to the hex format.
+Example output:
+	pokt13cwsnmp73vhwrw8yu0x72tcmfsdju6nsruusux`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		res, err := types.ConvertAddress(args[0])
+		if err != nil {
+			fmt.Printf("Address Error %s", err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var migrationsDryRunCmd = &cobra.Command{
+	Use:   "migrations-dry-run <height>",
+	Short: "Dry run the pending store migrations",
+	Long: `Runs the store migrations pending for this version of pocket core against the state at <height> (latest by default) of the running node,
+without persisting any change, and reports the entries migrated per module. Authenticated with the auth token in the config directory.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(GetMigrationsDryRunPath, j, app.GetAuthToken())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var blocklistCmd = &cobra.Command{
+	Use:   "blocklist [block-app|unblock-app|block-client|unblock-client] [<pubKey>]",
+	Short: "Gets or updates the local blocklist",
+	Long: `Retrieves the app and client public keys whose relays are rejected by the running node, after blocking/unblocking
+<pubKey> if an action is given. The changes are lost on restart, add the public keys to blocked_apps/blocked_clients of the
+config to persist them. Authenticated with the auth token in the config directory.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 && len(args) != 2 {
+			return fmt.Errorf("expected no arguments or an action and a public key")
+		}
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		var params types.BlocklistUpdate
+		if len(args) == 2 {
+			pks := []string{args[1]}
+			switch args[0] {
+			case "block-app":
+				params.BlockApps = pks
+			case "unblock-app":
+				params.UnblockApps = pks
+			case "block-client":
+				params.BlockClients = pks
+			case "unblock-client":
+				params.UnblockClients = pks
+			default:
+				fmt.Println("unknown action " + args[0] + ", expected block-app, unblock-app, block-client or unblock-client")
+				return
+			}
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(GetBlocklistPath, j, app.GetAuthToken())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var (
+	privValSourceState string
+	privValResetState  bool
+	privValDryRun      bool
+)
+
+var privValValidateCmd = &cobra.Command{
+	Use:   "pv-validate <key file> [<state file>]",
+	Short: "Validates priv validator files",
+	Long: `Validates a priv validator key file, in any format (tendermint key file, legacy priv_validator.json or hex
+private key), and its sign state file (if any): an ed25519 key matching its public key and address, a consistent
+sign state. Prints the address, the public key and the last signed height.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		statePath := ""
+		if len(args) == 2 {
+			statePath = args[1]
+		}
+		report, err := app.ValidatePrivValFiles(args[0], statePath)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(report.String())
+	},
+}
+
+var privValMigrateCmd = &cobra.Command{
+	Use:   "pv-migrate <source> <key file> <state file> [--state <source state file>] [--reset-state] [--dry-run]",
+	Short: "Migrates priv validator files to the pocket format",
+	Long: `Migrates the priv validator key of the source, a tendermint key file (of pocket or another tendermint chain), a
+legacy priv_validator.json (tendermint < 0.28) or a hex private key, to the key and sign state files of pocket.
+The sign state comes from the legacy file, the --state file of a tendermint source, or is reset with --reset-state.
+The migration is refused if the destination holds another key or a sign state ahead of the source, as the
+validator could double sign. Use --dry-run to validate the migration without writing anything.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		report, err := app.MigratePrivVal(app.PrivValMigration{
+			Source:      args[0],
+			SourceState: privValSourceState,
+			KeyPath:     args[1],
+			StatePath:   args[2],
+			ResetState:  privValResetState,
+			DryRun:      privValDryRun,
+		})
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(report.String())
+		if privValDryRun {
+			fmt.Println("dry run, nothing was written")
+			return
+		}
+		fmt.Printf("successfully migrated to %s and %s\n", args[1], args[2])
+	},
+}
+
+var privValExportRawCmd = &cobra.Command{
+	Use:   "pv-export-raw <key file>",
+	Short: "Exports the hex private key of a priv validator key file",
+	Long: `Exports the hex private key of a priv validator key file, in any format, to import it into the keybase
+with accounts import-raw.
+NOTE: THIS METHOD IS NOT RECOMMENDED FOR SECURITY REASONS, USE AT YOUR OWN RISK.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		res, err := app.ExportPrivValRaw(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Exported Raw Private Key:\n%s\n", res)
+	},
+}
+
+var (
+	replayFrom int64
+	replayTo   int64
+)
+
+var replayCmd = &cobra.Command{
+	Use:   "replay --from <height> --to <height>",
+	Short: "Replays stored blocks to validate this binary against the chain history",
+	Long: `Replays the stored blocks [from, to] of the node in the <datadir> through the handlers of this binary and compares
+the resulting app hashes with the ones committed by the network, to validate a new release against the recent history
+before the upgrade height. The node must be stopped and the state at from - 1 must be kept (not pruned) by the node.
+The blocks are executed on a scratch copy of the application db (in the temp dir), the data of the node is never
+modified. The replay stops at the first divergence.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		if replayTo == 0 {
+			replayTo = replayFrom
+		}
+		results, err := app.Replay(replayFrom, replayTo)
+		for _, r := range results {
+			switch {
+			case r.Error != "":
+				fmt.Printf("[fail] %d: %s (expected app hash %s)\n", r.Height, r.Error, r.Expected)
+			case !r.Match:
+				fmt.Printf("[fail] %d: app hash %s, expected %s\n", r.Height, r.AppHash, r.Expected)
+			default:
+				fmt.Printf("[ok]   %d: app hash %s\n", r.Height, r.AppHash)
+			}
+		}
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		if len(results) == 0 || !results[len(results)-1].Match {
+			os.Exit(1)
+		}
+	},
+}
+
+var (
+	replayEventsFrom  int64
+	replayEventsTo    int64
+	replayEventsSink  string
+	replayEventsURL   string
+	replayEventsTopic string
+)
+
+var replayEventsCmd = &cobra.Command{
+	Use:   "replay-events --from <height> --to <height> [--sink <sink>] [--url <url>] [--topic <topic>]",
+	Short: "Replays the events of committed blocks to a sink",
+	Long: fmt.Sprintf(`Publishes the records (blocks, txs and events) of the committed blocks [from, to] of the running node again, from its
+stored block results, to the sink: a record per line on the stdout (default), a webhook, the REST proxy of a Kafka cluster
+or a NATS server. Lets an external indexer rebuild its index after a bug without syncing the chain. The range is replayed
+in chunks of %d blocks, stops at the first failure. Authenticated with the auth token in the config directory.`, app.MaxEventReplayBlocks),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		app.InitAuthToken()
+		if replayEventsTo == 0 {
+			replayEventsTo = replayEventsFrom
+		}
+		for from := replayEventsFrom; from <= replayEventsTo; from += app.MaxEventReplayBlocks {
+			to := from + app.MaxEventReplayBlocks - 1
+			if to > replayEventsTo {
+				to = replayEventsTo
+			}
+			j, err := json.Marshal(rpc.ReplayEventsParams{From: from, To: to, Sink: replayEventsSink, URL: replayEventsURL, Topic: replayEventsTopic})
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			res, err := QuerySecuredRPC(GetReplayEventsPath, j, app.GetAuthToken())
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			var replay app.EventReplay
+			if err := json.Unmarshal([]byte(res), &replay); err != nil {
+				fmt.Println(res)
+				os.Exit(1)
+			}
+			if replayEventsSink == app.StreamSinkStdout {
+				for _, record := range replay.Output {
+					fmt.Println(string(record))
+				}
+				continue
+			}
+			fmt.Fprintf(os.Stderr, "replayed %d records of the blocks [%d, %d]\n", replay.Records, replay.From, replay.To)
+		}
+	},
+}
+
+var (
+	exportHeight  int64
+	exportChainID string
+)
+
+var exportGenesisCmd = &cobra.Command{
+	Use:   "export-genesis <genesis file> [--height <height>] [--chain-id <chain id>]",
+	Short: "Exports the state at a height as a genesis file",
+	Long: `Exports the full state (validators, apps, accounts, params, receipts, dao...) committed at the height to a
+genesis file, for a network upgrade or the fork of a devnet. The state must be kept (not pruned) by the node. Use
+--chain-id to start a new network (e.g. a devnet fork) from the exported state.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+		j, err := json.Marshal(rpc.ExportStateParams{Height: exportHeight, ChainID: exportChainID})
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetStatePath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if err = ioutil.WriteFile(args[0], []byte(res), 0644); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("successfully exported the state to %s\n", args[0])
+	},
+}
@@ -0,0 +1,156 @@
+package rpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+)
+
+const (
+	queryPathPrefix = "/v1/query/"
+	// the responses under this size are not worth compressing
+	minCompressionSize = 1024
+)
+
+// the query routes answering with the local state of the node instead of the state at a height, never cached
+var uncachedQueries = map[string]struct{}{
+	"/v1/query/nodestatus":  {},
+	"/v1/query/pruninginfo": {},
+}
+
+// "isCachedQuery" - Whether the route is a query of the state at a height, answered by Cache
+func isCachedQuery(route Route) bool {
+	if _, uncached := uncachedQueries[route.Path]; uncached {
+		return false
+	}
+	return route.Method == http.MethodPost && strings.HasPrefix(route.Path, queryPathPrefix)
+}
+
+// "Cache" - Wraps a query route handler: tags the response with an ETag of the route, the request body and the latest
+// height, answers 304 Not Modified to a request with a matching If-None-Match (the response can't change until the next
+// block) and compresses the response with gzip or deflate as accepted by the client
+func Cache(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		var body []byte
+		if r.Body != nil {
+			var err error
+			if body, err = ioutil.ReadAll(io.LimitReader(r.Body, 1048576)); err != nil {
+				WriteErrorResponse(w, 400, err.Error())
+				return
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		w.Header().Set("Vary", "Accept-Encoding")
+		etag := queryETag(r.URL.Path, body, app.PCA.LastBlockHeight())
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		res := &bufferedResponseWriter{header: w.Header(), status: http.StatusOK}
+		h(res, r, ps)
+		if res.status == http.StatusOK {
+			w.Header().Set("ETag", etag)
+		}
+		out := res.body.Bytes()
+		if encoding := acceptedEncoding(r.Header.Get("Accept-Encoding")); encoding != "" && len(out) >= minCompressionSize {
+			if compressed, err := compress(encoding, out); err == nil {
+				w.Header().Set("Content-Encoding", encoding)
+				w.Header().Del("Content-Length")
+				out = compressed
+			}
+		}
+		w.WriteHeader(res.status)
+		_, _ = w.Write(out)
+	}
+}
+
+// "queryETag" - The weak ETag of the query: the same route, request and height always answer the same, only the
+// encoding may differ
+func queryETag(path string, body []byte, height int64) string {
+	hasher := sha256.New()
+	hasher.Write([]byte(path + "\n" + strconv.FormatInt(height, 10) + "\n"))
+	hasher.Write(body)
+	return `W/"` + hex.EncodeToString(hasher.Sum(nil)[:16]) + `"`
+}
+
+// "etagMatches" - Whether the If-None-Match header (a list of ETags or *) matches the ETag, compared weakly
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// "acceptedEncoding" - The encoding of the response, gzip over deflate, none if the client accepts neither
+func acceptedEncoding(acceptEncoding string) string {
+	accepted := make(map[string]bool)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		coding := strings.ToLower(strings.TrimSpace(fields[0]))
+		accepted[coding] = true
+		for _, param := range fields[1:] {
+			if q := strings.TrimSpace(param); strings.HasPrefix(q, "q=") {
+				if v, err := strconv.ParseFloat(q[2:], 64); err == nil && v == 0 {
+					accepted[coding] = false
+				}
+			}
+		}
+	}
+	for _, encoding := range []string{"gzip", "deflate"} {
+		if acceptable, found := accepted[encoding]; (found && acceptable) || (!found && accepted["*"]) {
+			return encoding
+		}
+	}
+	return ""
+}
+
+// "compress" - Compresses the bytes with gzip or deflate (zlib, as http defines it)
+func compress(encoding string, bz []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	if encoding == "gzip" {
+		writer = gzip.NewWriter(&buf)
+	} else {
+		writer = zlib.NewWriter(&buf)
+	}
+	if _, err := writer.Write(bz); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// "bufferedResponseWriter" - Holds the response of a handler to tag and compress it before it is sent
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) Write(bz []byte) (int, error) {
+	return b.body.Write(bz)
+}
+
+func (b *bufferedResponseWriter) WriteHeader(status int) {
+	b.status = status
+}
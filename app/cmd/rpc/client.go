@@ -2,15 +2,16 @@ package rpc
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/x/auth"
 	"io/ioutil"
 	"net/http"
-	"strings"
 )
 
 // Dispatch supports CORS functionality
@@ -37,8 +38,9 @@ func Dispatch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 }
 
 type RPCRelayResponse struct {
-	Signature string `json:"signature"`
-	Response  string `json:"response"`
+	Signature string            `json:"signature"`
+	Response  string            `json:"response"`
+	Headers   map[string]string `json:"headers,omitempty"` // the allowed response headers of the chain, not signed
 	// remove proof object because client already knows about it
 }
 
@@ -60,6 +62,7 @@ func Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	response := RPCRelayResponse{
 		Signature: res.Signature,
 		Response:  res.Response,
+		Headers:   res.Headers,
 	}
 	j, er := json.Marshal(response)
 	if er != nil {
@@ -92,6 +95,38 @@ func Challenge(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+type challengesParams struct {
+	Responses []types.RelayResponse `json:"responses"` // the responses of the session nodes to the same requests
+	Address   string                `json:"address"`   // the address of the reporter
+}
+
+type challengesResponse struct {
+	Challenges []types.ChallengeResult `json:"challenges"`
+}
+
+// Challenges builds the challenges of the responses disagreeing with the majority and submits them, supports CORS
+func Challenges(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = challengesParams{}
+	if cors(&w, r) {
+		return
+	}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	reporter, err := types.ParseAddress(params.Address)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(challengesResponse{Challenges: app.PCA.HandleChallenges(params.Responses, reporter)})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 type SendRawTxParams struct {
 	Addr        string `json:"address"`
 	RawHexBytes string `json:"raw_hex_bytes"`
@@ -121,6 +156,161 @@ func SendRawTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+type RawTxParams struct {
+	RawBytes string `json:"raw_bytes"`          // the amino encoded signed StdTx
+	Encoding string `json:"encoding,omitempty"` // hex (default) or base64
+	Mode     string `json:"mode,omitempty"`     // async, sync (default) or commit
+}
+
+func RawTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = RawTxParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	var bz []byte
+	var err error
+	switch params.Encoding {
+	case "hex", "":
+		bz, err = hex.DecodeString(params.RawBytes)
+	case "base64":
+		bz, err = base64.StdEncoding.DecodeString(params.RawBytes)
+	default:
+		err = fmt.Errorf("unsupported encoding %s, supported encodings: hex, base64", params.Encoding)
+	}
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.BroadcastRawTx(bz, params.Mode)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type UnsignedTxParams struct {
+	Msg  json.RawMessage `json:"msg"` // the amino json msg, e.g. {"type":"pos/Send","value":{...}}
+	Memo string          `json:"memo,omitempty"`
+}
+
+func UnsignedTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = UnsignedTxParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.BuildUnsignedTx(params.Msg, params.Memo)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type SignedTxParams struct {
+	Tx        json.RawMessage `json:"tx"`         // the unsigned tx (of the unsignedtx response)
+	PublicKey string          `json:"public_key"` // the hex public key of the signer
+	Signature string          `json:"signature"`  // the hex signature of the sign bytes
+	Broadcast bool            `json:"broadcast,omitempty"`
+	Mode      string          `json:"mode,omitempty"` // async, sync (default) or commit
+}
+
+type signedTxResponse struct {
+	RawBytes string             `json:"raw_bytes"` // the hex amino encoded signed tx
+	Result   *app.RawTxResponse `json:"result,omitempty"`
+}
+
+func SignedTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = SignedTxParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	var tx auth.StdTx
+	if err := app.Codec().UnmarshalJSON(params.Tx, &tx); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	sig, err := hex.DecodeString(params.Signature)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	bz, err := app.PCA.AssembleSignedTx(tx, params.PublicKey, sig)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res := signedTxResponse{RawBytes: hex.EncodeToString(bz)}
+	if params.Broadcast {
+		result, err := app.PCA.BroadcastRawTx(bz, params.Mode)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		res.Result = &result
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type MultiSignedTxParams struct {
+	Tx         json.RawMessage        `json:"tx"`          // the unsigned tx (of the unsignedtx response)
+	PublicKeys []string               `json:"public_keys"` // the ordered hex public keys of the multisig
+	Signatures []app.PartialSignature `json:"signatures"`  // the signatures of every key, in any order
+	Broadcast  bool                   `json:"broadcast,omitempty"`
+	Mode       string                 `json:"mode,omitempty"` // async, sync (default) or commit
+}
+
+func MultiSignedTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = MultiSignedTxParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	var tx auth.StdTx
+	if err := app.Codec().UnmarshalJSON(params.Tx, &tx); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	bz, err := app.PCA.AssembleMultiSignedTx(tx, params.PublicKeys, params.Signatures)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res := signedTxResponse{RawBytes: hex.EncodeToString(bz)}
+	if params.Broadcast {
+		result, err := app.PCA.BroadcastRawTx(bz, params.Mode)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		res.Result = &result
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 type simRelayParams struct {
 	Url     string        `json:"chain_url"`
 	Payload types.Payload `json:"payload"` // the data payload of the request
@@ -133,12 +323,9 @@ func SimRequest(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	// retrieve the hosted blockchain url requested
-	url := strings.Trim(params.Url, `/`)
-	if len(params.Payload.Path) > 0 {
-		url = url + "/" + strings.Trim(params.Payload.Path, `/`)
-	}
+	url := params.Payload.URL(params.Url)
 	// do basic http request on the relay
-	res, er := executeHTTPRequest(params.Payload.Data, url, params.Payload.Method, params.Payload.Headers)
+	res, er := executeHTTPRequest(params.Payload.Data, url, params.Payload.HTTPMethod(), params.Payload.Headers)
 
 	if er != nil {
 		WriteErrorResponse(w, 400, er.Error())
@@ -179,3 +366,58 @@ func executeHTTPRequest(payload string, url string, method string, headers map[s
 	// return
 	return string(body), nil
 }
+
+type hashVectorsResponse struct {
+	Version string            `json:"version"` // the version of pocket core computing the vectors
+	Vectors types.HashVectors `json:"vectors"`
+}
+
+// "HashVectors" - Returns the canonical test vectors of the relay proof, aat and session key hashing so the sdk
+// implementations can be validated against this node
+func HashVectors(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	vectors, err := types.NewHashVectors()
+	if err != nil {
+		WriteErrorResponse(w, 500, err.Error())
+		return
+	}
+	j, err := json.Marshal(hashVectorsResponse{Version: app.AppVersion, Vectors: vectors})
+	if err != nil {
+		WriteErrorResponse(w, 500, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type verifyRelayResponseParams struct {
+	RelayResponse  types.RelayResponse `json:"relay_response"`
+	ServicerPubKey string              `json:"servicer_pub_key"`
+	Relay          *types.Relay        `json:"relay,omitempty"` // the request of the response (optional)
+}
+
+type verifyRelayResponseResponse struct {
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+// "VerifyRelayResponse" - Verifies the signature of the servicer on a relay response and the linkage of its proof with
+// the servicer (and the request if provided), so the sdks can validate the responses against the node identity
+func VerifyRelayResponse(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = verifyRelayResponseParams{}
+	if cors(&w, r) {
+		return
+	}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res := verifyRelayResponseResponse{Valid: true}
+	if err := app.PCA.VerifyRelayResponse(params.RelayResponse, params.ServicerPubKey, params.Relay); err != nil {
+		res = verifyRelayResponseResponse{Valid: false, Error: err.Error()}
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
@@ -243,7 +243,7 @@ func getTestConfig() (tmConfg *tmCfg.Config) {
 	tmConfg.Consensus.CreateEmptyBlocksInterval = time.Duration(50) * time.Millisecond
 	tmConfg.Consensus.TimeoutCommit = time.Duration(50) * time.Millisecond
 	tmConfg.TxIndex.Indexer = "kv"
-	tmConfg.TxIndex.IndexTags = "tx.hash,tx.height,message.sender"
+	tmConfg.TxIndex.IndexTags = "tx.hash,tx.height,message.sender,dao_transfer.module,dao_burn.module"
 	return
 }
 
@@ -311,6 +311,7 @@ func oneValTwoNodeGenesisState() []byte {
 	mACL := createTestACL(kp1)
 	govGenesisState.Params.ACL = mACL
 	govGenesisState.Params.DAOOwner = kp1.GetAddress()
+	govGenesisState.DAOTokens = sdk.NewInt(1000)
 	govGenesisState.Params.Upgrade = govTypes.NewUpgrade(10000, "2.0.0")
 	res4 := memCodec().MustMarshalJSON(govGenesisState)
 	defaultGenesis[govTypes.ModuleName] = res4
@@ -341,10 +342,17 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("pocketcore/ClaimExpiration", kp.GetAddress())
 		acl.SetOwner("pocketcore/SessionNodeCount", kp.GetAddress())
 		acl.SetOwner("pocketcore/MinimumNumberOfProofs", kp.GetAddress())
+		acl.SetOwner("pocketcore/ChallengeReporterReward", kp.GetAddress())
+		acl.SetOwner("pocketcore/ChainRegistry", kp.GetAddress())
+		acl.SetOwner("pocketcore/ClaimSubmissionWindowByChain", kp.GetAddress())
+		acl.SetOwner("pocketcore/ReputationWeightedSessions", kp.GetAddress())
+		acl.SetOwner("pocketcore/ReputationWindow", kp.GetAddress())
+		acl.SetOwner("pocketcore/ParamBounds", kp.GetAddress())
 		acl.SetOwner("pocketcore/ReplayAttackBurnMultiplier", kp.GetAddress())
 		acl.SetOwner("pos/MaxValidators", kp.GetAddress())
 		acl.SetOwner("pos/ProposerPercentage", kp.GetAddress())
 		acl.SetOwner("application/StabilityAdjustment", kp.GetAddress())
+		acl.SetOwner("application/StakeAdjustment", kp.GetAddress())
 		acl.SetOwner("application/AppUnstakingTime", kp.GetAddress())
 		acl.SetOwner("application/ParticipationRateOn", kp.GetAddress())
 		acl.SetOwner("pos/MaxEvidenceAge", kp.GetAddress())
@@ -357,6 +365,9 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("pos/DAOAllocation", kp.GetAddress())
 		acl.SetOwner("pos/SignedBlocksWindow", kp.GetAddress())
 		acl.SetOwner("pos/BlocksPerSession", kp.GetAddress())
+		acl.SetOwner("pos/SessionDuration", kp.GetAddress())
+		acl.SetOwner("pos/DAOEmissionSchedule", kp.GetAddress())
+		acl.SetOwner("pos/FeeRefund", kp.GetAddress())
 		acl.SetOwner("application/MaxApplications", kp.GetAddress())
 		acl.SetOwner("gov/daoOwner", kp.GetAddress())
 		acl.SetOwner("gov/upgrade", kp.GetAddress())
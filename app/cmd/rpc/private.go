@@ -0,0 +1,235 @@
+package rpc
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// the header carrying the auth token for the private routes
+const AuthHeader = "Authorization"
+
+// "Authenticate" - Wraps a private route handler, rejecting any request without the auth token of this node or a role
+// token with the role of the route
+func Authenticate(role string, handler httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		authorized, authenticated := app.IsAuthorized(r.Header.Get(AuthHeader), role)
+		if !authenticated {
+			WriteErrorResponse(w, http.StatusUnauthorized, "invalid or missing auth token")
+			return
+		}
+		if !authorized {
+			WriteErrorResponse(w, http.StatusForbidden, "the token does not have the "+role+" role")
+			return
+		}
+		handler(w, r, ps)
+	}
+}
+
+type localEvidenceResponse struct {
+	Evidence []pocketTypes.EvidenceSummary `json:"evidence"`
+}
+
+func LocalEvidence(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	res, err := app.PCA.QueryLocalEvidence()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(localEvidenceResponse{Evidence: res})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type chainsHealthResponse struct {
+	Chains []pocketTypes.ChainHealth `json:"chains"`
+}
+
+func ChainsHealth(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	j, err := json.Marshal(chainsHealthResponse{Chains: app.PCA.QueryChainsHealth()})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func KeyAudit(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginateOrderParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryKeyAudit(params.Page, params.PerPage, params.Order)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.JSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func NodeConfig(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	res, err := app.PCA.QueryNodeConfig()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type migrationsDryRunResponse struct {
+	Migrations []app.MigrationResult `json:"migrations"`
+}
+
+func MigrationsDryRun(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.DryRunMigrations(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(migrationsDryRunResponse{Migrations: res})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+// "Blocklist" - Adds/removes the app and client public keys of the update to/from the local blocklist and returns
+// the resulting blocklist (an empty update just returns it). The changes are not persisted to the config
+func Blocklist(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = pocketTypes.BlocklistUpdate{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if err := pocketTypes.UpdateBlocklist(params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(pocketTypes.GetBlocklist())
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type sessionWebhooksResponse struct {
+	Webhooks []pocketTypes.SessionWebhook `json:"webhooks"`
+}
+
+// "SessionWebhooks" - Registers/unregisters the webhooks of the update, notified when a new session of their app includes
+// this node, and returns the registered webhooks (an empty update just returns them). The webhooks are lost on restart
+func SessionWebhooks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = pocketTypes.SessionWebhooksUpdate{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if err := pocketTypes.UpdateSessionWebhooks(params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(sessionWebhooksResponse{Webhooks: pocketTypes.GetSessionWebhooks()})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type scheduledTxsResponse struct {
+	Txs []pocketTypes.ScheduledTx `json:"txs"`
+}
+
+// "ScheduledTxs" - Schedules/cancels the signed transactions of the update, broadcast by this node once the chain
+// reaches their height, and returns the scheduled transactions (an empty update just returns them). The schedule is
+// persisted across restarts
+func ScheduledTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = pocketTypes.ScheduledTxsUpdate{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.UpdateScheduledTxs(params)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(scheduledTxsResponse{Txs: res})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+// "ReplayEventsParams" - The blocks whose records are replayed and the sink they are replayed to (see app.NewStreamSink)
+type ReplayEventsParams struct {
+	From  int64  `json:"from"`
+	To    int64  `json:"to"`
+	Sink  string `json:"sink"`
+	URL   string `json:"url"`
+	Topic string `json:"topic"`
+}
+
+// "ReplayEvents" - Publishes the records (blocks, txs and events) of the committed blocks of the range again to the sink,
+// so external indexers can rebuild their index without syncing the chain. The records replayed to the stdout sink are
+// returned in the response
+func ReplayEvents(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = ReplayEventsParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.ReplayEvents(params.From, params.To, params.Sink, params.URL, params.Topic)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+// "ReplicateEvidence" - Receives the proofs replicated by a primary node of the same operator, authenticated by the
+// evidence replication key shared with the primary instead of the auth token
+func ReplicateEvidence(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := pocketTypes.ReceiveReplicatedEvidence(body)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusForbidden, err.Error())
+		return
+	}
+	WriteJSONResponse(w, res.String(), r.URL.Path, r.Host)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/pokt-network/pocket-core/app"
 	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 )
 
@@ -24,6 +26,11 @@ type HeightAndKeyParams struct {
 	Key    string `json:"key"`
 }
 
+type HeightAndPrefixParams struct {
+	Height int64  `json:"height"`
+	Prefix string `json:"prefix"` // a module name (e.g. "pos") or the start of an acl key, every param if empty
+}
+
 type HashAndProveParams struct {
 	Hash  string `json:"hash"`
 	Prove bool   `json:"prove"`
@@ -39,6 +46,12 @@ type HeightAndValidatorOptsParams struct {
 	Opts   nodeTypes.QueryValidatorsParams `json:"opts"`
 }
 
+type HeightAddrAndDenomParams struct {
+	Height  int64  `json:"height"`
+	Address string `json:"address"`
+	Denom   string `json:"denom,omitempty"` // every denomination if empty
+}
+
 type HeightAndApplicaitonOptsParams struct {
 	Height int64                              `json:"height"`
 	Opts   appTypes.QueryApplicationsWithOpts `json:"opts"`
@@ -52,6 +65,31 @@ type PaginateAddrParams struct {
 	Prove    bool   `json:"prove,omitempty"`
 }
 
+type PaginateAddrOrderParams struct {
+	Address string `json:"address"`
+	Page    int    `json:"page,omitempty"`
+	PerPage int    `json:"per_page,omitempty"`
+	Prove   bool   `json:"prove,omitempty"`
+	Order   string `json:"order,omitempty"`
+}
+
+type PaginateOrderParams struct {
+	Page    int    `json:"page,omitempty"`
+	PerPage int    `json:"per_page,omitempty"`
+	Order   string `json:"order,omitempty"`
+}
+
+type SessionValidatorsParams struct {
+	SessionHeight int64  `json:"session_height"`
+	Chain         string `json:"chain,omitempty"`
+}
+
+type SessionParams struct {
+	AppPubKey string `json:"app_public_key"`
+	Chain     string `json:"chain"`
+	Height    int64  `json:"height"`
+}
+
 type PaginatedHeightParams struct {
 	Height  int64 `json:"height"`
 	Page    int   `json:"page,omitempty"`
@@ -59,6 +97,26 @@ type PaginatedHeightParams struct {
 	Prove   bool  `json:"prove,omitempty"`
 }
 
+type PaginatedHeightRangeParams struct {
+	From    int64 `json:"from"`
+	To      int64 `json:"to"`
+	Page    int   `json:"page,omitempty"`
+	PerPage int   `json:"per_page,omitempty"`
+}
+
+type HeightRangeAndChainParams struct {
+	From   int64  `json:"from"`
+	To     int64  `json:"to"`
+	Chain  string `json:"chain,omitempty"` // every chain if empty
+	Height int64  `json:"height"`
+}
+
+type AddrAndHeightRangeParams struct {
+	Address string `json:"address"`
+	From    int64  `json:"from"`
+	To      int64  `json:"to"`
+}
+
 type PaginatedHeightAndAddrParams struct {
 	Height  int64  `json:"height"`
 	Addr    string `json:"address"`
@@ -66,6 +124,16 @@ type PaginatedHeightAndAddrParams struct {
 	PerPage int    `json:"per_page,omitempty"`
 }
 
+type PaginatedEvidenceParams struct {
+	Height       int64  `json:"height"`
+	Addr         string `json:"address"`
+	Page         int    `json:"page,omitempty"`
+	PerPage      int    `json:"per_page,omitempty"`
+	EvidenceType string `json:"evidence_type,omitempty"` // relay or challenge, every type if empty
+	Blockchain   string `json:"blockchain,omitempty"`    // every chain if empty
+	AppPubKey    string `json:"app_pubkey,omitempty"`    // every app if empty
+}
+
 func Block(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -98,6 +166,25 @@ func Tx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+func DecodedTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HashAndProveParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryDecodedTx(params.Hash)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	s, er := json.MarshalIndent(res, "", "  ")
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
 func AccountTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = PaginateAddrParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -124,6 +211,25 @@ func AccountTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+func AllAccountTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginateAddrOrderParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryAllAccountTxs(params.Address, params.Page, params.PerPage, params.Prove, params.Order)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	s, er := json.MarshalIndent(res, "", "  ")
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
 func BlockTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = PaginatedHeightParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -142,6 +248,25 @@ func BlockTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+func TxsByHeightRange(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginatedHeightRangeParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryTxsByHeightRange(params.From, params.To, params.Page, params.PerPage)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	s, er := json.MarshalIndent(res, "", "  ")
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
 type queryHeightResponse struct {
 	Height int64 `json:"height"`
 }
@@ -160,6 +285,34 @@ func Height(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(height), r.URL.Path, r.Host)
 }
 
+func NodeStatus(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	res, err := app.PCA.QueryNodeStatus()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func PruningInfo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	res, err := app.PCA.QueryPruningInfo()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 type queryBalanceResponse struct {
 	Balance *big.Int `json:"balance"`
 }
@@ -184,6 +337,29 @@ func Balance(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+type queryBalancesResponse struct {
+	Balances sdk.Coins `json:"balances"`
+}
+
+func Balances(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAddrAndDenomParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	balances, err := app.PCA.QueryBalances(params.Address, params.Height, params.Denom)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	s, err := json.MarshalIndent(&queryBalancesResponse{Balances: balances}, "", "")
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
 func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightAndAddrParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -203,6 +379,29 @@ func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+type queryAllowancesResponse struct {
+	Allowances []nodeTypes.Allowance `json:"allowances"`
+}
+
+func Allowances(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryAllowances(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	s, err := json.MarshalIndent(&queryAllowancesResponse{Allowances: res}, "", "")
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
 func Nodes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightAndValidatorOptsParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -251,6 +450,129 @@ func Node(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+type HeightAndAddrsParams struct {
+	Height    int64    `json:"height"`
+	Addresses []string `json:"addresses"`
+}
+
+func NodesByAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrsParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryNodesByAddresses(params.Addresses, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func OperatorOverview(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryOperatorOverview(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ClaimsSummary(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryClaimsSummary(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ClaimMaturity(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryClaimMaturity(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type networkRelaysResponse struct {
+	NetworkRelays []pocketTypes.NetworkRelays `json:"network_relays"`
+}
+
+func NetworkRelays(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightRangeAndChainParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryNetworkRelays(params.From, params.To, params.Chain, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(networkRelaysResponse{NetworkRelays: res})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func NodeRewards(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = AddrAndHeightRangeParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryNodeRewards(params.Address, params.From, params.To)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func NodeParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -270,13 +592,56 @@ func NodeParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func SessionValidators(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = SessionValidatorsParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QuerySessionValidators(params.SessionHeight, params.Chain)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Session(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = SessionParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QuerySession(params.AppPubKey, params.Chain, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func NodeReceipts(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	var params = PaginatedHeightAndAddrParams{}
+	var params = PaginatedEvidenceParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	res, err := app.PCA.QueryReceipts(params.Addr, params.Height, params.Page, params.PerPage)
+	filter, er := pocketTypes.NewEvidenceFilter(params.EvidenceType, params.Blockchain, params.AppPubKey)
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	res, err := app.PCA.QueryReceipts(params.Addr, params.Height, params.Page, params.PerPage, filter)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
@@ -337,12 +702,74 @@ func NodeClaim(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 }
 
 func NodeClaims(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginatedEvidenceParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	filter, er := pocketTypes.NewEvidenceFilter(params.EvidenceType, params.Blockchain, params.AppPubKey)
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	res, err := app.PCA.QueryClaims(params.Addr, params.Height, params.Page, params.PerPage, filter)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.JSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func OpenChallenges(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginatedHeightParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryOpenChallenges(params.Height, params.Page, params.PerPage)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.JSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ChallengesAgainst(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginatedHeightAndAddrParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryChallengesAgainst(params.Addr, params.Height, params.Page, params.PerPage)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.JSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ChallengeRewards(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = PaginatedHeightAndAddrParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	res, err := app.PCA.QueryClaims(params.Addr, params.Height, params.Page, params.PerPage)
+	res, err := app.PCA.QueryChallengeRewards(params.Addr, params.Height, params.Page, params.PerPage)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
@@ -403,6 +830,25 @@ func App(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func AppsByAddresses(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrsParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryAppsByAddresses(params.Addresses, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func AppParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -422,6 +868,25 @@ func AppParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func AppStakeAdjustment(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryAppStakeAdjustment(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func PocketParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -460,6 +925,25 @@ func SupportedChains(w http.ResponseWriter, r *http.Request, ps httprouter.Param
 	WriteResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func SupportedChainsMetadata(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryPocketSupportedBlockchainsMetadata(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 type querySupplyResponse struct {
 	NodeStaked    string `json:"node_staked"`
 	AppStaked     string `json:"app_staked"`
@@ -475,36 +959,43 @@ func Supply(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	nodesStake, total, err := app.PCA.QueryTotalNodeCoins(params.Height)
+	supply, err := app.PCA.QuerySupply(params.Height)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	appsStaked, err := app.PCA.QueryTotalAppCoins(params.Height)
+	res, err := json.MarshalIndent(&querySupplyResponse{
+		NodeStaked:    supply.NodeStaked.String(),
+		AppStaked:     supply.AppStaked.String(),
+		Dao:           supply.DAO.String(),
+		TotalStaked:   supply.TotalStaked.BigInt().String(),
+		TotalUnstaked: supply.TotalUnstaked.BigInt().String(),
+		Total:         supply.Total.BigInt().String(),
+	}, "", "  ")
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	dao, err := app.PCA.QueryDaoBalance(params.Height)
+	WriteJSONResponse(w, string(res), r.URL.Path, r.Host)
+}
+
+func EmissionSchedule(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryEmissionSchedule(params.Height)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	totalStaked := nodesStake.Add(appsStaked).Add(dao)
-	totalUnstaked := total.Sub(totalStaked)
-	res, err := json.MarshalIndent(&querySupplyResponse{
-		NodeStaked:    nodesStake.String(),
-		AppStaked:     appsStaked.String(),
-		Dao:           dao.String(),
-		TotalStaked:   totalStaked.BigInt().String(),
-		TotalUnstaked: totalUnstaked.BigInt().String(),
-		Total:         total.BigInt().String(),
-	}, "", "  ")
+	j, err := app.Codec().MarshalJSON(res)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	WriteJSONResponse(w, string(res), r.URL.Path, r.Host)
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
 func DAOOwner(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -526,6 +1017,44 @@ func DAOOwner(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+func DAOHistory(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginateOrderParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryDAOHistory(params.Page, params.PerPage, params.Order)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.JSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ACLHistory(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginateOrderParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryACLHistory(params.Page, params.PerPage, params.Order)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.JSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func Upgrade(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -564,6 +1093,31 @@ func ACL(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+type paramBoundsResponse struct {
+	Bounds pocketTypes.ParamBounds `json:"bounds"`
+}
+
+// "ParamBounds" - Returns the bounds (min, max and step) of the params enforced on the param changes, even the ones of
+// an authorized acl key
+func ParamBounds(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryParamBounds(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(paramBoundsResponse{Bounds: res})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func AllParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -575,7 +1129,7 @@ func AllParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	j, err := app.Codec().MarshalJSON(res)
+	j, err := json.Marshal(res)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
@@ -593,7 +1147,58 @@ func Param(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	j, err := app.Codec().MarshalJSON(res)
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type paramsResponse struct {
+	Params []app.SingleParamReturn `json:"params"`
+}
+
+// "Params" - Returns the params of a module or with an acl key prefix (case insensitive), with their typed values
+func Params(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndPrefixParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryParams(params.Height, params.Prefix)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(paramsResponse{Params: res})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+// "SimulateParamChangesParams" - The param changes to simulate at the height, sent by the address (the owner of each
+// param in the ACL if empty)
+type SimulateParamChangesParams struct {
+	Height  int64             `json:"height"`
+	Address string            `json:"address,omitempty"`
+	Changes []app.ParamChange `json:"changes"`
+}
+
+func SimulateParamChanges(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = SimulateParamChangesParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.SimulateParamChanges(params.Height, params.Changes, params.Address)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
@@ -601,8 +1206,20 @@ func Param(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
-func State(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
-	res, err := app.ExportState()
+// "ExportStateParams" - The height (the latest if zero) and the chain id (the chain id of the network if empty) of
+// the exported genesis
+type ExportStateParams struct {
+	Height  int64  `json:"height"`
+	ChainID string `json:"chain_id"`
+}
+
+func State(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = ExportStateParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.ExportGenesis(params.Height, params.ChainID)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
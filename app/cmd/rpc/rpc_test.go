@@ -2,8 +2,11 @@ package rpc
 
 import (
 	"bytes"
+	"compress/gzip"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/posmint/crypto"
 	"io"
@@ -11,12 +14,16 @@ import (
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	types3 "github.com/pokt-network/pocket-core/x/apps/types"
 
+	"github.com/gorilla/websocket"
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/x/nodes"
 	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
@@ -24,6 +31,8 @@ import (
 	"github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/auth"
 	authTypes "github.com/pokt-network/posmint/x/auth/types"
+	"github.com/pokt-network/posmint/x/gov"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/libs/common"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
@@ -56,6 +65,72 @@ func TestRPC_QueryHeight(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryNodeStatus(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	q := newQueryRequest("nodestatus", nil)
+	rec := httptest.NewRecorder()
+	NodeStatus(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+
+	var status app.NodeStatus
+	err := app.Codec().UnmarshalJSON(resp, &status)
+	assert.Nil(t, err)
+	assert.NotNil(t, status.Status)
+	assert.Equal(t, app.IsArchive(), status.Archive)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryPruningInfo(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	q := newQueryRequest("pruninginfo", nil)
+	rec := httptest.NewRecorder()
+	PruningInfo(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+
+	var info app.PruningInfo
+	err := json.Unmarshal(resp, &info)
+	assert.Nil(t, err)
+	assert.Equal(t, app.PruningStrategy(), info.Strategy)
+	assert.True(t, info.EarliestHeight >= 1)
+	assert.True(t, info.EarliestHeight <= info.LatestHeight)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryState(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	var params = ExportStateParams{
+		Height:  1,
+		ChainID: "pocket-devnet",
+	}
+	q := newQueryRequest("state", newBody(params))
+	rec := httptest.NewRecorder()
+	State(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	genDoc, err := tmTypes.GenesisDocFromJSON(resp)
+	assert.Nil(t, err)
+	assert.Equal(t, "pocket-devnet", genDoc.ChainID)
+	assert.NotEmpty(t, genDoc.AppState)
+	// the latest state of the network
+	q = newQueryRequest("state", newBody(ExportStateParams{}))
+	rec = httptest.NewRecorder()
+	State(rec, q, httprouter.Params{})
+	assert.Equal(t, 200, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryBlock(t *testing.T) {
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
 	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
@@ -109,6 +184,39 @@ func TestRPC_QueryTX(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryDecodedTX(t *testing.T) {
+	var tx *types.TxResponse
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	memCLI, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	tx, err = nodes.Send(memCodec(), memCLI, kb, cb.GetAddress(), cb.GetAddress(), "test", types.NewInt(100))
+	assert.Nil(t, err)
+
+	<-evtChan // Wait for tx
+	var params = HashAndProveParams{
+		Hash: tx.TxHash,
+	}
+	q := newQueryRequest("decodedtx", newBody(params))
+	rec := httptest.NewRecorder()
+	DecodedTx(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, resp)
+	var decoded map[string]interface{}
+	err = json.Unmarshal([]byte(resp), &decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, tx.TxHash, decoded["hash"])
+	assert.Equal(t, decoded["fee"], decoded["effective_fee"])
+	assert.Equal(t, "0", decoded["refunded"])
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryAccountTXs(t *testing.T) {
 	var tx *types.TxResponse
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
@@ -146,6 +254,196 @@ func TestRPC_QueryAccountTXs(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryAllAccountTXs(t *testing.T) {
+	var tx *types.TxResponse
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	memCLI, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	var err error
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	tx, err = nodes.Send(memCodec(), memCLI, kb, cb.GetAddress(), cb.GetAddress(), "test", types.NewInt(100))
+	assert.Nil(t, err)
+	assert.NotNil(t, tx)
+
+	<-evtChan // Wait for tx
+	var params = PaginateAddrOrderParams{
+		Address: cb.GetAddress().String(),
+		Order:   "asc",
+	}
+	q := newQueryRequest("allaccounttxs", newBody(params))
+	rec := httptest.NewRecorder()
+	AllAccountTxs(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, resp)
+	var resTXs core_types.ResultTxSearch
+	unmarshalErr := json.Unmarshal([]byte(resp), &resTXs)
+	assert.Nil(t, unmarshalErr)
+	// sent to itself, so only listed once
+	assert.Len(t, resTXs.Txs, 1)
+	assert.Equal(t, 1, resTXs.TotalCount)
+	// invalid order
+	params.Order = "random"
+	q = newQueryRequest("allaccounttxs", newBody(params))
+	rec = httptest.NewRecorder()
+	AllAccountTxs(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryTxsByHeightRange(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	memCLI, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	tx, err := nodes.Send(memCodec(), memCLI, kb, cb.GetAddress(), cb.GetAddress(), "test", types.NewInt(100))
+	assert.Nil(t, err)
+	assert.NotNil(t, tx)
+
+	<-evtChan // Wait for tx
+	var params = PaginatedHeightRangeParams{
+		From: 1,
+		To:   100,
+	}
+	q := newQueryRequest("txsbyheightrange", newBody(params))
+	rec := httptest.NewRecorder()
+	TxsByHeightRange(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	var resTXs core_types.ResultTxSearch
+	err = json.Unmarshal([]byte(resp), &resTXs)
+	assert.Nil(t, err)
+	assert.Len(t, resTXs.Txs, 1)
+	assert.Equal(t, tx.TxHash, resTXs.Txs[0].Hash.String())
+	// invalid range
+	params.From, params.To = 100, 1
+	q = newQueryRequest("txsbyheightrange", newBody(params))
+	rec = httptest.NewRecorder()
+	TxsByHeightRange(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryDAOHistory(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	memCLI, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	recipient := types.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	transfer, err := gov.DAOTransferTx(memCodec(), memCLI, kb, cb.GetAddress(), recipient, types.OneInt(), govTypes.DAOTransfer.String(), "test", 1000000)
+	assert.Nil(t, err)
+	assert.NotNil(t, transfer)
+	<-evtChan // Wait for tx
+	burn, err := gov.DAOTransferTx(memCodec(), memCLI, kb, cb.GetAddress(), nil, types.OneInt(), govTypes.DAOBurn.String(), "test", 1000000)
+	assert.Nil(t, err)
+	assert.NotNil(t, burn)
+	<-evtChan // Wait for tx
+	var params = PaginateOrderParams{Order: "asc"}
+	q := newQueryRequest("daohistory", newBody(params))
+	rec := httptest.NewRecorder()
+	DAOHistory(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	var history struct {
+		Result []app.DAOAction `json:"result"`
+	}
+	err = json.Unmarshal([]byte(resp), &history)
+	assert.Nil(t, err)
+	assert.Len(t, history.Result, 2)
+	assert.Equal(t, govTypes.DAOTransferString, history.Result[0].Action)
+	assert.Equal(t, recipient.String(), history.Result[0].Recipient)
+	assert.Equal(t, cb.GetAddress().String(), history.Result[0].Sender)
+	assert.True(t, history.Result[0].Amount.Equal(types.OneInt()))
+	assert.Equal(t, transfer.TxHash, history.Result[0].TxHash)
+	assert.Equal(t, govTypes.DAOBurnString, history.Result[1].Action)
+	assert.Empty(t, history.Result[1].Recipient)
+	assert.Equal(t, burn.TxHash, history.Result[1].TxHash)
+	// newest first by default
+	params.Order = ""
+	q = newQueryRequest("daohistory", newBody(params))
+	rec = httptest.NewRecorder()
+	DAOHistory(rec, q, httprouter.Params{})
+	resp = getJSONResponse(rec)
+	err = json.Unmarshal([]byte(resp), &history)
+	assert.Nil(t, err)
+	assert.Len(t, history.Result, 2)
+	assert.Equal(t, burn.TxHash, history.Result[0].TxHash)
+	// invalid order
+	params.Order = "random"
+	q = newQueryRequest("daohistory", newBody(params))
+	rec = httptest.NewRecorder()
+	DAOHistory(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryACLHistory(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	memCLI, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	// not an acl change
+	tx, err := gov.ChangeParamsTx(memCodec(), memCLI, kb, cb.GetAddress(), "pocketcore/SessionNodeCount", 5, "test", 1000000)
+	assert.Nil(t, err)
+	assert.NotNil(t, tx)
+	<-evtChan // Wait for tx
+
+	var params = PaginateOrderParams{}
+	q := newQueryRequest("aclhistory", newBody(params))
+	rec := httptest.NewRecorder()
+	ACLHistory(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var history struct {
+		Result []pocketTypes.ACLChange `json:"result"`
+	}
+	err = json.Unmarshal(getJSONResponse(rec), &history)
+	assert.Nil(t, err)
+	assert.Empty(t, history.Result)
+	// invalid order
+	params.Order = "random"
+	q = newQueryRequest("aclhistory", newBody(params))
+	rec = httptest.NewRecorder()
+	ACLHistory(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryParamBounds(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	q := newQueryRequest("parambounds", newBody(HeightParams{Height: 0}))
+	rec := httptest.NewRecorder()
+	ParamBounds(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res paramBoundsResponse
+	assert.Nil(t, json.Unmarshal(getJSONResponse(rec), &res))
+	b, found := res.Bounds.Bound("pocketcore/SessionNodeCount")
+	assert.True(t, found)
+	assert.Equal(t, "1", b.Min)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryBlockTXs(t *testing.T) {
 	var tx *types.TxResponse
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
@@ -223,6 +521,43 @@ func TestRPC_QueryBalance(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryBalances(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+
+	<-evtChan // Wait for block
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = HeightAddrAndDenomParams{
+		Height:  0,
+		Address: cb.GetAddress().String(),
+	}
+	q := newQueryRequest("balances", newBody(params))
+	rec := httptest.NewRecorder()
+	Balances(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, resp)
+
+	var b queryBalancesResponse
+	err = json.Unmarshal([]byte(resp), &b)
+	assert.Nil(t, err)
+	assert.NotZero(t, b.Balances.AmountOf(types.DefaultStakeDenom))
+	// filtered by an unknown denom
+	params.Denom = "wpokt"
+	q = newQueryRequest("balances", newBody(params))
+	rec = httptest.NewRecorder()
+	Balances(rec, q, httprouter.Params{})
+	resp = getJSONResponse(rec)
+	err = json.Unmarshal([]byte(resp), &b)
+	assert.Nil(t, err)
+	assert.True(t, b.Balances.Empty())
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryAccount(t *testing.T) {
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
 	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
@@ -244,6 +579,31 @@ func TestRPC_QueryAccount(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryAllowances(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = HeightAndAddrParams{
+		Height:  0,
+		Address: cb.GetAddress().String(),
+	}
+	q := newQueryRequest("allowances", newBody(params))
+	rec := httptest.NewRecorder()
+	Allowances(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	var res queryAllowancesResponse
+	err = json.Unmarshal(resp, &res)
+	assert.Nil(t, err)
+	assert.NotNil(t, res.Allowances)
+	assert.Empty(t, res.Allowances)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryNodes(t *testing.T) {
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
 	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
@@ -295,6 +655,196 @@ func TestRPC_QueryNode(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryNodesByAddresses(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+
+	<-evtChan // Wait for block
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	unknown := "0101010101010101010101010101010101010101"
+	var params = HeightAndAddrsParams{
+		Height:    0,
+		Addresses: []string{cb.GetAddress().String(), unknown, "invalid"},
+	}
+	q := newQueryRequest("nodesbyaddresses", newBody(params))
+	rec := httptest.NewRecorder()
+	NodesByAddresses(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	var res struct {
+		Found   map[string]json.RawMessage `json:"found"`
+		Missing map[string]string          `json:"missing"`
+	}
+	err = json.Unmarshal(resp, &res)
+	assert.Nil(t, err)
+	assert.Len(t, res.Found, 1)
+	assert.Contains(t, res.Found, cb.GetAddress().String())
+	assert.Len(t, res.Missing, 2)
+	assert.Contains(t, res.Missing, unknown)
+	assert.Contains(t, res.Missing, "invalid")
+	// no addresses
+	params.Addresses = nil
+	q = newQueryRequest("nodesbyaddresses", newBody(params))
+	rec = httptest.NewRecorder()
+	NodesByAddresses(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryOperatorOverview(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+
+	<-evtChan // Wait for block
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = HeightAndAddrParams{
+		Height:  0,
+		Address: cb.GetAddress().String(),
+	}
+	q := newQueryRequest("operatoroverview", newBody(params))
+	rec := httptest.NewRecorder()
+	OperatorOverview(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, resp)
+	var overview app.OperatorOverview
+	err = json.Unmarshal(resp, &overview)
+	assert.Nil(t, err)
+	assert.Equal(t, cb.GetAddress().String(), overview.Address)
+	assert.NotNil(t, overview.Validator)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryClaimsSummary(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+
+	<-evtChan // Wait for block
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = HeightAndAddrParams{
+		Height:  0,
+		Address: cb.GetAddress().String(),
+	}
+	q := newQueryRequest("claimssummary", newBody(params))
+	rec := httptest.NewRecorder()
+	ClaimsSummary(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, resp)
+	var summary app.ClaimsSummary
+	err = json.Unmarshal(resp, &summary)
+	assert.Nil(t, err)
+	assert.Equal(t, cb.GetAddress().String(), summary.Address)
+	assert.Zero(t, summary.PendingClaims.Count)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryClaimMaturity(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+
+	<-evtChan // Wait for block
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = HeightAndAddrParams{
+		Height:  0,
+		Address: cb.GetAddress().String(),
+	}
+	q := newQueryRequest("claimmaturity", newBody(params))
+	rec := httptest.NewRecorder()
+	ClaimMaturity(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	var maturity app.ClaimsMaturity
+	err = json.Unmarshal(resp, &maturity)
+	assert.Nil(t, err)
+	assert.Equal(t, cb.GetAddress().String(), maturity.Address)
+	assert.NotNil(t, maturity.Claims)
+	assert.Empty(t, maturity.Claims)
+	// an invalid address
+	params.Address = "invalid"
+	q = newQueryRequest("claimmaturity", newBody(params))
+	rec = httptest.NewRecorder()
+	ClaimMaturity(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryNetworkRelays(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+
+	<-evtChan // Wait for block
+	var params = HeightRangeAndChainParams{From: 1, To: 100, Chain: PlaceholderHash}
+	q := newQueryRequest("networkrelays", newBody(params))
+	rec := httptest.NewRecorder()
+	NetworkRelays(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	var res networkRelaysResponse
+	err := json.Unmarshal(resp, &res)
+	assert.Nil(t, err)
+	assert.Empty(t, res.NetworkRelays)
+	// invalid range
+	params = HeightRangeAndChainParams{From: 10, To: 1}
+	q = newQueryRequest("networkrelays", newBody(params))
+	rec = httptest.NewRecorder()
+	NetworkRelays(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryNodeRewards(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = AddrAndHeightRangeParams{Address: cb.GetAddress().String(), From: 1, To: 100}
+	q := newQueryRequest("noderewards", newBody(params))
+	rec := httptest.NewRecorder()
+	NodeRewards(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	var res app.NodeRewards
+	err = json.Unmarshal(resp, &res)
+	assert.Nil(t, err)
+	assert.Equal(t, cb.GetAddress().String(), res.Address)
+	assert.Empty(t, res.ByChain)
+	// no relays proven
+	assert.True(t, res.RelayRewards.IsZero())
+	assert.True(t, res.Total.Equal(res.RelayRewards.Add(res.ProposerRewards)))
+	// invalid range
+	params = AddrAndHeightRangeParams{Address: cb.GetAddress().String(), From: 0, To: 1}
+	q = newQueryRequest("noderewards", newBody(params))
+	rec = httptest.NewRecorder()
+	NodeRewards(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryApp(t *testing.T) {
 	gBZ, _, _, app := fiveValidatorsOneAppGenesis()
 	_, _, cleanup := NewInMemoryTendermintNode(t, gBZ)
@@ -316,6 +866,40 @@ func TestRPC_QueryApp(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryAppsByAddresses(t *testing.T) {
+	gBZ, _, _, application := fiveValidatorsOneAppGenesis()
+	_, _, cleanup := NewInMemoryTendermintNode(t, gBZ)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	unknown := "0101010101010101010101010101010101010101"
+	var params = HeightAndAddrsParams{
+		Height:    0,
+		Addresses: []string{application.GetAddress().String(), unknown},
+	}
+	q := newQueryRequest("appsbyaddresses", newBody(params))
+	rec := httptest.NewRecorder()
+	AppsByAddresses(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	var res struct {
+		Found   map[string]json.RawMessage `json:"found"`
+		Missing map[string]string          `json:"missing"`
+	}
+	err := json.Unmarshal(resp, &res)
+	assert.Nil(t, err)
+	assert.Contains(t, res.Found, application.GetAddress().String())
+	assert.Contains(t, res.Missing, unknown)
+	// too many addresses
+	params.Addresses = make([]string, app.MaxBulkLookupAddresses+1)
+	q = newQueryRequest("appsbyaddresses", newBody(params))
+	rec = httptest.NewRecorder()
+	AppsByAddresses(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryApps(t *testing.T) {
 	gBZ, _, _, app := fiveValidatorsOneAppGenesis()
 	_, _, cleanup := NewInMemoryTendermintNode(t, gBZ)
@@ -418,6 +1002,26 @@ func TestRPC_QuerySupportedChains(t *testing.T) {
 	cleanup()
 	stopCli()
 }
+
+func TestRPC_QuerySupportedChainsMetadata(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	var params = HeightParams{
+		Height: 0,
+	}
+	q := newQueryRequest("supportedchainsmetadata", newBody(params))
+	rec := httptest.NewRecorder()
+	SupportedChainsMetadata(rec, q, httprouter.Params{})
+	var res []pocketTypes.ChainMetadata
+	err := json.Unmarshal([]byte(getResponse(rec)), &res)
+	assert.Nil(t, err)
+	assert.Equal(t, []pocketTypes.ChainMetadata{{ID: dummyChainsHash}}, res)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QuerySupply(t *testing.T) {
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
 	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
@@ -540,6 +1144,68 @@ func TestRPCQueryParam(t *testing.T) {
 	stopCli()
 }
 
+func TestRPCQueryParams(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	var params = HeightAndPrefixParams{
+		Height: 0,
+		Prefix: "POS",
+	}
+	q := newQueryRequest("params", newBody(params))
+	rec := httptest.NewRecorder()
+	Params(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	var res paramsResponse
+	err := json.Unmarshal(resp, &res)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, res.Params)
+	for _, p := range res.Params {
+		assert.True(t, strings.HasPrefix(p.Key, "pos/"))
+		if p.Key == "pos/StakeMinimum" {
+			var min int64
+			assert.Nil(t, json.Unmarshal(p.TypedValue, &min))
+			assert.Equal(t, "int", p.Type)
+			assert.NotZero(t, min)
+		}
+	}
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPCSimulateParamChanges(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	var params = SimulateParamChangesParams{
+		Height:  0,
+		Changes: []app.ParamChange{{Key: "pocketcore/SessionNodeCount", Value: json.RawMessage(`3`)}},
+	}
+	q := newQueryRequest("simulateparamchanges", newBody(params))
+	rec := httptest.NewRecorder()
+	SimulateParamChanges(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	var res app.ParamChangeSimulation
+	err := json.Unmarshal(resp, &res)
+	assert.Nil(t, err)
+	assert.True(t, res.Applied)
+	assert.Equal(t, int64(3), res.After.SessionNodeCount)
+	// not the owner of the param
+	params.Address = "0000000000000000000000000000000000000000"
+	q = newQueryRequest("simulateparamchanges", newBody(params))
+	rec = httptest.NewRecorder()
+	SimulateParamChanges(rec, q, httprouter.Params{})
+	resp = getJSONResponse(rec)
+	err = json.Unmarshal(resp, &res)
+	assert.Nil(t, err)
+	assert.False(t, res.Applied)
+	assert.NotEmpty(t, res.Changes[0].Error)
+
+	cleanup()
+	stopCli()
+}
+
 const (
 	acaoHeaderKey   = "Access-Control-Allow-Origin"
 	acaoHeaderValue = "*"
@@ -675,43 +1341,281 @@ func TestRPC_Relay(t *testing.T) {
 	var response RPCRelayResponse
 	err = json.Unmarshal(resp, &response)
 	assert.Nil(t, err)
-	assert.Equal(t, expectedResponse, response.Response)
+	assert.Equal(t, expectedResponse, response.Response)
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_Dispatch(t *testing.T) {
+	kb := getInMemoryKeybase()
+	genBZ, _, validators, app := fiveValidatorsOneAppGenesis()
+	_, _, cleanup := NewInMemoryTendermintNode(t, genBZ)
+	appPrivateKey, err := kb.ExportPrivateKeyObject(app.Address, "test")
+	assert.Nil(t, err)
+	// Setup HandleDispatch Request
+	key := pocketTypes.SessionHeader{
+		ApplicationPubKey:  appPrivateKey.PublicKey().RawString(),
+		Chain:              dummyChainsHash,
+		SessionBlockHeight: 1,
+	}
+	// setup the query
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	q := newClientRequest("dispatch", newBody(key))
+	rec := httptest.NewRecorder()
+	Dispatch(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	rawResp := string(resp)
+	assert.Regexp(t, key.ApplicationPubKey, rawResp)
+	assert.Regexp(t, key.Chain, rawResp)
+
+	for _, validator := range validators {
+		assert.Regexp(t, validator.Address.String(), rawResp)
+	}
+	cleanup()
+	stopCli()
+
+}
+
+func TestRPC_QuerySession(t *testing.T) {
+	kb := getInMemoryKeybase()
+	genBZ, _, validators, app := fiveValidatorsOneAppGenesis()
+	_, _, cleanup := NewInMemoryTendermintNode(t, genBZ)
+	appPrivateKey, err := kb.ExportPrivateKeyObject(app.Address, "test")
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	var params = SessionParams{
+		AppPubKey: appPrivateKey.PublicKey().RawString(),
+		Chain:     dummyChainsHash,
+	}
+	q := newQueryRequest("session", newBody(params))
+	rec := httptest.NewRecorder()
+	Session(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	var res struct {
+		Session struct {
+			SessionHeader pocketTypes.SessionHeader `json:"header"`
+			SessionKey    []byte                    `json:"key"`
+			SessionNodes  []json.RawMessage         `json:"nodes"`
+		} `json:"session"`
+	}
+	err = json.Unmarshal(resp, &res)
+	assert.Nil(t, err)
+	assert.Equal(t, params.AppPubKey, res.Session.SessionHeader.ApplicationPubKey)
+	assert.Equal(t, int64(1), res.Session.SessionHeader.SessionBlockHeight)
+	assert.NotEmpty(t, res.Session.SessionKey)
+	assert.Len(t, res.Session.SessionNodes, len(validators))
+	rawResp := string(resp)
+	for _, validator := range validators {
+		assert.Regexp(t, validator.Address.String(), rawResp)
+	}
+	// invalid chain
+	params.Chain = "invalid"
+	q = newQueryRequest("session", newBody(params))
+	rec = httptest.NewRecorder()
+	Session(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_RawTX(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	// create the transaction
+	txBz, err := auth.DefaultTxEncoder(memCodec())(authTypes.NewTestTx(types.Context{}.WithChainID("pocket-test"),
+		types2.MsgSend{
+			FromAddress: cb.GetAddress(),
+			ToAddress:   kp.GetAddress(),
+			Amount:      types.NewInt(1),
+		},
+		pk,
+		common.RandInt64(),
+		types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(100000)))))
+	assert.Nil(t, err)
+	<-evtChan // Wait for block
+	params := SendRawTxParams{
+		Addr:        cb.GetAddress().String(),
+		RawHexBytes: hex.EncodeToString(txBz),
+	}
+	q := newClientRequest("rawtx", newBody(params))
+	rec := httptest.NewRecorder()
+	SendRawTx(rec, q, httprouter.Params{})
+	resp := getResponse(rec)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	var response types.TxResponse
+	err = memCodec().UnmarshalJSON([]byte(resp), &response)
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(response.Logs.String(), `"success":true`))
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_HashVectors(t *testing.T) {
+	req, err := http.NewRequest("GET", "localhost:8081/v1/hashvectors", nil)
+	assert.Nil(t, err)
+	rec := httptest.NewRecorder()
+	HashVectors(rec, req, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res hashVectorsResponse
+	err = json.Unmarshal(getJSONResponse(rec), &res)
+	assert.Nil(t, err)
+	assert.Equal(t, app.AppVersion, res.Version)
+	vectors, err := pocketTypes.NewHashVectors()
+	assert.Nil(t, err)
+	assert.Equal(t, vectors.RelayProof.Hash, res.Vectors.RelayProof.Hash)
+	assert.Equal(t, vectors.SessionKey, res.Vectors.SessionKey)
+}
+
+func TestRPC_VerifyRelayResponse(t *testing.T) {
+	kb := getInMemoryKeybase()
+	genBZ, _, _, app := fiveValidatorsOneAppGenesis()
+	_, _, cleanup := NewInMemoryTendermintNode(t, genBZ)
+	appPrivateKey, err := kb.ExportPrivateKeyObject(app.Address, "test")
+	assert.Nil(t, err)
+	servicerPrivateKey := crypto.GenerateEd25519PrivKey()
+	aat := pocketTypes.AAT{
+		Version:              "0.0.1",
+		ApplicationPublicKey: appPrivateKey.PublicKey().RawString(),
+		ClientPublicKey:      appPrivateKey.PublicKey().RawString(),
+	}
+	sig, err := appPrivateKey.Sign(aat.Hash())
+	assert.Nil(t, err)
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	relay := pocketTypes.Relay{
+		Payload: pocketTypes.Payload{Data: "foo", Method: "POST"},
+		Meta:    pocketTypes.RelayMeta{BlockHeight: 5},
+		Proof: pocketTypes.RelayProof{
+			Entropy:            32598345349034509,
+			SessionBlockHeight: 1,
+			ServicerPubKey:     servicerPrivateKey.PublicKey().RawString(),
+			Blockchain:         dummyChainsHash,
+			Token:              aat,
+		},
+	}
+	relay.Proof.RequestHash = relay.RequestHashString()
+	sig, err = appPrivateKey.Sign(relay.Proof.Hash())
+	assert.Nil(t, err)
+	relay.Proof.Signature = hex.EncodeToString(sig)
+	response := pocketTypes.RelayResponse{Response: "bar", Proof: relay.Proof}
+	sig, err = servicerPrivateKey.Sign(response.Hash())
+	assert.Nil(t, err)
+	response.Signature = hex.EncodeToString(sig)
+	otherRelay := relay
+	otherRelay.Payload.Data = "baz"
+	tests := []struct {
+		name   string
+		params verifyRelayResponseParams
+		valid  bool
+	}{
+		{"valid response", verifyRelayResponseParams{RelayResponse: response, ServicerPubKey: relay.Proof.ServicerPubKey}, true},
+		{"valid response of the request", verifyRelayResponseParams{RelayResponse: response, ServicerPubKey: relay.Proof.ServicerPubKey, Relay: &relay}, true},
+		{"response of another request", verifyRelayResponseParams{RelayResponse: response, ServicerPubKey: relay.Proof.ServicerPubKey, Relay: &otherRelay}, false},
+		{"response of another servicer", verifyRelayResponseParams{RelayResponse: response, ServicerPubKey: appPrivateKey.PublicKey().RawString()}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newClientRequest("verifyrelayresponse", newBody(tt.params))
+			rec := httptest.NewRecorder()
+			VerifyRelayResponse(rec, q, httprouter.Params{})
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var res verifyRelayResponseResponse
+			err := json.Unmarshal(getJSONResponse(rec), &res)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.valid, res.Valid, res.Error)
+		})
+	}
 	cleanup()
-	stopCli()
 }
 
-func TestRPC_Dispatch(t *testing.T) {
-	kb := getInMemoryKeybase()
-	genBZ, _, validators, app := fiveValidatorsOneAppGenesis()
-	_, _, cleanup := NewInMemoryTendermintNode(t, genBZ)
-	appPrivateKey, err := kb.ExportPrivateKeyObject(app.Address, "test")
+func TestRPC_Subscribe(t *testing.T) {
+	server := httptest.NewServer(Router(Routes{Route{Name: "Subscribe", Method: "GET", Path: "/v1/subscribe", HandlerFunc: Subscribe}}))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/subscribe"
+	// unsupported event types are rejected before upgrading
+	_, resp, err := websocket.DefaultDialer.Dial(url+"?events=invalid", nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?events="+pocketTypes.SubscriptionEventProofVerified, nil)
 	assert.Nil(t, err)
-	// Setup HandleDispatch Request
-	key := pocketTypes.SessionHeader{
-		ApplicationPubKey:  appPrivateKey.PublicKey().RawString(),
-		Chain:              dummyChainsHash,
-		SessionBlockHeight: 1,
+	defer conn.Close()
+	// wait for the handler to subscribe
+	proof := pocketTypes.SubscriptionEvent{Type: pocketTypes.SubscriptionEventProofVerified, Height: 5, Attributes: map[string]string{"validator": "abc"}}
+	for i := 0; i < 10; i++ {
+		time.Sleep(50 * time.Millisecond)
+		pocketTypes.PublishEvent(pocketTypes.SubscriptionEvent{Type: pocketTypes.SubscriptionEventRelayServed, Height: 5})
+		pocketTypes.PublishEvent(proof)
 	}
-	// setup the query
+	var event pocketTypes.SubscriptionEvent
+	assert.Nil(t, conn.ReadJSON(&event))
+	assert.Equal(t, proof, event)
+}
+
+func TestRPC_RawTxWithEvents(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
 	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	// create the transaction
+	txBz, err := auth.DefaultTxEncoder(memCodec())(authTypes.NewTestTx(types.Context{}.WithChainID("pocket-test"),
+		types2.MsgSend{
+			FromAddress: cb.GetAddress(),
+			ToAddress:   kp.GetAddress(),
+			Amount:      types.NewInt(1),
+		},
+		pk,
+		common.RandInt64(),
+		types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(100000)))))
+	assert.Nil(t, err)
 	<-evtChan // Wait for block
-	q := newClientRequest("dispatch", newBody(key))
+	// bad bytes
+	q := newClientRequest("rawtx", newBody(RawTxParams{RawBytes: "zz"}))
 	rec := httptest.NewRecorder()
-	Dispatch(rec, q, httprouter.Params{})
+	RawTx(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// not a tx
+	q = newClientRequest("rawtx", newBody(RawTxParams{RawBytes: hex.EncodeToString([]byte("foo"))}))
+	rec = httptest.NewRecorder()
+	RawTx(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// unknown mode
+	q = newClientRequest("rawtx", newBody(RawTxParams{RawBytes: hex.EncodeToString(txBz), Mode: "foo"}))
+	rec = httptest.NewRecorder()
+	RawTx(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// base64 bytes, sync mode
+	q = newClientRequest("rawtx", newBody(RawTxParams{RawBytes: base64.StdEncoding.EncodeToString(txBz), Encoding: "base64"}))
+	rec = httptest.NewRecorder()
+	RawTx(rec, q, httprouter.Params{})
 	resp := getJSONResponse(rec)
-	rawResp := string(resp)
-	assert.Regexp(t, key.ApplicationPubKey, rawResp)
-	assert.Regexp(t, key.Chain, rawResp)
-
-	for _, validator := range validators {
-		assert.Regexp(t, validator.Address.String(), rawResp)
-	}
+	assert.NotNil(t, resp)
+	var response app.RawTxResponse
+	err = memCodec().UnmarshalJSON(resp, &response)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("%X", tmTypes.Tx(txBz).Hash()), response.TxHash)
+	assert.Equal(t, cb.GetAddress(), response.Tx.GetSigner())
+	assert.NotNil(t, response.CheckTx)
+	assert.Zero(t, response.CheckTx.Code)
+	assert.Nil(t, response.DeliverTx)
 	cleanup()
 	stopCli()
-
 }
 
-func TestRPC_RawTX(t *testing.T) {
+func TestRPC_ScheduledTxs(t *testing.T) {
 	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
 	cb, err := kb.GetCoinbase()
 	assert.Nil(t, err)
@@ -719,8 +1623,8 @@ func TestRPC_RawTX(t *testing.T) {
 	assert.Nil(t, err)
 	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
 	assert.Nil(t, err)
+	app.SetAuthToken(app.AuthToken{Value: "token"})
 	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
-	// create the transaction
 	txBz, err := auth.DefaultTxEncoder(memCodec())(authTypes.NewTestTx(types.Context{}.WithChainID("pocket-test"),
 		types2.MsgSend{
 			FromAddress: cb.GetAddress(),
@@ -732,21 +1636,180 @@ func TestRPC_RawTX(t *testing.T) {
 		types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(100000)))))
 	assert.Nil(t, err)
 	<-evtChan // Wait for block
-	params := SendRawTxParams{
-		Addr:        cb.GetAddress().String(),
-		RawHexBytes: hex.EncodeToString(txBz),
+	stopCli()
+	hash := fmt.Sprintf("%X", tmTypes.Tx(txBz).Hash())
+	height := app.PCA.LastBlockHeight()
+	// not a tx
+	q := newPrivateRequest("scheduledtxs", newBody(pocketTypes.ScheduledTxsUpdate{Schedule: []pocketTypes.ScheduledTx{{Height: height + 2, Tx: hex.EncodeToString([]byte("foo"))}}}), "token")
+	rec := httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, ScheduledTxs)(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// a past height
+	q = newPrivateRequest("scheduledtxs", newBody(pocketTypes.ScheduledTxsUpdate{Schedule: []pocketTypes.ScheduledTx{{Height: height, Tx: hex.EncodeToString(txBz)}}}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, ScheduledTxs)(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// schedule the tx
+	q = newPrivateRequest("scheduledtxs", newBody(pocketTypes.ScheduledTxsUpdate{Schedule: []pocketTypes.ScheduledTx{{Height: height + 2, Tx: hex.EncodeToString(txBz)}}}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, ScheduledTxs)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res scheduledTxsResponse
+	err = json.Unmarshal(getJSONResponse(rec), &res)
+	assert.Nil(t, err)
+	assert.Len(t, res.Txs, 1)
+	assert.Equal(t, hash, res.Txs[0].Hash)
+	assert.Equal(t, height+2, res.Txs[0].Height)
+	// the tx is broadcast at its height
+	_, stopTxCli, txChan := subscribeTo(t, tmTypes.EventTx)
+	select {
+	case evt := <-txChan:
+		assert.Equal(t, hash, fmt.Sprintf("%X", evt.Data.(tmTypes.EventDataTx).Tx.Hash()))
+	case <-time.After(30 * time.Second):
+		t.Fatal("the scheduled tx was not broadcast")
 	}
-	q := newClientRequest("rawtx", newBody(params))
+	assert.Empty(t, pocketTypes.GetScheduledTxs())
+	// unknown tx
+	q = newPrivateRequest("scheduledtxs", newBody(pocketTypes.ScheduledTxsUpdate{Cancel: []string{hash}}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, ScheduledTxs)(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	stopTxCli()
+	cleanup()
+}
+
+func TestRPC_OfflineTx(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	msg, err := memCodec().MarshalJSON(types2.MsgSend{
+		FromAddress: cb.GetAddress(),
+		ToAddress:   kp.GetAddress(),
+		Amount:      types.NewInt(1),
+	})
+	assert.Nil(t, err)
+	// invalid msg
+	q := newClientRequest("unsignedtx", newBody(UnsignedTxParams{Msg: []byte(`{"type":"pos/Send","value":{}}`)}))
 	rec := httptest.NewRecorder()
-	SendRawTx(rec, q, httprouter.Params{})
-	resp := getResponse(rec)
+	UnsignedTx(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// build the unsigned tx
+	q = newClientRequest("unsignedtx", newBody(UnsignedTxParams{Msg: msg, Memo: "offline"}))
+	rec = httptest.NewRecorder()
+	UnsignedTx(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	var unsigned app.UnsignedTx
+	err = memCodec().UnmarshalJSON(resp, &unsigned)
 	assert.Nil(t, err)
-	assert.NotNil(t, resp)
-	var response types.TxResponse
-	err = memCodec().UnmarshalJSON([]byte(resp), &response)
+	assert.Equal(t, cb.GetAddress().String(), unsigned.Signer)
+	assert.Equal(t, "offline", unsigned.Tx.Memo)
+	assert.Nil(t, unsigned.Tx.Signature.Signature)
+	// sign offline
+	signBytes, err := hex.DecodeString(unsigned.SignBytes)
 	assert.Nil(t, err)
-	assert.True(t, strings.Contains(response.Logs.String(), `"success":true`))
+	sig, err := pk.Sign(signBytes)
+	assert.Nil(t, err)
+	tx, err := memCodec().MarshalJSON(unsigned.Tx)
+	assert.Nil(t, err)
+	// the key of another account
+	other, err := kb.ExportPrivateKeyObject(kp.GetAddress(), "test")
+	assert.Nil(t, err)
+	q = newClientRequest("signedtx", newBody(SignedTxParams{Tx: tx, PublicKey: other.PublicKey().RawString(), Signature: hex.EncodeToString(sig)}))
+	rec = httptest.NewRecorder()
+	SignedTx(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// a signature of other bytes
+	badSig, err := pk.Sign([]byte("foo"))
+	assert.Nil(t, err)
+	q = newClientRequest("signedtx", newBody(SignedTxParams{Tx: tx, PublicKey: pk.PublicKey().RawString(), Signature: hex.EncodeToString(badSig)}))
+	rec = httptest.NewRecorder()
+	SignedTx(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// assemble and broadcast
+	q = newClientRequest("signedtx", newBody(SignedTxParams{Tx: tx, PublicKey: pk.PublicKey().RawString(), Signature: hex.EncodeToString(sig), Broadcast: true}))
+	rec = httptest.NewRecorder()
+	SignedTx(rec, q, httprouter.Params{})
+	resp = getJSONResponse(rec)
+	var signed signedTxResponse
+	err = memCodec().UnmarshalJSON(resp, &signed)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, signed.RawBytes)
+	assert.NotNil(t, signed.Result)
+	assert.NotNil(t, signed.Result.CheckTx)
+	assert.Zero(t, signed.Result.CheckTx.Code)
+	cleanup()
+	stopCli()
+}
 
+func TestRPC_MultiSignedTx(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp2, err := kb.Create("test")
+	assert.Nil(t, err)
+	kp3, err := kb.Create("test")
+	assert.Nil(t, err)
+	pms := crypto.PublicKeyMultiSignature{PublicKeys: []crypto.PublicKey{cb.PublicKey, kp2.PublicKey, kp3.PublicKey}}
+	memCLI, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	// fund the multisig account
+	_, err = nodes.Send(memCodec(), memCLI, kb, cb.GetAddress(), types.Address(pms.Address()), "test", types.NewInt(100000000))
+	assert.Nil(t, err)
+	<-evtChan // Wait for tx
+	msg, err := memCodec().MarshalJSON(types2.MsgSend{
+		FromAddress: types.Address(pms.Address()),
+		ToAddress:   kp2.GetAddress(),
+		Amount:      types.NewInt(1),
+	})
+	assert.Nil(t, err)
+	q := newClientRequest("unsignedtx", newBody(UnsignedTxParams{Msg: msg}))
+	rec := httptest.NewRecorder()
+	UnsignedTx(rec, q, httprouter.Params{})
+	var unsigned app.UnsignedTx
+	err = memCodec().UnmarshalJSON(getJSONResponse(rec), &unsigned)
+	assert.Nil(t, err)
+	signBytes, err := hex.DecodeString(unsigned.SignBytes)
+	assert.Nil(t, err)
+	tx, err := memCodec().MarshalJSON(unsigned.Tx)
+	assert.Nil(t, err)
+	// the partial signatures, out of order
+	var keys []string
+	var sigs []app.PartialSignature
+	for _, addr := range []types.Address{kp3.GetAddress(), cb.GetAddress(), kp2.GetAddress()} {
+		sig, pk, err := kb.Sign(addr, "test", signBytes)
+		assert.Nil(t, err)
+		sigs = append(sigs, app.PartialSignature{PublicKey: pk.RawString(), Signature: hex.EncodeToString(sig)})
+	}
+	for _, pk := range pms.PublicKeys {
+		keys = append(keys, pk.RawString())
+	}
+	// a missing signature
+	q = newClientRequest("multisignedtx", newBody(MultiSignedTxParams{Tx: tx, PublicKeys: keys, Signatures: sigs[:2]}))
+	rec = httptest.NewRecorder()
+	MultiSignedTx(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// the keys of another multisig
+	q = newClientRequest("multisignedtx", newBody(MultiSignedTxParams{Tx: tx, PublicKeys: []string{keys[1], keys[0], keys[2]}, Signatures: sigs}))
+	rec = httptest.NewRecorder()
+	MultiSignedTx(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// combine and broadcast
+	q = newClientRequest("multisignedtx", newBody(MultiSignedTxParams{Tx: tx, PublicKeys: keys, Signatures: sigs, Broadcast: true}))
+	rec = httptest.NewRecorder()
+	MultiSignedTx(rec, q, httprouter.Params{})
+	var signed signedTxResponse
+	err = memCodec().UnmarshalJSON(getJSONResponse(rec), &signed)
+	assert.Nil(t, err)
+	assert.NotNil(t, signed.Result)
+	assert.NotNil(t, signed.Result.CheckTx)
+	assert.Zero(t, signed.Result.CheckTx.Code)
 	cleanup()
 	stopCli()
 }
@@ -758,7 +1821,7 @@ func TestRPC_QueryNodeClaims(t *testing.T) {
 	kb := getInMemoryKeybase()
 	cb, err := kb.GetCoinbase()
 	assert.Nil(t, err)
-	var params = PaginatedHeightAndAddrParams{
+	var params = PaginatedEvidenceParams{
 		Height: 0,
 		Addr:   cb.GetAddress().String(),
 	}
@@ -766,6 +1829,99 @@ func TestRPC_QueryNodeClaims(t *testing.T) {
 	rec := httptest.NewRecorder()
 	NodeClaims(rec, q, httprouter.Params{})
 	getJSONResponse(rec)
+	// filtered
+	params.EvidenceType = "relay"
+	params.Blockchain = PlaceholderHash
+	q = newQueryRequest("nodeclaims", newBody(params))
+	rec = httptest.NewRecorder()
+	NodeClaims(rec, q, httprouter.Params{})
+	assert.Equal(t, 200, rec.Code)
+	// invalid filter
+	params.EvidenceType = "invalid"
+	q = newQueryRequest("nodeclaims", newBody(params))
+	rec = httptest.NewRecorder()
+	NodeClaims(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QuerySessionValidators(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	var params = SessionValidatorsParams{
+		SessionHeight: 1,
+	}
+	q := newQueryRequest("sessionvalidators", newBody(params))
+	rec := httptest.NewRecorder()
+	SessionValidators(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, resp)
+	var snapshot types2.SessionSnapshot
+	err := app.Codec().UnmarshalJSON([]byte(resp), &snapshot)
+	assert.Nil(t, err)
+	assert.Len(t, snapshot.Validators, 1)
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryOpenChallenges(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	var params = PaginatedHeightParams{
+		Height: 0,
+	}
+	q := newQueryRequest("openchallenges", newBody(params))
+	rec := httptest.NewRecorder()
+	OpenChallenges(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, resp)
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryChallengesAgainst(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = PaginatedHeightAndAddrParams{
+		Height: 0,
+		Addr:   cb.GetAddress().String(),
+	}
+	q := newQueryRequest("challengesagainst", newBody(params))
+	rec := httptest.NewRecorder()
+	ChallengesAgainst(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, resp)
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryChallengeRewards(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = PaginatedHeightAndAddrParams{
+		Height: 0,
+		Addr:   cb.GetAddress().String(),
+	}
+	q := newQueryRequest("challengerewards", newBody(params))
+	rec := httptest.NewRecorder()
+	ChallengeRewards(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	assert.NotEmpty(t, resp)
 	cleanup()
 	stopCli()
 }
@@ -793,6 +1949,233 @@ func TestRPC_QueryNodeClaim(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_NodeConfig(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	app.SetAuthToken(app.AuthToken{Value: "token"})
+	// no auth token
+	q := newPrivateRequest("nodeconfig", nil, "")
+	rec := httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, NodeConfig)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	// correct auth token
+	q = newPrivateRequest("nodeconfig", nil, "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, NodeConfig)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res app.NodeConfig
+	err := json.Unmarshal(getJSONResponse(rec), &res)
+	assert.Nil(t, err)
+	assert.Equal(t, app.AppVersion, res.Version)
+	assert.Equal(t, app.NodeRoleServicer, res.Role)
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_LocalEvidence(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	app.SetAuthToken(app.AuthToken{Value: "token"})
+	header := pocketTypes.SessionHeader{
+		ApplicationPubKey:  crypto.GenerateEd25519PrivKey().PublicKey().RawString(),
+		Chain:              PlaceholderHash,
+		SessionBlockHeight: 1,
+	}
+	pocketTypes.SetProof(header, pocketTypes.RelayEvidence, pocketTypes.RelayProof{Entropy: 1}, types.NewInt(100))
+	// no auth token
+	q := newPrivateRequest("evidence", nil, "")
+	rec := httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, LocalEvidence)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	// wrong auth token
+	q = newPrivateRequest("evidence", nil, "wrong")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, LocalEvidence)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	// correct auth token
+	q = newPrivateRequest("evidence", nil, "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, LocalEvidence)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res localEvidenceResponse
+	err := json.Unmarshal(getJSONResponse(rec), &res)
+	assert.Nil(t, err)
+	assert.Len(t, res.Evidence, 1)
+	assert.Equal(t, header, res.Evidence[0].SessionHeader)
+	assert.Equal(t, int64(1), res.Evidence[0].NumOfProofs)
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_ChainsHealth(t *testing.T) {
+	app.SetAuthToken(app.AuthToken{Value: "token"})
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":"0x10"}`))
+	}))
+	defer chain.Close()
+	hb := pocketTypes.HostedBlockchains{M: map[string]pocketTypes.HostedBlockchain{
+		PlaceholderHash: {ID: PlaceholderHash, URL: chain.URL, HealthCheck: &pocketTypes.Payload{Data: `{"method":"eth_blockNumber"}`}},
+	}}
+	notHosted := "0021"
+	pocketTypes.CheckChainsHealth(&hb, []string{notHosted})
+	// no auth token
+	q := newPrivateRequest("chainshealth", nil, "")
+	rec := httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, ChainsHealth)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	// correct auth token
+	q = newPrivateRequest("chainshealth", nil, "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, ChainsHealth)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res chainsHealthResponse
+	assert.Nil(t, json.Unmarshal(getJSONResponse(rec), &res))
+	assert.Len(t, res.Chains, 2)
+	for _, h := range res.Chains {
+		switch h.ID {
+		case PlaceholderHash:
+			assert.True(t, h.Healthy)
+			assert.Equal(t, int64(16), h.Height)
+		case notHosted:
+			assert.True(t, h.Staked)
+			assert.False(t, h.Hosted || h.Healthy)
+		}
+	}
+}
+
+func TestRPC_KeyAudit(t *testing.T) {
+	app.SetAuthToken(app.AuthToken{Value: "token"})
+	dir, err := ioutil.TempDir("", "keyaudit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	pocketTypes.InitKeyAuditLog(filepath.Join(dir, pocketTypes.DefaultKeyAuditLogName))
+	defer pocketTypes.InitKeyAuditLog("")
+	pocketTypes.RecordNodeKeyUse(pocketTypes.MsgClaimName, &types.TxResponse{TxHash: "ABC"}, nil)
+	pocketTypes.RecordNodeKeyUse(pocketTypes.MsgProofName, &types.TxResponse{TxHash: "DEF"}, nil)
+	// no auth token
+	q := newPrivateRequest("keyaudit", nil, "")
+	rec := httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, KeyAudit)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	// newest first
+	q = newPrivateRequest("keyaudit", newBody(PaginateOrderParams{}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, KeyAudit)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res struct {
+		Result []pocketTypes.KeyAuditRecord `json:"result"`
+		Total  int                          `json:"total_pages"`
+	}
+	assert.Nil(t, json.Unmarshal(getJSONResponse(rec), &res))
+	assert.Len(t, res.Result, 2)
+	assert.Equal(t, "DEF", res.Result[0].TxHash)
+	assert.Equal(t, pocketTypes.NodeKey, res.Result[0].Key)
+	// oldest first, paginated
+	q = newPrivateRequest("keyaudit", newBody(PaginateOrderParams{Page: 1, PerPage: 1, Order: app.TxOrderAsc}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, KeyAudit)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Nil(t, json.Unmarshal(getJSONResponse(rec), &res))
+	assert.Len(t, res.Result, 1)
+	assert.Equal(t, "ABC", res.Result[0].TxHash)
+	assert.Equal(t, 2, res.Total)
+}
+
+func TestRPC_Blocklist(t *testing.T) {
+	app.SetAuthToken(app.AuthToken{Value: "token"})
+	appPubKey := crypto.GenerateEd25519PrivKey().PublicKey().RawString()
+	// no auth token
+	q := newPrivateRequest("blocklist", nil, "")
+	rec := httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, Blocklist)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	// block the app
+	q = newPrivateRequest("blocklist", newBody(pocketTypes.BlocklistUpdate{BlockApps: []string{appPubKey}}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, Blocklist)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res pocketTypes.Blocklist
+	err := json.Unmarshal(getJSONResponse(rec), &res)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{appPubKey}, res.Apps)
+	// invalid public key
+	q = newPrivateRequest("blocklist", newBody(pocketTypes.BlocklistUpdate{BlockClients: []string{"invalid"}}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, Blocklist)(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// unblock the app
+	q = newPrivateRequest("blocklist", newBody(pocketTypes.BlocklistUpdate{UnblockApps: []string{appPubKey}}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, Blocklist)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	err = json.Unmarshal(getJSONResponse(rec), &res)
+	assert.Nil(t, err)
+	assert.Empty(t, res.Apps)
+}
+
+func TestRPC_RoleTokens(t *testing.T) {
+	app.SetAuthToken(app.AuthToken{Value: "token"})
+	app.SetRoleTokens([]app.RoleToken{{Name: "monitoring", Value: "readtoken", Roles: []string{app.AuthRoleRead}}})
+	defer app.SetRoleTokens(nil)
+	handler := func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		WriteJSONResponse(w, "{}", r.URL.Path, r.Host)
+	}
+	// the read only token is allowed on the read only routes
+	q := newPrivateRequest("blocklist", nil, "readtoken")
+	rec := httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, handler)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	// and forbidden on the others
+	q = newPrivateRequest("blocklist", nil, "readtoken")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, handler)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	// the auth token of the node has all of the roles
+	q = newPrivateRequest("blocklist", nil, "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, handler)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	// unknown token
+	q = newPrivateRequest("blocklist", nil, "unknown")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleRead, handler)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestRPC_SessionWebhooks(t *testing.T) {
+	app.SetAuthToken(app.AuthToken{Value: "token"})
+	hook := pocketTypes.SessionWebhook{ApplicationPubKey: crypto.GenerateEd25519PrivKey().PublicKey().RawString(), URL: "http://localhost:8082/sessions"}
+	// no auth token
+	q := newPrivateRequest("sessionwebhooks", nil, "")
+	rec := httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, SessionWebhooks)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	// register the webhook
+	q = newPrivateRequest("sessionwebhooks", newBody(pocketTypes.SessionWebhooksUpdate{Register: []pocketTypes.SessionWebhook{hook}}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, SessionWebhooks)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res sessionWebhooksResponse
+	err := json.Unmarshal(getJSONResponse(rec), &res)
+	assert.Nil(t, err)
+	assert.Equal(t, []pocketTypes.SessionWebhook{hook}, res.Webhooks)
+	// invalid url
+	q = newPrivateRequest("sessionwebhooks", newBody(pocketTypes.SessionWebhooksUpdate{Register: []pocketTypes.SessionWebhook{{ApplicationPubKey: hook.ApplicationPubKey, URL: "invalid"}}}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, SessionWebhooks)(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// unregister the webhook
+	q = newPrivateRequest("sessionwebhooks", newBody(pocketTypes.SessionWebhooksUpdate{Unregister: []pocketTypes.SessionWebhook{hook}}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, SessionWebhooks)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	err = json.Unmarshal(getJSONResponse(rec), &res)
+	assert.Nil(t, err)
+	assert.Empty(t, res.Webhooks)
+}
+
 func TestRPC_Challenge(t *testing.T) {
 	kb := getInMemoryKeybase()
 	genBZ, keys, _, app := fiveValidatorsOneAppGenesis()
@@ -815,6 +2198,36 @@ func TestRPC_Challenge(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_Challenges(t *testing.T) {
+	genBZ, keys, _, _ := fiveValidatorsOneAppGenesis()
+	_, _, cleanup := NewInMemoryTendermintNode(t, genBZ)
+	c := NewValidChallengeProof(t, keys)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	params := challengesParams{
+		Responses: []pocketTypes.RelayResponse{c.MinorityResponse, c.MajorityResponses[0], c.MajorityResponses[1]},
+		Address:   c.ReporterAddress.String(),
+	}
+	q := newClientRequest("challenges", newBody(params))
+	rec := httptest.NewRecorder()
+	Challenges(rec, q, httprouter.Params{})
+	assert.Equal(t, 200, rec.Code)
+	var res challengesResponse
+	assert.Nil(t, json.Unmarshal([]byte(getJSONResponse(rec)), &res))
+	assert.Len(t, res.Challenges, 1)
+	assert.Equal(t, c.MinorityResponse.Proof.ServicerPubKey, res.Challenges[0].Servicer)
+	assert.Empty(t, res.Challenges[0].Error)
+	assert.Contains(t, res.Challenges[0].Response, "success")
+	// invalid reporter address
+	params.Address = "invalid"
+	q = newClientRequest("challenges", newBody(params))
+	rec = httptest.NewRecorder()
+	Challenges(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_SimRelay(t *testing.T) {
 	// setup relay endpoint
 	expectedRequest := `"jsonrpc":"2.0","method":"web3_sha3","params":["0x68656c6c6f20776f726c64"],"id":64`
@@ -840,6 +2253,78 @@ func TestRPC_SimRelay(t *testing.T) {
 	assert.Equal(t, resp, expectedResponse)
 }
 
+func TestRPC_Recovery(t *testing.T) {
+	handler := Recovery(func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		panic("boom")
+	})
+	req := newQueryRequest("height", nil)
+	rec := httptest.NewRecorder()
+	assert.NotPanics(t, func() { handler(rec, req, httprouter.Params{}) })
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestRPC_QueryCache(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	handler := Cache(Block)
+	q := newQueryRequest("block", newBody(HeightParams{Height: 1}))
+	q.Header.Set("Accept-Encoding", "deflate;q=0.5, gzip")
+	rec := httptest.NewRecorder()
+	handler(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	reader, err := gzip.NewReader(rec.Body)
+	assert.Nil(t, err)
+	resp, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	var blk core_types.ResultBlock
+	err = memCodec().UnmarshalJSON(resp, &blk)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), blk.Block.Height)
+	// not modified at the same height
+	q = newQueryRequest("block", newBody(HeightParams{Height: 1}))
+	q.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	handler(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.Bytes())
+	// another route, too small to compress
+	q = newQueryRequest("height", nil)
+	q.Header.Set("Accept-Encoding", "gzip")
+	q.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	Cache(Height)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+	// the next block
+	<-evtChan
+	q = newQueryRequest("block", newBody(HeightParams{Height: 1}))
+	q.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	handler(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_AcceptedEncoding(t *testing.T) {
+	assert.Equal(t, "gzip", acceptedEncoding("gzip, deflate, br"))
+	assert.Equal(t, "deflate", acceptedEncoding("deflate"))
+	assert.Equal(t, "deflate", acceptedEncoding("gzip;q=0, deflate"))
+	assert.Equal(t, "gzip", acceptedEncoding("*"))
+	assert.Equal(t, "", acceptedEncoding("br"))
+	assert.Equal(t, "", acceptedEncoding(""))
+	assert.True(t, isCachedQuery(Route{Method: "POST", Path: "/v1/query/block"}))
+	assert.False(t, isCachedQuery(Route{Method: "POST", Path: "/v1/query/nodestatus"}))
+	assert.False(t, isCachedQuery(Route{Method: "POST", Path: "/v1/client/relay"}))
+}
+
 func newBody(params interface{}) io.Reader {
 	bz, err := json.Marshal(params)
 	if err != nil {
@@ -857,6 +2342,21 @@ func newCORSRequest(query string, body io.Reader) *http.Request {
 	return req
 }
 
+func TestRPC_ReplicateEvidence(t *testing.T) {
+	// the replication is not enabled
+	q := newPrivateRequest("replicateevidence", bytes.NewReader([]byte("evidence")), "")
+	rec := httptest.NewRecorder()
+	ReplicateEvidence(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	// the evidence is not encrypted with the shared key
+	assert.Nil(t, pocketTypes.InitEvidenceReplication(nil, "secret"))
+	defer func() { _ = pocketTypes.InitEvidenceReplication(nil, "") }()
+	q = newPrivateRequest("replicateevidence", bytes.NewReader([]byte("evidence")), "")
+	rec = httptest.NewRecorder()
+	ReplicateEvidence(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
 func newClientRequest(query string, body io.Reader) *http.Request {
 	req, err := http.NewRequest("POST", "localhost:8081/v1/client/"+query, body)
 	if err != nil {
@@ -873,6 +2373,15 @@ func newQueryRequest(query string, body io.Reader) *http.Request {
 	return req
 }
 
+func newPrivateRequest(query string, body io.Reader, authToken string) *http.Request {
+	req, err := http.NewRequest("POST", "localhost:8081/v1/private/"+query, body)
+	if err != nil {
+		panic("could not create request: %v")
+	}
+	req.Header.Set(AuthHeader, authToken)
+	return req
+}
+
 func getResponse(rec *httptest.ResponseRecorder) string {
 	res := rec.Result()
 	defer res.Body.Close()
@@ -1037,3 +2546,32 @@ func NewValidChallengeProof(t *testing.T, privateKeys []crypto.PrivateKey) (chal
 	}
 	return proof
 }
+
+func TestRPC_ReplayEvents(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	app.SetAuthToken(app.AuthToken{Value: "token"})
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for another block
+	q := newPrivateRequest("replayevents", newBody(ReplayEventsParams{From: 1, To: 2, Sink: app.StreamSinkStdout}), "token")
+	rec := httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, ReplayEvents)(rec, q, httprouter.Params{})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var res app.EventReplay
+	assert.Nil(t, json.Unmarshal(getJSONResponse(rec), &res))
+	assert.Equal(t, 4, res.Records)
+	assert.Len(t, res.Output, 4)
+	// not a sink
+	q = newPrivateRequest("replayevents", newBody(ReplayEventsParams{From: 1, To: 2, Sink: "foo", URL: "http://localhost"}), "token")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, ReplayEvents)(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+	// a read token
+	q = newPrivateRequest("replayevents", newBody(ReplayEventsParams{From: 1, To: 2, Sink: app.StreamSinkStdout}), "foo")
+	rec = httptest.NewRecorder()
+	Authenticate(app.AuthRoleConfig, ReplayEvents)(rec, q, httprouter.Params{})
+	assert.NotEqual(t, http.StatusOK, rec.Code)
+
+	cleanup()
+	stopCli()
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
 
 var APIVersion = app.AppVersion
@@ -26,11 +27,28 @@ func StartRPC(port string, simulation bool) {
 func Router(routes Routes) *httprouter.Router {
 	router := httprouter.New()
 	for _, route := range routes {
-		router.Handle(route.Method, route.Path, route.HandlerFunc)
+		handler := route.HandlerFunc
+		if isCachedQuery(route) {
+			handler = Cache(handler)
+		}
+		router.Handle(route.Method, route.Path, Recovery(handler))
 	}
 	return router
 }
 
+// Recovery isolates a panicking handler, logging a crash dump and responding with an internal error
+func Recovery(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				types.HandlePanic("rpc", rec, "method", r.Method, "path", r.URL.Path)
+				WriteErrorResponse(w, http.StatusInternalServerError, "internal error")
+			}
+		}()
+		h(w, r, ps)
+	}
+}
+
 func cors(w *http.ResponseWriter, r *http.Request) (isOptions bool) {
 	(*w).Header().Set("Access-Control-Allow-Origin", "*")
 	(*w).Header().Set("Access-Control-Allow-Methods", "POST")
@@ -56,33 +74,80 @@ func GetRoutes() Routes {
 		Route{Name: "ServiceCORS", Method: "OPTIONS", Path: "/v1/client/relay", HandlerFunc: Relay},
 		Route{Name: "Challenge", Method: "POST", Path: "/v1/client/challenge", HandlerFunc: Challenge},
 		Route{Name: "ChallengeCORS", Method: "OPTIONS", Path: "/v1/client/challenge", HandlerFunc: Challenge},
+		Route{Name: "Challenges", Method: "POST", Path: "/v1/client/challenges", HandlerFunc: Challenges},
+		Route{Name: "ChallengesCORS", Method: "OPTIONS", Path: "/v1/client/challenges", HandlerFunc: Challenges},
 		Route{Name: "SendRawTx", Method: "POST", Path: "/v1/client/rawtx", HandlerFunc: SendRawTx},
+		Route{Name: "RawTx", Method: "POST", Path: "/v1/rawtx", HandlerFunc: RawTx},
+		Route{Name: "UnsignedTx", Method: "POST", Path: "/v1/client/unsignedtx", HandlerFunc: UnsignedTx},
+		Route{Name: "SignedTx", Method: "POST", Path: "/v1/client/signedtx", HandlerFunc: SignedTx},
+		Route{Name: "MultiSignedTx", Method: "POST", Path: "/v1/client/multisignedtx", HandlerFunc: MultiSignedTx},
+		Route{Name: "VerifyRelayResponse", Method: "POST", Path: "/v1/client/verifyrelayresponse", HandlerFunc: VerifyRelayResponse},
+		Route{Name: "VerifyRelayResponseCORS", Method: "OPTIONS", Path: "/v1/client/verifyrelayresponse", HandlerFunc: VerifyRelayResponse},
+		Route{Name: "HashVectors", Method: "GET", Path: "/v1/hashvectors", HandlerFunc: HashVectors},
+		Route{Name: "Subscribe", Method: "GET", Path: "/v1/subscribe", HandlerFunc: Subscribe},
 		Route{Name: "QueryBlock", Method: "POST", Path: "/v1/query/block", HandlerFunc: Block},
 		Route{Name: "QueryTX", Method: "POST", Path: "/v1/query/tx", HandlerFunc: Tx},
+		Route{Name: "QueryDecodedTX", Method: "POST", Path: "/v1/query/decodedtx", HandlerFunc: DecodedTx},
 		Route{Name: "QueryAccountTXS", Method: "POST", Path: "/v1/query/accounttxs", HandlerFunc: AccountTxs},
+		Route{Name: "QueryAllAccountTxs", Method: "POST", Path: "/v1/query/allaccounttxs", HandlerFunc: AllAccountTxs},
 		Route{Name: "QueryBlockTXS", Method: "POST", Path: "/v1/query/blocktxs", HandlerFunc: BlockTxs},
+		Route{Name: "QueryTxsByHeightRange", Method: "POST", Path: "/v1/query/txsbyheightrange", HandlerFunc: TxsByHeightRange},
 		Route{Name: "QueryHeight", Method: "POST", Path: "/v1/query/height", HandlerFunc: Height},
+		Route{Name: "QueryNodeStatus", Method: "POST", Path: "/v1/query/nodestatus", HandlerFunc: NodeStatus},
+		Route{Name: "QueryPruningInfo", Method: "POST", Path: "/v1/query/pruninginfo", HandlerFunc: PruningInfo},
 		Route{Name: "QueryBalance", Method: "POST", Path: "/v1/query/balance", HandlerFunc: Balance},
+		Route{Name: "QueryBalances", Method: "POST", Path: "/v1/query/balances", HandlerFunc: Balances},
 		Route{Name: "QueryAccount", Method: "POST", Path: "/v1/query/account", HandlerFunc: Account},
+		Route{Name: "QueryAllowances", Method: "POST", Path: "/v1/query/allowances", HandlerFunc: Allowances},
 		Route{Name: "QueryNodes", Method: "POST", Path: "/v1/query/nodes", HandlerFunc: Nodes},
 		Route{Name: "QueryNode", Method: "POST", Path: "/v1/query/node", HandlerFunc: Node},
+		Route{Name: "QueryNodesByAddresses", Method: "POST", Path: "/v1/query/nodesbyaddresses", HandlerFunc: NodesByAddresses},
+		Route{Name: "QueryOperatorOverview", Method: "POST", Path: "/v1/query/operatoroverview", HandlerFunc: OperatorOverview},
+		Route{Name: "QueryClaimsSummary", Method: "POST", Path: "/v1/query/claimssummary", HandlerFunc: ClaimsSummary},
+		Route{Name: "QueryClaimMaturity", Method: "POST", Path: "/v1/query/claimmaturity", HandlerFunc: ClaimMaturity},
+		Route{Name: "QueryNetworkRelays", Method: "POST", Path: "/v1/query/networkrelays", HandlerFunc: NetworkRelays},
+		Route{Name: "QueryNodeRewards", Method: "POST", Path: "/v1/query/noderewards", HandlerFunc: NodeRewards},
 		Route{Name: "QueryNodeParams", Method: "POST", Path: "/v1/query/nodeparams", HandlerFunc: NodeParams},
+		Route{Name: "QuerySessionValidators", Method: "POST", Path: "/v1/query/sessionvalidators", HandlerFunc: SessionValidators},
+		Route{Name: "QuerySession", Method: "POST", Path: "/v1/query/session", HandlerFunc: Session},
 		Route{Name: "QueryNodeReceipts", Method: "POST", Path: "/v1/query/nodereceipts", HandlerFunc: NodeReceipts},
 		Route{Name: "QueryNodeReceipt", Method: "POST", Path: "/v1/query/nodereceipt", HandlerFunc: NodeReceipt},
 		Route{Name: "QueryNodeClaims", Method: "POST", Path: "/v1/query/nodeclaims", HandlerFunc: NodeClaims},
 		Route{Name: "QueryNodeClaim", Method: "POST", Path: "/v1/query/nodeclaim", HandlerFunc: NodeClaim},
+		Route{Name: "QueryOpenChallenges", Method: "POST", Path: "/v1/query/openchallenges", HandlerFunc: OpenChallenges},
+		Route{Name: "QueryChallengesAgainst", Method: "POST", Path: "/v1/query/challengesagainst", HandlerFunc: ChallengesAgainst},
+		Route{Name: "QueryChallengeRewards", Method: "POST", Path: "/v1/query/challengerewards", HandlerFunc: ChallengeRewards},
 		Route{Name: "QueryApps", Method: "POST", Path: "/v1/query/apps", HandlerFunc: Apps},
 		Route{Name: "QueryApp", Method: "POST", Path: "/v1/query/app", HandlerFunc: App},
+		Route{Name: "QueryAppsByAddresses", Method: "POST", Path: "/v1/query/appsbyaddresses", HandlerFunc: AppsByAddresses},
 		Route{Name: "QueryAppParams", Method: "POST", Path: "/v1/query/appparams", HandlerFunc: AppParams},
+		Route{Name: "QueryAppStakeAdjustment", Method: "POST", Path: "/v1/query/appstakeadjustment", HandlerFunc: AppStakeAdjustment},
 		Route{Name: "QueryPocketParams", Method: "POST", Path: "/v1/query/pocketparams", HandlerFunc: PocketParams},
 		Route{Name: "QuerySupportedChains", Method: "POST", Path: "/v1/query/supportedchains", HandlerFunc: SupportedChains},
+		Route{Name: "QuerySupportedChainsMetadata", Method: "POST", Path: "/v1/query/supportedchainsmetadata", HandlerFunc: SupportedChainsMetadata},
 		Route{Name: "QuerySupply", Method: "POST", Path: "/v1/query/supply", HandlerFunc: Supply},
+		Route{Name: "QueryEmissionSchedule", Method: "POST", Path: "/v1/query/emissionschedule", HandlerFunc: EmissionSchedule},
 		Route{Name: "QueryDAOOwner", Method: "POST", Path: "/v1/query/daoowner", HandlerFunc: DAOOwner},
+		Route{Name: "QueryDAOHistory", Method: "POST", Path: "/v1/query/daohistory", HandlerFunc: DAOHistory},
 		Route{Name: "QueryUpgrade", Method: "POST", Path: "/v1/query/upgrade", HandlerFunc: Upgrade},
 		Route{Name: "QueryACL", Method: "POST", Path: "/v1/query/acl", HandlerFunc: ACL},
+		Route{Name: "QueryACLHistory", Method: "POST", Path: "/v1/query/aclhistory", HandlerFunc: ACLHistory},
+		Route{Name: "QueryParamBounds", Method: "POST", Path: "/v1/query/parambounds", HandlerFunc: ParamBounds},
 		Route{Name: "QueryAllParams", Method: "POST", Path: "/v1/query/allparams", HandlerFunc: AllParams},
 		Route{Name: "QueryParam", Method: "POST", Path: "/v1/query/param", HandlerFunc: Param},
+		Route{Name: "QueryParams", Method: "POST", Path: "/v1/query/params", HandlerFunc: Params},
+		Route{Name: "QuerySimulateParamChanges", Method: "POST", Path: "/v1/query/simulateparamchanges", HandlerFunc: SimulateParamChanges},
 		Route{Name: "QueryState", Method: "POST", Path: "/v1/query/state", HandlerFunc: State},
+		Route{Name: "LocalEvidence", Method: "POST", Path: "/v1/private/evidence", HandlerFunc: Authenticate(app.AuthRoleRead, LocalEvidence)},
+		Route{Name: "NodeConfig", Method: "POST", Path: "/v1/private/nodeconfig", HandlerFunc: Authenticate(app.AuthRoleRead, NodeConfig)},
+		Route{Name: "ChainsHealth", Method: "POST", Path: "/v1/private/chainshealth", HandlerFunc: Authenticate(app.AuthRoleRead, ChainsHealth)},
+		Route{Name: "KeyAudit", Method: "POST", Path: "/v1/private/keyaudit", HandlerFunc: Authenticate(app.AuthRoleRead, KeyAudit)},
+		Route{Name: "MigrationsDryRun", Method: "POST", Path: "/v1/private/migrations/dryrun", HandlerFunc: Authenticate(app.AuthRoleRead, MigrationsDryRun)},
+		Route{Name: "Blocklist", Method: "POST", Path: "/v1/private/blocklist", HandlerFunc: Authenticate(app.AuthRoleConfig, Blocklist)},
+		Route{Name: "SessionWebhooks", Method: "POST", Path: "/v1/private/sessionwebhooks", HandlerFunc: Authenticate(app.AuthRoleConfig, SessionWebhooks)},
+		Route{Name: "ScheduledTxs", Method: "POST", Path: "/v1/private/scheduledtxs", HandlerFunc: Authenticate(app.AuthRoleConfig, ScheduledTxs)},
+		Route{Name: "ReplayEvents", Method: "POST", Path: "/v1/private/replayevents", HandlerFunc: Authenticate(app.AuthRoleConfig, ReplayEvents)},
+		Route{Name: "ReplicateEvidence", Method: "POST", Path: "/v1/private/replicateevidence", HandlerFunc: ReplicateEvidence},
 	}
 	return routes
 }
@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+const (
+	// the time allowed to write an event (or ping) to the subscriber
+	subscriptionWriteWait = 10 * time.Second
+	// the interval of the pings keeping the subscription alive
+	subscriptionPingPeriod = 30 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	// the subscriptions are public like the rest of the client routes
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// Subscribe upgrades the connection to a websocket and streams the events (comma separated 'events' query param, all if empty)
+func Subscribe(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var eventTypes []string
+	if events := r.URL.Query().Get("events"); events != "" {
+		eventTypes = strings.Split(events, ",")
+	}
+	sub, err := types.Subscribe(eventTypes)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	defer types.Unsubscribe(sub)
+	conn, er := upgrader.Upgrade(w, r, nil)
+	if er != nil {
+		// the upgrader already responded with the error
+		return
+	}
+	defer conn.Close()
+	// the subscriber doesn't send anything, reading only detects the closing of the connection
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+	ping := time.NewTicker(subscriptionPingPeriod)
+	defer ping.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case event := <-sub.Events():
+			_ = conn.SetWriteDeadline(time.Now().Add(subscriptionWriteWait))
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(subscriptionWriteWait)); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -88,6 +88,7 @@ var (
 	memCDC  *codec.Codec
 	inMemKB keys.Keybase
 	memCLI  client.Client
+	memDB   dbm.DB
 )
 
 func getInMemoryKeybase() keys.Keybase {
@@ -143,6 +144,7 @@ func inMemTendermintNode(genesisState []byte) (*node.Node, keys.Keybase) {
 		Logger:   log.NewTMLogger(loggerFile),
 	}
 	db := dbm.NewMemDB()
+	memDB = db
 	traceWriter, err := openTraceWriter(c.TraceWriter)
 	if err != nil {
 		panic(err)
@@ -387,6 +389,7 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("application/MaximumChains", kp.GetAddress())
 		acl.SetOwner("application/ParticipationRateOn", kp.GetAddress())
 		acl.SetOwner("application/StabilityAdjustment", kp.GetAddress())
+		acl.SetOwner("application/StakeAdjustment", kp.GetAddress())
 		acl.SetOwner("auth/MaxMemoCharacters", kp.GetAddress())
 		acl.SetOwner("auth/TxSigLimit", kp.GetAddress())
 		acl.SetOwner("auth/FeeMultipliers", kp.GetAddress())
@@ -396,10 +399,19 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("pocketcore/ClaimExpiration", kp.GetAddress())
 		acl.SetOwner("pocketcore/ClaimSubmissionWindow", kp.GetAddress())
 		acl.SetOwner("pocketcore/MinimumNumberOfProofs", kp.GetAddress())
+		acl.SetOwner("pocketcore/ChallengeReporterReward", kp.GetAddress())
+		acl.SetOwner("pocketcore/ChainRegistry", kp.GetAddress())
+		acl.SetOwner("pocketcore/ClaimSubmissionWindowByChain", kp.GetAddress())
+		acl.SetOwner("pocketcore/ReputationWeightedSessions", kp.GetAddress())
+		acl.SetOwner("pocketcore/ReputationWindow", kp.GetAddress())
+		acl.SetOwner("pocketcore/ParamBounds", kp.GetAddress())
 		acl.SetOwner("pocketcore/ReplayAttackBurnMultiplier", kp.GetAddress())
 		acl.SetOwner("pocketcore/SessionNodeCount", kp.GetAddress())
 		acl.SetOwner("pocketcore/SupportedBlockchains", kp.GetAddress())
 		acl.SetOwner("pos/BlocksPerSession", kp.GetAddress())
+		acl.SetOwner("pos/SessionDuration", kp.GetAddress())
+		acl.SetOwner("pos/DAOEmissionSchedule", kp.GetAddress())
+		acl.SetOwner("pos/FeeRefund", kp.GetAddress())
 		acl.SetOwner("pos/DAOAllocation", kp.GetAddress())
 		acl.SetOwner("pos/DowntimeJailDuration", kp.GetAddress())
 		acl.SetOwner("pos/MaxEvidenceAge", kp.GetAddress())
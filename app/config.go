@@ -29,6 +29,7 @@ import (
 	"github.com/pokt-network/posmint/crypto"
 	kb "github.com/pokt-network/posmint/crypto/keys"
 	"github.com/pokt-network/posmint/store"
+	storeTypes "github.com/pokt-network/posmint/store/types"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/types/module"
 	"github.com/pokt-network/posmint/x/auth"
@@ -54,6 +55,9 @@ const (
 	DefaultPVSName                  = "priv_val_state.json"
 	DefaultNKName                   = "node_key.json"
 	DefaultChainsName               = "chains.json"
+	DefaultAuthTokenName            = "auth.json"
+	DefaultRoleTokensName           = "auth_roles.json"
+	DefaultChainsSecretsName        = "chains_secrets.json"
 	DefaultGenesisName              = "genesis.json"
 	DefaultRPCPort                  = "8081"
 	DefaultSessionDBType            = dbm.GoLevelDBBackend
@@ -68,7 +72,7 @@ const (
 	DefaultJSONSortRelayResponses   = true
 	DefaultDBBackend                = string(dbm.GoLevelDBBackend)
 	DefaultTxIndexer                = "kv"
-	DefaultTxIndexTags              = "tx.hash,tx.height,message.sender,transfer.recipient"
+	DefaultTxIndexTags              = "tx.hash,tx.height,message.sender,transfer.recipient,dao_transfer.module,dao_burn.module"
 	ConfigDirName                   = "config"
 	ConfigFileName                  = "config.json"
 	ApplicationDBName               = "application"
@@ -79,6 +83,18 @@ const (
 	DefaultUserAgent                = ""
 	DefaultValidatorCacheSize       = 500
 	DefaultApplicationCacheSize     = DefaultValidatorCacheSize
+	DefaultServiceURLSelfCheck      = true
+	DefaultCrashDumpDirName         = "crash_dumps"
+	DefaultAutoRestakeThreshold     = 1000000 // 1 POKT
+	DefaultAutoRestakeReserve       = 1000000 // 5 claims and 5 proofs
+	DefaultPruningKeepRecent        = 10000   // covers the session, claim and proof windows of the protocol
+	DefaultPruningKeepEvery         = 10000
+	PruningEverything               = "everything" // only the latest state is kept
+	PruningNothing                  = "nothing"    // the full state history is kept (archive)
+	PruningCustom                   = "custom"     // the pruning_keep_recent latest states and every pruning_keep_every-th state are kept
+	DefaultAddressFormat            = types.AddressFormatHex
+	DefaultPerPage                  = 30
+	DefaultMaxPerPage               = 10000
 )
 
 var (
@@ -102,6 +118,9 @@ type PocketConfig struct {
 	DataDir                  string            `json:"data_dir"`
 	GenesisName              string            `json:"genesis_file"`
 	ChainsName               string            `json:"chains_name"`
+	AuthTokenName            string            `json:"auth_token_name"`
+	RoleTokensName           string            `json:"role_tokens_name"`
+	ChainsSecretsName        string            `json:"chains_secrets_name"`
 	SessionDBType            dbm.DBBackendType `json:"session_db_type"`
 	SessionDBName            string            `json:"session_db_name"`
 	EvidenceDBType           dbm.DBBackendType `json:"evidence_db_type"`
@@ -117,6 +136,40 @@ type PocketConfig struct {
 	UserAgent                string            `json:"user_agent"`
 	ValidatorCacheSize       int64             `json:"validator_cache_size"`
 	ApplicationCacheSize     int64             `json:"application_cache_size"`
+	ExternalAddress          string            `json:"external_address"`         // the public ip or hostname of the node, advertised to the peers
+	NATPortMapping           string            `json:"nat_port_mapping"`         // map the ports on the NAT gateway at startup: upnp, natpmp or empty
+	NATPMPGateway            string            `json:"nat_pmp_gateway"`          // the NAT-PMP gateway ip, defaults to the default route gateway
+	ServiceURLSelfCheck      bool              `json:"service_url_self_check"`   // dial the advertised service url at startup
+	AutoRestake              bool              `json:"auto_restake"`             // roll the earned rewards into the stake at session boundaries
+	AutoRestakeThreshold     int64             `json:"auto_restake_threshold"`   // the minimum rewards (uPOKT) to restake
+	AutoRestakeReserve       int64             `json:"auto_restake_reserve"`     // the balance (uPOKT) kept unstaked for the claim and proof fees
+	BlockedApps              []string          `json:"blocked_apps"`             // the app public keys whose relays are rejected
+	BlockedClients           []string          `json:"blocked_clients"`          // the client public keys whose relays are rejected
+	Archive                  bool              `json:"archive"`                  // never prune the state history (receipts, proofs, params), advertised in the node status
+	PruningStrategy          string            `json:"pruning_strategy"`         // everything, nothing (archive) or custom, empty for nothing if archive else custom
+	PruningKeepRecent        int64             `json:"pruning_keep_recent"`      // the blocks of state history kept by the custom pruning strategy
+	PruningKeepEvery         int64             `json:"pruning_keep_every"`       // the custom pruning strategy also keeps every pruning_keep_every-th state (0 for none)
+	AddressFormat            string            `json:"address_format"`           // the format of the addresses emitted by the cli: hex or bech32 (both are accepted)
+	DefaultPerPage           int               `json:"default_per_page"`         // the page size of the paginated queries that don't set one
+	MaxPerPage               int               `json:"max_per_page"`             // the largest page size served, larger requested page sizes are capped
+	MaxPerPageByQuery        map[string]int    `json:"max_per_page_by_query"`    // overrides the max per page of a query: txs, nodes, apps, receipts, claims or challenges
+	ClaimPriority            map[string]int64  `json:"claim_priority"`           // the claim priority of the chains (network id), the higher priority chains are claimed first (default 0)
+	StreamSink               string            `json:"stream_sink"`              // publish the committed blocks, txs and events: nats, kafka-rest or empty
+	StreamURL                string            `json:"stream_url"`               // the url of the nats server or of the kafka rest proxy
+	StreamTopic              string            `json:"stream_topic"`             // the subject/topic of the published records
+	MaxRelayBatchSize        int               `json:"max_relay_batch_size"`     // the max number of requests of a batched json rpc relay, each request is metered as a relay
+	ClientRelayLimit         int64             `json:"client_relay_limit"`       // the max percent of the relays of an app session served to a single client key, zero for no limit
+	EvidenceReplicas         []string          `json:"evidence_replicas"`        // the rpc urls of the standby nodes (same operator and validator key) the proofs are replicated to
+	EvidenceReplicationKey   string            `json:"evidence_replication_key"` // the secret shared with the replicas encrypting the proofs, empty disables the replication
+	TxRetries                int               `json:"tx_retries"`               // the broadcasts of a failed claim or proof transaction before retrying at the next session
+	TxRetryBackoff           int64             `json:"tx_retry_backoff"`         // the milliseconds before the first rebroadcast, doubled at every retry
+	Replica                  bool              `json:"replica"`                  // a read only node serving the queries and dispatches: no relays, no transactions, never signs a block
+	RelayRedaction           map[string]string `json:"relay_redaction"`          // how the relay payloads of the chains (network id) appear in the logs and errors: full (default), truncate or hash
+	UpgradeURLTemplate       string            `json:"upgrade_url_template"`     // the url of the binary of an upgrade for the supervisor ({version}, {os} and {arch} are replaced, checksum at <url>.sha256), empty to place the binaries manually
+	EvidenceSealer           string            `json:"evidence_sealer"`          // the gRPC sealer signing the claims, proofs and restakes outside of the node (unix:///path or host:port), empty to sign locally
+	EvidenceSealerTimeout    int64             `json:"evidence_sealer_timeout"`  // the milliseconds before a sealing request fails
+	EvidenceSealerFallback   bool              `json:"evidence_sealer_fallback"` // sign locally when the sealer fails
+	ChainHealthInterval      int64             `json:"chain_health_interval"`    // the milliseconds between the health checks of the hosted chains, zero disables them
 }
 
 func DefaultConfig(dataDir string) Config {
@@ -127,6 +180,9 @@ func DefaultConfig(dataDir string) Config {
 			RPCPort:                  DefaultRPCPort,
 			GenesisName:              DefaultGenesisName,
 			ChainsName:               DefaultChainsName,
+			AuthTokenName:            DefaultAuthTokenName,
+			RoleTokensName:           DefaultRoleTokensName,
+			ChainsSecretsName:        DefaultChainsSecretsName,
 			SessionDBType:            DefaultSessionDBType,
 			SessionDBName:            DefaultSessionDBName,
 			EvidenceDBType:           DefaultEvidenceDBType,
@@ -141,6 +197,20 @@ func DefaultConfig(dataDir string) Config {
 			UserAgent:                DefaultUserAgent,
 			ValidatorCacheSize:       DefaultValidatorCacheSize,
 			ApplicationCacheSize:     DefaultApplicationCacheSize,
+			ServiceURLSelfCheck:      DefaultServiceURLSelfCheck,
+			AutoRestakeThreshold:     DefaultAutoRestakeThreshold,
+			AutoRestakeReserve:       DefaultAutoRestakeReserve,
+			PruningKeepRecent:        DefaultPruningKeepRecent,
+			PruningKeepEvery:         DefaultPruningKeepEvery,
+			AddressFormat:            DefaultAddressFormat,
+			DefaultPerPage:           DefaultPerPage,
+			MaxPerPage:               DefaultMaxPerPage,
+			StreamTopic:              DefaultStreamTopic,
+			MaxRelayBatchSize:        types.DefaultMaxRelayBatchSize,
+			TxRetries:                types.DefaultTxRetries,
+			TxRetryBackoff:           int64(types.DefaultTxRetryBackoff / time.Millisecond),
+			EvidenceSealerTimeout:    int64(types.DefaultSealerTimeout / time.Millisecond),
+			ChainHealthInterval:      int64(types.DefaultChainHealthInterval / time.Millisecond),
 		},
 	}
 	c.TendermintConfig.SetRoot(dataDir)
@@ -163,12 +233,16 @@ func DefaultConfig(dataDir string) Config {
 func InitApp(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL string, keybase bool) *node.Node {
 	// init config
 	InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort, remoteCLIURL)
+	// init the auth token for the private rpc routes
+	InitAuthToken()
 	// init the keyfiles
 	InitKeyfiles()
 	// init cache
 	InitPocketCoreConfig()
 	// init genesis
 	InitGenesis()
+	// init the port mapping and the external address
+	InitNetworking()
 	// init the tendermint node
 	return InitTendermint(keybase)
 }
@@ -245,6 +319,8 @@ func InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort,
 		c.PocketConfig.RemoteCLIURL = strings.TrimRight(remoteCLIURL, "/")
 	}
 	GlobalConfig = c
+	// the node and the cli record the uses of the keys
+	types.InitKeyAuditLog(c.PocketConfig.DataDir + FS + types.DefaultKeyAuditLogName)
 }
 
 func InitGenesis() {
@@ -273,8 +349,69 @@ func InitGenesis() {
 	}
 }
 
-func InitTendermint(keybase bool) *node.Node {
-	logger := log.NewTMLoggerWithColorFn(log.NewSyncWriter(os.Stdout), func(keyvals ...interface{}) term.FgBgColor {
+// PruningOptions returns the state history kept by the node, archive nodes never prune it
+func PruningOptions() store.PruningOptions {
+	switch PruningStrategy() {
+	case PruningNothing:
+		return store.PruneNothing
+	case PruningEverything:
+		return store.PruneEverything
+	default:
+		return storeTypes.NewPruningOptions(GlobalConfig.PocketConfig.PruningKeepRecent, GlobalConfig.PocketConfig.PruningKeepEvery)
+	}
+}
+
+// PruningStrategy returns the pruning strategy of the node, an archive node prunes nothing
+func PruningStrategy() string {
+	return pruningStrategy(GlobalConfig.PocketConfig)
+}
+
+func pruningStrategy(c PocketConfig) string {
+	switch {
+	case c.PruningStrategy != "":
+		return c.PruningStrategy
+	case c.Archive:
+		return PruningNothing
+	default:
+		return PruningCustom
+	}
+}
+
+// IsArchive returns whether the node keeps the full state history
+func IsArchive() bool {
+	return PruningStrategy() == PruningNothing
+}
+
+// ValidatePruningConfig validates the pruning strategy of the config
+func ValidatePruningConfig(c PocketConfig) error {
+	switch c.PruningStrategy {
+	case "", PruningNothing:
+	case PruningEverything, PruningCustom:
+		if c.Archive {
+			return fmt.Errorf("an archive node prunes nothing, the pruning_strategy %s contradicts archive", c.PruningStrategy)
+		}
+	default:
+		return fmt.Errorf("invalid pruning_strategy %s, expected %s, %s, %s or empty", c.PruningStrategy, PruningEverything, PruningNothing, PruningCustom)
+	}
+	if pruningStrategy(c) != PruningCustom {
+		return nil
+	}
+	if c.PruningKeepRecent < 1 {
+		return fmt.Errorf("invalid pruning_keep_recent %d, at least the latest state is kept", c.PruningKeepRecent)
+	}
+	if c.PruningKeepEvery < 0 {
+		return fmt.Errorf("invalid pruning_keep_every %d, expected 0 (none) or more", c.PruningKeepEvery)
+	}
+	return nil
+}
+
+// the logger of the node, colored text or json lines (log_format of the tendermint config), filtered by module with
+// the log_level of the tendermint config (e.g. "x/pocketcore:debug,*:error")
+func newLogger() log.Logger {
+	if GlobalConfig.TendermintConfig.LogFormat == con.LogFormatJSON {
+		return log.NewTMJSONLogger(log.NewSyncWriter(os.Stdout))
+	}
+	return log.NewTMLoggerWithColorFn(log.NewSyncWriter(os.Stdout), func(keyvals ...interface{}) term.FgBgColor {
 		if keyvals[0] != kitlevel.Key() {
 			fmt.Printf("expected level key to be first, got %v", keyvals[0])
 			log2.Fatal(1)
@@ -290,6 +427,10 @@ func InitTendermint(keybase bool) *node.Node {
 			return term.FgBgColor{}
 		}
 	})
+}
+
+func InitTendermint(keybase bool) *node.Node {
+	logger := newLogger()
 	logger, err := flags.ParseLogLevel(GlobalConfig.TendermintConfig.LogLevel, logger, "info")
 	if err != nil {
 		log2.Fatal(err)
@@ -307,7 +448,7 @@ func InitTendermint(keybase bool) *node.Node {
 		keys = MustGetKeybase()
 	}
 	tmNode, app, err := NewClient(config(c), func(logger log.Logger, db dbm.DB, _ io.Writer) *PocketCoreApp {
-		return NewPocketCoreApp(nil, keys, getTMClient(), NewHostedChains(false), logger, db, baseapp.SetPruning(store.PruneNothing))
+		return NewPocketCoreApp(nil, keys, getTMClient(), NewHostedChains(false), logger, db, baseapp.SetPruning(PruningOptions()))
 	})
 	if err != nil {
 		log2.Fatal(err)
@@ -344,6 +485,37 @@ func InitPocketCoreConfig() {
 	types.InitConfig(GlobalConfig.PocketConfig.UserAgent, GlobalConfig.PocketConfig.DataDir, GlobalConfig.PocketConfig.DataDir, GlobalConfig.PocketConfig.SessionDBType, GlobalConfig.PocketConfig.EvidenceDBType, GlobalConfig.PocketConfig.MaxEvidenceCacheEntires, GlobalConfig.PocketConfig.MaxSessionCacheEntries, GlobalConfig.PocketConfig.EvidenceDBName, GlobalConfig.PocketConfig.SessionDBName)
 	types.InitClientBlockAllowance(GlobalConfig.PocketConfig.ClientBlockSyncAllowance)
 	types.InitJSONSorting(GlobalConfig.PocketConfig.JSONSortRelayResponses)
+	types.InitCrashDumps(GlobalConfig.PocketConfig.DataDir + FS + DefaultCrashDumpDirName)
+	types.InitAutoRestake(GlobalConfig.PocketConfig.AutoRestake, GlobalConfig.PocketConfig.AutoRestakeThreshold, GlobalConfig.PocketConfig.AutoRestakeReserve)
+	types.InitClaimPriority(GlobalConfig.PocketConfig.ClaimPriority)
+	types.InitMaxRelayBatchSize(GlobalConfig.PocketConfig.MaxRelayBatchSize)
+	types.InitReplica(GlobalConfig.PocketConfig.Replica)
+	if err := ValidatePruningConfig(GlobalConfig.PocketConfig); err != nil {
+		log2.Fatal(fmt.Sprintf("invalid pruning config: %s", err.Error()))
+	}
+	types.InitTxRetry(GlobalConfig.PocketConfig.TxRetries, time.Duration(GlobalConfig.PocketConfig.TxRetryBackoff)*time.Millisecond)
+	if err := types.InitTxRetryQueue(GlobalConfig.PocketConfig.DataDir + FS + types.DefaultTxRetryQueueName); err != nil {
+		log2.Fatal(err)
+	}
+	if err := types.InitScheduledTxs(GlobalConfig.PocketConfig.DataDir + FS + types.DefaultScheduledTxsName); err != nil {
+		log2.Fatal(err)
+	}
+	if err := types.InitEvidenceReplication(GlobalConfig.PocketConfig.EvidenceReplicas, GlobalConfig.PocketConfig.EvidenceReplicationKey); err != nil {
+		log2.Fatal(fmt.Sprintf("invalid evidence replication config: %s", err.Error()))
+	}
+	if err := types.InitEvidenceSealer(GlobalConfig.PocketConfig.EvidenceSealer, time.Duration(GlobalConfig.PocketConfig.EvidenceSealerTimeout)*time.Millisecond,
+		GlobalConfig.PocketConfig.EvidenceSealerFallback, GlobalConfig.PocketConfig.DataDir+FS+types.DefaultSealerAuditLogName); err != nil {
+		log2.Fatal(fmt.Sprintf("invalid evidence sealer config: %s", err.Error()))
+	}
+	if err := types.InitRelayRedaction(GlobalConfig.PocketConfig.RelayRedaction); err != nil {
+		log2.Fatal(fmt.Sprintf("invalid relay redaction config: %s", err.Error()))
+	}
+	if err := types.InitClientRelayLimit(GlobalConfig.PocketConfig.ClientRelayLimit); err != nil {
+		log2.Fatal(fmt.Sprintf("invalid client relay limit in the config: %s", err.Error()))
+	}
+	if err := types.InitBlocklist(GlobalConfig.PocketConfig.BlockedApps, GlobalConfig.PocketConfig.BlockedClients); err != nil {
+		log2.Fatal(fmt.Sprintf("invalid public key in the blocklist of the config: %s", err.Error()))
+	}
 	nodesTypes.InitConfig(GlobalConfig.PocketConfig.ValidatorCacheSize)
 	appsTypes.InitConfig(GlobalConfig.PocketConfig.ApplicationCacheSize)
 }
@@ -468,8 +640,14 @@ func getTMClient() client.Client {
 	return tmClient
 }
 
-// get the hosted chains variable
+// get the hosted chains variable (with the credentials from the chains secrets file / environment)
 func NewHostedChains(generate bool) *types.HostedBlockchains {
+	hostedChains := newHostedChains(generate)
+	InjectChainCredentials(hostedChains)
+	return hostedChains
+}
+
+func newHostedChains(generate bool) *types.HostedBlockchains {
 	// create the chains path
 	var chainsPath = GlobalConfig.PocketConfig.DataDir + FS + ConfigDirName + FS + GlobalConfig.PocketConfig.ChainsName
 	// if file exists open, else create and open
@@ -507,6 +685,9 @@ func NewHostedChains(generate bool) *types.HostedBlockchains {
 		if err := nodesTypes.ValidateNetworkIdentifier(chain.ID); err != nil {
 			log2.Fatal(fmt.Sprintf("invalid ID: %s in network identifier in %s file", chain.ID, GlobalConfig.PocketConfig.ChainsName))
 		}
+		if err := chain.HTTP.Validate(); err != nil {
+			log2.Fatal(fmt.Sprintf("invalid http configuration of %s in %s file: %s", chain.ID, GlobalConfig.PocketConfig.ChainsName, err.Error()))
+		}
 		m[chain.ID] = chain
 	}
 	// return the map
@@ -731,30 +912,39 @@ func newDefaultGenesisState() []byte {
 	// end genesis setup
 	j, _ := types.ModuleCdc.MarshalJSONIndent(defaultGenesis, "", "    ")
 	j, _ = types.ModuleCdc.MarshalJSONIndent(tmType.GenesisDoc{
-		GenesisTime: time.Now(),
-		ChainID:     "pocket-test",
-		ConsensusParams: &tmType.ConsensusParams{
-			Block: tmType.BlockParams{
-				MaxBytes:   15000,
-				MaxGas:     -1,
-				TimeIotaMs: 1,
-			},
-			Evidence: tmType.EvidenceParams{
-				MaxAge: 1000000,
-			},
-			Validator: tmType.ValidatorParams{
-				PubKeyTypes: []string{"ed25519"},
-			},
-		},
-		Validators: nil,
-		AppHash:    nil,
-		AppState:   j,
+		GenesisTime:     time.Now(),
+		ChainID:         "pocket-test",
+		ConsensusParams: defaultConsensusParams(),
+		Validators:      nil,
+		AppHash:         nil,
+		AppState:        j,
 	}, "", "    ")
 	return j
 }
 
+// the consensus params of the generated genesis files
+func defaultConsensusParams() *tmType.ConsensusParams {
+	return &tmType.ConsensusParams{
+		Block: tmType.BlockParams{
+			MaxBytes:   15000,
+			MaxGas:     -1,
+			TimeIotaMs: 1,
+		},
+		Evidence: tmType.EvidenceParams{
+			MaxAge: 1000000,
+		},
+		Validator: tmType.ValidatorParams{
+			PubKeyTypes: []string{"ed25519"},
+		},
+	}
+}
+
 func createDummyACL(kp crypto.PublicKey) govTypes.ACL {
-	addr := sdk.Address(kp.Address())
+	return createACL(sdk.Address(kp.Address()))
+}
+
+// the acl of every param owned by the address
+func createACL(addr sdk.Address) govTypes.ACL {
 	acl := govTypes.ACL{}
 	acl = make([]govTypes.ACLPair, 0)
 	acl.SetOwner("application/ApplicationStakeMinimum", addr)
@@ -764,6 +954,7 @@ func createDummyACL(kp crypto.PublicKey) govTypes.ACL {
 	acl.SetOwner("application/MaximumChains", addr)
 	acl.SetOwner("application/ParticipationRateOn", addr)
 	acl.SetOwner("application/StabilityAdjustment", addr)
+	acl.SetOwner("application/StakeAdjustment", addr)
 	acl.SetOwner("auth/MaxMemoCharacters", addr)
 	acl.SetOwner("auth/TxSigLimit", addr)
 	acl.SetOwner("gov/acl", addr)
@@ -775,9 +966,18 @@ func createDummyACL(kp crypto.PublicKey) govTypes.ACL {
 	acl.SetOwner("pos/ProposerPercentage", addr)
 	acl.SetOwner("pocketcore/ClaimSubmissionWindow", addr)
 	acl.SetOwner("pocketcore/MinimumNumberOfProofs", addr)
+	acl.SetOwner("pocketcore/ChallengeReporterReward", addr)
+	acl.SetOwner("pocketcore/ChainRegistry", addr)
+	acl.SetOwner("pocketcore/ClaimSubmissionWindowByChain", addr)
+	acl.SetOwner("pocketcore/ReputationWeightedSessions", addr)
+	acl.SetOwner("pocketcore/ReputationWindow", addr)
+	acl.SetOwner("pocketcore/ParamBounds", addr)
 	acl.SetOwner("pocketcore/SessionNodeCount", addr)
 	acl.SetOwner("pocketcore/SupportedBlockchains", addr)
 	acl.SetOwner("pos/BlocksPerSession", addr)
+	acl.SetOwner("pos/SessionDuration", addr)
+	acl.SetOwner("pos/DAOEmissionSchedule", addr)
+	acl.SetOwner("pos/FeeRefund", addr)
 	acl.SetOwner("pos/DAOAllocation", addr)
 	acl.SetOwner("pos/DowntimeJailDuration", addr)
 	acl.SetOwner("pos/MaxEvidenceAge", addr)
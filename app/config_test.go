@@ -1,8 +1,11 @@
 package app
 
 import (
-	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -14,3 +17,111 @@ func TestDefaultConfig(t *testing.T) {
 	assert.EqualValues(t, DefaultTxIndexer, c.TendermintConfig.TxIndex.Indexer)
 	assert.EqualValues(t, DefaultTxIndexTags, c.TendermintConfig.TxIndex.IndexTags)
 }
+
+func TestPruningOptions(t *testing.T) {
+	defer func(c Config) { GlobalConfig = c }(GlobalConfig)
+	GlobalConfig = DefaultConfig("~/.pocket")
+	// non archive nodes keep the recent state history only
+	assert.False(t, GlobalConfig.PocketConfig.Archive)
+	assert.EqualValues(t, DefaultPruningKeepRecent, PruningOptions().KeepRecent())
+	assert.EqualValues(t, DefaultPruningKeepEvery, PruningOptions().KeepEvery())
+	// archive nodes never prune
+	GlobalConfig.PocketConfig.Archive = true
+	assert.EqualValues(t, 0, PruningOptions().KeepRecent())
+	assert.EqualValues(t, 1, PruningOptions().KeepEvery())
+	assert.True(t, IsArchive())
+	// explicit strategies
+	GlobalConfig.PocketConfig.Archive = false
+	GlobalConfig.PocketConfig.PruningStrategy = PruningNothing
+	assert.True(t, IsArchive())
+	assert.EqualValues(t, 1, PruningOptions().KeepEvery())
+	GlobalConfig.PocketConfig.PruningStrategy = PruningEverything
+	assert.False(t, IsArchive())
+	assert.EqualValues(t, 0, PruningOptions().KeepRecent())
+	assert.EqualValues(t, 0, PruningOptions().KeepEvery())
+	GlobalConfig.PocketConfig.PruningStrategy = PruningCustom
+	GlobalConfig.PocketConfig.PruningKeepRecent = 500
+	GlobalConfig.PocketConfig.PruningKeepEvery = 0
+	assert.EqualValues(t, 500, PruningOptions().KeepRecent())
+	assert.EqualValues(t, 0, PruningOptions().KeepEvery())
+}
+
+func TestValidatePruningConfig(t *testing.T) {
+	c := DefaultConfig("~/.pocket").PocketConfig
+	assert.Nil(t, ValidatePruningConfig(c))
+	archive := c
+	archive.Archive = true
+	assert.Nil(t, ValidatePruningConfig(archive))
+	archive.PruningStrategy = PruningNothing
+	assert.Nil(t, ValidatePruningConfig(archive))
+	archive.PruningStrategy = PruningCustom
+	assert.NotNil(t, ValidatePruningConfig(archive))
+	invalid := c
+	invalid.PruningStrategy = "foo"
+	assert.NotNil(t, ValidatePruningConfig(invalid))
+	invalid = c
+	invalid.PruningKeepRecent = 0
+	assert.NotNil(t, ValidatePruningConfig(invalid))
+	invalid = c
+	invalid.PruningKeepEvery = -1
+	assert.NotNil(t, ValidatePruningConfig(invalid))
+	// the keep recent and keep every of the other strategies are ignored
+	invalid.PruningStrategy = PruningEverything
+	assert.Nil(t, ValidatePruningConfig(invalid))
+}
+
+func TestCheckPagination(t *testing.T) {
+	defer func(c Config) { GlobalConfig = c }(GlobalConfig)
+	GlobalConfig = DefaultConfig("~/.pocket")
+	page, limit := checkPagination(PaginationQueryClaims, 0, 0)
+	assert.Equal(t, 1, page)
+	assert.Equal(t, DefaultPerPage, limit)
+	// the requested page size is served up to the max per page
+	_, limit = checkPagination(PaginationQueryClaims, 2, 50)
+	assert.Equal(t, 50, limit)
+	_, limit = checkPagination(PaginationQueryClaims, 1, 1000000)
+	assert.Equal(t, DefaultMaxPerPage, limit)
+	// configured defaults and per query overrides
+	GlobalConfig.PocketConfig.DefaultPerPage = 10
+	GlobalConfig.PocketConfig.MaxPerPage = 100
+	GlobalConfig.PocketConfig.MaxPerPageByQuery = map[string]int{PaginationQueryNodes: 500}
+	_, limit = checkPagination(PaginationQueryClaims, 1, 0)
+	assert.Equal(t, 10, limit)
+	_, limit = checkPagination(PaginationQueryClaims, 1, 1000)
+	assert.Equal(t, 100, limit)
+	_, limit = checkPagination(PaginationQueryNodes, 1, 1000)
+	assert.Equal(t, 500, limit)
+}
+
+func TestGenerateRoleToken(t *testing.T) {
+	defer func(c Config) { GlobalConfig = c }(GlobalConfig)
+	defer SetRoleTokens(nil)
+	dir, err := ioutil.TempDir("", "roles")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, os.MkdirAll(dir+FS+ConfigDirName, os.ModePerm))
+	GlobalConfig = DefaultConfig(dir)
+	// invalid roles
+	_, err = GenerateRoleToken("monitoring", nil)
+	assert.NotNil(t, err)
+	_, err = GenerateRoleToken("monitoring", []string{"invalid"})
+	assert.NotNil(t, err)
+	token, err := GenerateRoleToken("monitoring", []string{AuthRoleRead})
+	assert.Nil(t, err)
+	assert.True(t, token.HasRole(AuthRoleRead))
+	assert.False(t, token.HasRole(AuthRoleConfig))
+	// the names are unique
+	_, err = GenerateRoleToken("monitoring", []string{AuthRoleConfig})
+	assert.NotNil(t, err)
+	// the tokens are read on start
+	tokens, err := readRoleTokens(roleTokensPath())
+	assert.Nil(t, err)
+	assert.Equal(t, []RoleToken{token}, tokens)
+	SetRoleTokens(tokens)
+	authorized, authenticated := IsAuthorized(token.Value, AuthRoleRead)
+	assert.True(t, authorized)
+	assert.True(t, authenticated)
+	authorized, authenticated = IsAuthorized(token.Value, AuthRoleConfig)
+	assert.False(t, authorized)
+	assert.True(t, authenticated)
+}
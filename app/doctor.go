@@ -0,0 +1,251 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	DoctorOK                 = "ok"     // nothing to do
+	DoctorWarn               = "warn"   // the node starts, but something should be looked at
+	DoctorFail               = "fail"   // the node won't start or won't work properly
+	DefaultDoctorMinFreeDisk = 10 << 30 // 10 GiB
+	doctorDialTimeout        = 5 * time.Second
+)
+
+// "DoctorFinding" - The outcome of a preflight check and what to do about it
+type DoctorFinding struct {
+	Check  string `json:"check"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// "Doctor" - Runs the preflight checks of the node of the data dir (the default one if empty) without starting it nor
+// writing any file. The genesis file is verified against the genesis hash if not empty
+func Doctor(datadir, genesisHash string) (findings []DoctorFinding) {
+	if datadir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return []DoctorFinding{{"datadir", DoctorFail, "could not get the home directory, set --datadir: " + err.Error()}}
+		}
+		datadir = home + FS + DefaultDDName
+	}
+	c, finding := doctorConfig(datadir)
+	findings = append(findings, finding)
+	findings = append(findings, doctorChains(c)...)
+	findings = append(findings, doctorGenesis(c, genesisHash))
+	findings = append(findings, doctorKeyFiles(c)...)
+	findings = append(findings, doctorPorts(c)...)
+	findings = append(findings, doctorDiskSpace(c.PocketConfig.DataDir, DefaultDoctorMinFreeDisk))
+	return
+}
+
+// "DoctorFailed" - Returns whether any of the findings is a failure
+func DoctorFailed(findings []DoctorFinding) bool {
+	for _, f := range findings {
+		if f.Status == DoctorFail {
+			return true
+		}
+	}
+	return false
+}
+
+// reads the config file like InitConfig (without creating it) and validates it
+func doctorConfig(datadir string) (c Config, finding DoctorFinding) {
+	c = DefaultConfig(datadir)
+	finding.Check = "config"
+	configFilepath := datadir + FS + ConfigDirName + FS + ConfigFileName
+	bz, err := ioutil.ReadFile(configFilepath)
+	if os.IsNotExist(err) {
+		return c, DoctorFinding{finding.Check, DoctorWarn, fmt.Sprintf("%s not found, the default config will be written on start", configFilepath)}
+	}
+	if err != nil {
+		return c, DoctorFinding{finding.Check, DoctorFail, fmt.Sprintf("cannot read %s: %s", configFilepath, err.Error())}
+	}
+	if err := json.Unmarshal(bz, &c); err != nil {
+		return c, DoctorFinding{finding.Check, DoctorFail, fmt.Sprintf("%s is not valid json: %s", configFilepath, err.Error())}
+	}
+	var problems []string
+	// the unknown fields are ignored on start, likely typos
+	strict := DefaultConfig(datadir)
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&strict); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if _, err := types.FormatAddress(nil, c.PocketConfig.AddressFormat); err != nil {
+		problems = append(problems, err.Error())
+	}
+	switch c.PocketConfig.NATPortMapping {
+	case "", NATPortMappingUPnP, NATPortMappingNATPMP:
+	default:
+		problems = append(problems, fmt.Sprintf("invalid nat_port_mapping %s, expected %s, %s or empty", c.PocketConfig.NATPortMapping, NATPortMappingUPnP, NATPortMappingNATPMP))
+	}
+	if err := ValidatePruningConfig(c.PocketConfig); err != nil {
+		problems = append(problems, err.Error())
+	}
+	switch pruningStrategy(c.PocketConfig) {
+	case PruningEverything:
+		problems = append(problems, fmt.Sprintf("pruning_strategy %s keeps no state history, the claim and proof windows won't be covered", PruningEverything))
+	case PruningCustom:
+		if c.PocketConfig.PruningKeepRecent < DefaultPruningKeepRecent {
+			problems = append(problems, fmt.Sprintf("pruning_keep_recent %d is below %d, the state history won't cover the claim and proof windows", c.PocketConfig.PruningKeepRecent, DefaultPruningKeepRecent))
+		}
+	}
+	if len(problems) != 0 {
+		return c, DoctorFinding{finding.Check, DoctorWarn, configFilepath + ": " + strings.Join(problems, "; ")}
+	}
+	return c, DoctorFinding{finding.Check, DoctorOK, configFilepath}
+}
+
+// validates the chains file and dials every chain
+func doctorChains(c Config) (findings []DoctorFinding) {
+	const check = "chains"
+	chainsPath := c.PocketConfig.DataDir + FS + ConfigDirName + FS + c.PocketConfig.ChainsName
+	bz, err := ioutil.ReadFile(chainsPath)
+	if os.IsNotExist(err) {
+		return []DoctorFinding{{check, DoctorWarn, fmt.Sprintf("%s not found, the node won't serve relays (see util generate-chains)", chainsPath)}}
+	}
+	if err != nil {
+		return []DoctorFinding{{check, DoctorFail, fmt.Sprintf("cannot read %s: %s", chainsPath, err.Error())}}
+	}
+	var chains []types.HostedBlockchain
+	if err := json.Unmarshal(bz, &chains); err != nil {
+		return []DoctorFinding{{check, DoctorFail, NewInvalidChainsError(err).Error()}}
+	}
+	if len(chains) == 0 {
+		return []DoctorFinding{{check, DoctorWarn, fmt.Sprintf("%s has no chains, the node won't serve relays", chainsPath)}}
+	}
+	client := http.Client{Timeout: doctorDialTimeout}
+	for _, chain := range chains {
+		if err := nodesTypes.ValidateNetworkIdentifier(chain.ID); err != nil {
+			findings = append(findings, DoctorFinding{check, DoctorFail, fmt.Sprintf("invalid network identifier %s in %s", chain.ID, chainsPath)})
+			continue
+		}
+		if _, err := url.ParseRequestURI(chain.URL); err != nil {
+			findings = append(findings, DoctorFinding{check, DoctorFail, fmt.Sprintf("invalid url of the chain %s: %s", chain.ID, err.Error())})
+			continue
+		}
+		// any http response means the chain is reachable, the relays are validated by the chain itself
+		resp, err := client.Get(chain.URL)
+		if err != nil {
+			findings = append(findings, DoctorFinding{check, DoctorFail, fmt.Sprintf("the chain %s is not reachable: %s", chain.ID, err.Error())})
+			continue
+		}
+		_ = resp.Body.Close()
+		findings = append(findings, DoctorFinding{check, DoctorOK, fmt.Sprintf("the chain %s is reachable (%s)", chain.ID, resp.Status)})
+	}
+	return
+}
+
+// validates the genesis file and verifies its hash (sha256 of the file) if expected
+func doctorGenesis(c Config, genesisHash string) DoctorFinding {
+	const check = "genesis"
+	genesisPath := c.PocketConfig.DataDir + FS + ConfigDirName + FS + c.PocketConfig.GenesisName
+	bz, err := ioutil.ReadFile(genesisPath)
+	if os.IsNotExist(err) {
+		return DoctorFinding{check, DoctorFail, fmt.Sprintf("%s not found, a local test genesis would be written on start: download the genesis of the network", genesisPath)}
+	}
+	if err != nil {
+		return DoctorFinding{check, DoctorFail, fmt.Sprintf("cannot read %s: %s", genesisPath, err.Error())}
+	}
+	doc, err := tmTypes.GenesisDocFromJSON(bz)
+	if err != nil {
+		return DoctorFinding{check, DoctorFail, fmt.Sprintf("%s is not a valid genesis: %s", genesisPath, err.Error())}
+	}
+	hash := sha256.Sum256(bz)
+	h := hex.EncodeToString(hash[:])
+	if genesisHash != "" && !strings.EqualFold(genesisHash, h) {
+		return DoctorFinding{check, DoctorFail, fmt.Sprintf("the hash of %s is %s, expected %s: the genesis is not the one of the network", genesisPath, h, genesisHash)}
+	}
+	return DoctorFinding{check, DoctorOK, fmt.Sprintf("chain id %s, hash %s", doc.ChainID, h)}
+}
+
+// checks the key files are only accessible to their owner
+func doctorKeyFiles(c Config) (findings []DoctorFinding) {
+	const check = "key files"
+	for _, name := range []string{c.TendermintConfig.PrivValidatorKey, c.TendermintConfig.NodeKey} {
+		path := c.PocketConfig.DataDir + FS + name
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			findings = append(findings, DoctorFinding{check, DoctorWarn, fmt.Sprintf("%s not found, it will be generated on start", path)})
+			continue
+		}
+		if err != nil {
+			findings = append(findings, DoctorFinding{check, DoctorFail, fmt.Sprintf("cannot stat %s: %s", path, err.Error())})
+			continue
+		}
+		if info.Mode().Perm()&0077 != 0 {
+			findings = append(findings, DoctorFinding{check, DoctorWarn, fmt.Sprintf("%s is accessible to other users (%s): chmod 600 %s", path, info.Mode().Perm(), path)})
+			continue
+		}
+		findings = append(findings, DoctorFinding{check, DoctorOK, path})
+	}
+	return
+}
+
+// checks the ports of the node are free
+func doctorPorts(c Config) (findings []DoctorFinding) {
+	const check = "ports"
+	addrs := map[string]string{
+		"pocket rpc":     net.JoinHostPort("0.0.0.0", c.PocketConfig.RPCPort),
+		"tendermint rpc": strings.TrimPrefix(c.TendermintConfig.RPC.ListenAddress, "tcp://"),
+		"p2p":            strings.TrimPrefix(c.TendermintConfig.P2P.ListenAddress, "tcp://"),
+	}
+	for _, name := range []string{"pocket rpc", "tendermint rpc", "p2p"} {
+		l, err := net.Listen("tcp", addrs[name])
+		if err != nil {
+			findings = append(findings, DoctorFinding{check, DoctorFail, fmt.Sprintf("cannot listen on the %s address %s (in use by another process?): %s", name, addrs[name], err.Error())})
+			continue
+		}
+		_ = l.Close()
+		findings = append(findings, DoctorFinding{check, DoctorOK, fmt.Sprintf("the %s address %s is free", name, addrs[name])})
+	}
+	return
+}
+
+// checks the free space of the disk of the data dir
+func doctorDiskSpace(datadir string, minFree uint64) DoctorFinding {
+	const check = "disk space"
+	// the data dir may not exist yet
+	dir := datadir
+	for {
+		if _, err := os.Stat(dir); err == nil || filepath.Dir(dir) == dir {
+			break
+		}
+		dir = filepath.Dir(dir)
+	}
+	free, err := freeDiskSpace(dir)
+	if err != nil {
+		return DoctorFinding{check, DoctorWarn, fmt.Sprintf("cannot get the free space of %s: %s", dir, err.Error())}
+	}
+	if free < minFree {
+		return DoctorFinding{check, DoctorWarn, fmt.Sprintf("%d MiB free on the disk of %s, below %d MiB: the chain state will outgrow it", free>>20, dir, minFree>>20)}
+	}
+	return DoctorFinding{check, DoctorOK, fmt.Sprintf("%d MiB free on the disk of %s", free>>20, dir)}
+}
+
+// the free space (bytes) available to the user on the disk of the dir
+func freeDiskSpace(dir string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
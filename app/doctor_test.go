@@ -0,0 +1,64 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoctor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "doctor")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	configDir := filepath.Join(dir, ConfigDirName)
+	assert.Nil(t, os.MkdirAll(configDir, os.ModePerm))
+	// a reachable chain
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer chain.Close()
+	// a port in use
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer l.Close()
+	_, port, err := net.SplitHostPort(l.Addr().String())
+	assert.Nil(t, err)
+	// a typo in the config
+	config := `{"pocket_config": {"rpc_port": "` + port + `", "adress_format": "bech32"}}`
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(configDir, ConfigFileName), []byte(config), 0600))
+	chains := `[{"id": "0001", "url": "` + chain.URL + `"}, {"id": "0002", "url": "http://127.0.0.1:1"}]`
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(configDir, DefaultChainsName), []byte(chains), 0600))
+	genesis := []byte(`{"genesis_time": "2020-01-01T00:00:00Z", "chain_id": "pocket-test", "app_hash": ""}`)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(configDir, DefaultGenesisName), genesis, 0600))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, DefaultPVKName), []byte("{}"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, DefaultNKName), []byte("{}"), 0600))
+	hash := sha256.Sum256(genesis)
+
+	findings := Doctor(dir, hex.EncodeToString(hash[:]))
+	assert.True(t, DoctorFailed(findings))
+	status := func(check, detail string) string {
+		for _, f := range findings {
+			if f.Check == check && strings.Contains(f.Detail, detail) {
+				return f.Status
+			}
+		}
+		return ""
+	}
+	assert.Equal(t, DoctorWarn, status("config", "adress_format"))
+	assert.Equal(t, DoctorOK, status("chains", "0001"))
+	assert.Equal(t, DoctorFail, status("chains", "0002"))
+	assert.Equal(t, DoctorOK, status("genesis", hex.EncodeToString(hash[:])))
+	assert.Equal(t, DoctorWarn, status("key files", DefaultPVKName))
+	assert.Equal(t, DoctorOK, status("key files", DefaultNKName))
+	assert.Equal(t, DoctorFail, status("ports", "pocket rpc"))
+	// the genesis of another network
+	findings = Doctor(dir, "00")
+	assert.Equal(t, DoctorFail, status("genesis", "expected 00"))
+}
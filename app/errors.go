@@ -2,13 +2,20 @@ package app
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
 	UninitializedKeybaseError = errors.New(`no keys stored in keybase, create a key pair by using "./main accounts create"`)
 	InvalidChainsError        = errors.New("invalid chains.json")
+	PrunedStateError          = errors.New("the state history at this height may be pruned by this node, query an archive node (archive in the node status)")
+	ReplicaBroadcastError     = errors.New("this node is a read only replica, broadcast the transactions through a primary node")
 )
 
 func NewInvalidChainsError(err error) error {
 	return errors.New(InvalidChainsError.Error() + ": " + err.Error())
 }
+
+func NewPrunedStateError(height int64, err error) error {
+	return fmt.Errorf("%s, height %d: %s", PrunedStateError.Error(), height, err.Error())
+}
@@ -0,0 +1,271 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	apps "github.com/pokt-network/pocket-core/x/apps"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/pocket-core/x/nodes"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocket "github.com/pokt-network/pocket-core/x/pocketcore"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/types/module"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/pokt-network/posmint/x/gov"
+	tmType "github.com/tendermint/tendermint/types"
+)
+
+// "GenesisFilePath" - Returns the path of the genesis file in the data directory
+func GenesisFilePath() string {
+	return GlobalConfig.PocketConfig.DataDir + FS + ConfigDirName + FS + GlobalConfig.PocketConfig.GenesisName
+}
+
+// "GenesisAddAccount" - Adds a funded account to the genesis file at path
+func GenesisAddAccount(path string, publicKey crypto.PublicKey, amount sdk.Int) error {
+	return mutateGenesisFile(path, func(appState map[string]json.RawMessage) error {
+		return genesisAddAccount(appState, publicKey, amount)
+	})
+}
+
+// "GenesisAddValidator" - Adds a pre-staked validator to the genesis file at path
+func GenesisAddValidator(path string, publicKey crypto.PublicKey, chains []string, serviceURL string, amount sdk.Int) error {
+	return mutateGenesisFile(path, func(appState map[string]json.RawMessage) error {
+		return genesisAddValidator(appState, publicKey, chains, serviceURL, amount)
+	})
+}
+
+// "GenesisAddApp" - Adds a pre-staked application to the genesis file at path
+func GenesisAddApp(path string, publicKey crypto.PublicKey, chains []string, amount sdk.Int) error {
+	return mutateGenesisFile(path, func(appState map[string]json.RawMessage) error {
+		return genesisAddApp(appState, publicKey, chains, amount)
+	})
+}
+
+// "GenesisImportState" - Writes to the genesis file at path a new chain booting from the state exported to the genesis
+// file at exportedPath, see importGenesis
+func GenesisImportState(exportedPath, path, chainID string, validators []crypto.PublicKey) error {
+	bz, err := ioutil.ReadFile(exportedPath)
+	if err != nil {
+		return fmt.Errorf("cannot read exported genesis file: %s", err.Error())
+	}
+	res, err := importGenesis(bz, chainID, validators)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, res, 0644)
+}
+
+// "mutateGenesisFile" - Reads the genesis file, applies the mutation to the app state, validates the result against
+// the module genesis logic and only then writes it back to the file
+func mutateGenesisFile(path string, mutate func(appState map[string]json.RawMessage) error) error {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("cannot read genesis file: %s", err.Error())
+	}
+	res, err := mutateGenesis(bz, mutate)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, res, 0644)
+}
+
+func mutateGenesis(genesis []byte, mutate func(appState map[string]json.RawMessage) error) ([]byte, error) {
+	genDoc, err := tmType.GenesisDocFromJSON(genesis)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse genesis file: %s", err.Error())
+	}
+	appState := make(map[string]json.RawMessage)
+	err = Codec().UnmarshalJSON(genDoc.AppState, &appState)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse genesis app state: %s", err.Error())
+	}
+	err = mutate(appState)
+	if err != nil {
+		return nil, err
+	}
+	err = module.NewBasicManager(
+		apps.AppModuleBasic{},
+		auth.AppModuleBasic{},
+		gov.AppModuleBasic{},
+		nodes.AppModuleBasic{},
+		pocket.AppModuleBasic{},
+	).ValidateGenesis(appState)
+	if err != nil {
+		return nil, fmt.Errorf("the resulting genesis state is invalid: %s", err.Error())
+	}
+	genDoc.AppState, err = Codec().MarshalJSONIndent(appState, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	return Codec().MarshalJSONIndent(genDoc, "", "    ")
+}
+
+func genesisAddAccount(appState map[string]json.RawMessage, publicKey crypto.PublicKey, amount sdk.Int) error {
+	if !amount.IsPositive() {
+		return fmt.Errorf("the account amount must be positive")
+	}
+	var accountGenesis auth.GenesisState
+	err := Codec().UnmarshalJSON(appState[auth.ModuleName], &accountGenesis)
+	if err != nil {
+		return err
+	}
+	address := sdk.Address(publicKey.Address())
+	for _, acc := range accountGenesis.Accounts {
+		if acc.GetAddress().Equals(address) {
+			return fmt.Errorf("account %s already exists in genesis", address.String())
+		}
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
+	accountGenesis.Accounts = append(accountGenesis.Accounts, &auth.BaseAccount{
+		Address: address,
+		Coins:   coins,
+		PubKey:  publicKey,
+	})
+	// the supply is calculated from the accounts on InitGenesis unless it's explicitly provided
+	if !accountGenesis.Supply.Empty() {
+		accountGenesis.Supply = accountGenesis.Supply.Add(coins)
+	}
+	appState[auth.ModuleName], err = Codec().MarshalJSON(accountGenesis)
+	return err
+}
+
+// "genesisAddToStakedPool" - Keeps a staked pool provided in genesis (exported) consistent with newly staked actors
+func genesisAddToStakedPool(appState map[string]json.RawMessage, poolName string, amount sdk.Int) error {
+	var accountGenesis auth.GenesisState
+	err := Codec().UnmarshalJSON(appState[auth.ModuleName], &accountGenesis)
+	if err != nil {
+		return err
+	}
+	for _, acc := range accountGenesis.Accounts {
+		moduleAcc, ok := acc.(interface{ GetName() string })
+		if !ok || moduleAcc.GetName() != poolName || acc.GetCoins().IsZero() {
+			continue
+		}
+		// an empty staked pool is filled on InitGenesis, otherwise it must match the total staked
+		if err := acc.SetCoins(acc.GetCoins().Add(sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount)))); err != nil {
+			return err
+		}
+	}
+	appState[auth.ModuleName], err = Codec().MarshalJSON(accountGenesis)
+	return err
+}
+
+func genesisAddValidator(appState map[string]json.RawMessage, publicKey crypto.PublicKey, chains []string, serviceURL string, amount sdk.Int) error {
+	var posGenesis nodesTypes.GenesisState
+	err := Codec().UnmarshalJSON(appState[nodesTypes.ModuleName], &posGenesis)
+	if err != nil {
+		return err
+	}
+	if err := nodesTypes.ValidateServiceURL(serviceURL); err != nil {
+		return err
+	}
+	posGenesis.Validators = append(posGenesis.Validators, nodesTypes.NewValidator(sdk.Address(publicKey.Address()), publicKey, chains, serviceURL, amount))
+	appState[nodesTypes.ModuleName], err = Codec().MarshalJSON(posGenesis)
+	if err != nil {
+		return err
+	}
+	return genesisAddToStakedPool(appState, nodesTypes.StakedPoolName, amount)
+}
+
+func genesisAddApp(appState map[string]json.RawMessage, publicKey crypto.PublicKey, chains []string, amount sdk.Int) error {
+	var appsGenesis appsTypes.GenesisState
+	err := Codec().UnmarshalJSON(appState[appsTypes.ModuleName], &appsGenesis)
+	if err != nil {
+		return err
+	}
+	application := appsTypes.NewApplication(sdk.Address(publicKey.Address()), publicKey, chains, amount)
+	// max relays are calculated from the stake on InitGenesis
+	application.MaxRelays = sdk.ZeroInt()
+	appsGenesis.Applications = append(appsGenesis.Applications, application)
+	appState[appsTypes.ModuleName], err = Codec().MarshalJSON(appsGenesis)
+	if err != nil {
+		return err
+	}
+	return genesisAddToStakedPool(appState, appsTypes.StakedPoolName, amount)
+}
+
+// "importGenesis" - Returns the genesis of a new chain (e.g. a testnet forked from mainnet) booting from the exported
+// state. The new chain starts at height 1, so the state bound to the heights of the exported chain is dropped: the
+// outstanding claims, the liveness tracking and the validator powers of the last block. The validator set is
+// re-derived from the staked nodes on InitChain; if validators are passed, only those stay in the set and the other
+// staked nodes are jailed
+func importGenesis(exported []byte, chainID string, validators []crypto.PublicKey) ([]byte, error) {
+	if chainID == "" {
+		return nil, fmt.Errorf("the chain id of the new chain is required")
+	}
+	genDoc, err := tmType.GenesisDocFromJSON(exported)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse exported genesis file: %s", err.Error())
+	}
+	genDoc.ChainID = chainID
+	genDoc.GenesisTime = time.Now()
+	genDoc.Validators = nil
+	genDoc.AppHash = nil
+	bz, err := Codec().MarshalJSON(genDoc)
+	if err != nil {
+		return nil, err
+	}
+	return mutateGenesis(bz, func(appState map[string]json.RawMessage) error {
+		return genesisImportState(appState, validators)
+	})
+}
+
+func genesisImportState(appState map[string]json.RawMessage, validators []crypto.PublicKey) error {
+	var posGenesis nodesTypes.GenesisState
+	err := Codec().UnmarshalJSON(appState[nodesTypes.ModuleName], &posGenesis)
+	if err != nil {
+		return err
+	}
+	// re-derive the validator set from the staked nodes instead of the powers of the last exported block
+	posGenesis.Exported = false
+	posGenesis.PrevStateTotalPower = sdk.ZeroInt()
+	posGenesis.PrevStateValidatorPowers = nil
+	posGenesis.PreviousProposer = nil
+	// restart the liveness tracking of the validators at the first block
+	for addr, info := range posGenesis.SigningInfos {
+		info.StartHeight = 0
+		info.IndexOffset = 0
+		info.MissedBlocksCounter = 0
+		info.JailedBlocksCounter = 0
+		posGenesis.SigningInfos[addr] = info
+	}
+	posGenesis.MissedBlocks = make(map[string][]nodesTypes.MissedBlock)
+	// keep only the passed validators (if any) in the validator set
+	if len(validators) != 0 {
+		keep := make(map[string]bool)
+		for _, pk := range validators {
+			keep[sdk.Address(pk.Address()).String()] = true
+		}
+		for i, val := range posGenesis.Validators {
+			if !keep[val.Address.String()] {
+				posGenesis.Validators[i].Jailed = true
+				continue
+			}
+			if !val.IsStaked() || val.IsJailed() {
+				return fmt.Errorf("the validator %s is not staked (and unjailed) in the exported state", val.Address.String())
+			}
+			delete(keep, val.Address.String())
+		}
+		for addr := range keep {
+			return fmt.Errorf("the validator %s is not in the exported state, add it with genesis add-validator", addr)
+		}
+	}
+	appState[nodesTypes.ModuleName], err = Codec().MarshalJSON(posGenesis)
+	if err != nil {
+		return err
+	}
+	// the claims are of the sessions of the exported chain, they can't be proven on the new chain
+	var pocketGenesis pocketTypes.GenesisState
+	err = Codec().UnmarshalJSON(appState[pocketTypes.ModuleName], &pocketGenesis)
+	if err != nil {
+		return err
+	}
+	pocketGenesis.Claims = nil
+	appState[pocketTypes.ModuleName], err = Codec().MarshalJSON(pocketGenesis)
+	return err
+}
@@ -0,0 +1,229 @@
+package app
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	apps "github.com/pokt-network/pocket-core/x/apps"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/pocket-core/x/nodes"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocket "github.com/pokt-network/pocket-core/x/pocketcore"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/types/module"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/pokt-network/posmint/x/gov"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+	tmType "github.com/tendermint/tendermint/types"
+	"gopkg.in/yaml.v2"
+)
+
+// "GenesisTemplate" - The intended economics of a network, the params not set keep the module defaults
+type GenesisTemplate struct {
+	ChainID  string `yaml:"chain_id"`
+	DAOOwner string `yaml:"dao_owner"` // the address owning the dao and every param (acl)
+	// sessions
+	SessionBlocks         *int64   `yaml:"session_blocks"`          // the blocks of a session
+	SessionNodeCount      *int64   `yaml:"session_node_count"`      // the servicers of a session
+	SupportedChains       []string `yaml:"supported_chains"`        // the network identifiers of the supported chains
+	ClaimSubmissionWindow *int64   `yaml:"claim_submission_window"` // the sessions to submit a proof after the claim
+	ClaimExpiration       *int64   `yaml:"claim_expiration"`        // the sessions a claim lives without its proof
+	// rewards
+	RelaysToTokensMultiplier *int64 `yaml:"relays_to_tokens_multiplier"` // the uPOKT minted per relay
+	DAOAllocation            *int64 `yaml:"dao_allocation"`              // the percentage of the relay rewards for the dao
+	ProposerAllocation       *int64 `yaml:"proposer_allocation"`         // the percentage of the relay rewards for the block proposer
+	BaseRelaysPerPOKT        *int64 `yaml:"base_relays_per_pokt"`        // the relays of an app per staked POKT
+	// staking
+	NodeStakeMinimum     *int64         `yaml:"node_stake_minimum"` // uPOKT
+	AppStakeMinimum      *int64         `yaml:"app_stake_minimum"`  // uPOKT
+	MaxValidators        *int64         `yaml:"max_validators"`
+	MaxApplications      *int64         `yaml:"max_applications"`
+	MaxChains            *int64         `yaml:"max_chains"` // the chains a node or an app can stake for
+	NodeUnstakingTime    *time.Duration `yaml:"node_unstaking_time"`
+	AppUnstakingTime     *time.Duration `yaml:"app_unstaking_time"`
+	DowntimeJailDuration *time.Duration `yaml:"downtime_jail_duration"`
+	SignedBlocksWindow   *int64         `yaml:"signed_blocks_window"`
+	MinSignedPerWindow   string         `yaml:"min_signed_per_window"` // decimal, e.g. 0.6
+	SlashFractionDouble  string         `yaml:"slash_fraction_double_sign"`
+	SlashFractionDown    string         `yaml:"slash_fraction_downtime"`
+}
+
+// "GenerateGenesisFile" - Generates the genesis file at path from the yaml genesis template
+func GenerateGenesisFile(templatePath, path string) error {
+	bz, err := ioutil.ReadFile(templatePath)
+	if err != nil {
+		return fmt.Errorf("cannot read the genesis template: %s", err.Error())
+	}
+	var template GenesisTemplate
+	if err := yaml.UnmarshalStrict(bz, &template); err != nil {
+		return fmt.Errorf("cannot parse the genesis template: %s", err.Error())
+	}
+	genesis, err := GenerateGenesis(template, time.Now())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, genesis, 0644)
+}
+
+// "GenerateGenesis" - Generates a genesis (with no accounts nor actors, see the genesis commands) with the params of
+// the template, cross validated and validated against the module genesis logic
+func GenerateGenesis(template GenesisTemplate, genesisTime time.Time) ([]byte, error) {
+	if template.ChainID == "" {
+		return nil, fmt.Errorf("the chain_id of the genesis template is empty")
+	}
+	owner, err := pocketTypes.ParseAddress(template.DAOOwner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dao_owner of the genesis template: %s", err.Error())
+	}
+	appState := module.NewBasicManager(
+		apps.AppModuleBasic{},
+		auth.AppModuleBasic{},
+		gov.AppModuleBasic{},
+		nodes.AppModuleBasic{},
+		pocket.AppModuleBasic{},
+	).DefaultGenesis()
+	// nodes
+	var posGenesis nodesTypes.GenesisState
+	if err := Codec().UnmarshalJSON(appState[nodesTypes.ModuleName], &posGenesis); err != nil {
+		return nil, err
+	}
+	p := &posGenesis.Params
+	setInt64(&p.SessionBlockFrequency, template.SessionBlocks)
+	setInt64(&p.RelaysToTokensMultiplier, template.RelaysToTokensMultiplier)
+	setInt64(&p.DAOAllocation, template.DAOAllocation)
+	setInt64(&p.ProposerAllocation, template.ProposerAllocation)
+	setInt64(&p.StakeMinimum, template.NodeStakeMinimum)
+	setInt64(&p.MaxValidators, template.MaxValidators)
+	setInt64(&p.MaximumChains, template.MaxChains)
+	setInt64(&p.SignedBlocksWindow, template.SignedBlocksWindow)
+	setDuration(&p.UnstakingTime, template.NodeUnstakingTime)
+	setDuration(&p.DowntimeJailDuration, template.DowntimeJailDuration)
+	for _, d := range []struct {
+		name  string
+		value string
+		dst   *sdk.Dec
+	}{
+		{"min_signed_per_window", template.MinSignedPerWindow, &p.MinSignedPerWindow},
+		{"slash_fraction_double_sign", template.SlashFractionDouble, &p.SlashFractionDoubleSign},
+		{"slash_fraction_downtime", template.SlashFractionDown, &p.SlashFractionDowntime},
+	} {
+		if d.value == "" {
+			continue
+		}
+		dec, err := sdk.NewDecFromStr(d.value)
+		if err != nil || dec.IsNegative() || dec.GT(sdk.OneDec()) {
+			return nil, fmt.Errorf("invalid %s of the genesis template %s, expected a decimal between 0 and 1", d.name, d.value)
+		}
+		*d.dst = dec
+	}
+	if appState[nodesTypes.ModuleName], err = Codec().MarshalJSON(posGenesis); err != nil {
+		return nil, err
+	}
+	// apps
+	var appsGenesis appsTypes.GenesisState
+	if err := Codec().UnmarshalJSON(appState[appsTypes.ModuleName], &appsGenesis); err != nil {
+		return nil, err
+	}
+	setInt64(&appsGenesis.Params.AppStakeMin, template.AppStakeMinimum)
+	setInt64(&appsGenesis.Params.MaxApplications, template.MaxApplications)
+	setInt64(&appsGenesis.Params.BaseRelaysPerPOKT, template.BaseRelaysPerPOKT)
+	setInt64(&appsGenesis.Params.MaxChains, template.MaxChains)
+	setDuration(&appsGenesis.Params.UnstakingTime, template.AppUnstakingTime)
+	if appState[appsTypes.ModuleName], err = Codec().MarshalJSON(appsGenesis); err != nil {
+		return nil, err
+	}
+	// pocketcore
+	var pocketGenesis pocketTypes.GenesisState
+	if err := Codec().UnmarshalJSON(appState[pocketTypes.ModuleName], &pocketGenesis); err != nil {
+		return nil, err
+	}
+	setInt64(&pocketGenesis.Params.SessionNodeCount, template.SessionNodeCount)
+	setInt64(&pocketGenesis.Params.ClaimSubmissionWindow, template.ClaimSubmissionWindow)
+	setInt64(&pocketGenesis.Params.ClaimExpiration, template.ClaimExpiration)
+	if template.SupportedChains != nil {
+		pocketGenesis.Params.SupportedBlockchains = template.SupportedChains
+	}
+	if appState[pocketTypes.ModuleName], err = Codec().MarshalJSON(pocketGenesis); err != nil {
+		return nil, err
+	}
+	// gov
+	var govGenesis govTypes.GenesisState
+	if err := Codec().UnmarshalJSON(appState[govTypes.ModuleName], &govGenesis); err != nil {
+		return nil, err
+	}
+	govGenesis.Params.ACL = createACL(owner)
+	govGenesis.Params.DAOOwner = owner
+	govGenesis.Params.Upgrade = govTypes.NewUpgrade(0, "0")
+	if appState[govTypes.ModuleName], err = Codec().MarshalJSON(govGenesis); err != nil {
+		return nil, err
+	}
+	if err := crossValidateGenesisParams(posGenesis.Params, appsGenesis.Params, pocketGenesis.Params); err != nil {
+		return nil, fmt.Errorf("the genesis template is invalid: %s", err.Error())
+	}
+	err = module.NewBasicManager(
+		apps.AppModuleBasic{},
+		auth.AppModuleBasic{},
+		gov.AppModuleBasic{},
+		nodes.AppModuleBasic{},
+		pocket.AppModuleBasic{},
+	).ValidateGenesis(appState)
+	if err != nil {
+		return nil, fmt.Errorf("the resulting genesis state is invalid: %s", err.Error())
+	}
+	j, err := Codec().MarshalJSONIndent(appState, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	genDoc := tmType.GenesisDoc{
+		GenesisTime:     genesisTime,
+		ChainID:         template.ChainID,
+		ConsensusParams: defaultConsensusParams(),
+		AppState:        j,
+	}
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return nil, err
+	}
+	return Codec().MarshalJSONIndent(genDoc, "", "    ")
+}
+
+// validates the params depending on the params of another module (or of the same one), not validated by the modules
+func crossValidateGenesisParams(pos nodesTypes.Params, apps appsTypes.Params, pocket pocketTypes.Params) error {
+	if pocket.SessionNodeCount > pos.MaxValidators {
+		return fmt.Errorf("the session node count %d is above the max validators %d", pocket.SessionNodeCount, pos.MaxValidators)
+	}
+	if pocket.ClaimExpiration != 0 && pocket.ClaimExpiration <= pocket.ClaimSubmissionWindow {
+		return fmt.Errorf("the claim expiration %d must be above the claim submission window %d", pocket.ClaimExpiration, pocket.ClaimSubmissionWindow)
+	}
+	if pos.MaximumChains < 1 || apps.MaxChains < 1 {
+		return fmt.Errorf("the max chains must be at least 1")
+	}
+	if pos.SignedBlocksWindow < 1 {
+		return fmt.Errorf("the signed blocks window must be at least 1")
+	}
+	if pos.RelaysToTokensMultiplier < 0 {
+		return fmt.Errorf("the relays to tokens multiplier must not be negative")
+	}
+	if pos.UnstakingTime < 0 || apps.UnstakingTime < 0 || pos.DowntimeJailDuration < 0 {
+		return fmt.Errorf("the unstaking times and the downtime jail duration must not be negative")
+	}
+	for _, chain := range pocket.SupportedBlockchains {
+		if err := nodesTypes.ValidateNetworkIdentifier(chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setInt64(dst *int64, value *int64) {
+	if value != nil {
+		*dst = *value
+	}
+}
+
+func setDuration(dst *time.Duration, value *time.Duration) {
+	if value != nil {
+		*dst = *value
+	}
+}
@@ -0,0 +1,255 @@
+package app
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+	"github.com/stretchr/testify/assert"
+	tmType "github.com/tendermint/tendermint/types"
+)
+
+func newTestGenesisDoc(t *testing.T) []byte {
+	genDoc, err := Codec().MarshalJSONIndent(tmType.GenesisDoc{
+		GenesisTime: time.Now(),
+		ChainID:     "pocket-test",
+		AppState:    oneValTwoNodeGenesisState(),
+	}, "", "    ")
+	assert.Nil(t, err)
+	return genDoc
+}
+
+func getTestAppState(t *testing.T, genesis []byte) map[string]json.RawMessage {
+	genDoc, err := tmType.GenesisDocFromJSON(genesis)
+	assert.Nil(t, err)
+	appState := make(map[string]json.RawMessage)
+	assert.Nil(t, Codec().UnmarshalJSON(genDoc.AppState, &appState))
+	return appState
+}
+
+func TestGenesisAddAccount(t *testing.T) {
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	add := func(appState map[string]json.RawMessage) error {
+		return genesisAddAccount(appState, pk, sdk.NewInt(100))
+	}
+	res, err := mutateGenesis(newTestGenesisDoc(t), add)
+	assert.Nil(t, err)
+	var accountGenesis auth.GenesisState
+	assert.Nil(t, Codec().UnmarshalJSON(getTestAppState(t, res)[auth.ModuleName], &accountGenesis))
+	var found bool
+	for _, acc := range accountGenesis.Accounts {
+		if acc.GetAddress().Equals(sdk.Address(pk.Address())) {
+			found = true
+			assert.Equal(t, sdk.NewInt(100), acc.GetCoins().AmountOf(sdk.DefaultStakeDenom))
+		}
+	}
+	assert.True(t, found)
+	// duplicate account
+	_, err = mutateGenesis(res, add)
+	assert.NotNil(t, err)
+	// non positive amount
+	_, err = mutateGenesis(res, func(appState map[string]json.RawMessage) error {
+		return genesisAddAccount(appState, crypto.GenerateEd25519PrivKey().PublicKey(), sdk.ZeroInt())
+	})
+	assert.NotNil(t, err)
+}
+
+func TestGenesisAddValidator(t *testing.T) {
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	add := func(appState map[string]json.RawMessage) error {
+		return genesisAddValidator(appState, pk, []string{dummyChainsHash}, PlaceholderServiceURL, sdk.NewInt(10000000000))
+	}
+	res, err := mutateGenesis(newTestGenesisDoc(t), add)
+	assert.Nil(t, err)
+	var posGenesis nodesTypes.GenesisState
+	assert.Nil(t, Codec().UnmarshalJSON(getTestAppState(t, res)[nodesTypes.ModuleName], &posGenesis))
+	assert.Len(t, posGenesis.Validators, 2)
+	assert.True(t, posGenesis.Validators[1].IsStaked())
+	// duplicate validator
+	_, err = mutateGenesis(res, add)
+	assert.NotNil(t, err)
+	// stake below minimum
+	_, err = mutateGenesis(res, func(appState map[string]json.RawMessage) error {
+		return genesisAddValidator(appState, crypto.GenerateEd25519PrivKey().PublicKey(), []string{dummyChainsHash}, PlaceholderServiceURL, sdk.NewInt(1))
+	})
+	assert.NotNil(t, err)
+	// bad service url
+	_, err = mutateGenesis(res, func(appState map[string]json.RawMessage) error {
+		return genesisAddValidator(appState, crypto.GenerateEd25519PrivKey().PublicKey(), []string{dummyChainsHash}, "foo", sdk.NewInt(10000000000))
+	})
+	assert.NotNil(t, err)
+}
+
+func TestImportGenesis(t *testing.T) {
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	// an exported state with a second validator, the powers of the last block, liveness tracking and a claim
+	exported, err := mutateGenesis(newTestGenesisDoc(t), func(appState map[string]json.RawMessage) error {
+		if err := genesisAddValidator(appState, pk, []string{dummyChainsHash}, PlaceholderServiceURL, sdk.NewInt(10000000000)); err != nil {
+			return err
+		}
+		var posGenesis nodesTypes.GenesisState
+		if err := Codec().UnmarshalJSON(appState[nodesTypes.ModuleName], &posGenesis); err != nil {
+			return err
+		}
+		addr := sdk.Address(pk.Address())
+		posGenesis.Exported = true
+		posGenesis.PrevStateTotalPower = sdk.NewInt(10000)
+		posGenesis.PrevStateValidatorPowers = []nodesTypes.PrevStatePowerMapping{{Address: addr, Power: 10000}}
+		posGenesis.SigningInfos = map[string]nodesTypes.ValidatorSigningInfo{addr.String(): {Address: addr, StartHeight: 100, IndexOffset: 7, MissedBlocksCounter: 2, JailedUntil: time.Unix(0, 0)}}
+		posGenesis.MissedBlocks = map[string][]nodesTypes.MissedBlock{addr.String(): {{Index: 3, Missed: true}}}
+		var err error
+		appState[nodesTypes.ModuleName], err = Codec().MarshalJSON(posGenesis)
+		return err
+	})
+	assert.Nil(t, err)
+	// the chain id is required
+	_, err = importGenesis(exported, "", nil)
+	assert.NotNil(t, err)
+	res, err := importGenesis(exported, "pocket-fork", nil)
+	assert.Nil(t, err)
+	genDoc, err := tmType.GenesisDocFromJSON(res)
+	assert.Nil(t, err)
+	assert.Equal(t, "pocket-fork", genDoc.ChainID)
+	var posGenesis nodesTypes.GenesisState
+	assert.Nil(t, Codec().UnmarshalJSON(getTestAppState(t, res)[nodesTypes.ModuleName], &posGenesis))
+	assert.False(t, posGenesis.Exported)
+	assert.Empty(t, posGenesis.PrevStateValidatorPowers)
+	assert.Empty(t, posGenesis.MissedBlocks)
+	info := posGenesis.SigningInfos[sdk.Address(pk.Address()).String()]
+	assert.Equal(t, int64(0), info.StartHeight)
+	assert.Equal(t, int64(0), info.MissedBlocksCounter)
+	for _, val := range posGenesis.Validators {
+		assert.False(t, val.IsJailed())
+	}
+	var pocketGenesis pocketTypes.GenesisState
+	assert.Nil(t, Codec().UnmarshalJSON(getTestAppState(t, res)[pocketTypes.ModuleName], &pocketGenesis))
+	assert.Empty(t, pocketGenesis.Claims)
+	// only the passed validators stay in the validator set
+	res, err = importGenesis(exported, "pocket-fork", []crypto.PublicKey{pk})
+	assert.Nil(t, err)
+	assert.Nil(t, Codec().UnmarshalJSON(getTestAppState(t, res)[nodesTypes.ModuleName], &posGenesis))
+	assert.Len(t, posGenesis.Validators, 2)
+	for _, val := range posGenesis.Validators {
+		assert.Equal(t, !val.Address.Equals(sdk.Address(pk.Address())), val.IsJailed())
+	}
+	// the passed validators must be in the exported state
+	_, err = importGenesis(exported, "pocket-fork", []crypto.PublicKey{crypto.GenerateEd25519PrivKey().PublicKey()})
+	assert.NotNil(t, err)
+}
+
+func TestGenesisAddApp(t *testing.T) {
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	add := func(appState map[string]json.RawMessage) error {
+		return genesisAddApp(appState, pk, []string{dummyChainsHash}, sdk.NewInt(10000000000))
+	}
+	res, err := mutateGenesis(newTestGenesisDoc(t), add)
+	assert.Nil(t, err)
+	var appsGenesis appsTypes.GenesisState
+	assert.Nil(t, Codec().UnmarshalJSON(getTestAppState(t, res)[appsTypes.ModuleName], &appsGenesis))
+	assert.Len(t, appsGenesis.Applications, 2)
+	assert.Equal(t, []string{dummyChainsHash}, appsGenesis.Applications[1].Chains)
+	// duplicate application
+	_, err = mutateGenesis(res, add)
+	assert.NotNil(t, err)
+	// invalid chain
+	_, err = mutateGenesis(res, func(appState map[string]json.RawMessage) error {
+		return genesisAddApp(appState, crypto.GenerateEd25519PrivKey().PublicKey(), []string{"zz"}, sdk.NewInt(10000000000))
+	})
+	assert.NotNil(t, err)
+}
+
+func TestGenerateGenesis(t *testing.T) {
+	owner := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	template := `chain_id: pocket-testnet
+dao_owner: ` + owner.String() + `
+session_blocks: 4
+session_node_count: 3
+supported_chains: ["0001", "0021"]
+claim_submission_window: 3
+relays_to_tokens_multiplier: 1000
+dao_allocation: 10
+proposer_allocation: 1
+node_stake_minimum: 15000000000
+app_stake_minimum: 2000000
+max_chains: 10
+node_unstaking_time: 504h
+slash_fraction_downtime: "0.001"
+`
+	dir, err := ioutil.TempDir("", "genesis")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	templatePath, path := dir+FS+"template.yaml", dir+FS+"genesis.json"
+	assert.Nil(t, ioutil.WriteFile(templatePath, []byte(template), 0644))
+	assert.Nil(t, GenerateGenesisFile(templatePath, path))
+	bz, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	genDoc, err := tmType.GenesisDocFromJSON(bz)
+	assert.Nil(t, err)
+	assert.Equal(t, "pocket-testnet", genDoc.ChainID)
+	appState := getTestAppState(t, bz)
+	var posGenesis nodesTypes.GenesisState
+	assert.Nil(t, Codec().UnmarshalJSON(appState[nodesTypes.ModuleName], &posGenesis))
+	assert.Equal(t, int64(4), posGenesis.Params.SessionBlockFrequency)
+	assert.Equal(t, int64(1000), posGenesis.Params.RelaysToTokensMultiplier)
+	assert.Equal(t, int64(15000000000), posGenesis.Params.StakeMinimum)
+	assert.Equal(t, 504*time.Hour, posGenesis.Params.UnstakingTime)
+	assert.Equal(t, sdk.NewDecWithPrec(1, 3), posGenesis.Params.SlashFractionDowntime)
+	var appsGenesis appsTypes.GenesisState
+	assert.Nil(t, Codec().UnmarshalJSON(appState[appsTypes.ModuleName], &appsGenesis))
+	assert.Equal(t, int64(2000000), appsGenesis.Params.AppStakeMin)
+	assert.Equal(t, int64(10), appsGenesis.Params.MaxChains)
+	// the params not in the template keep their defaults
+	assert.Equal(t, appsTypes.DefaultParams().MaxApplications, appsGenesis.Params.MaxApplications)
+	var pocketGenesis pocketTypes.GenesisState
+	assert.Nil(t, Codec().UnmarshalJSON(appState[pocketTypes.ModuleName], &pocketGenesis))
+	assert.Equal(t, int64(3), pocketGenesis.Params.SessionNodeCount)
+	assert.Equal(t, []string{"0001", "0021"}, pocketGenesis.Params.SupportedBlockchains)
+	var govGenesis govTypes.GenesisState
+	assert.Nil(t, Codec().UnmarshalJSON(appState[govTypes.ModuleName], &govGenesis))
+	assert.Equal(t, owner, govGenesis.Params.DAOOwner)
+	assert.Equal(t, owner, govGenesis.Params.ACL.GetOwner("pos/StakeMinimum"))
+	// the generated genesis is mutable with the genesis commands
+	assert.Nil(t, GenesisAddAccount(path, crypto.GenerateEd25519PrivKey().PublicKey(), sdk.NewInt(100)))
+	// invalid templates
+	valid := GenesisTemplate{ChainID: "pocket-testnet", DAOOwner: owner.String()}
+	_, err = GenerateGenesis(valid, time.Now())
+	assert.Nil(t, err)
+	for name, mutate := range map[string]func(template *GenesisTemplate){
+		"no chain id":       func(template *GenesisTemplate) { template.ChainID = "" },
+		"invalid dao owner": func(template *GenesisTemplate) { template.DAOOwner = "foo" },
+		"allocations above 100": func(template *GenesisTemplate) {
+			template.DAOAllocation, template.ProposerAllocation = int64Ptr(90), int64Ptr(20)
+		},
+		"session above max vals": func(template *GenesisTemplate) {
+			template.SessionNodeCount, template.MaxValidators = int64Ptr(5), int64Ptr(4)
+		},
+		"expiration below window": func(template *GenesisTemplate) {
+			template.ClaimSubmissionWindow, template.ClaimExpiration = int64Ptr(10), int64Ptr(5)
+		},
+		"invalid chain":         func(template *GenesisTemplate) { template.SupportedChains = []string{"zz"} },
+		"invalid slash":         func(template *GenesisTemplate) { template.SlashFractionDown = "2" },
+		"stake below minimum":   func(template *GenesisTemplate) { template.AppStakeMinimum = int64Ptr(0) },
+		"one block per session": func(template *GenesisTemplate) { template.SessionBlocks = int64Ptr(1) },
+	} {
+		template := valid
+		mutate(&template)
+		_, err := GenerateGenesis(template, time.Now())
+		assert.NotNil(t, err, name)
+	}
+	// unknown fields of the template are rejected
+	assert.Nil(t, ioutil.WriteFile(templatePath, []byte(template+"session_length: 4\n"), 0644))
+	assert.NotNil(t, GenerateGenesisFile(templatePath, path))
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"fmt"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// "RecordCLIKeyUse" - Appends the signature of a transaction by the cli to the key audit log, as a use of the node key
+// if signed by the address of the node
+func RecordCLIKeyUse(addr sdk.Address, msgType string, txBz []byte, err error) {
+	r := pocketTypes.KeyAuditRecord{Key: pocketTypes.AccountKey, Address: addr.String(), MsgType: msgType, Source: pocketTypes.KeyUseCLI}
+	if nodeAddr, ok := nodeKeyAddress(); ok && nodeAddr.Equals(addr) {
+		r.Key = pocketTypes.NodeKey
+	}
+	if len(txBz) != 0 {
+		r.TxHash = fmt.Sprintf("%X", tmTypes.Tx(txBz).Hash())
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	_ = pocketTypes.RecordKeyUse(r)
+}
+
+// the address of the node key: the loaded private validator key, or the one of its file (e.g. in the cli)
+func nodeKeyAddress() (sdk.Address, bool) {
+	if pvKey, err := pocketTypes.GetPVKeyFile(); err == nil {
+		return sdk.Address(pvKey.Address), true
+	}
+	key, _, _, err := loadPrivVal(GlobalConfig.PocketConfig.DataDir + FS + GlobalConfig.TendermintConfig.PrivValidatorKey)
+	if err != nil {
+		return nil, false
+	}
+	return sdk.Address(key.Address), true
+}
+
+// "QueryKeyAudit" - Returns the uses of the node and account keys recorded in the key audit log, sorted by time in the
+// order (desc by default)
+func (app *PocketCoreApp) QueryKeyAudit(page, perPage int, order string) (res Page, err error) {
+	if order, err = checkTxOrder(order); err != nil {
+		return
+	}
+	records, err := pocketTypes.KeyAuditRecords()
+	if err != nil {
+		return
+	}
+	// the log is appended in time order
+	if order == TxOrderDesc {
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+	}
+	page, perPage = checkPagination(PaginationQueryTxs, page, perPage)
+	return paginate(page, perPage, records)
+}
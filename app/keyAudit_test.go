@@ -0,0 +1,51 @@
+package app
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/privval"
+)
+
+func TestRecordCLIKeyUse(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyaudit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	pocketTypes.InitKeyAuditLog(dir + FS + pocketTypes.DefaultKeyAuditLogName)
+	defer pocketTypes.InitKeyAuditLog("")
+	config := GlobalConfig
+	defer func() { GlobalConfig = config }()
+	GlobalConfig = DefaultConfig(dir)
+	pvKey, _ := pocketTypes.GetPVKeyFile()
+	defer pocketTypes.InitPVKeyFile(pvKey)
+	// the node key of the key file (e.g. in the cli)
+	pocketTypes.InitPVKeyFile(privval.FilePVKey{})
+	pk := ed25519.GenPrivKey()
+	assert.Nil(t, ioutil.WriteFile(dir+FS+GlobalConfig.TendermintConfig.PrivValidatorKey, []byte(hex.EncodeToString(pk[:])), 0600))
+	nodeAddr := sdk.Address(pk.PubKey().Address())
+	RecordCLIKeyUse(nodeAddr, "unjail", []byte("tx"), nil)
+	other := sdk.Address(ed25519.GenPrivKey().PubKey().Address())
+	RecordCLIKeyUse(other, "send", nil, fmt.Errorf("wrong passphrase"))
+	// the loaded node key
+	pocketTypes.InitPVKeyFile(privval.FilePVKey{Address: other.Bytes(), PubKey: pk.PubKey(), PrivKey: pk})
+	RecordCLIKeyUse(other, "send", []byte("tx"), nil)
+	records, err := pocketTypes.KeyAuditRecords()
+	assert.Nil(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, pocketTypes.NodeKey, records[0].Key)
+	assert.Equal(t, nodeAddr.String(), records[0].Address)
+	assert.Equal(t, "unjail", records[0].MsgType)
+	assert.Equal(t, pocketTypes.KeyUseCLI, records[0].Source)
+	assert.Len(t, records[0].TxHash, 64)
+	assert.Equal(t, pocketTypes.AccountKey, records[1].Key)
+	assert.Empty(t, records[1].TxHash)
+	assert.Equal(t, "wrong passphrase", records[1].Error)
+	assert.Equal(t, pocketTypes.NodeKey, records[2].Key)
+}
@@ -0,0 +1,221 @@
+package app
+
+import (
+	stdEd25519 "crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/go-bip39"
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/pokt-network/posmint/crypto/keys"
+	"github.com/pokt-network/posmint/crypto/keys/mintkey"
+	"github.com/tendermint/crypto/bcrypt"
+	"github.com/tendermint/tendermint/crypto/armor"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+)
+
+const (
+	KeyAlgoEd25519   = "ed25519"
+	KeyAlgoSecp256k1 = "secp256k1"
+	// the pocket coin type (slip-44), every level is hardened as ed25519 (slip-10) only derives hardened keys
+	DefaultEd25519HDPath = "m/44'/635'/0'/0'/0'"
+	// the path of the cosmos sdk accounts (bip-44)
+	DefaultSecp256k1HDPath = "m/44'/118'/0'/0/0"
+	// the armor of the private keys exported by the cosmos sdk (keys export)
+	cosmosArmorBlockType = "TENDERMINT PRIVATE KEY"
+	// the bcrypt security parameter of the private keys exported by the cosmos sdk
+	cosmosBcryptSecurityParameter = 12
+	hardenedKeyStart              = uint32(0x80000000)
+)
+
+// "ImportKey" - Encrypts the private key with the passphrase into the keybase, the key must not be there already
+func ImportKey(kb keys.Keybase, pk crypto.PrivateKey, encryptPassphrase string) (keys.KeyPair, error) {
+	// the keybase imports raw ed25519 keys only, any other goes through its armor
+	armored, err := mintkey.EncryptArmorPrivKey(pk, encryptPassphrase, "")
+	if err != nil {
+		return keys.KeyPair{}, err
+	}
+	return kb.ImportPrivKey(armored, encryptPassphrase, encryptPassphrase)
+}
+
+// "PrivateKeyFromCosmosArmor" - Decrypts a private key exported by the cosmos sdk (keys export), the amino encoded key
+// encrypted with xsalsa20 by the bcrypt hash of the passphrase in ASCII armor
+func PrivateKeyFromCosmosArmor(armorStr, passphrase string) (crypto.PrivateKey, error) {
+	blockType, header, encBytes, err := armor.DecodeArmor(armorStr)
+	if err != nil {
+		return nil, err
+	}
+	if blockType != cosmosArmorBlockType {
+		return nil, fmt.Errorf("unrecognized armor type %q, expected: %q", blockType, cosmosArmorBlockType)
+	}
+	if header["kdf"] != "bcrypt" {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", header["kdf"])
+	}
+	salt, err := hex.DecodeString(header["salt"])
+	if err != nil || len(salt) == 0 {
+		return nil, fmt.Errorf("invalid salt bytes: %v", header["salt"])
+	}
+	key, err := bcrypt.GenerateFromPassword(salt, []byte(passphrase), cosmosBcryptSecurityParameter)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(key)
+	bz, err := xsalsa20symmetric.DecryptSymmetric(encBytes, hash[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt the private key (invalid passphrase?): %s", err.Error())
+	}
+	pk, err := cryptoAmino.PrivKeyFromBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.PrivKeyToPrivateKey(pk)
+}
+
+// "PrivateKeyFromPrivValFile" - Retrieves the private key of a tendermint priv validator key file (in any format)
+func PrivateKeyFromPrivValFile(path string) (crypto.PrivateKey, error) {
+	key, _, _, err := loadPrivVal(path)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.PrivKeyToPrivateKey(key.PrivKey)
+}
+
+// "PrivateKeyFromSeed" - Creates the private key of the algo from a raw 32 bytes seed: the seed of the ed25519 key or
+// the secp256k1 private key
+func PrivateKeyFromSeed(seed []byte, algo string) (crypto.PrivateKey, error) {
+	if len(seed) != 32 {
+		return nil, fmt.Errorf("the seed must be 32 bytes, not %d", len(seed))
+	}
+	switch algo {
+	case KeyAlgoEd25519:
+		var pk ed25519.PrivKeyEd25519
+		copy(pk[:], stdEd25519.NewKeyFromSeed(seed))
+		return crypto.PrivKeyToPrivateKey(pk)
+	case KeyAlgoSecp256k1:
+		if k := new(big.Int).SetBytes(seed); k.Sign() == 0 || k.Cmp(btcec.S256().N) >= 0 {
+			return nil, fmt.Errorf("the seed is not a valid secp256k1 private key")
+		}
+		var pk secp256k1.PrivKeySecp256k1
+		copy(pk[:], seed)
+		return crypto.PrivKeyToPrivateKey(pk)
+	default:
+		return nil, fmt.Errorf("unsupported key algo %s, expected %s or %s", algo, KeyAlgoEd25519, KeyAlgoSecp256k1)
+	}
+}
+
+// "PrivateKeyFromMnemonic" - Derives the private key of the algo at the hd path (the default path of the algo if empty)
+// from the bip-39 mnemonic and its passphrase: bip-32 for secp256k1 and slip-10 for ed25519
+func PrivateKeyFromMnemonic(mnemonic, bip39Passphrase, hdPath, algo string) (crypto.PrivateKey, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %s", err.Error())
+	}
+	return derivePrivateKey(seed, hdPath, algo)
+}
+
+// derives the private key of the algo at the hd path (the default path of the algo if empty) from the seed
+func derivePrivateKey(seed []byte, hdPath, algo string) (crypto.PrivateKey, error) {
+	var curveSeed string
+	switch algo {
+	case KeyAlgoEd25519:
+		curveSeed = "ed25519 seed"
+		if hdPath == "" {
+			hdPath = DefaultEd25519HDPath
+		}
+	case KeyAlgoSecp256k1:
+		curveSeed = "Bitcoin seed"
+		if hdPath == "" {
+			hdPath = DefaultSecp256k1HDPath
+		}
+	default:
+		return nil, fmt.Errorf("unsupported key algo %s, expected %s or %s", algo, KeyAlgoEd25519, KeyAlgoSecp256k1)
+	}
+	path, err := ParseHDPath(hdPath)
+	if err != nil {
+		return nil, err
+	}
+	key, chainCode := hmacSHA512([]byte(curveSeed), seed)
+	for _, index := range path {
+		if key, chainCode, err = deriveChildKey(algo, key, chainCode, index); err != nil {
+			return nil, err
+		}
+	}
+	return PrivateKeyFromSeed(key, algo)
+}
+
+// "ParseHDPath" - Parses the indexes of a bip-32 path (e.g. m/44'/635'/0'/0'/0'), the hardened ones are marked by '
+func ParseHDPath(hdPath string) ([]uint32, error) {
+	levels := strings.Split(strings.TrimSpace(hdPath), "/")
+	if levels[0] != "m" {
+		return nil, fmt.Errorf("invalid hd path %s: it must start with m", hdPath)
+	}
+	path := make([]uint32, 0, len(levels)-1)
+	for _, level := range levels[1:] {
+		hardened := strings.HasSuffix(level, "'")
+		index, err := strconv.ParseUint(strings.TrimSuffix(level, "'"), 10, 32)
+		if err != nil || uint32(index) >= hardenedKeyStart {
+			return nil, fmt.Errorf("invalid hd path %s: invalid index %s", hdPath, level)
+		}
+		if hardened {
+			index += uint64(hardenedKeyStart)
+		}
+		path = append(path, uint32(index))
+	}
+	return path, nil
+}
+
+// derives the private key and the chain code of the child at index of the parent key
+func deriveChildKey(algo string, key, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	hardened := index >= hardenedKeyStart
+	data := make([]byte, 0, 37)
+	switch {
+	case hardened:
+		data = append(append(data, 0), key...)
+	case algo == KeyAlgoSecp256k1:
+		_, pub := btcec.PrivKeyFromBytes(btcec.S256(), key)
+		data = append(data, pub.SerializeCompressed()...)
+	default:
+		return nil, nil, fmt.Errorf("%s only derives hardened keys, the index %d is not", algo, index)
+	}
+	var indexBz [4]byte
+	binary.BigEndian.PutUint32(indexBz[:], index)
+	childKey, childChainCode := hmacSHA512(chainCode, append(data, indexBz[:]...))
+	if algo == KeyAlgoEd25519 {
+		return childKey, childChainCode, nil
+	}
+	// the child key of secp256k1 is the parent key tweaked by the hash
+	n := btcec.S256().N
+	tweak := new(big.Int).SetBytes(childKey)
+	if tweak.Cmp(n) >= 0 {
+		return nil, nil, fmt.Errorf("invalid child key at index %d, use the next index", index)
+	}
+	k := tweak.Add(tweak, new(big.Int).SetBytes(key))
+	k.Mod(k, n)
+	if k.Sign() == 0 {
+		return nil, nil, fmt.Errorf("invalid child key at index %d, use the next index", index)
+	}
+	bz := make([]byte, 32)
+	kBz := k.Bytes()
+	copy(bz[32-len(kBz):], kBz)
+	return bz, childChainCode, nil
+}
+
+// the halves of the hmac-sha512 of the data: the key and the chain code
+func hmacSHA512(key, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/pokt-network/posmint/crypto/keys"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/crypto/bcrypt"
+	tmCrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/armor"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+	"github.com/tendermint/tendermint/privval"
+)
+
+func TestDerivePrivateKey(t *testing.T) {
+	// the test vector 1 of bip-32 and slip-10
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	tests := []struct {
+		algo     string
+		path     string
+		expected string
+		hasError bool
+	}{
+		{KeyAlgoSecp256k1, "m/0'", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea", false},
+		{KeyAlgoSecp256k1, "m/0'/1", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368", false},
+		{KeyAlgoSecp256k1, "m/0'/1/2'", "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca", false},
+		{KeyAlgoEd25519, "m", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", false},
+		{KeyAlgoEd25519, "m/0'", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", false},
+		{KeyAlgoEd25519, "m/0'/1'", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2", false},
+		{KeyAlgoEd25519, "m/0'/1", "", true},
+		{KeyAlgoSecp256k1, "44'/0'", "", true},
+		{KeyAlgoSecp256k1, "m/a", "", true},
+		{"rsa", "m/0'", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s", tt.algo, tt.path), func(t *testing.T) {
+			pk, err := derivePrivateKey(seed, tt.path, tt.algo)
+			if tt.hasError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			// the raw ed25519 key is the seed and the public key
+			assert.Equal(t, tt.expected, hex.EncodeToString(pk.RawBytes()[:32]))
+		})
+	}
+}
+
+func TestPrivateKeyFromMnemonic(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	ed, err := PrivateKeyFromMnemonic(mnemonic, "", "", KeyAlgoEd25519)
+	assert.Nil(t, err)
+	assert.IsType(t, crypto.Ed25519PrivateKey{}, ed)
+	sameEd, err := PrivateKeyFromMnemonic(" abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon  about\n", "", DefaultEd25519HDPath, KeyAlgoEd25519)
+	assert.Nil(t, err)
+	assert.True(t, ed.Equals(sameEd))
+	other, err := PrivateKeyFromMnemonic(mnemonic, "passphrase", "", KeyAlgoEd25519)
+	assert.Nil(t, err)
+	assert.False(t, ed.Equals(other))
+	sec, err := PrivateKeyFromMnemonic(mnemonic, "", "", KeyAlgoSecp256k1)
+	assert.Nil(t, err)
+	assert.IsType(t, crypto.Secp256k1PrivateKey{}, sec)
+	// the checksum of the mnemonic is invalid
+	_, err = PrivateKeyFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", "", "", KeyAlgoEd25519)
+	assert.NotNil(t, err)
+}
+
+func TestPrivateKeyFromSeed(t *testing.T) {
+	seed := sha256.Sum256([]byte("seed"))
+	ed, err := PrivateKeyFromSeed(seed[:], KeyAlgoEd25519)
+	assert.Nil(t, err)
+	assert.Equal(t, seed[:], ed.RawBytes()[:32])
+	sec, err := PrivateKeyFromSeed(seed[:], KeyAlgoSecp256k1)
+	assert.Nil(t, err)
+	assert.Equal(t, seed[:], sec.RawBytes())
+	_, err = PrivateKeyFromSeed(seed[:16], KeyAlgoEd25519)
+	assert.NotNil(t, err)
+	_, err = PrivateKeyFromSeed(make([]byte, 32), KeyAlgoSecp256k1)
+	assert.NotNil(t, err)
+}
+
+func TestPrivateKeyFromCosmosArmor(t *testing.T) {
+	pk := secp256k1.GenPrivKey()
+	// as the cosmos sdk exports the keys
+	salt := tmCrypto.CRandBytes(16)
+	key, err := bcrypt.GenerateFromPassword(salt, []byte("pass"), cosmosBcryptSecurityParameter)
+	assert.Nil(t, err)
+	hash := sha256.Sum256(key)
+	armored := armor.EncodeArmor(cosmosArmorBlockType, map[string]string{"kdf": "bcrypt", "salt": fmt.Sprintf("%X", salt)}, xsalsa20symmetric.EncryptSymmetric(pk.Bytes(), hash[:]))
+	got, err := PrivateKeyFromCosmosArmor(armored, "pass")
+	assert.Nil(t, err)
+	assert.Equal(t, pk.PubKey().Address(), got.PubKey().Address())
+	_, err = PrivateKeyFromCosmosArmor(armored, "wrong")
+	assert.NotNil(t, err)
+	_, err = PrivateKeyFromCosmosArmor("not armored", "pass")
+	assert.NotNil(t, err)
+}
+
+func TestPrivateKeyFromPrivValFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyimport")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "priv_validator_key.json")
+	pv := privval.GenFilePV(path, filepath.Join(dir, "priv_validator_state.json"))
+	pv.Save()
+	got, err := PrivateKeyFromPrivValFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, pv.GetPubKey().Address(), got.PubKey().Address())
+}
+
+func TestImportKey(t *testing.T) {
+	kb := keys.NewInMemory()
+	for _, pk := range []crypto.PrivateKey{crypto.GenerateEd25519PrivKey(), crypto.GenerateSecp256k1PrivKey()} {
+		kp, err := ImportKey(kb, pk, "pass")
+		assert.Nil(t, err)
+		assert.Equal(t, pk.PublicKey().Address().Bytes(), kp.GetAddress().Bytes())
+		exported, err := kb.ExportPrivateKeyObject(kp.GetAddress(), "pass")
+		assert.Nil(t, err)
+		assert.True(t, pk.Equals(exported))
+		// the key is already in the keybase
+		_, err = ImportKey(kb, pk, "pass")
+		assert.NotNil(t, err)
+	}
+}
@@ -0,0 +1,172 @@
+package app
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketKeeper "github.com/pokt-network/pocket-core/x/pocketcore/keeper"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	bam "github.com/pokt-network/posmint/baseapp"
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// the prefix of the schema version keys (per module) in the main store
+var schemaVersionKey = []byte("schema_version/")
+
+// "MigrationFn" - Migrates the store of a module in place, returning the number of migrated entries
+type MigrationFn func(ctx sdk.Ctx, store sdk.KVStore, cdc *codec.Codec) (migrated int64, err error)
+
+// "Migration" - An in place migration of a module store, from the previous schema version to Version
+type Migration struct {
+	Module  string      // the module (store key name) to migrate
+	Version uint64      // the schema version of the module store after the migration
+	Name    string      // a short human readable description
+	Migrate MigrationFn // the migration function
+}
+
+// "MigrationResult" - The outcome of a single migration
+type MigrationResult struct {
+	Module      string        `json:"module"`
+	Name        string        `json:"name"`
+	FromVersion uint64        `json:"from_version"`
+	ToVersion   uint64        `json:"to_version"`
+	Migrated    int64         `json:"migrated"`
+	Duration    time.Duration `json:"duration"`
+	Error       string        `json:"error,omitempty"`
+}
+
+// "MigrationRegistry" - The versioned migrations of each module
+type MigrationRegistry struct {
+	M map[string][]Migration // M[module] -> migrations ordered by version
+}
+
+// "Migrations" - The migrations of this version of pocket core, run at the upgrade height
+var Migrations = NewMigrationRegistry()
+
+func init() {
+	Migrations.Register(Migration{
+		Module:  appsTypes.StoreKey,
+		Version: 1,
+		Name:    "index the staked applications by chain",
+		Migrate: appsKeeper.IndexStakedApplicationsByChains,
+	})
+	Migrations.Register(Migration{
+		Module:  nodesTypes.StoreKey,
+		Version: 1,
+		Name:    "set the output address of the validators to their operator address",
+		Migrate: nodesKeeper.SetValidatorsOutputAddress,
+	})
+	Migrations.Register(Migration{
+		Module:  pocketTypes.StoreKey,
+		Version: 1,
+		Name:    "index the receipts and claims by evidence type, chain and app",
+		Migrate: pocketKeeper.IndexReceiptsAndClaims,
+	})
+}
+
+// "NewMigrationRegistry" - Returns an empty migration registry
+func NewMigrationRegistry() *MigrationRegistry {
+	return &MigrationRegistry{M: make(map[string][]Migration)}
+}
+
+// "Register" - Adds the migration to the registry, versions must be registered in order (starting at 1) per module
+func (mr *MigrationRegistry) Register(m Migration) {
+	if m.Migrate == nil {
+		panic(fmt.Sprintf("migration %s for %s has no migration function", m.Name, m.Module))
+	}
+	if expected := uint64(len(mr.M[m.Module]) + 1); m.Version != expected {
+		panic(fmt.Sprintf("migration %s for %s has version %d, expected version %d", m.Name, m.Module, m.Version, expected))
+	}
+	mr.M[m.Module] = append(mr.M[m.Module], m)
+}
+
+// "Pending" - Returns the migrations that have not been applied to the state yet, ordered by module and version
+func (mr *MigrationRegistry) Pending(ctx sdk.Ctx, mainKey sdk.StoreKey) (pending []Migration) {
+	modules := make([]string, 0, len(mr.M))
+	for module := range mr.M {
+		modules = append(modules, module)
+	}
+	// deterministic order
+	sort.Strings(modules)
+	for _, module := range modules {
+		version := SchemaVersion(ctx, mainKey, module)
+		for _, m := range mr.M[module] {
+			if m.Version > version {
+				pending = append(pending, m)
+			}
+		}
+	}
+	return
+}
+
+// "Run" - Applies the pending migrations, stopping at the first failure
+func (mr *MigrationRegistry) Run(ctx sdk.Ctx, mainKey sdk.StoreKey, keys map[string]*sdk.KVStoreKey, cdc *codec.Codec) (results []MigrationResult, err error) {
+	pending := mr.Pending(ctx, mainKey)
+	for i, m := range pending {
+		ctx.Logger().Info(fmt.Sprintf("running migration (%d/%d) %s for %s to version %d", i+1, len(pending), m.Name, m.Module, m.Version))
+		result := MigrationResult{Module: m.Module, Name: m.Name, FromVersion: m.Version - 1, ToVersion: m.Version}
+		key, ok := keys[m.Module]
+		if !ok {
+			err = fmt.Errorf("no store found for module %s", m.Module)
+			result.Error = err.Error()
+			return append(results, result), err
+		}
+		start := time.Now()
+		result.Migrated, err = m.Migrate(ctx, ctx.KVStore(key), cdc)
+		result.Duration = time.Since(start)
+		if err != nil {
+			result.Error = err.Error()
+			return append(results, result), err
+		}
+		setSchemaVersion(ctx, mainKey, m.Module, m.Version)
+		ctx.Logger().Info(fmt.Sprintf("migration %s for %s done, %d entries migrated in %s", m.Name, m.Module, result.Migrated, result.Duration))
+		results = append(results, result)
+	}
+	return
+}
+
+// "SchemaVersion" - Returns the schema version of the module store (0 if never migrated)
+func SchemaVersion(ctx sdk.Ctx, mainKey sdk.StoreKey, module string) uint64 {
+	bz := ctx.KVStore(mainKey).Get(append(schemaVersionKey, []byte(module)...))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func setSchemaVersion(ctx sdk.Ctx, mainKey sdk.StoreKey, module string, version uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	ctx.KVStore(mainKey).Set(append(schemaVersionKey, []byte(module)...), bz)
+}
+
+// "runUpgradeMigrations" - Runs the pending migrations if this is the upgrade height, a failed migration halts the chain
+func (app *PocketCoreApp) runUpgradeMigrations(ctx sdk.Ctx) {
+	upgrade := app.govKeeper.GetUpgrade(ctx)
+	if upgrade.Height == 0 || upgrade.Height != ctx.BlockHeight() {
+		return
+	}
+	_, err := Migrations.Run(ctx, app.keys[bam.MainStoreKey], app.keys, app.cdc)
+	if err != nil {
+		panic(fmt.Sprintf("migration failed at upgrade height %d (version %s): %s", upgrade.Height, upgrade.Version, err.Error()))
+	}
+}
+
+// "DryRunMigrations" - Runs the pending migrations against the state at height, without persisting any change
+func (app *PocketCoreApp) DryRunMigrations(height int64) (results []MigrationResult, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return nil, err
+	}
+	// branch the state, the cache is never written
+	ctx = ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+	results, _ = Migrations.Run(ctx, app.keys[bam.MainStoreKey], app.keys, app.cdc)
+	return results, nil
+}
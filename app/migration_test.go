@@ -0,0 +1,83 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	bam "github.com/pokt-network/posmint/baseapp"
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+var migratedKey = []byte("migrated")
+
+func testMigration(version uint64) Migration {
+	return Migration{
+		Module:  pocketTypes.StoreKey,
+		Version: version,
+		Name:    "test migration",
+		Migrate: func(ctx sdk.Ctx, store sdk.KVStore, cdc *codec.Codec) (migrated int64, err error) {
+			store.Set(migratedKey, []byte{byte(version)})
+			return 1, nil
+		},
+	}
+}
+
+func TestMigrationRegistry_Register(t *testing.T) {
+	registry := NewMigrationRegistry()
+	// versions must start at 1
+	assert.Panics(t, func() { registry.Register(testMigration(2)) })
+	registry.Register(testMigration(1))
+	registry.Register(testMigration(2))
+	// no duplicates
+	assert.Panics(t, func() { registry.Register(testMigration(2)) })
+	assert.Len(t, registry.M[pocketTypes.StoreKey], 2)
+}
+
+func TestMigrations(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	registry := NewMigrationRegistry()
+	registry.Register(testMigration(1))
+	registry.Register(Migration{
+		Module:  pocketTypes.StoreKey,
+		Version: 2,
+		Name:    "failing migration",
+		Migrate: func(ctx sdk.Ctx, store sdk.KVStore, cdc *codec.Codec) (migrated int64, err error) {
+			return 0, errors.New("failed")
+		},
+	})
+	defaultMigrations := Migrations
+	Migrations = registry
+	defer func() { Migrations = defaultMigrations }()
+	mainKey := PCA.keys[bam.MainStoreKey]
+	// dry run
+	results, err := PCA.DryRunMigrations(0)
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, int64(1), results[0].Migrated)
+	assert.Empty(t, results[0].Error)
+	assert.Equal(t, "failed", results[1].Error)
+	// nothing is persisted
+	ctx, err := PCA.NewContext(0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), SchemaVersion(ctx, mainKey, pocketTypes.StoreKey))
+	assert.Nil(t, ctx.KVStore(PCA.keys[pocketTypes.StoreKey]).Get(migratedKey))
+	assert.Len(t, registry.Pending(ctx, mainKey), 2)
+	// run stops at the failed migration, the successful ones are applied
+	results, err = registry.Run(ctx, mainKey, PCA.keys, PCA.cdc)
+	assert.NotNil(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, uint64(1), SchemaVersion(ctx, mainKey, pocketTypes.StoreKey))
+	assert.Equal(t, []byte{1}, ctx.KVStore(PCA.keys[pocketTypes.StoreKey]).Get(migratedKey))
+	pending := registry.Pending(ctx, mainKey)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, uint64(2), pending[0].Version)
+
+	cleanup()
+	stopCli()
+}
@@ -0,0 +1,310 @@
+package app
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	log2 "log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/tendermint/tendermint/p2p/upnp"
+)
+
+const (
+	NATPortMappingUPnP    = "upnp"
+	NATPortMappingNATPMP  = "natpmp"
+	natMappingDescription = "pocket-core"
+	natPMPPort            = 5351
+	natPMPTries           = 4
+	natPMPLifetime        = time.Hour
+	selfCheckDelay        = 5 * time.Second
+	selfCheckTimeout      = 10 * time.Second
+)
+
+// "InitNetworking" - Maps the p2p and rpc ports on the NAT gateway (if configured) and sets the address advertised to peers
+func InitNetworking() {
+	pc := &GlobalConfig.PocketConfig
+	if pc.NATPortMapping != "" {
+		externalIP, err := mapPorts(pc.NATPortMapping, pc.NATPMPGateway)
+		if err != nil {
+			log2.Println(fmt.Sprintf("WARNING: unable to map the ports with %s: %s", pc.NATPortMapping, err.Error()))
+		} else if pc.ExternalAddress == "" {
+			log2.Println(fmt.Sprintf("using the external address of the NAT gateway %s", externalIP))
+			pc.ExternalAddress = externalIP.String()
+		}
+	}
+	if err := applyExternalAddress(&GlobalConfig); err != nil {
+		log2.Println(fmt.Sprintf("WARNING: unable to set the external address: %s", err.Error()))
+	}
+}
+
+// "applyExternalAddress" - Advertises the external address to the peers, unless the tendermint external address is set
+func applyExternalAddress(c *Config) error {
+	if c.PocketConfig.ExternalAddress == "" || c.TendermintConfig.P2P.ExternalAddress != "" {
+		return nil
+	}
+	port, err := listenPort(c.TendermintConfig.P2P.ListenAddress)
+	if err != nil {
+		return err
+	}
+	c.TendermintConfig.P2P.ExternalAddress = net.JoinHostPort(c.PocketConfig.ExternalAddress, strconv.Itoa(port))
+	return nil
+}
+
+// "listenPort" - Returns the port of a listen address (e.g. tcp://0.0.0.0:26656)
+func listenPort(laddr string) (int, error) {
+	if i := strings.Index(laddr, "://"); i >= 0 {
+		laddr = laddr[i+3:]
+	}
+	_, port, err := net.SplitHostPort(laddr)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(port)
+}
+
+// "mapPorts" - Maps the p2p and rpc ports on the NAT gateway, returns the external ip of the gateway
+func mapPorts(method, gateway string) (externalIP net.IP, err error) {
+	var nat upnp.NAT
+	switch method {
+	case NATPortMappingUPnP:
+		nat, err = upnp.Discover()
+	case NATPortMappingNATPMP:
+		nat, err = newNATPMP(gateway)
+	default:
+		err = fmt.Errorf("unknown port mapping method %s, expected %s or %s", method, NATPortMappingUPnP, NATPortMappingNATPMP)
+	}
+	if err != nil {
+		return nil, err
+	}
+	p2pPort, err := listenPort(GlobalConfig.TendermintConfig.P2P.ListenAddress)
+	if err != nil {
+		return nil, err
+	}
+	rpcPort, err := strconv.Atoi(GlobalConfig.PocketConfig.RPCPort)
+	if err != nil {
+		return nil, err
+	}
+	for _, port := range []int{p2pPort, rpcPort} {
+		if err = addPortMapping(nat, port); err != nil {
+			return nil, err
+		}
+		// NAT-PMP mappings expire, renew them before the lifetime ends
+		if method == NATPortMappingNATPMP {
+			go renewPortMapping(nat, port)
+		}
+	}
+	return nat.GetExternalAddress()
+}
+
+func addPortMapping(nat upnp.NAT, port int) error {
+	mapped, err := nat.AddPortMapping("tcp", port, port, natMappingDescription, int(natPMPLifetime.Seconds()))
+	if err != nil {
+		return fmt.Errorf("port %d: %s", port, err.Error())
+	}
+	if mapped != port {
+		return fmt.Errorf("port %d was mapped to the external port %d", port, mapped)
+	}
+	log2.Println(fmt.Sprintf("mapped the port %d on the NAT gateway", port))
+	return nil
+}
+
+func renewPortMapping(nat upnp.NAT, port int) {
+	for range time.Tick(natPMPLifetime / 2) {
+		if err := addPortMapping(nat, port); err != nil {
+			log2.Println(fmt.Sprintf("WARNING: unable to renew the port mapping: %s", err.Error()))
+		}
+	}
+}
+
+// "natPMP" - A minimal NAT-PMP (RFC 6886) client
+type natPMP struct {
+	gateway *net.UDPAddr
+}
+
+var _ upnp.NAT = natPMP{}
+
+func newNATPMP(gateway string) (upnp.NAT, error) {
+	var ip net.IP
+	if gateway == "" {
+		f, err := os.Open("/proc/net/route")
+		if err != nil {
+			return nil, fmt.Errorf("no NAT-PMP gateway configured and unable to read the default gateway: %s", err.Error())
+		}
+		defer f.Close()
+		if ip, err = defaultGateway(f); err != nil {
+			return nil, err
+		}
+	} else if ip = net.ParseIP(gateway); ip == nil {
+		return nil, fmt.Errorf("invalid NAT-PMP gateway %s", gateway)
+	}
+	return natPMP{gateway: &net.UDPAddr{IP: ip, Port: natPMPPort}}, nil
+}
+
+// "defaultGateway" - Returns the gateway of the default route from a linux routing table (/proc/net/route)
+func defaultGateway(routes io.Reader) (net.IP, error) {
+	scanner := bufio.NewScanner(routes)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		// Iface Destination Gateway ...
+		if len(fields) < 3 || fields[1] != "00000000" {
+			continue
+		}
+		bz, err := hex.DecodeString(fields[2])
+		if err != nil || len(bz) != net.IPv4len {
+			continue
+		}
+		// little endian
+		return net.IPv4(bz[3], bz[2], bz[1], bz[0]), nil
+	}
+	return nil, fmt.Errorf("no default gateway found")
+}
+
+// "request" - Sends the request to the gateway, retransmitting with an exponential backoff (RFC 6886 3.1)
+func (n natPMP) request(msg []byte, respLen int) ([]byte, error) {
+	conn, err := net.DialUDP("udp", nil, n.gateway)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	resp := make([]byte, 16)
+	timeout := 250 * time.Millisecond
+	for i := 0; i < natPMPTries; i++ {
+		if _, err = conn.Write(msg); err != nil {
+			return nil, err
+		}
+		if err = conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+			return nil, err
+		}
+		l, err := conn.Read(resp)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				timeout *= 2
+				continue
+			}
+			return nil, err
+		}
+		if l < respLen || resp[0] != 0 || resp[1] != msg[1]+128 {
+			return nil, fmt.Errorf("unexpected NAT-PMP response from %s", n.gateway)
+		}
+		if code := binary.BigEndian.Uint16(resp[2:4]); code != 0 {
+			return nil, fmt.Errorf("NAT-PMP gateway %s returned the result code %d", n.gateway, code)
+		}
+		return resp[:l], nil
+	}
+	return nil, fmt.Errorf("no NAT-PMP response from %s", n.gateway)
+}
+
+// "GetExternalAddress" - Returns the external ip of the gateway
+func (n natPMP) GetExternalAddress() (addr net.IP, err error) {
+	resp, err := n.request([]byte{0, 0}, 12)
+	if err != nil {
+		return nil, err
+	}
+	return net.IPv4(resp[8], resp[9], resp[10], resp[11]), nil
+}
+
+// "AddPortMapping" - Maps the external port of the gateway to the internal port for timeout seconds
+func (n natPMP) AddPortMapping(protocol string, externalPort, internalPort int, description string, timeout int) (mappedExternalPort int, err error) {
+	op, err := natPMPOp(protocol)
+	if err != nil {
+		return 0, err
+	}
+	msg := make([]byte, 12)
+	msg[1] = op
+	binary.BigEndian.PutUint16(msg[4:6], uint16(internalPort))
+	binary.BigEndian.PutUint16(msg[6:8], uint16(externalPort))
+	binary.BigEndian.PutUint32(msg[8:12], uint32(timeout))
+	resp, err := n.request(msg, 16)
+	if err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint16(resp[10:12])), nil
+}
+
+// "DeletePortMapping" - Removes the mapping of the internal port
+func (n natPMP) DeletePortMapping(protocol string, externalPort, internalPort int) (err error) {
+	op, err := natPMPOp(protocol)
+	if err != nil {
+		return err
+	}
+	msg := make([]byte, 12)
+	msg[1] = op
+	binary.BigEndian.PutUint16(msg[4:6], uint16(internalPort))
+	_, err = n.request(msg, 16)
+	return err
+}
+
+func natPMPOp(protocol string) (byte, error) {
+	switch protocol {
+	case "udp":
+		return 1, nil
+	case "tcp":
+		return 2, nil
+	}
+	return 0, fmt.Errorf("unsupported protocol %s", protocol)
+}
+
+// "ServiceURLSelfCheck" - Dials the service url advertised by this node and warns if it is not reachable
+func ServiceURLSelfCheck() {
+	if !GlobalConfig.PocketConfig.ServiceURLSelfCheck {
+		return
+	}
+	// give the rpc server time to start
+	time.Sleep(selfCheckDelay)
+	serviceURL, err := advertisedServiceURL()
+	if err != nil {
+		log2.Println(fmt.Sprintf("skipping the service url self check: %s", err.Error()))
+		return
+	}
+	for _, warning := range checkServiceURL(serviceURL, GlobalConfig.PocketConfig.ExternalAddress) {
+		log2.Println("WARNING: " + warning)
+	}
+}
+
+// "advertisedServiceURL" - Returns the service url of the staked node, or the external address when not staked
+func advertisedServiceURL() (string, error) {
+	pvKey, err := types.GetPVKeyFile()
+	if err != nil {
+		return "", err
+	}
+	node, er := PCA.QueryNode(sdk.Address(pvKey.Address).String(), 0)
+	if er == nil {
+		return node.ServiceURL, nil
+	}
+	if GlobalConfig.PocketConfig.ExternalAddress == "" {
+		return "", fmt.Errorf("the node is not staked and no external address is configured")
+	}
+	return "http://" + net.JoinHostPort(GlobalConfig.PocketConfig.ExternalAddress, GlobalConfig.PocketConfig.RPCPort), nil
+}
+
+// "checkServiceURL" - Returns the problems found dialing the service url
+func checkServiceURL(serviceURL, externalAddress string) (warnings []string) {
+	u, err := url.Parse(serviceURL)
+	if err != nil {
+		return []string{fmt.Sprintf("the service url %s is invalid: %s", serviceURL, err.Error())}
+	}
+	if externalAddress != "" && u.Hostname() != externalAddress {
+		warnings = append(warnings, fmt.Sprintf("the service url host %s doesn't match the external address %s", u.Hostname(), externalAddress))
+	}
+	client := http.Client{Timeout: selfCheckTimeout}
+	resp, err := client.Get(strings.TrimRight(serviceURL, "/") + "/v1")
+	if err != nil {
+		return append(warnings, fmt.Sprintf("the service url %s is not reachable: %s", serviceURL, err.Error()))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		warnings = append(warnings, fmt.Sprintf("the service url %s returned the status %s", serviceURL, resp.Status))
+	}
+	return warnings
+}
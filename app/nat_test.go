@@ -0,0 +1,105 @@
+package app
+
+import (
+	"encoding/binary"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExternalAddress(t *testing.T) {
+	c := DefaultConfig("")
+	c.TendermintConfig.P2P.ListenAddress = "tcp://0.0.0.0:26656"
+	// not set
+	assert.Nil(t, applyExternalAddress(&c))
+	assert.Empty(t, c.TendermintConfig.P2P.ExternalAddress)
+	c.PocketConfig.ExternalAddress = "1.2.3.4"
+	assert.Nil(t, applyExternalAddress(&c))
+	assert.Equal(t, "1.2.3.4:26656", c.TendermintConfig.P2P.ExternalAddress)
+	// the tendermint config trumps the override
+	c.PocketConfig.ExternalAddress = "node.example.com"
+	assert.Nil(t, applyExternalAddress(&c))
+	assert.Equal(t, "1.2.3.4:26656", c.TendermintConfig.P2P.ExternalAddress)
+	c.TendermintConfig.P2P.ExternalAddress = ""
+	c.TendermintConfig.P2P.ListenAddress = "bad"
+	assert.NotNil(t, applyExternalAddress(&c))
+}
+
+func TestNATDefaultGateway(t *testing.T) {
+	routes := `Iface	Destination	Gateway 	Flags	RefCnt	Use	Metric	Mask		MTU	Window	IRTT
+eth0	0000FEA9	00000000	0001	0	0	1002	0000FFFF	0	0	0
+eth0	00000000	0101A8C0	0003	0	0	0	00000000	0	0	0
+`
+	gw, err := defaultGateway(strings.NewReader(routes))
+	assert.Nil(t, err)
+	assert.Equal(t, "192.168.1.1", gw.String())
+	_, err = defaultGateway(strings.NewReader("Iface	Destination	Gateway\n"))
+	assert.NotNil(t, err)
+}
+
+func TestNATPMP(t *testing.T) {
+	// a fake NAT-PMP gateway
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	assert.Nil(t, err)
+	defer conn.Close()
+	go func() {
+		req := make([]byte, 12)
+		for {
+			l, addr, err := conn.ReadFromUDP(req)
+			if err != nil {
+				return
+			}
+			resp := make([]byte, 16)
+			resp[1] = req[1] + 128
+			switch {
+			case l == 2 && req[1] == 0:
+				copy(resp[8:12], net.IPv4(5, 6, 7, 8).To4())
+				resp = resp[:12]
+			case l == 12 && req[1] == 2:
+				copy(resp[8:12], req[4:8])
+				binary.BigEndian.PutUint32(resp[12:16], binary.BigEndian.Uint32(req[8:12]))
+			default:
+				binary.BigEndian.PutUint16(resp[2:4], 5) // unsupported opcode
+			}
+			_, _ = conn.WriteToUDP(resp, addr)
+		}
+	}()
+	nat := natPMP{gateway: conn.LocalAddr().(*net.UDPAddr)}
+	ip, err := nat.GetExternalAddress()
+	assert.Nil(t, err)
+	assert.Equal(t, "5.6.7.8", ip.String())
+	mapped, err := nat.AddPortMapping("tcp", 26656, 26656, natMappingDescription, 3600)
+	assert.Nil(t, err)
+	assert.Equal(t, 26656, mapped)
+	assert.Nil(t, nat.DeletePortMapping("tcp", 26656, 26656))
+	_, err = nat.AddPortMapping("udp", 26656, 26656, natMappingDescription, 3600)
+	assert.NotNil(t, err)
+	_, err = nat.AddPortMapping("sctp", 26656, 26656, natMappingDescription, 3600)
+	assert.NotNil(t, err)
+}
+
+func TestServiceURLSelfCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	assert.Empty(t, checkServiceURL(server.URL, ""))
+	assert.Empty(t, checkServiceURL(server.URL, "127.0.0.1"))
+	// advertised host doesn't match the external address
+	assert.Len(t, checkServiceURL(server.URL, "1.2.3.4"), 1)
+	// not reachable
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+	assert.Len(t, checkServiceURL(unreachable.URL, ""), 1)
+	// not a pocket node
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+	assert.Len(t, checkServiceURL(notFound.URL, ""), 1)
+	assert.Len(t, checkServiceURL("%gh&%ij", ""), 1)
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+)
+
+// the acl key of the param bounds, changed by the dao owner only
+var paramBoundsACLKey = govTypes.NewACLKey(pocketTypes.ModuleName, string(pocketTypes.KeyParamBounds))
+
+// "paramBoundsHandler" - Wraps the handler of the gov messages: the param changes out of the bounds of their param are
+// rejected whoever the sender, and the param bounds are changed by the dao owner only
+func (app *PocketCoreApp) paramBoundsHandler(h sdk.Handler) sdk.Handler {
+	return func(ctx sdk.Ctx, msg sdk.Msg) sdk.Result {
+		change, ok := msg.(govTypes.MsgChangeParam)
+		if !ok {
+			return h(ctx, msg)
+		}
+		if change.ParamKey == paramBoundsACLKey {
+			return app.changeParamBounds(ctx, change)
+		}
+		if err := app.pocketKeeper.ParamBounds(ctx).OrDefault().Check(change.ParamKey, change.ParamVal); err != nil {
+			return err.Result()
+		}
+		return h(ctx, msg)
+	}
+}
+
+// sets the param bounds of the change, as the gov handler sets a param
+func (app *PocketCoreApp) changeParamBounds(ctx sdk.Ctx, msg govTypes.MsgChangeParam) sdk.Result {
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	bounds, err := app.paramBoundsChange(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	app.pocketKeeper.SetParamBounds(ctx, bounds)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			govTypes.EventParamChange,
+			sdk.NewAttribute(sdk.AttributeKeyModule, govTypes.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, fmt.Sprintf("modified: %s to: %v", msg.ParamKey, bounds)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, govTypes.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// the param bounds of the change, sent by the dao owner
+func (app *PocketCoreApp) paramBoundsChange(ctx sdk.Ctx, msg govTypes.MsgChangeParam) (bounds pocketTypes.ParamBounds, err sdk.Error) {
+	if !app.govKeeper.GetDAOOwner(ctx).Equals(msg.FromAddress) {
+		return nil, govTypes.ErrUnauthorizedParamChange(govTypes.ModuleName, msg.FromAddress, msg.ParamKey)
+	}
+	if er := json.Unmarshal(msg.ParamVal, &bounds); er != nil {
+		return nil, pocketTypes.NewInvalidParamBoundsError(pocketTypes.ModuleName, er.Error())
+	}
+	if er := bounds.Validate(); er != nil {
+		return nil, pocketTypes.NewInvalidParamBoundsError(pocketTypes.ModuleName, er.Error())
+	}
+	return bounds, nil
+}
@@ -0,0 +1,162 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/gov/types"
+)
+
+// "ParamChange" - A proposed change of the param of the acl key to the (json) value, as in a MsgChangeParam
+type ParamChange struct {
+	Key   string          `json:"param_key"`
+	Value json.RawMessage `json:"param_value"`
+}
+
+// "ParamChangeResult" - Whether the change would be applied and the param before and after the change
+type ParamChangeResult struct {
+	Key    string            `json:"param_key"`
+	Error  string            `json:"error,omitempty"` // why the change would fail, nothing is applied if any fails
+	Before SingleParamReturn `json:"before"`
+	After  SingleParamReturn `json:"after"`
+}
+
+// "ParamChangeEffects" - The protocol values derived from the params (e.g. the blocks a servicer has to claim)
+type ParamChangeEffects struct {
+	SessionNodeCount       int64 `json:"session_node_count"`
+	BlocksPerSession       int64 `json:"blocks_per_session"`
+	ClaimSubmissionBlocks  int64 `json:"claim_submission_blocks"` // the blocks after a session to submit its claim
+	ClaimExpirationBlocks  int64 `json:"claim_expiration_blocks"` // the blocks after a claim to prove it
+	MaxValidators          int64 `json:"max_validators"`
+	NodeStakeMinimum       int64 `json:"node_stake_minimum"`
+	AppStakeMinimum        int64 `json:"app_stake_minimum"`
+	NodesBelowStakeMinimum int   `json:"nodes_below_stake_minimum"` // the staked nodes under the node stake minimum
+	AppsBelowStakeMinimum  int   `json:"apps_below_stake_minimum"`  // the staked apps under the app stake minimum
+}
+
+// "ParamChangeSimulation" - The results of the proposed changes and their effects on the protocol
+type ParamChangeSimulation struct {
+	Height  int64               `json:"height"`
+	Applied bool                `json:"applied"`
+	Changes []ParamChangeResult `json:"changes"`
+	Before  ParamChangeEffects  `json:"before"`
+	After   ParamChangeEffects  `json:"after"`
+}
+
+// "SimulateParamChanges" - Applies the proposed changes, sent by the address (the owner of each param in the ACL, or the
+// dao owner for the param bounds, if empty), to a branch of the state at height that is never written, as the handler of
+// MsgChangeParam does. Reports the changes that would fail and the effects of the changes on the protocol
+func (app PocketCoreApp) SimulateParamChanges(height int64, changes []ParamChange, addr string) (res ParamChangeSimulation, err error) {
+	if len(changes) == 0 {
+		return res, fmt.Errorf("no param changes to simulate")
+	}
+	var sender sdk.Address
+	if addr != "" {
+		if sender, err = pocketTypes.ParseAddress(addr); err != nil {
+			return
+		}
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	if height == 0 {
+		height = app.LastBlockHeight() // latest
+	}
+	res = ParamChangeSimulation{Height: height, Applied: true, Changes: make([]ParamChangeResult, 0, len(changes))}
+	res.Before = app.paramChangeEffects(ctx)
+	before := app.govKeeper.GetAllParamNameValue(ctx)
+	// branch the state, the cache is never written
+	branch := ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+	for _, change := range changes {
+		result := ParamChangeResult{Key: change.Key, Before: newSingleParamReturn(change.Key, before[change.Key])}
+		owner := sender
+		switch {
+		case owner != nil:
+		case change.Key == paramBoundsACLKey:
+			owner = app.govKeeper.GetDAOOwner(branch)
+		default:
+			owner = app.govKeeper.GetACL(branch).GetOwner(change.Key)
+		}
+		if er := app.simulateParamChange(branch, types.MsgChangeParam{FromAddress: owner, ParamKey: change.Key, ParamVal: change.Value}); er != nil {
+			result.Error = er.Error()
+			res.Applied = false
+		}
+		res.Changes = append(res.Changes, result)
+	}
+	after := app.govKeeper.GetAllParamNameValue(branch)
+	for i, result := range res.Changes {
+		res.Changes[i].After = newSingleParamReturn(result.Key, after[result.Key])
+	}
+	res.After = app.paramChangeEffects(branch)
+	return
+}
+
+// applies the param change as the handler of MsgChangeParam does (without writing the subspaces of the keeper, shared
+// with the block execution), setting a value not of the param type panics
+func (app PocketCoreApp) simulateParamChange(ctx sdk.Ctx, msg types.MsgChangeParam) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("the change fails: %v", r)
+		}
+	}()
+	if er := msg.ValidateBasic(); er != nil {
+		return er
+	}
+	// as the wrapped handler, the param bounds are set by the dao owner
+	if msg.ParamKey == paramBoundsACLKey {
+		bounds, er := app.paramBoundsChange(ctx, msg)
+		if er != nil {
+			return er
+		}
+		app.pocketKeeper.SetParamBounds(ctx, bounds)
+		return nil
+	}
+	subspaceName, paramKey := types.SplitACLKey(msg.ParamKey)
+	space, found := app.govKeeper.GetSubspace(subspaceName)
+	if _, exists := app.govKeeper.GetAllParamNameValue(ctx)[msg.ParamKey]; !found || !exists {
+		return fmt.Errorf("the param %s doesn't exist", msg.ParamKey)
+	}
+	var value interface{}
+	if er := json.Unmarshal(msg.ParamVal, &value); er != nil {
+		return fmt.Errorf("the value is not json, the change would be ignored: %s", er.Error())
+	}
+	// as the handler, the json numbers are int64
+	if toFloat, ok := value.(float64); ok {
+		value = int64(toFloat)
+	}
+	if er := app.pocketKeeper.ParamBounds(ctx).OrDefault().Check(msg.ParamKey, msg.ParamVal); er != nil {
+		return er
+	}
+	if er := app.govKeeper.VerifyACL(ctx, msg.ParamKey, msg.FromAddress); er != nil {
+		return er
+	}
+	space.Set(ctx, []byte(paramKey), value)
+	return nil
+}
+
+// the protocol values derived from the params at ctx
+func (app PocketCoreApp) paramChangeEffects(ctx sdk.Ctx) (effects ParamChangeEffects) {
+	effects = ParamChangeEffects{
+		SessionNodeCount: app.pocketKeeper.SessionNodeCount(ctx),
+		BlocksPerSession: app.nodesKeeper.BlocksPerSession(ctx),
+		MaxValidators:    app.nodesKeeper.MaxValidators(ctx),
+		NodeStakeMinimum: app.nodesKeeper.MinimumStake(ctx),
+		AppStakeMinimum:  app.appsKeeper.MinimumStake(ctx),
+	}
+	effects.ClaimSubmissionBlocks = app.pocketKeeper.ClaimSubmissionWindow(ctx) * effects.BlocksPerSession
+	effects.ClaimExpirationBlocks = app.pocketKeeper.ClaimExpiration(ctx) * effects.BlocksPerSession
+	for _, validator := range app.nodesKeeper.GetStakedValidators(ctx) {
+		if validator.GetTokens().LT(sdk.NewInt(effects.NodeStakeMinimum)) {
+			effects.NodesBelowStakeMinimum++
+		}
+	}
+	for _, application := range app.appsKeeper.GetAllApplications(ctx) {
+		if application.IsStaked() && application.GetTokens().LT(sdk.NewInt(effects.AppStakeMinimum)) {
+			effects.AppsBelowStakeMinimum++
+		}
+	}
+	return
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
@@ -78,12 +79,53 @@ func (app *PocketCoreApp) InitChainerWithGenesis(ctx sdk.Ctx, req abci.RequestIn
 
 // setups all of the begin blockers for each module
 func (app *PocketCoreApp) BeginBlocker(ctx sdk.Ctx, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	return app.mm.BeginBlock(ctx, req)
+	// never process the blocks of an upgrade with an outdated version
+	app.haltAtUpgradeHeight(ctx)
+	// migrate the stores before any module touches them at the upgrade height
+	app.runUpgradeMigrations(ctx)
+	res := app.mm.BeginBlock(ctx, req)
+	publishEvents(ctx.BlockHeight(), res.Events)
+	return res
 }
 
 // setups all of the end blockers for each module
 func (app *PocketCoreApp) EndBlocker(ctx sdk.Ctx, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+	publishEvents(ctx.BlockHeight(), res.Events)
+	return res
+}
+
+// delivers the transaction and notifies the subscribers of its events
+func (app *PocketCoreApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+	if res.IsOK() {
+		publishEvents(app.LastBlockHeight()+1, res.Events)
+	}
+	return res
+}
+
+// the subscription events of the (abci) events emitted by the modules
+var subscriptionEvents = map[string]string{
+	pocketTypes.EventTypeClaim:    pocketTypes.SubscriptionEventClaimSubmitted,
+	pocketTypes.EventTypeProof:    pocketTypes.SubscriptionEventProofVerified,
+	nodesTypes.EventTypeJail:      pocketTypes.SubscriptionEventValidatorJailed,
+	nodesTypes.EventTypeSlash:     pocketTypes.SubscriptionEventValidatorSlashed,
+	nodesTypes.EventTypeTombstone: pocketTypes.SubscriptionEventValidatorTombstoned,
+}
+
+// publishes the events of the block to the local subscribers
+func publishEvents(height int64, events []abci.Event) {
+	for _, event := range events {
+		eventType, ok := subscriptionEvents[event.Type]
+		if !ok {
+			continue
+		}
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+		pocketTypes.PublishEvent(pocketTypes.SubscriptionEvent{Type: eventType, Height: height, Attributes: attributes})
+	}
 }
 
 // loads the hight from the store
@@ -104,7 +146,16 @@ func (app *PocketCoreApp) ModuleAccountAddrs() map[string]bool {
 // exports the app state to json
 func (app *PocketCoreApp) ExportAppState(forZeroHeight bool, jailWhiteList []string) (appState json.RawMessage, err error) {
 	// as if they could withdraw from the start of the next block
-	ctx, err := app.NewContext(app.LastBlockHeight())
+	return app.ExportAppStateAtHeight(app.LastBlockHeight())
+}
+
+// "ExportAppStateAtHeight" - Exports the genesis state of every module (validators, apps, params, receipts, dao...)
+// committed at the height
+func (app *PocketCoreApp) ExportAppStateAtHeight(height int64) (appState json.RawMessage, err error) {
+	if height < 1 || height > app.LastBlockHeight() {
+		return nil, fmt.Errorf("invalid height %d, the latest height is %d", height, app.LastBlockHeight())
+	}
+	ctx, err := app.NewContext(height)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +171,12 @@ func (app *PocketCoreApp) NewContext(height int64) (sdk.Ctx, error) {
 	store := app.Store()
 	blockStore := app.BlockStore()
 	ctx := sdk.NewContext(store, abci.Header{}, false, app.Logger()).WithBlockStore(blockStore)
-	return ctx.PrevCtx(height)
+	prevCtx, err := ctx.PrevCtx(height)
+	// hint the clients to the archive nodes when the (past) height is out of the history kept by this node
+	if err != nil && !IsArchive() && height < app.LastBlockHeight() {
+		return prevCtx, NewPrunedStateError(height, err)
+	}
+	return prevCtx, err
 }
 
 func (app *PocketCoreApp) GetClient() client.Client {
@@ -0,0 +1,271 @@
+package app
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/privval"
+)
+
+const (
+	// the key and sign state files of tendermint >= 0.28 (pocket and the other tendermint chains)
+	PrivValFormatTendermint = "tendermint"
+	// the single priv_validator.json file (key and sign state) of tendermint < 0.28
+	PrivValFormatLegacy = "legacy"
+	// the hex ed25519 private key of the keybase (accounts export-raw / import-raw)
+	PrivValFormatRaw = "raw"
+)
+
+// "PrivValReport" - The outcome of the validation or the migration of priv validator files
+type PrivValReport struct {
+	Format     string   `json:"format"` // the format of the source key file
+	Address    string   `json:"address"`
+	PublicKey  string   `json:"public_key"`
+	LastHeight int64    `json:"last_height"` // the last signed height of the sign state
+	LastRound  int      `json:"last_round"`
+	LastStep   int8     `json:"last_step"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
+// "String" - Returns the indented json of the report
+func (r PrivValReport) String() string {
+	bz, _ := json.MarshalIndent(r, "", "  ")
+	return string(bz)
+}
+
+// "PrivValMigration" - The source and the destination of a priv validator migration
+type PrivValMigration struct {
+	Source      string // the source key file, in any format
+	SourceState string // the source sign state file (tendermint format only, the legacy file has its own)
+	KeyPath     string // the migrated key file
+	StatePath   string // the migrated sign state file
+	ResetState  bool   // start from an empty sign state when the source has none (a new chain or a key that never signed)
+	DryRun      bool   // validate and report the migration without writing anything
+}
+
+// "ValidatePrivValFiles" - Validates the priv validator key file (in any format) and the sign state file (if any)
+func ValidatePrivValFiles(keyPath, statePath string) (PrivValReport, error) {
+	key, state, format, err := loadPrivVal(keyPath)
+	if err != nil {
+		return PrivValReport{}, err
+	}
+	if statePath != "" {
+		if state, err = loadPrivValState(statePath); err != nil {
+			return PrivValReport{}, err
+		}
+	}
+	return newPrivValReport(key, state, format)
+}
+
+// "MigratePrivVal" - Migrates the priv validator key (and sign state) of the source, in any format, to the pocket key
+// and sign state files, refusing any migration that would roll back the sign state of the destination (double sign)
+func MigratePrivVal(m PrivValMigration) (PrivValReport, error) {
+	key, state, format, err := loadPrivVal(m.Source)
+	if err != nil {
+		return PrivValReport{}, err
+	}
+	if m.SourceState != "" {
+		if format != PrivValFormatTendermint {
+			return PrivValReport{}, fmt.Errorf("a source sign state only applies to a %s key file, the source is %s", PrivValFormatTendermint, format)
+		}
+		if state, err = loadPrivValState(m.SourceState); err != nil {
+			return PrivValReport{}, err
+		}
+	}
+	if state == nil {
+		if !m.ResetState {
+			return PrivValReport{}, fmt.Errorf("the sign state of the %s source is unknown, pass its sign state file or reset it (only for a new chain or a key that never signed)", format)
+		}
+		state = &privval.FilePVLastSignState{}
+	}
+	report, err := newPrivValReport(key, state, format)
+	if err != nil {
+		return PrivValReport{}, err
+	}
+	// never replace another key nor roll back the sign state of the destination
+	if _, err := os.Stat(m.KeyPath); err == nil {
+		existing, _, _, err := loadPrivVal(m.KeyPath)
+		if err != nil {
+			return report, fmt.Errorf("the destination key file %s exists and is invalid: %s", m.KeyPath, err.Error())
+		}
+		if !existing.PrivKey.Equals(key.PrivKey) {
+			return report, fmt.Errorf("the destination key file %s holds another key (%s)", m.KeyPath, existing.Address.String())
+		}
+	}
+	if _, err := os.Stat(m.StatePath); err == nil {
+		existing, err := loadPrivValState(m.StatePath)
+		if err != nil {
+			return report, fmt.Errorf("the destination sign state file %s exists and is invalid: %s", m.StatePath, err.Error())
+		}
+		if signStateBefore(state, existing) {
+			return report, fmt.Errorf("the destination sign state (height %d, round %d, step %d) is ahead of the source (height %d, round %d, step %d), migrating would allow double signing",
+				existing.Height, existing.Round, existing.Step, state.Height, state.Round, state.Step)
+		}
+	}
+	if m.DryRun {
+		return report, nil
+	}
+	keyBz, err := Codec().MarshalJSONIndent(key, "", "  ")
+	if err != nil {
+		return report, err
+	}
+	stateBz, err := Codec().MarshalJSONIndent(state, "", "  ")
+	if err != nil {
+		return report, err
+	}
+	// the state is written first: a key without its state must never be usable
+	if err := writeFileAtomic(m.StatePath, stateBz); err != nil {
+		return report, err
+	}
+	if err := writeFileAtomic(m.KeyPath, keyBz); err != nil {
+		return report, err
+	}
+	// read back what was written
+	if _, err := ValidatePrivValFiles(m.KeyPath, m.StatePath); err != nil {
+		return report, fmt.Errorf("the migrated files are invalid: %s", err.Error())
+	}
+	return report, nil
+}
+
+// "ExportPrivValRaw" - Returns the hex private key of the priv validator key file (in any format), to import it into
+// the keybase
+func ExportPrivValRaw(keyPath string) (string, error) {
+	key, _, _, err := loadPrivVal(keyPath)
+	if err != nil {
+		return "", err
+	}
+	pk := key.PrivKey.(ed25519.PrivKeyEd25519)
+	return hex.EncodeToString(pk[:]), nil
+}
+
+// loads the key file in any format, with the sign state of the legacy format (nil otherwise)
+func loadPrivVal(path string) (key privval.FilePVKey, state *privval.FilePVLastSignState, format string, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(bz, &fields) != nil {
+		// not json: a raw hex private key
+		format = PrivValFormatRaw
+		raw, er := hex.DecodeString(strings.TrimSpace(string(bz)))
+		if er != nil || len(raw) != len(ed25519.PrivKeyEd25519{}) {
+			return key, nil, format, fmt.Errorf("%s is neither a json priv validator file nor a hex ed25519 private key", path)
+		}
+		var pk ed25519.PrivKeyEd25519
+		copy(pk[:], raw)
+		key = privval.FilePVKey{Address: pk.PubKey().Address(), PubKey: pk.PubKey(), PrivKey: pk}
+		return key, nil, format, nil
+	}
+	if _, legacy := fields["last_height"]; legacy {
+		format = PrivValFormatLegacy
+		var old privval.OldFilePV
+		if err = Codec().UnmarshalJSON(bz, &old); err != nil {
+			return key, nil, format, fmt.Errorf("invalid %s priv validator file %s: %s", format, path, err.Error())
+		}
+		key = privval.FilePVKey{Address: old.Address, PubKey: old.PubKey, PrivKey: old.PrivKey}
+		state = &privval.FilePVLastSignState{Height: old.LastHeight, Round: old.LastRound, Step: old.LastStep, Signature: old.LastSignature, SignBytes: old.LastSignBytes}
+	} else {
+		format = PrivValFormatTendermint
+		if err = Codec().UnmarshalJSON(bz, &key); err != nil {
+			return key, nil, format, fmt.Errorf("invalid %s priv validator key file %s: %s", format, path, err.Error())
+		}
+	}
+	if err = validatePrivValKey(key); err != nil {
+		return key, nil, format, fmt.Errorf("invalid %s priv validator key file %s: %s", format, path, err.Error())
+	}
+	return key, state, format, nil
+}
+
+func loadPrivValState(path string) (*privval.FilePVLastSignState, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var state privval.FilePVLastSignState
+	if err := Codec().UnmarshalJSON(bz, &state); err != nil {
+		return nil, fmt.Errorf("invalid priv validator state file %s: %s", path, err.Error())
+	}
+	if err := validatePrivValState(state); err != nil {
+		return nil, fmt.Errorf("invalid priv validator state file %s: %s", path, err.Error())
+	}
+	return &state, nil
+}
+
+// the validator key must be an ed25519 key matching its public key and address
+func validatePrivValKey(key privval.FilePVKey) error {
+	if key.PrivKey == nil {
+		return fmt.Errorf("missing private key")
+	}
+	if _, ok := key.PrivKey.(ed25519.PrivKeyEd25519); !ok {
+		return fmt.Errorf("the private key is a %T, expected an ed25519 key", key.PrivKey)
+	}
+	if key.PubKey == nil || !key.PubKey.Equals(key.PrivKey.PubKey()) {
+		return fmt.Errorf("the public key doesn't match the private key")
+	}
+	if !bytes.Equal(key.Address, key.PubKey.Address()) {
+		return fmt.Errorf("the address %s doesn't match the public key (%s)", key.Address.String(), key.PubKey.Address().String())
+	}
+	return nil
+}
+
+func validatePrivValState(state privval.FilePVLastSignState) error {
+	if state.Height < 0 || state.Round < 0 {
+		return fmt.Errorf("negative height %d or round %d", state.Height, state.Round)
+	}
+	// none, propose, prevote or precommit
+	if state.Step < 0 || state.Step > 3 {
+		return fmt.Errorf("invalid step %d", state.Step)
+	}
+	if (len(state.Signature) == 0) != (len(state.SignBytes) == 0) {
+		return fmt.Errorf("the last signature and the last sign bytes must be both set or both empty")
+	}
+	if len(state.SignBytes) != 0 && state.Height == 0 {
+		return fmt.Errorf("the last sign bytes are set without a signed height")
+	}
+	return nil
+}
+
+// returns whether the sign state a is before b
+func signStateBefore(a, b *privval.FilePVLastSignState) bool {
+	if a.Height != b.Height {
+		return a.Height < b.Height
+	}
+	if a.Round != b.Round {
+		return a.Round < b.Round
+	}
+	return a.Step < b.Step
+}
+
+func newPrivValReport(key privval.FilePVKey, state *privval.FilePVLastSignState, format string) (PrivValReport, error) {
+	r := PrivValReport{Format: format, Address: strings.ToLower(key.Address.String())}
+	pk := key.PubKey.(ed25519.PubKeyEd25519)
+	r.PublicKey = hex.EncodeToString(pk[:])
+	if state == nil {
+		r.Warnings = append(r.Warnings, "no sign state: an empty sign state allows double signing the heights this key already signed")
+		return r, nil
+	}
+	if err := validatePrivValState(*state); err != nil {
+		return r, err
+	}
+	r.LastHeight, r.LastRound, r.LastStep = state.Height, state.Round, state.Step
+	if state.Height == 0 {
+		r.Warnings = append(r.Warnings, "the sign state is empty: only use it for a new chain or a key that never signed")
+	}
+	return r, nil
+}
+
+// replaces the file with the data, so the file is never half written
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
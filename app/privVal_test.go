@@ -0,0 +1,64 @@
+package app
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/privval"
+)
+
+func TestMigratePrivVal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "privval")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	pk := ed25519.GenPrivKey()
+	raw := dir + FS + "raw.txt"
+	assert.Nil(t, ioutil.WriteFile(raw, []byte(hex.EncodeToString(pk[:])+"\n"), 0600))
+	keyPath, statePath := dir+FS+"priv_val_key.json", dir+FS+"priv_val_state.json"
+	// the sign state of a raw key is unknown
+	_, err = MigratePrivVal(PrivValMigration{Source: raw, KeyPath: keyPath, StatePath: statePath})
+	assert.NotNil(t, err)
+	// a dry run writes nothing
+	report, err := MigratePrivVal(PrivValMigration{Source: raw, KeyPath: keyPath, StatePath: statePath, ResetState: true, DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, PrivValFormatRaw, report.Format)
+	assert.NotEmpty(t, report.Warnings)
+	_, err = os.Stat(keyPath)
+	assert.True(t, os.IsNotExist(err))
+	report, err = MigratePrivVal(PrivValMigration{Source: raw, KeyPath: keyPath, StatePath: statePath, ResetState: true})
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(pk.PubKey().Address()), report.Address)
+	report, err = ValidatePrivValFiles(keyPath, statePath)
+	assert.Nil(t, err)
+	assert.Equal(t, PrivValFormatTendermint, report.Format)
+	exported, err := ExportPrivValRaw(keyPath)
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(pk[:]), exported)
+	// a legacy file carries its sign state
+	legacy := dir + FS + "priv_validator.json"
+	bz, err := Codec().MarshalJSON(privval.OldFilePV{Address: pk.PubKey().Address(), PubKey: pk.PubKey(), PrivKey: pk, LastHeight: 10, LastRound: 1, LastStep: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(legacy, bz, 0600))
+	report, err = MigratePrivVal(PrivValMigration{Source: legacy, KeyPath: keyPath, StatePath: statePath})
+	assert.Nil(t, err)
+	assert.Equal(t, PrivValFormatLegacy, report.Format)
+	assert.Equal(t, int64(10), report.LastHeight)
+	// the sign state of the destination is never rolled back
+	_, err = MigratePrivVal(PrivValMigration{Source: raw, KeyPath: keyPath, StatePath: statePath, ResetState: true})
+	assert.NotNil(t, err)
+	// nor another key replaced
+	other := ed25519.GenPrivKey()
+	assert.Nil(t, ioutil.WriteFile(raw, []byte(hex.EncodeToString(other[:])), 0600))
+	_, err = MigratePrivVal(PrivValMigration{Source: raw, KeyPath: keyPath, StatePath: dir + FS + "other_state.json", ResetState: true})
+	assert.NotNil(t, err)
+	// a key not matching its address is invalid
+	bz, err = Codec().MarshalJSON(privval.FilePVKey{Address: other.PubKey().Address(), PubKey: pk.PubKey(), PrivKey: pk})
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(keyPath, bz, 0600))
+	_, err = ValidatePrivValFiles(keyPath, "")
+	assert.NotNil(t, err)
+}
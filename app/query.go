@@ -7,21 +7,34 @@ import (
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	bam "github.com/pokt-network/posmint/baseapp"
 	"github.com/pokt-network/posmint/codec"
 	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
 	"github.com/pokt-network/posmint/x/auth/exported"
 	"github.com/pokt-network/posmint/x/auth/util"
 	"github.com/pokt-network/posmint/x/gov/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/rpc/client"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 	"math"
+	"net/url"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 )
 
 const (
 	messageSenderQuery     = "message.sender='%s'"
 	transferRecipientQuery = "transfer.recipient='%s'"
 	txHeightQuery          = "tx.height=%d"
+	txHeightRangeQuery     = "tx.height>=%d AND tx.height<=%d"
+	moduleEventQuery       = "%s.module='%s'"
+	TxOrderDesc            = "desc" // newest transactions first
+	TxOrderAsc             = "asc"  // oldest transactions first
+	maxTxSearchPerPage     = 100    // the page size limit of the tendermint tx search
 )
 
 // zero for height = latest
@@ -46,40 +59,194 @@ func (app PocketCoreApp) QueryTx(hash string, prove bool) (res *core_types.Resul
 	return
 }
 
+// "DecodedTx" - A committed transaction decoded, with its result and the fee charged to its signer
+type DecodedTx struct {
+	Hash         string       `json:"hash"`
+	Height       int64        `json:"height"`
+	Index        uint32       `json:"index"`
+	Tx           auth.StdTx   `json:"tx"`
+	Result       *RawTxResult `json:"result"`
+	Fee          sdk.Int      `json:"fee"`           // the fee paid with the tx
+	Refunded     sdk.Int      `json:"refunded"`      // the fee paid above the fee of the message, refunded (pos/FeeRefund)
+	EffectiveFee sdk.Int      `json:"effective_fee"` // the fee charged to the signer
+}
+
+// "decodeStdTx" - Decodes the transaction of the tx search result
+func decodeStdTx(resTx *core_types.ResultTx) (auth.StdTx, error) {
+	tx, err := auth.DefaultTxDecoder(cdc)(resTx.Tx)
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+	stdTx, ok := tx.(auth.StdTx)
+	if !ok {
+		return auth.StdTx{}, fmt.Errorf("unexpected tx type %T", tx)
+	}
+	return stdTx, nil
+}
+
+// "QueryDecodedTx" - Returns the decoded transaction of the hash along with its result and its effective fee
+func (app PocketCoreApp) QueryDecodedTx(hash string) (res DecodedTx, err error) {
+	resTx, err := app.QueryTx(hash, false)
+	if err != nil {
+		return
+	}
+	stdTx, err := decodeStdTx(resTx)
+	if err != nil {
+		return
+	}
+	r := resTx.TxResult
+	res = DecodedTx{
+		Hash:     resTx.Hash.String(),
+		Height:   resTx.Height,
+		Index:    resTx.Index,
+		Tx:       stdTx,
+		Result:   newRawTxResult(r.Code, r.Codespace, r.Log, r.Events),
+		Fee:      stdTx.Fee.AmountOf(sdk.DefaultStakeDenom),
+		Refunded: sdk.ZeroInt(),
+	}
+	for _, event := range res.Result.Events {
+		if event.Type != nodesTypes.EventTypeFeeRefund {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key != nodesTypes.AttributeKeyRefunded {
+				continue
+			}
+			refunded, ok := sdk.NewIntFromString(attribute.Value)
+			if !ok {
+				return res, fmt.Errorf("invalid refunded fee %s", attribute.Value)
+			}
+			res.Refunded = res.Refunded.Add(refunded)
+		}
+	}
+	res.EffectiveFee = res.Fee.Sub(res.Refunded)
+	return
+}
+
 func (app PocketCoreApp) QueryAccountTxs(addr string, page, perPage int, prove bool) (res *core_types.ResultTxSearch, err error) {
 	tmClient := app.GetClient()
 	defer func() { _ = tmClient.Stop() }()
-	_, err = hex.DecodeString(addr)
+	addr, err = hexAddress(addr)
 	if err != nil {
 		return nil, err
 	}
 	query := fmt.Sprintf(messageSenderQuery, addr)
-	page, perPage = checkPagination(page, perPage)
+	page, perPage = checkPagination(PaginationQueryTxs, page, perPage)
 	res, err = tmClient.TxSearch(query, prove, page, perPage)
 	return
 }
 func (app PocketCoreApp) QueryRecipientTxs(addr string, page, perPage int, prove bool) (res *core_types.ResultTxSearch, err error) {
 	tmClient := app.GetClient()
 	defer func() { _ = tmClient.Stop() }()
-	_, err = hex.DecodeString(addr)
+	addr, err = hexAddress(addr)
 	if err != nil {
 		return nil, err
 	}
 	query := fmt.Sprintf(transferRecipientQuery, addr)
-	page, perPage = checkPagination(page, perPage)
+	page, perPage = checkPagination(PaginationQueryTxs, page, perPage)
 	res, err = tmClient.TxSearch(query, prove, page, perPage)
 	return
 }
 
+// "QueryAllAccountTxs" - Returns the transactions sent and received by the address, merged and sorted by height
+// in the order (desc by default), paginated by page and perPage
+func (app PocketCoreApp) QueryAllAccountTxs(addr string, page, perPage int, prove bool, order string) (res *core_types.ResultTxSearch, err error) {
+	addr, err = hexAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	if order, err = checkTxOrder(order); err != nil {
+		return nil, err
+	}
+	tmClient := app.GetClient()
+	defer func() { _ = tmClient.Stop() }()
+	sent, err := searchAllTxs(tmClient, fmt.Sprintf(messageSenderQuery, addr), prove)
+	if err != nil {
+		return nil, err
+	}
+	received, err := searchAllTxs(tmClient, fmt.Sprintf(transferRecipientQuery, addr), prove)
+	if err != nil {
+		return nil, err
+	}
+	// merge, a transaction sent to itself shows up in both searches
+	txs := sent
+	seen := make(map[string]struct{}, len(sent))
+	for _, tx := range sent {
+		seen[tx.Hash.String()] = struct{}{}
+	}
+	for _, tx := range received {
+		if _, found := seen[tx.Hash.String()]; !found {
+			txs = append(txs, tx)
+		}
+	}
+	sortTxs(txs, order)
+	page, perPage = checkPagination(PaginationQueryTxs, page, perPage)
+	res = &core_types.ResultTxSearch{Txs: []*core_types.ResultTx{}, TotalCount: len(txs)}
+	start, end := util.Paginate(len(txs), page, perPage, perPage)
+	if start >= 0 && end >= 0 {
+		res.Txs = txs[start:end]
+	}
+	return res, nil
+}
+
+// "checkTxOrder" - Returns the order of the transactions, desc by default
+func checkTxOrder(order string) (string, error) {
+	switch order {
+	case "":
+		return TxOrderDesc, nil
+	case TxOrderDesc, TxOrderAsc:
+		return order, nil
+	default:
+		return "", fmt.Errorf("invalid order %s, expected %s or %s", order, TxOrderDesc, TxOrderAsc)
+	}
+}
+
+// "sortTxs" - Sorts the transactions by height and by index in the block in the order
+func sortTxs(txs []*core_types.ResultTx, order string) {
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].Height != txs[j].Height {
+			return (txs[i].Height < txs[j].Height) == (order == TxOrderAsc)
+		}
+		return (txs[i].Index < txs[j].Index) == (order == TxOrderAsc)
+	})
+}
+
+// "searchAllTxs" - Returns every transaction matching the query, going through all of the tx search pages
+func searchAllTxs(tmClient client.Client, query string, prove bool) (txs []*core_types.ResultTx, err error) {
+	for page := 1; ; page++ {
+		res, err := tmClient.TxSearch(query, prove, page, maxTxSearchPerPage)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, res.Txs...)
+		if len(res.Txs) == 0 || len(txs) >= res.TotalCount {
+			return txs, nil
+		}
+	}
+}
+
 func (app PocketCoreApp) QueryBlockTxs(height int64, page, perPage int, prove bool) (res *core_types.ResultTxSearch, err error) {
 	tmClient := app.GetClient()
 	defer func() { _ = tmClient.Stop() }()
 	query := fmt.Sprintf(txHeightQuery, height)
-	page, perPage = checkPagination(page, perPage)
+	page, perPage = checkPagination(PaginationQueryTxs, page, perPage)
 	res, err = tmClient.TxSearch(query, prove, page, perPage)
 	return
 }
 
+// "QueryTxsByHeightRange" - Returns the transactions from height to height (inclusive), paginated by page and perPage
+func (app PocketCoreApp) QueryTxsByHeightRange(from, to int64, page, perPage int) (res *core_types.ResultTxSearch, err error) {
+	if from < 1 || to < from {
+		return nil, fmt.Errorf("invalid height range %d to %d", from, to)
+	}
+	tmClient := app.GetClient()
+	defer func() { _ = tmClient.Stop() }()
+	query := fmt.Sprintf(txHeightRangeQuery, from, to)
+	page, perPage = checkPagination(PaginationQueryTxs, page, perPage)
+	res, err = tmClient.TxSearch(query, false, page, perPage)
+	return
+}
+
 func (app PocketCoreApp) QueryHeight() (res int64, err error) {
 	tmClient := app.GetClient()
 	defer func() { _ = tmClient.Stop() }()
@@ -92,10 +259,140 @@ func (app PocketCoreApp) QueryHeight() (res int64, err error) {
 	return height, nil
 }
 
-func (app PocketCoreApp) QueryNodeStatus() (res *core_types.ResultStatus, err error) {
+// NodeStatus is the tendermint status of the node along with the role it serves
+type NodeStatus struct {
+	Status  *core_types.ResultStatus `json:"status"`
+	Archive bool                     `json:"archive"` // the full state history is kept (never pruned)
+	Replica bool                     `json:"replica"` // a read only replica: no relays nor transactions
+}
+
+func (app PocketCoreApp) QueryNodeStatus() (res NodeStatus, err error) {
 	tmClient := app.GetClient()
 	defer func() { _ = tmClient.Stop() }()
-	return tmClient.Status()
+	status, err := tmClient.Status()
+	if err != nil {
+		return
+	}
+	return NodeStatus{Status: status, Archive: IsArchive(), Replica: GlobalConfig.PocketConfig.Replica}, nil
+}
+
+const (
+	NodeRoleReplica  = "replica"   // a read only replica
+	NodeRoleServicer = "servicer"  // the key of the node is staked
+	NodeRoleFullNode = "full_node" // the key of the node is not staked
+	redactedValue    = "REDACTED"
+)
+
+// NodeConfig is the effective runtime configuration of the node, with the secrets redacted
+type NodeConfig struct {
+	Version      string                         `json:"version"`
+	Role         string                         `json:"role"`          // replica, servicer or full_node
+	Listeners    map[string]string              `json:"listeners"`     // the listen addresses: rpc, tendermint_rpc and p2p
+	Pruning      PruningInfo                    `json:"pruning"`       // the effective pruning strategy
+	HostedChains []pocketTypes.HostedBlockchain `json:"hosted_chains"` // the credentials are never returned
+	Config       Config                         `json:"config"`
+}
+
+// "QueryNodeConfig" - Returns the effective runtime configuration of the node, the secrets, the passwords and the
+// query parameters of the urls (api keys) are redacted
+func (app PocketCoreApp) QueryNodeConfig() (res NodeConfig, err error) {
+	res = NodeConfig{Version: AppVersion, Role: NodeRoleFullNode, Config: GlobalConfig}
+	if GlobalConfig.PocketConfig.Replica {
+		res.Role = NodeRoleReplica
+	} else if ctx, err := app.NewContext(0); err == nil {
+		if self, err := app.pocketKeeper.GetSelfNode(ctx); err == nil && self.IsStaked() {
+			res.Role = NodeRoleServicer
+		}
+	}
+	res.Listeners = map[string]string{"rpc": ":" + GlobalConfig.PocketConfig.RPCPort}
+	if GlobalConfig.TendermintConfig.RPC != nil {
+		res.Listeners["tendermint_rpc"] = GlobalConfig.TendermintConfig.RPC.ListenAddress
+	}
+	if GlobalConfig.TendermintConfig.P2P != nil {
+		res.Listeners["p2p"] = GlobalConfig.TendermintConfig.P2P.ListenAddress
+	}
+	res.Pruning, err = app.QueryPruningInfo()
+	if err != nil {
+		return
+	}
+	res.HostedChains = make([]pocketTypes.HostedBlockchain, 0)
+	if hostedChains := app.pocketKeeper.GetHostedBlockchains(); hostedChains != nil {
+		for _, chain := range hostedChains.M {
+			chain.URL = redactURL(chain.URL)
+			if chain.BasicAuth.Password != "" {
+				chain.BasicAuth.Password = redactedValue
+			}
+			res.HostedChains = append(res.HostedChains, chain)
+		}
+	}
+	sort.Slice(res.HostedChains, func(i, j int) bool { return res.HostedChains[i].ID < res.HostedChains[j].ID })
+	pc := &res.Config.PocketConfig
+	if pc.EvidenceReplicationKey != "" {
+		pc.EvidenceReplicationKey = redactedValue
+	}
+	pc.RemoteCLIURL = redactURL(pc.RemoteCLIURL)
+	pc.TendermintURI = redactURL(pc.TendermintURI)
+	pc.StreamURL = redactURL(pc.StreamURL)
+	pc.EvidenceReplicas = make([]string, len(GlobalConfig.PocketConfig.EvidenceReplicas))
+	for i, replica := range GlobalConfig.PocketConfig.EvidenceReplicas {
+		pc.EvidenceReplicas[i] = redactURL(replica)
+	}
+	return res, nil
+}
+
+// redacts the password and the query parameters (e.g. api keys) of the url
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redactedValue)
+	}
+	if u.RawQuery != "" {
+		q := u.Query()
+		for k := range q {
+			q.Set(k, redactedValue)
+		}
+		u.RawQuery = q.Encode()
+	}
+	return u.String()
+}
+
+// PruningInfo is the state history kept by the node, the heights queryable
+type PruningInfo struct {
+	Strategy       string `json:"strategy"`        // everything, nothing (archive) or custom
+	KeepRecent     int64  `json:"keep_recent"`     // the latest states kept (custom)
+	KeepEvery      int64  `json:"keep_every"`      // every keep_every-th state is also kept (custom)
+	LatestHeight   int64  `json:"latest_height"`   // the latest committed height
+	EarliestHeight int64  `json:"earliest_height"` // the earliest height of the queryable state history, older heights are only queryable at the multiples of keep_every
+}
+
+func (app PocketCoreApp) QueryPruningInfo() (res PruningInfo, err error) {
+	opts := PruningOptions()
+	res = PruningInfo{Strategy: PruningStrategy(), LatestHeight: app.LastBlockHeight()}
+	if res.Strategy == PruningCustom {
+		res.KeepRecent, res.KeepEvery = opts.KeepRecent(), opts.KeepEvery()
+	}
+	// the history kept by the strategy
+	switch res.Strategy {
+	case PruningNothing:
+		res.EarliestHeight = 1
+	case PruningEverything:
+		res.EarliestHeight = res.LatestHeight
+	default:
+		res.EarliestHeight = res.LatestHeight - opts.KeepRecent()
+	}
+	if res.EarliestHeight < 1 {
+		res.EarliestHeight = 1
+	}
+	// the history may be shorter, if the strategy changed since (e.g. a pruned node turned into an archive node)
+	if store, ok := app.Store().GetCommitKVStore(app.keys[bam.MainStoreKey]).(interface{ VersionExists(int64) bool }); ok {
+		for res.EarliestHeight < res.LatestHeight && !store.VersionExists(res.EarliestHeight) {
+			res.EarliestHeight++
+		}
+	}
+	return res, nil
 }
 
 func (app PocketCoreApp) QueryBalance(addr string, height int64) (res sdk.Int, err error) {
@@ -106,8 +403,24 @@ func (app PocketCoreApp) QueryBalance(addr string, height int64) (res sdk.Int, e
 	return (*acc).GetCoins().AmountOf(sdk.DefaultStakeDenom), nil
 }
 
+// "QueryBalances" - Returns every coin denomination of the account at height, only the denom one if not empty
+func (app PocketCoreApp) QueryBalances(addr string, height int64, denom string) (res sdk.Coins, err error) {
+	acc, err := app.QueryAccount(addr, height)
+	if err != nil {
+		return
+	}
+	if *acc == nil {
+		return sdk.NewCoins(), nil
+	}
+	coins := (*acc).GetCoins()
+	if denom == "" {
+		return coins, nil
+	}
+	return sdk.NewCoins(sdk.NewCoin(denom, coins.AmountOf(denom))), nil
+}
+
 func (app PocketCoreApp) QueryAccount(addr string, height int64) (res *exported.Account, err error) {
-	a, err := sdk.AddressFromHex(addr)
+	a, err := pocketTypes.ParseAddress(addr)
 	if err != nil {
 		return nil, err
 	}
@@ -119,18 +432,31 @@ func (app PocketCoreApp) QueryAccount(addr string, height int64) (res *exported.
 	return &acc, nil
 }
 
+// "QueryAllowances" - Returns the spending allowances granted by the account at height
+func (app PocketCoreApp) QueryAllowances(addr string, height int64) (res []nodesTypes.Allowance, err error) {
+	a, err := pocketTypes.ParseAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.nodesKeeper.GetAllowances(ctx, a), nil
+}
+
 func (app PocketCoreApp) QueryNodes(height int64, opts nodesTypes.QueryValidatorsParams) (res Page, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
 		return
 	}
-	opts.Page, opts.Limit = checkPagination(opts.Page, opts.Limit)
+	opts.Page, opts.Limit = checkPagination(PaginationQueryNodes, opts.Page, opts.Limit)
 	nodes := app.nodesKeeper.GetAllValidatorsWithOpts(ctx, opts)
-	return paginate(opts.Page, opts.Limit, nodes, int(app.nodesKeeper.GetParams(ctx).MaxValidators))
+	return paginate(opts.Page, opts.Limit, nodes)
 }
 
 func (app PocketCoreApp) QueryNode(addr string, height int64) (res nodesTypes.Validator, err error) {
-	a, err := sdk.AddressFromHex(addr)
+	a, err := pocketTypes.ParseAddress(addr)
 	if err != nil {
 		return res, err
 	}
@@ -145,6 +471,83 @@ func (app PocketCoreApp) QueryNode(addr string, height int64) (res nodesTypes.Va
 	return
 }
 
+// "QuerySessionValidators" - Returns the snapshot of the validators and reward params of the session that starts at
+// sessionHeight, derived from the state at the session block (the state sessions are generated from)
+func (app PocketCoreApp) QuerySessionValidators(sessionHeight int64, chain string) (res nodesTypes.SessionSnapshot, err error) {
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return
+	}
+	sessionCtx, err := ctx.PrevCtx(sessionHeight)
+	if err != nil {
+		return
+	}
+	if !app.nodesKeeper.IsSessionBlock(sessionCtx) {
+		return res, fmt.Errorf("%d is not a session block height", sessionHeight)
+	}
+	res = app.nodesKeeper.NewSessionSnapshot(sessionCtx)
+	if chain != "" {
+		res = res.ForChain(chain)
+	}
+	return res, nil
+}
+
+// the max number of addresses of a bulk lookup
+const MaxBulkLookupAddresses = 100
+
+// "NodesByAddresses" - The nodes found by address and the reason the others are missing (invalid or not found)
+type NodesByAddresses struct {
+	Found   map[string]nodesTypes.Validator `json:"found"`
+	Missing map[string]string               `json:"missing"`
+}
+
+// "QueryNodesByAddresses" - Returns the nodes of the addresses at height, in one lookup
+func (app PocketCoreApp) QueryNodesByAddresses(addrs []string, height int64) (res NodesByAddresses, err error) {
+	if err = checkBulkLookup(addrs); err != nil {
+		return
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res = NodesByAddresses{Found: make(map[string]nodesTypes.Validator), Missing: make(map[string]string)}
+	for _, addr := range addrs {
+		a, er := pocketTypes.ParseAddress(addr)
+		if er != nil {
+			res.Missing[addr] = er.Error()
+			continue
+		}
+		validator, found := app.nodesKeeper.GetValidator(ctx, a)
+		if !found {
+			res.Missing[addr] = fmt.Sprintf("validator not found for %s", a.String())
+			continue
+		}
+		res.Found[addr] = validator
+	}
+	return
+}
+
+// ensures the bulk lookup has at least one and at most MaxBulkLookupAddresses addresses
+func checkBulkLookup(addrs []string) error {
+	if len(addrs) == 0 {
+		return fmt.Errorf("no addresses to look up")
+	}
+	if len(addrs) > MaxBulkLookupAddresses {
+		return fmt.Errorf("%d addresses exceed the max of %d per lookup", len(addrs), MaxBulkLookupAddresses)
+	}
+	return nil
+}
+
+// "QuerySession" - Returns the session (session key, session block height and nodes) of the app for the chain at height,
+// generated as it is dispatched
+func (app PocketCoreApp) QuerySession(appPubKey, chain string, height int64) (res *pocketTypes.DispatchResponse, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.pocketKeeper.GetSession(ctx, appPubKey, chain)
+}
+
 func (app PocketCoreApp) QueryNodeParams(height int64) (res nodesTypes.Params, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -154,7 +557,7 @@ func (app PocketCoreApp) QueryNodeParams(height int64) (res nodesTypes.Params, e
 }
 
 func (app PocketCoreApp) QuerySigningInfo(height int64, addr string) (res nodesTypes.ValidatorSigningInfo, err error) {
-	a, err := sdk.AddressFromHex(addr)
+	a, err := pocketTypes.ParseAddress(addr)
 	if err != nil {
 		return nodesTypes.ValidatorSigningInfo{}, err
 	}
@@ -187,6 +590,39 @@ func (app PocketCoreApp) QueryDaoBalance(height int64) (res sdk.Int, err error)
 	return app.govKeeper.GetDAOTokens(ctx), nil
 }
 
+// "Supply" - The token supply at a height: staked by the nodes, staked by the apps, in the dao and the rest unstaked
+type Supply struct {
+	NodeStaked    sdk.Int `json:"node_staked"`
+	AppStaked     sdk.Int `json:"app_staked"`
+	DAO           sdk.Int `json:"dao"`
+	TotalStaked   sdk.Int `json:"total_staked"` // nodes, apps and dao
+	TotalUnstaked sdk.Int `json:"total_unstaked"`
+	Total         sdk.Int `json:"total"`
+}
+
+// "QuerySupply" - Returns the token supply at height, from a single context
+func (app PocketCoreApp) QuerySupply(height int64) (res Supply, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res.NodeStaked = app.nodesKeeper.GetStakedTokens(ctx)
+	res.AppStaked = app.appsKeeper.GetStakedTokens(ctx)
+	res.DAO = app.govKeeper.GetDAOTokens(ctx)
+	res.Total = app.nodesKeeper.TotalTokens(ctx)
+	res.TotalStaked = res.NodeStaked.Add(res.AppStaked).Add(res.DAO)
+	res.TotalUnstaked = res.Total.Sub(res.TotalStaked)
+	return
+}
+
+func (app PocketCoreApp) QueryEmissionSchedule(height int64) (res nodesTypes.EmissionScheduleStatus, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.nodesKeeper.EmissionScheduleStatus(ctx), nil
+}
+
 func (app PocketCoreApp) QueryDaoOwner(height int64) (res sdk.Address, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -195,6 +631,76 @@ func (app PocketCoreApp) QueryDaoOwner(height int64) (res sdk.Address, err error
 	return app.govKeeper.GetDAOOwner(ctx), nil
 }
 
+// "DAOAction" - A transfer or a burn of the dao tokens by the dao owner
+type DAOAction struct {
+	Action    string  `json:"action"` // dao_transfer or dao_burn
+	Amount    sdk.Int `json:"amount"`
+	Sender    string  `json:"sender"`              // the dao owner at the time
+	Recipient string  `json:"recipient,omitempty"` // none for a burn
+	Height    int64   `json:"height"`
+	TxHash    string  `json:"tx_hash"`
+}
+
+// "QueryDAOHistory" - Returns the transfers and the burns of the dao tokens, sorted by height in the order (desc by
+// default), paginated by page and perPage. The node must index the dao events (dao_transfer.module and dao_burn.module
+// in the tx index tags) from the genesis to return the whole history
+func (app PocketCoreApp) QueryDAOHistory(page, perPage int, order string) (res Page, err error) {
+	if order, err = checkTxOrder(order); err != nil {
+		return
+	}
+	tmClient := app.GetClient()
+	defer func() { _ = tmClient.Stop() }()
+	// only the successful actions emit the events
+	transfers, err := searchAllTxs(tmClient, fmt.Sprintf(moduleEventQuery, types.EventDAOTransfer, types.ModuleName), false)
+	if err != nil {
+		return
+	}
+	burns, err := searchAllTxs(tmClient, fmt.Sprintf(moduleEventQuery, types.EventDAOBurn, types.ModuleName), false)
+	if err != nil {
+		return
+	}
+	txs := append(transfers, burns...)
+	sortTxs(txs, order)
+	actions := make([]DAOAction, 0, len(txs))
+	for _, resTx := range txs {
+		stdTx, er := decodeStdTx(resTx)
+		if er != nil {
+			return res, er
+		}
+		msg, ok := stdTx.Msg.(types.MsgDAOTransfer)
+		if !ok {
+			return res, fmt.Errorf("unexpected msg type %T in the dao tx %s", stdTx.Msg, resTx.Hash.String())
+		}
+		action := DAOAction{Action: msg.Action, Amount: msg.Amount, Sender: msg.FromAddress.String(), Height: resTx.Height, TxHash: resTx.Hash.String()}
+		if msg.Action == types.DAOTransferString {
+			action.Recipient = msg.ToAddress.String()
+		}
+		actions = append(actions, action)
+	}
+	page, perPage = checkPagination(PaginationQueryTxs, page, perPage)
+	return paginate(page, perPage, actions)
+}
+
+// "QueryACLHistory" - Returns the changes of the ACL and of the dao owner recorded in the state, sorted by height in
+// the order (desc by default), paginated by page and perPage
+func (app PocketCoreApp) QueryACLHistory(page, perPage int, order string) (res Page, err error) {
+	if order, err = checkTxOrder(order); err != nil {
+		return
+	}
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return
+	}
+	changes := app.pocketKeeper.GetACLChanges(ctx)
+	if order == TxOrderDesc {
+		for i, j := 0, len(changes)-1; i < j; i, j = i+1, j-1 {
+			changes[i], changes[j] = changes[j], changes[i]
+		}
+	}
+	page, perPage = checkPagination(PaginationQueryTxs, page, perPage)
+	return paginate(page, perPage, changes)
+}
+
 func (app PocketCoreApp) QueryUpgrade(height int64) (res types.Upgrade, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -211,6 +717,16 @@ func (app PocketCoreApp) QueryACL(height int64) (res types.ACL, err error) {
 	return app.govKeeper.GetACL(ctx), nil
 }
 
+// "QueryParamBounds" - Returns the bounds of the params enforced on the param changes at the height (the default bounds
+// if none are set)
+func (app PocketCoreApp) QueryParamBounds(height int64) (res pocketTypes.ParamBounds, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.pocketKeeper.ParamBounds(ctx).OrDefault(), nil
+}
+
 type AllParamsReturn struct {
 	AppParams    []SingleParamReturn `json:"app_params"`
 	NodeParams   []SingleParamReturn `json:"node_params"`
@@ -222,6 +738,75 @@ type AllParamsReturn struct {
 type SingleParamReturn struct {
 	Key   string `json:"param_key"`
 	Value string `json:"param_value"`
+	// the type of the value (int, decimal, bool, string, array, object) and the value as that type, if decodable
+	Type       string          `json:"param_type,omitempty"`
+	TypedValue json.RawMessage `json:"param_typed_value,omitempty"`
+	// the go type of the param in its module (e.g. int64, time.Duration, types.Dec)
+	GoType string `json:"param_go_type,omitempty"`
+}
+
+// the go types of the params by acl key, from the param sets of the modules
+var paramGoTypes = func() map[string]string {
+	sets := map[string]sdk.ParamSet{
+		auth.DefaultParamspace:        &auth.Params{},
+		nodesTypes.DefaultParamspace:  &nodesTypes.Params{},
+		appsTypes.DefaultParamspace:   &appsTypes.Params{},
+		pocketTypes.DefaultParamspace: &pocketTypes.Params{},
+		types.DefaultParamspace:       &types.Params{},
+	}
+	goTypes := make(map[string]string)
+	for space, set := range sets {
+		for _, pair := range set.ParamSetPairs() {
+			t := reflect.TypeOf(pair.Value)
+			if t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+			goTypes[space+"/"+string(pair.Key)] = t.String()
+		}
+	}
+	return goTypes
+}()
+
+// "newSingleParamReturn" - Returns the param of the raw (amino json) value, with its value unquoted and typed and its go
+// type
+func newSingleParamReturn(key, raw string) SingleParamReturn {
+	r := SingleParamReturn{Key: key, Value: raw, GoType: paramGoTypes[key]}
+	if s, err := strconv.Unquote(raw); err == nil {
+		r.Value = s
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		// not json, only the raw value
+		return r
+	}
+	r.TypedValue = json.RawMessage(raw)
+	switch val := v.(type) {
+	case string:
+		// amino encodes the (big) integers and the decimals as strings
+		var n json.Number
+		if err := json.Unmarshal([]byte(val), &n); err != nil || n.String() != val {
+			r.Type = "string"
+		} else if _, ok := sdk.NewIntFromString(val); ok {
+			r.Type, r.TypedValue = "int", json.RawMessage(val)
+		} else {
+			r.Type, r.TypedValue = "decimal", json.RawMessage(val)
+		}
+	case bool:
+		r.Type = "bool"
+	case float64:
+		if _, ok := sdk.NewIntFromString(raw); ok {
+			r.Type = "int"
+		} else {
+			r.Type = "decimal"
+		}
+	case []interface{}:
+		r.Type = "array"
+	case map[string]interface{}:
+		r.Type = "object"
+	default:
+		r.TypedValue = nil
+	}
+	return r
 }
 
 func (app PocketCoreApp) QueryAllParams(height int64) (r AllParamsReturn, err error) {
@@ -235,37 +820,17 @@ func (app PocketCoreApp) QueryAllParams(height int64) (r AllParamsReturn, err er
 	//transform for easy handling
 	for k, v := range allmap {
 		sub, _ := types.SplitACLKey(k)
-		s, err2 := strconv.Unquote(v)
-		if err2 != nil {
-			//ignoring this error as content is a json object
-			s = v
-		}
 		switch sub {
 		case "pos":
-			r.NodeParams = append(r.NodeParams, SingleParamReturn{
-				Key:   k,
-				Value: s,
-			})
+			r.NodeParams = append(r.NodeParams, newSingleParamReturn(k, v))
 		case "application":
-			r.AppParams = append(r.AppParams, SingleParamReturn{
-				Key:   k,
-				Value: s,
-			})
+			r.AppParams = append(r.AppParams, newSingleParamReturn(k, v))
 		case "pocketcore":
-			r.PocketParams = append(r.PocketParams, SingleParamReturn{
-				Key:   k,
-				Value: s,
-			})
+			r.PocketParams = append(r.PocketParams, newSingleParamReturn(k, v))
 		case "gov":
-			r.GovParams = append(r.GovParams, SingleParamReturn{
-				Key:   k,
-				Value: s,
-			})
+			r.GovParams = append(r.GovParams, newSingleParamReturn(k, v))
 		case "auth":
-			r.AuthParams = append(r.AuthParams, SingleParamReturn{
-				Key:   k,
-				Value: s,
-			})
+			r.AuthParams = append(r.AuthParams, newSingleParamReturn(k, v))
 		default:
 		}
 	}
@@ -273,6 +838,7 @@ func (app PocketCoreApp) QueryAllParams(height int64) (r AllParamsReturn, err er
 	return r, nil
 }
 
+// "QueryParam" - Returns the param of the acl key at height, the key is matched case insensitively if not found as is
 func (app PocketCoreApp) QueryParam(height int64, paramkey string) (r SingleParamReturn, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -282,30 +848,49 @@ func (app PocketCoreApp) QueryParam(height int64, paramkey string) (r SinglePara
 	allmap := app.govKeeper.GetAllParamNameValue(ctx)
 
 	if val, ok := allmap[paramkey]; ok {
-		r.Key = paramkey
-		s, err2 := strconv.Unquote(val)
-		if err2 != nil {
-			//ignoring this error as content is a json object
-			r.Value = val
-			return r, err
+		return newSingleParamReturn(paramkey, val), nil
+	}
+	for k, val := range allmap {
+		if strings.EqualFold(k, paramkey) {
+			return newSingleParamReturn(k, val), nil
 		}
-		r.Value = s
 	}
 	return
 }
 
+// "QueryParams" - Returns the params at height of the acl keys starting with the prefix (case insensitive), a prefix
+// without a '/' being the name of a module (e.g. "pos" for all the "pos/" params). Sorted by key
+func (app PocketCoreApp) QueryParams(height int64, prefix string) (r []SingleParamReturn, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	prefix = strings.ToLower(prefix)
+	if prefix != "" && !strings.Contains(prefix, "/") {
+		prefix += "/"
+	}
+	r = make([]SingleParamReturn, 0)
+	for k, v := range app.govKeeper.GetAllParamNameValue(ctx) {
+		if strings.HasPrefix(strings.ToLower(k), prefix) {
+			r = append(r, newSingleParamReturn(k, v))
+		}
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].Key < r[j].Key })
+	return
+}
+
 func (app PocketCoreApp) QueryApps(height int64, opts appsTypes.QueryApplicationsWithOpts) (res Page, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
 		return
 	}
-	opts.Page, opts.Limit = checkPagination(opts.Page, opts.Limit)
+	opts.Page, opts.Limit = checkPagination(PaginationQueryApps, opts.Page, opts.Limit)
 	applications := app.appsKeeper.GetAllApplicationsWithOpts(ctx, opts)
-	return paginate(opts.Page, opts.Limit, applications, int(app.appsKeeper.GetParams(ctx).MaxApplications))
+	return paginate(opts.Page, opts.Limit, applications)
 }
 
 func (app PocketCoreApp) QueryApp(addr string, height int64) (res appsTypes.Application, err error) {
-	a, err := sdk.AddressFromHex(addr)
+	a, err := pocketTypes.ParseAddress(addr)
 	if err != nil {
 		return res, err
 	}
@@ -321,6 +906,38 @@ func (app PocketCoreApp) QueryApp(addr string, height int64) (res appsTypes.Appl
 	return
 }
 
+// "AppsByAddresses" - The applications found by address and the reason the others are missing (invalid or not found)
+type AppsByAddresses struct {
+	Found   map[string]appsTypes.Application `json:"found"`
+	Missing map[string]string                `json:"missing"`
+}
+
+// "QueryAppsByAddresses" - Returns the applications of the addresses at height, in one lookup
+func (app PocketCoreApp) QueryAppsByAddresses(addrs []string, height int64) (res AppsByAddresses, err error) {
+	if err = checkBulkLookup(addrs); err != nil {
+		return
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res = AppsByAddresses{Found: make(map[string]appsTypes.Application), Missing: make(map[string]string)}
+	for _, addr := range addrs {
+		a, er := pocketTypes.ParseAddress(addr)
+		if er != nil {
+			res.Missing[addr] = er.Error()
+			continue
+		}
+		application, found := app.appsKeeper.GetApplication(ctx, a)
+		if !found {
+			res.Missing[addr] = appsTypes.ErrNoApplicationFound(appsTypes.ModuleName).Error()
+			continue
+		}
+		res.Found[addr] = application
+	}
+	return
+}
+
 func (app PocketCoreApp) QueryTotalAppCoins(height int64) (staked sdk.Int, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -337,25 +954,33 @@ func (app PocketCoreApp) QueryAppParams(height int64) (res appsTypes.Params, err
 	return app.appsKeeper.GetParams(ctx), nil
 }
 
-func (app PocketCoreApp) QueryReceipts(addr string, height int64, page, perPage int) (res Page, err error) {
-	a, err := sdk.AddressFromHex(addr)
+func (app PocketCoreApp) QueryAppStakeAdjustment(height int64) (res appsTypes.StakeAdjustmentStatus, err error) {
+	ctx, err := app.NewContext(height)
 	if err != nil {
 		return
 	}
-	page, perPage = checkPagination(page, perPage)
+	return app.appsKeeper.StakeAdjustmentStatus(ctx), nil
+}
+
+func (app PocketCoreApp) QueryReceipts(addr string, height int64, page, perPage int, filter pocketTypes.EvidenceFilter) (res Page, err error) {
+	a, err := pocketTypes.ParseAddress(addr)
+	if err != nil {
+		return
+	}
+	page, perPage = checkPagination(PaginationQueryReceipts, page, perPage)
 	ctx, err := app.NewContext(height)
 	if err != nil {
 		return
 	}
-	r, err := app.pocketKeeper.GetReceipts(ctx, a)
+	r, err := app.pocketKeeper.GetReceipts(ctx, a, filter)
 	if err != nil {
 		return
 	}
-	return paginate(page, perPage, r, 1000)
+	return paginate(page, perPage, r)
 }
 
-func (app PocketCoreApp) QueryReceipt(blockchain, appPubKey, addr, receiptType string, sessionblockHeight, height int64) (res *pocketTypes.Receipt, err error) {
-	a, err := sdk.AddressFromHex(addr)
+func (app PocketCoreApp) QueryReceipt(blockchain, appPubKey, addr, receiptType string, sessionblockHeight, height int64) (res *pocketTypes.SettledReceipt, err error) {
+	a, err := pocketTypes.ParseAddress(addr)
 	if err != nil {
 		return nil, err
 	}
@@ -372,7 +997,7 @@ func (app PocketCoreApp) QueryReceipt(blockchain, appPubKey, addr, receiptType s
 	if err != nil {
 		return nil, err
 	}
-	r, found := app.pocketKeeper.GetReceipt(ctx, a, h, et)
+	r, found := app.pocketKeeper.GetSettledReceipt(ctx, a, h, et)
 	if !found {
 		return nil, fmt.Errorf("receipt for node: %s for app: %s with height %d with type %s for chain %s not found", addr, appPubKey, sessionblockHeight, receiptType, blockchain)
 	}
@@ -388,8 +1013,18 @@ func (app PocketCoreApp) QueryPocketSupportedBlockchains(height int64) (res []st
 	return sb, nil
 }
 
+// "QueryPocketSupportedBlockchainsMetadata" - Returns the supported blockchains along with their metadata (name,
+// description) in the chain registry at height
+func (app PocketCoreApp) QueryPocketSupportedBlockchainsMetadata(height int64) (res []pocketTypes.ChainMetadata, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.pocketKeeper.SupportedBlockchainsMetadata(ctx), nil
+}
+
 func (app PocketCoreApp) QueryClaim(address, appPubkey, chain, evidenceType string, sessionBlockHeight int64, height int64) (res *pocketTypes.MsgClaim, err error) {
-	a, err := sdk.AddressFromHex(address)
+	a, err := pocketTypes.ParseAddress(address)
 	if err != nil {
 		return nil, err
 	}
@@ -417,8 +1052,8 @@ func (app PocketCoreApp) QueryClaim(address, appPubkey, chain, evidenceType stri
 	return &claim, nil
 }
 
-func (app PocketCoreApp) QueryClaims(address string, height int64, page, perPage int) (res Page, err error) {
-	a, err := sdk.AddressFromHex(address)
+func (app PocketCoreApp) QueryClaims(address string, height int64, page, perPage int, filter pocketTypes.EvidenceFilter) (res Page, err error) {
+	a, err := pocketTypes.ParseAddress(address)
 	if err != nil {
 		return Page{}, err
 	}
@@ -426,18 +1061,75 @@ func (app PocketCoreApp) QueryClaims(address string, height int64, page, perPage
 	if err != nil {
 		return
 	}
-	page, perPage = checkPagination(page, perPage)
-	claims, err := app.pocketKeeper.GetClaims(ctx, a)
+	page, perPage = checkPagination(PaginationQueryClaims, page, perPage)
+	claims, err := app.pocketKeeper.GetClaims(ctx, a, filter)
 	if err != nil {
 		return Page{}, err
 	}
-	p, err := paginate(page, perPage, claims, 10000)
+	p, err := paginate(page, perPage, claims)
 	if err != nil {
 		return Page{}, err
 	}
 	return p, nil
 }
 
+// "QueryOpenChallenges" - Returns the open (pending) challenges at height
+func (app PocketCoreApp) QueryOpenChallenges(height int64, page, perPage int) (res Page, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	page, perPage = checkPagination(PaginationQueryChallenges, page, perPage)
+	challenges := app.pocketKeeper.GetOpenChallenges(ctx)
+	return paginateChallenges(page, perPage, challenges)
+}
+
+// "QueryChallengesAgainst" - Returns the open challenges of the sessions the node is part of, followed by the settled
+// challenges against the node at height
+func (app PocketCoreApp) QueryChallengesAgainst(address string, height int64, page, perPage int) (res Page, err error) {
+	a, err := pocketTypes.ParseAddress(address)
+	if err != nil {
+		return Page{}, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	page, perPage = checkPagination(PaginationQueryChallenges, page, perPage)
+	challenges, err := app.pocketKeeper.GetOpenChallengesAgainst(ctx, a)
+	if err != nil {
+		return Page{}, err
+	}
+	settled, err := app.pocketKeeper.GetChallengesAgainst(ctx, a)
+	if err != nil {
+		return Page{}, err
+	}
+	return paginateChallenges(page, perPage, append(challenges, settled...))
+}
+
+// the page of the challenges, an empty page rather than a zero page if there are none
+func paginateChallenges(page, perPage int, challenges []pocketTypes.Challenge) (Page, error) {
+	if len(challenges) == 0 {
+		return Page{Result: make([]pocketTypes.Challenge, 0), Total: 1, Page: page}, nil
+	}
+	return paginate(page, perPage, challenges)
+}
+
+// "QueryChallengeRewards" - Returns the settled challenges reported by the client (app) and the reward of each one at height
+func (app PocketCoreApp) QueryChallengeRewards(address string, height int64, page, perPage int) (res Page, err error) {
+	a, err := pocketTypes.ParseAddress(address)
+	if err != nil {
+		return Page{}, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	page, perPage = checkPagination(PaginationQueryChallenges, page, perPage)
+	challenges := app.pocketKeeper.GetChallengeRewards(ctx, a)
+	return paginate(page, perPage, challenges)
+}
+
 func (app PocketCoreApp) QueryPocketParams(height int64) (res pocketTypes.Params, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -447,6 +1139,354 @@ func (app PocketCoreApp) QueryPocketParams(height int64) (res pocketTypes.Params
 	return p, nil
 }
 
+// "QueryLocalEvidence" - Returns a summary of the evidence this node has recorded but not yet claimed/proven
+func (app PocketCoreApp) QueryLocalEvidence() (res []pocketTypes.EvidenceSummary, err error) {
+	res, err = pocketTypes.GetEvidenceSummaries()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].SessionBlockHeight != res[j].SessionBlockHeight {
+			return res[i].SessionBlockHeight > res[j].SessionBlockHeight
+		}
+		if res[i].ApplicationPubKey != res[j].ApplicationPubKey {
+			return res[i].ApplicationPubKey < res[j].ApplicationPubKey
+		}
+		if res[i].Chain != res[j].Chain {
+			return res[i].Chain < res[j].Chain
+		}
+		return res[i].EvidenceType < res[j].EvidenceType
+	})
+	return
+}
+
+// the max number of settled receipts returned as recent rewards in the operator overview
+const OperatorOverviewRecentRewards = 10
+
+// "OperatorOverview" - Everything a node operator dashboard needs for a single address
+type OperatorOverview struct {
+	Address       string                           `json:"address"`
+	Height        int64                            `json:"height"`
+	Balance       sdk.Int                          `json:"balance"`
+	Validator     *nodesTypes.Validator            `json:"validator,omitempty"`
+	SigningInfo   *nodesTypes.ValidatorSigningInfo `json:"signing_info,omitempty"`
+	PendingClaims []pocketTypes.MsgClaim           `json:"pending_claims"`
+	MatureClaims  []pocketTypes.MsgClaim           `json:"mature_claims"`
+	RecentRewards []pocketTypes.SettledReceipt     `json:"recent_rewards"`
+	JailHistory   JailHistory                      `json:"jail_history"`
+}
+
+// "JailHistory" - The jail record of a validator, as tracked by its signing info
+type JailHistory struct {
+	Jailed              bool      `json:"jailed"`
+	JailedUntil         time.Time `json:"jailed_until"`
+	JailedBlocksCounter int64     `json:"jailed_blocks_counter"`
+	MissedBlocksCounter int64     `json:"missed_blocks_counter"`
+	Tombstoned          bool      `json:"tombstoned"`
+}
+
+// "QueryOperatorOverview" - Returns the validator state, signing info, balance, pending/mature claims,
+// recent rewards and jail history of the address, all at the same height
+func (app PocketCoreApp) QueryOperatorOverview(addr string, height int64) (res OperatorOverview, err error) {
+	a, err := pocketTypes.ParseAddress(addr)
+	if err != nil {
+		return
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res = OperatorOverview{
+		Address:       a.String(),
+		Height:        ctx.BlockHeight(),
+		Balance:       sdk.ZeroInt(),
+		PendingClaims: make([]pocketTypes.MsgClaim, 0),
+		MatureClaims:  make([]pocketTypes.MsgClaim, 0),
+		RecentRewards: make([]pocketTypes.SettledReceipt, 0),
+	}
+	if acc := app.accountKeeper.GetAccount(ctx, a); acc != nil {
+		res.Balance = acc.GetCoins().AmountOf(sdk.DefaultStakeDenom)
+	}
+	if validator, found := app.nodesKeeper.GetValidator(ctx, a); found {
+		res.Validator = &validator
+		res.JailHistory.Jailed = validator.IsJailed()
+	}
+	if signingInfo, found := app.nodesKeeper.GetValidatorSigningInfo(ctx, a); found {
+		res.SigningInfo = &signingInfo
+		res.JailHistory.JailedUntil = signingInfo.JailedUntil
+		res.JailHistory.JailedBlocksCounter = signingInfo.JailedBlocksCounter
+		res.JailHistory.MissedBlocksCounter = signingInfo.MissedBlocksCounter
+		res.JailHistory.Tombstoned = signingInfo.Tombstoned
+	}
+	claims, err := app.pocketKeeper.GetClaims(ctx, a, pocketTypes.EvidenceFilter{})
+	if err != nil {
+		return
+	}
+	for _, claim := range claims {
+		if app.pocketKeeper.ClaimIsMature(ctx, claim.SessionBlockHeight, claim.Chain) {
+			res.MatureClaims = append(res.MatureClaims, claim)
+		} else {
+			res.PendingClaims = append(res.PendingClaims, claim)
+		}
+	}
+	receipts, err := app.pocketKeeper.GetReceipts(ctx, a, pocketTypes.EvidenceFilter{})
+	if err != nil {
+		return
+	}
+	for _, receipt := range receipts {
+		sr, found := app.pocketKeeper.GetSettledReceipt(ctx, a, receipt.SessionHeader, receipt.EvidenceType)
+		if !found || sr.Settlement == nil || !sr.Settlement.Minted.IsPositive() {
+			continue
+		}
+		res.RecentRewards = append(res.RecentRewards, sr)
+	}
+	// most recent sessions first
+	sort.SliceStable(res.RecentRewards, func(i, j int) bool {
+		return res.RecentRewards[i].SessionBlockHeight > res.RecentRewards[j].SessionBlockHeight
+	})
+	if len(res.RecentRewards) > OperatorOverviewRecentRewards {
+		res.RecentRewards = res.RecentRewards[:OperatorOverviewRecentRewards]
+	}
+	return
+}
+
+// "ClaimsSummary" - The claim pipeline of a node: the count and total relays of its claims at each stage
+type ClaimsSummary struct {
+	Address         string                  `json:"address"`
+	Height          int64                   `json:"height"`
+	PendingClaims   pocketTypes.ClaimsTally `json:"pending_claims"`   // claims within their submission window
+	MatureClaims    pocketTypes.ClaimsTally `json:"mature_claims"`    // claims awaiting their proof
+	SubmittedProofs pocketTypes.ClaimsTally `json:"submitted_proofs"` // verified proofs (receipts)
+	ExpiredClaims   pocketTypes.ClaimsTally `json:"expired_claims"`   // claims that expired before their proof
+	Reputation      pocketTypes.Reputation  `json:"reputation"`       // proven claims and settled challenges in the reputation window
+	ReputationScore int64                   `json:"reputation_score"` // the reputation score weighting the session selection
+}
+
+// "QueryClaimsSummary" - Returns the count and total relays of the pending claims, mature claims, submitted proofs
+// and expired claims, along with the reputation, of the node address at height
+func (app PocketCoreApp) QueryClaimsSummary(addr string, height int64) (res ClaimsSummary, err error) {
+	a, err := pocketTypes.ParseAddress(addr)
+	if err != nil {
+		return
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res = ClaimsSummary{Address: a.String(), Height: ctx.BlockHeight()}
+	claims, err := app.pocketKeeper.GetClaims(ctx, a, pocketTypes.EvidenceFilter{})
+	if err != nil {
+		return
+	}
+	for _, claim := range claims {
+		if app.pocketKeeper.ClaimIsMature(ctx, claim.SessionBlockHeight, claim.Chain) {
+			res.MatureClaims.Add(claim.TotalProofs)
+		} else {
+			res.PendingClaims.Add(claim.TotalProofs)
+		}
+	}
+	receipts, err := app.pocketKeeper.GetReceipts(ctx, a, pocketTypes.EvidenceFilter{})
+	if err != nil {
+		return
+	}
+	for _, receipt := range receipts {
+		res.SubmittedProofs.Add(receipt.Total)
+	}
+	res.ExpiredClaims, err = app.pocketKeeper.GetExpiredClaims(ctx, a)
+	if err != nil {
+		return
+	}
+	res.Reputation, err = app.pocketKeeper.GetReputation(ctx, a)
+	res.ReputationScore = res.Reputation.Score()
+	return
+}
+
+// "ClaimsMaturity" - The claims of the node awaiting their proof and when each may be proved
+type ClaimsMaturity struct {
+	Address string                      `json:"address"`
+	Height  int64                       `json:"height"`
+	Claims  []pocketTypes.ClaimMaturity `json:"claims"`
+}
+
+// "QueryClaimMaturity" - Returns each claim of the node address awaiting its proof, with the height it is mature at and
+// the blocks until then, at height
+func (app PocketCoreApp) QueryClaimMaturity(addr string, height int64) (res ClaimsMaturity, err error) {
+	a, err := pocketTypes.ParseAddress(addr)
+	if err != nil {
+		return
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res = ClaimsMaturity{Address: a.String(), Height: ctx.BlockHeight(), Claims: make([]pocketTypes.ClaimMaturity, 0)}
+	claims, err := app.pocketKeeper.GetClaimsMaturity(ctx, a)
+	if err != nil {
+		return
+	}
+	res.Claims = append(res.Claims, claims...)
+	return
+}
+
+// "QueryNetworkRelays" - Returns the relays settled network wide per chain (all the chains if empty) and session, for
+// the sessions from height to height (inclusive), at height
+func (app PocketCoreApp) QueryNetworkRelays(from, to int64, chain string, height int64) (res []pocketTypes.NetworkRelays, err error) {
+	if from < 1 || to < from {
+		return nil, fmt.Errorf("invalid session height range %d to %d", from, to)
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.pocketKeeper.GetNetworkRelays(ctx, from, to, chain), nil
+}
+
+// the max number of blocks scanned by a node rewards query
+const MaxNodeRewardsRange = 10000
+
+// "NodeRewards" - The relay and block proposer rewards earned by a node over a height range
+type NodeRewards struct {
+	Address         string           `json:"address"`
+	From            int64            `json:"from"`
+	To              int64            `json:"to"`
+	RelayRewards    sdk.Int          `json:"relay_rewards"`    // the rewards of the proven relays
+	ProposerRewards sdk.Int          `json:"proposer_rewards"` // the block proposer rewards
+	Total           sdk.Int          `json:"total"`
+	ByChain         []ChainRewards   `json:"by_chain"`   // the relay rewards per chain
+	BySession       []SessionRewards `json:"by_session"` // the relay rewards per session of the relays and the proposer rewards per session of the blocks
+}
+
+// "ChainRewards" - The relay rewards of a node for a chain
+type ChainRewards struct {
+	Chain   string  `json:"chain"`
+	Relays  int64   `json:"relays"`
+	Rewards sdk.Int `json:"rewards"`
+}
+
+// "SessionRewards" - The relay and block proposer rewards of a node for a session
+type SessionRewards struct {
+	SessionBlockHeight int64   `json:"session_block_height"`
+	Relays             int64   `json:"relays"`
+	RelayRewards       sdk.Int `json:"relay_rewards"`
+	ProposerRewards    sdk.Int `json:"proposer_rewards"`
+}
+
+// "QueryNodeRewards" - Returns the relay and block proposer rewards of the node address from height to height
+// (inclusive), in total, per chain and per session, by scanning the reward events of the blocks
+func (app PocketCoreApp) QueryNodeRewards(addr string, from, to int64) (res NodeRewards, err error) {
+	a, err := pocketTypes.ParseAddress(addr)
+	if err != nil {
+		return
+	}
+	if latest := app.LastBlockHeight(); to > latest {
+		to = latest
+	}
+	if from < 1 || to < from {
+		return res, fmt.Errorf("invalid height range %d to %d", from, to)
+	}
+	if to-from+1 > MaxNodeRewardsRange {
+		return res, fmt.Errorf("the height range %d to %d exceeds the max of %d blocks", from, to, MaxNodeRewardsRange)
+	}
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return
+	}
+	res = NodeRewards{
+		Address:         a.String(),
+		From:            from,
+		To:              to,
+		RelayRewards:    sdk.ZeroInt(),
+		ProposerRewards: sdk.ZeroInt(),
+		ByChain:         make([]ChainRewards, 0),
+		BySession:       make([]SessionRewards, 0),
+	}
+	chains := make(map[string]*ChainRewards)
+	sessions := make(map[int64]*SessionRewards)
+	session := func(sessionBlockHeight int64) *SessionRewards {
+		if _, found := sessions[sessionBlockHeight]; !found {
+			sessions[sessionBlockHeight] = &SessionRewards{SessionBlockHeight: sessionBlockHeight, RelayRewards: sdk.ZeroInt(), ProposerRewards: sdk.ZeroInt()}
+		}
+		return sessions[sessionBlockHeight]
+	}
+	tmClient := app.GetClient()
+	defer func() { _ = tmClient.Stop() }()
+	for height := from; height <= to; height++ {
+		h := height
+		results, er := tmClient.BlockResults(&h)
+		if er != nil {
+			return res, er
+		}
+		if results.Results == nil {
+			continue
+		}
+		// the proposer of the previous block is rewarded at the beginning of the block
+		if results.Results.BeginBlock != nil {
+			for _, event := range results.Results.BeginBlock.Events {
+				attributes := eventAttributes(event)
+				if event.Type != nodesTypes.EventTypeProposerReward || attributes[nodesTypes.AttributeKeyValidator] != a.String() {
+					continue
+				}
+				amount, ok := sdk.NewIntFromString(attributes[sdk.AttributeKeyAmount])
+				if !ok {
+					continue
+				}
+				res.ProposerRewards = res.ProposerRewards.Add(amount)
+				s := session(app.nodesKeeper.GetLatestSessionBlockHeight(ctx.WithBlockHeight(height)))
+				s.ProposerRewards = s.ProposerRewards.Add(amount)
+			}
+		}
+		// the relays are rewarded by the proof transactions
+		for _, tx := range results.Results.DeliverTx {
+			if tx == nil || tx.Code != 0 {
+				continue
+			}
+			for _, event := range tx.Events {
+				attributes := eventAttributes(event)
+				if event.Type != pocketTypes.EventTypeRelayReward || attributes[pocketTypes.AttributeKeyValidator] != a.String() {
+					continue
+				}
+				amount, ok := sdk.NewIntFromString(attributes[sdk.AttributeKeyAmount])
+				if !ok {
+					continue
+				}
+				relays, _ := strconv.ParseInt(attributes[pocketTypes.AttributeKeyRelays], 10, 64)
+				sessionBlockHeight, _ := strconv.ParseInt(attributes[pocketTypes.AttributeKeySessionHeight], 10, 64)
+				res.RelayRewards = res.RelayRewards.Add(amount)
+				chain := attributes[pocketTypes.AttributeKeyChain]
+				if _, found := chains[chain]; !found {
+					chains[chain] = &ChainRewards{Chain: chain, Rewards: sdk.ZeroInt()}
+				}
+				chains[chain].Relays += relays
+				chains[chain].Rewards = chains[chain].Rewards.Add(amount)
+				s := session(sessionBlockHeight)
+				s.Relays += relays
+				s.RelayRewards = s.RelayRewards.Add(amount)
+			}
+		}
+	}
+	res.Total = res.RelayRewards.Add(res.ProposerRewards)
+	for _, c := range chains {
+		res.ByChain = append(res.ByChain, *c)
+	}
+	sort.Slice(res.ByChain, func(i, j int) bool { return res.ByChain[i].Chain < res.ByChain[j].Chain })
+	for _, s := range sessions {
+		res.BySession = append(res.BySession, *s)
+	}
+	sort.Slice(res.BySession, func(i, j int) bool {
+		return res.BySession[i].SessionBlockHeight < res.BySession[j].SessionBlockHeight
+	})
+	return
+}
+
+// the attributes of the event by key
+func eventAttributes(event abci.Event) map[string]string {
+	attributes := make(map[string]string, len(event.Attributes))
+	for _, attribute := range event.Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+	return attributes
+}
+
 func (app PocketCoreApp) HandleChallenge(c pocketTypes.ChallengeProofInvalidData) (res *pocketTypes.ChallengeResponse, err error) {
 	ctx, err := app.NewContext(app.LastBlockHeight())
 	if err != nil {
@@ -455,6 +1495,23 @@ func (app PocketCoreApp) HandleChallenge(c pocketTypes.ChallengeProofInvalidData
 	return app.pocketKeeper.HandleChallenge(ctx, c)
 }
 
+// "HandleChallenges" - Builds the challenges of the responses of the session nodes (see BuildChallenges) and handles
+// each of them, returning their outcomes
+func (app PocketCoreApp) HandleChallenges(responses []pocketTypes.RelayResponse, reporter sdk.Address) []pocketTypes.ChallengeResult {
+	results := make([]pocketTypes.ChallengeResult, 0)
+	for _, c := range pocketTypes.BuildChallenges(responses, reporter) {
+		result := pocketTypes.ChallengeResult{Servicer: c.MinorityResponse.Proof.ServicerPubKey, Challenge: c}
+		res, err := app.HandleChallenge(c)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Response = res.Response
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
 func (app PocketCoreApp) HandleDispatch(header pocketTypes.SessionHeader) (res *pocketTypes.DispatchResponse, err error) {
 	ctx, err := app.NewContext(app.LastBlockHeight())
 	if err != nil {
@@ -471,23 +1528,52 @@ func (app PocketCoreApp) HandleRelay(r pocketTypes.Relay) (res *pocketTypes.Rela
 	return app.pocketKeeper.HandleRelay(ctx, r)
 }
 
-func checkPagination(page, limit int) (int, int) {
+// the names of the paginated queries, whose max per page may be overridden in the config (max_per_page_by_query)
+const (
+	PaginationQueryTxs        = "txs"
+	PaginationQueryNodes      = "nodes"
+	PaginationQueryApps       = "apps"
+	PaginationQueryReceipts   = "receipts"
+	PaginationQueryClaims     = "claims"
+	PaginationQueryChallenges = "challenges"
+)
+
+// "checkPagination" - Defaults the page and limit (per page) of the query, capping the limit to the max per page
+// of the query so public nodes can't be asked for unbounded pages
+func checkPagination(query string, page, limit int) (int, int) {
 	if page <= 0 {
 		page = 1
 	}
 	if limit <= 0 {
-		limit = 30
+		limit = GlobalConfig.PocketConfig.DefaultPerPage
+		if limit <= 0 {
+			limit = DefaultPerPage
+		}
+	}
+	if max := maxPerPage(query); limit > max {
+		limit = max
 	}
 	return page, limit
 }
 
-func paginate(page, limit int, items interface{}, max int) (res Page, error error) {
+// the max per page of the query: its override in the config, else the max of the config
+func maxPerPage(query string) int {
+	if max, found := GlobalConfig.PocketConfig.MaxPerPageByQuery[query]; found && max > 0 {
+		return max
+	}
+	if GlobalConfig.PocketConfig.MaxPerPage > 0 {
+		return GlobalConfig.PocketConfig.MaxPerPage
+	}
+	return DefaultMaxPerPage
+}
+
+func paginate(page, limit int, items interface{}) (res Page, error error) {
 	slice, success := takeArg(items, reflect.Slice)
 	if !success {
 		return Page{}, fmt.Errorf("invalid argument, non slice input to paginate")
 	}
 	l := slice.Len()
-	start, end := util.Paginate(l, page, limit, max)
+	start, end := util.Paginate(l, page, limit, limit)
 	if start == -1 && end == -1 {
 		return Page{}, nil
 	}
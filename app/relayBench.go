@@ -0,0 +1,314 @@
+package app
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+)
+
+const (
+	// the defaults of the relay bench
+	DefaultRelayBenchRate        = 10
+	DefaultRelayBenchDuration    = 30000 // milliseconds
+	DefaultRelayBenchConcurrency = 10
+	// the request relayed by the bench and the answer of its fake chain backend
+	relayBenchRequest  = `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`
+	relayBenchResponse = `{"jsonrpc":"2.0","id":1,"result":"0x1"}`
+)
+
+// "RelayBenchConfig" - The target and the load of a relay bench
+type RelayBenchConfig struct {
+	DispatchURL string // the dispatch url of the node
+	RelayURL    string // the relay url of the node
+	EvidenceURL string // the (private) local evidence url of the node, the evidence growth is not reported if empty
+	AuthToken   string // the auth token of the evidence url
+	Chain       string // the network identifier of the relays
+	Rate        int    // the relays per second
+	Duration    int64  // the milliseconds of the bench
+	Concurrency int    // the max relays in flight
+	Backend     string // the listen address of the fake chain backend (e.g. 127.0.0.1:8082), none if empty
+}
+
+// "RelayBenchReport" - The throughput, latencies and evidence growth of a relay bench
+type RelayBenchReport struct {
+	Sent           int64            `json:"sent"`
+	Succeeded      int64            `json:"succeeded"`
+	Failed         int64            `json:"failed"`
+	Duration       int64            `json:"duration"`    // the milliseconds of the bench
+	Throughput     float64          `json:"throughput"`  // the successful relays per second
+	LatencyP50     int64            `json:"latency_p50"` // the latencies of the successful relays in milliseconds
+	LatencyP90     int64            `json:"latency_p90"`
+	LatencyP99     int64            `json:"latency_p99"`
+	LatencyMax     int64            `json:"latency_max"`
+	EvidenceBefore int64            `json:"evidence_before"` // the relay proofs of the app and chain stored by the node
+	EvidenceAfter  int64            `json:"evidence_after"`
+	EvidenceGrowth int64            `json:"evidence_growth"`
+	Errors         map[string]int64 `json:"errors,omitempty"`
+}
+
+// "RelayBench" - Sends signed synthetic relays of the app (a valid aat, the app being its own client) to the node
+// at the rate of the config, serving the chain with a fake backend if configured (the chain of the node must point to
+// it), and reports the throughput, the latency percentiles and the growth of the evidence store. The node must be in
+// the current session of the app for the chain
+func RelayBench(appKey crypto.PrivateKey, servicerPubKey string, config RelayBenchConfig) (report RelayBenchReport, err error) {
+	if config.Rate <= 0 || config.Duration <= 0 || config.Concurrency <= 0 {
+		return report, fmt.Errorf("the rate, the duration and the concurrency of the bench must be positive")
+	}
+	if config.Backend != "" {
+		stop, err := startRelayBenchBackend(config.Backend)
+		if err != nil {
+			return report, err
+		}
+		defer stop()
+	}
+	aat := pocketTypes.AAT{
+		Version:              "0.0.1",
+		ApplicationPublicKey: appKey.PublicKey().RawString(),
+		ClientPublicKey:      appKey.PublicKey().RawString(),
+	}
+	sig, err := appKey.Sign(aat.Hash())
+	if err != nil {
+		return report, err
+	}
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	dispatch, err := relayBenchDispatch(config, aat.ApplicationPublicKey)
+	if err != nil {
+		return report, err
+	}
+	inSession := false
+	for _, n := range dispatch.Session.Nodes {
+		inSession = inSession || n.PublicKey == servicerPubKey
+	}
+	if !inSession {
+		return report, fmt.Errorf("the node %s is not in the session of the app for the chain %s at height %d", servicerPubKey, config.Chain, dispatch.Session.Header.SessionBlockHeight)
+	}
+	if report.EvidenceBefore, err = relayBenchEvidence(config, aat.ApplicationPublicKey); err != nil {
+		return report, err
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	relays := make(chan pocketTypes.Relay)
+	var l sync.Mutex
+	var latencies []int64
+	report.Errors = make(map[string]int64)
+	var wg sync.WaitGroup
+	for i := 0; i < config.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for relay := range relays {
+				start := time.Now()
+				er := relayBenchSend(client, config.RelayURL, relay)
+				latency := int64(time.Since(start) / time.Millisecond)
+				l.Lock()
+				if er != nil {
+					report.Failed++
+					report.Errors[er.Error()]++
+				} else {
+					report.Succeeded++
+					latencies = append(latencies, latency)
+				}
+				l.Unlock()
+			}
+		}()
+	}
+	// unique entropies across the benches, so the relays are not duplicates of the evidence of a previous bench
+	entropy := rand.New(rand.NewSource(time.Now().UnixNano()))
+	start := time.Now()
+	ticker := time.NewTicker(time.Second / time.Duration(config.Rate))
+	for time.Since(start) < time.Duration(config.Duration)*time.Millisecond {
+		<-ticker.C
+		relay, er := newRelayBenchRelay(appKey, aat, servicerPubKey, dispatch, entropy.Int63())
+		if er != nil {
+			ticker.Stop()
+			close(relays)
+			return report, er
+		}
+		// blocks while the max relays are in flight, so the throughput is the one of the node
+		relays <- relay
+		report.Sent++
+	}
+	ticker.Stop()
+	close(relays)
+	wg.Wait()
+	elapsed := time.Since(start)
+	report.Duration = int64(elapsed / time.Millisecond)
+	report.Throughput = float64(report.Succeeded) / elapsed.Seconds()
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	report.LatencyP50, report.LatencyP90, report.LatencyP99 = percentile(latencies, 50), percentile(latencies, 90), percentile(latencies, 99)
+	if len(latencies) != 0 {
+		report.LatencyMax = latencies[len(latencies)-1]
+	}
+	if report.EvidenceAfter, err = relayBenchEvidence(config, aat.ApplicationPublicKey); err != nil {
+		return report, err
+	}
+	report.EvidenceGrowth = report.EvidenceAfter - report.EvidenceBefore
+	return report, nil
+}
+
+// "NodePublicKey" - Returns the public key of the node, from the key file of the data directory if not loaded
+func NodePublicKey() (string, error) {
+	key, er := pocketTypes.GetPVKeyFile()
+	if er != nil {
+		var err error
+		if key, _, _, err = loadPrivVal(GlobalConfig.PocketConfig.DataDir + FS + GlobalConfig.TendermintConfig.PrivValidatorKey); err != nil {
+			return "", err
+		}
+	}
+	pk, err := crypto.PubKeyToPublicKey(key.PubKey)
+	if err != nil {
+		return "", err
+	}
+	return pk.RawString(), nil
+}
+
+// the nearest rank percentile of the sorted values, 0 if none
+func percentile(sorted []int64, p int) int64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// a new relay of the app signed as its client
+func newRelayBenchRelay(appKey crypto.PrivateKey, aat pocketTypes.AAT, servicerPubKey string, dispatch relayBenchSession, entropy int64) (relay pocketTypes.Relay, err error) {
+	relay = pocketTypes.Relay{
+		Payload: pocketTypes.Payload{Data: relayBenchRequest, Method: http.MethodPost},
+		Meta:    pocketTypes.RelayMeta{BlockHeight: dispatch.BlockHeight},
+		Proof: pocketTypes.RelayProof{
+			Entropy:            entropy,
+			SessionBlockHeight: dispatch.Session.Header.SessionBlockHeight,
+			ServicerPubKey:     servicerPubKey,
+			Blockchain:         dispatch.Session.Header.Chain,
+			Token:              aat,
+		},
+	}
+	relay.Proof.RequestHash = relay.RequestHashString()
+	sig, err := appKey.Sign(relay.Proof.Hash())
+	if err != nil {
+		return
+	}
+	relay.Proof.Signature = hex.EncodeToString(sig)
+	return
+}
+
+// the dispatch response of the node, the nodes of the session decoded by their public key only
+type relayBenchSession struct {
+	Session struct {
+		Header pocketTypes.SessionHeader `json:"header"`
+		Nodes  []struct {
+			PublicKey string `json:"public_key"`
+		} `json:"nodes"`
+	} `json:"session"`
+	BlockHeight int64 `json:"block_height"`
+}
+
+// the current session of the app for the chain
+func relayBenchDispatch(config RelayBenchConfig, appPubKey string) (res relayBenchSession, err error) {
+	j, err := json.Marshal(pocketTypes.SessionHeader{ApplicationPubKey: appPubKey, Chain: config.Chain})
+	if err != nil {
+		return
+	}
+	bz, err := relayBenchPost(&http.Client{Timeout: 30 * time.Second}, config.DispatchURL, j, "")
+	if err != nil {
+		return res, fmt.Errorf("dispatch: %s", err.Error())
+	}
+	err = json.Unmarshal(bz, &res)
+	return
+}
+
+// the relay proofs of the app and chain in the local evidence of the node, 0 without an evidence url
+func relayBenchEvidence(config RelayBenchConfig, appPubKey string) (proofs int64, err error) {
+	if config.EvidenceURL == "" {
+		return 0, nil
+	}
+	bz, err := relayBenchPost(&http.Client{Timeout: 30 * time.Second}, config.EvidenceURL, []byte("{}"), config.AuthToken)
+	if err != nil {
+		return 0, fmt.Errorf("local evidence: %s", err.Error())
+	}
+	var res struct {
+		Evidence []pocketTypes.EvidenceSummary `json:"evidence"`
+	}
+	if err = json.Unmarshal(bz, &res); err != nil {
+		return
+	}
+	for _, e := range res.Evidence {
+		if e.EvidenceType == pocketTypes.RelayEvidence && e.ApplicationPubKey == appPubKey && e.Chain == config.Chain {
+			proofs += e.NumOfProofs
+		}
+	}
+	return
+}
+
+func relayBenchSend(client *http.Client, url string, relay pocketTypes.Relay) error {
+	j, err := json.Marshal(relay)
+	if err != nil {
+		return err
+	}
+	_, err = relayBenchPost(client, url, j, "")
+	return err
+}
+
+// posts the json to the node, returns the (unquoted) json answered or the message of the error answered
+func relayBenchPost(client *http.Client, url string, j []byte, authToken string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(j))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authToken != "" {
+		req.Header.Set("Authorization", authToken)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res, er := strconv.Unquote(string(bz)); er == nil {
+		bz = []byte(res)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var rpcErr struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(bz, &rpcErr) == nil && rpcErr.Message != "" {
+			return nil, fmt.Errorf("%s", rpcErr.Message)
+		}
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return bz, nil
+}
+
+// serves the fake chain of the bench at the address, answering every request with the same json rpc result
+func startRelayBenchBackend(addr string) (stop func(), err error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(relayBenchResponse))
+	})}
+	go func() { _ = server.Serve(listener) }()
+	return func() { _ = server.Close() }, nil
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, int64(0), percentile(nil, 50))
+	values := make([]int64, 100)
+	for i := range values {
+		values[i] = int64(i + 1)
+	}
+	assert.Equal(t, int64(50), percentile(values, 50))
+	assert.Equal(t, int64(99), percentile(values, 99))
+	assert.Equal(t, int64(7), percentile([]int64{7}, 99))
+}
+
+func TestRelayBench(t *testing.T) {
+	appKey := crypto.GenerateEd25519PrivKey()
+	servicer := crypto.GenerateEd25519PrivKey().PublicKey().RawString()
+	chain := "0001"
+	var l sync.Mutex
+	entropies := make(map[int64]bool)
+	// a node validating the relays of the bench, failing every 5th relay
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bz, _ := ioutil.ReadAll(r.Body)
+		switch r.URL.Path {
+		case "/dispatch":
+			fmt.Fprintf(w, `{"session":{"header":{"app_public_key":%q,"chain":%q,"session_height":11},"nodes":[{"public_key":%q}]},"block_height":12}`,
+				appKey.PublicKey().RawString(), chain, servicer)
+		case "/evidence":
+			l.Lock()
+			proofs := len(entropies)
+			l.Unlock()
+			fmt.Fprintf(w, `{"evidence":[{"header":{"app_public_key":%q,"chain":%q,"session_height":11},"evidence_type":1,"num_of_proofs":%d}]}`,
+				appKey.PublicKey().RawString(), chain, proofs)
+		case "/relay":
+			var relay pocketTypes.Relay
+			assert.Nil(t, json.Unmarshal(bz, &relay))
+			assert.Nil(t, relay.Proof.Token.Validate())
+			assert.Equal(t, relay.RequestHashString(), relay.Proof.RequestHash)
+			assert.Equal(t, int64(11), relay.Proof.SessionBlockHeight)
+			assert.Equal(t, servicer, relay.Proof.ServicerPubKey)
+			l.Lock()
+			entropies[relay.Proof.Entropy] = true
+			fail := len(entropies)%5 == 0
+			l.Unlock()
+			if fail {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"code":400,"message":"overloaded"}`)
+				return
+			}
+			fmt.Fprint(w, strconv.Quote(`{"signature":"foo","response":"bar"}`))
+		}
+	}))
+	defer node.Close()
+	report, err := RelayBench(appKey, servicer, RelayBenchConfig{
+		DispatchURL: node.URL + "/dispatch",
+		RelayURL:    node.URL + "/relay",
+		EvidenceURL: node.URL + "/evidence",
+		Chain:       chain,
+		Rate:        100,
+		Duration:    200,
+		Concurrency: 4,
+	})
+	assert.Nil(t, err)
+	assert.True(t, report.Sent > 0)
+	assert.Equal(t, report.Sent, report.Succeeded+report.Failed)
+	assert.Equal(t, report.Sent/5, report.Failed)
+	assert.Equal(t, report.Failed, report.Errors["overloaded"])
+	assert.Equal(t, int64(0), report.EvidenceBefore)
+	assert.Equal(t, report.Sent, report.EvidenceGrowth)
+	assert.True(t, report.LatencyP50 <= report.LatencyP99 && report.LatencyP99 <= report.LatencyMax)
+	// the node must be in the session
+	_, err = RelayBench(appKey, crypto.GenerateEd25519PrivKey().PublicKey().RawString(), RelayBenchConfig{
+		DispatchURL: node.URL + "/dispatch", Chain: chain, Rate: 1, Duration: 1000, Concurrency: 1,
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	bam "github.com/pokt-network/posmint/baseapp"
+	sdk "github.com/pokt-network/posmint/types"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	con "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// "ReplayResult" - The outcome of the replay of a stored block
+type ReplayResult struct {
+	Height   int64  `json:"height"`
+	AppHash  string `json:"app_hash"` // computed by this binary
+	Expected string `json:"expected"` // committed by the network
+	Match    bool   `json:"match"`
+	Error    string `json:"error,omitempty"`
+}
+
+// "Replay" - Replays the stored blocks [from, to] of the (stopped) node through the handlers of this binary and compares
+// the app hashes with the ones committed by the network. The blocks are executed on a scratch copy of the application
+// db, the data of the node is never modified. The replay stops at the first divergence
+func Replay(from, to int64) (results []ReplayResult, err error) {
+	if from < 2 || to < from {
+		return nil, fmt.Errorf("invalid range [%d, %d]: from must be above 1 and to must not be below from", from, to)
+	}
+	tmConfig := GlobalConfig.TendermintConfig
+	// never listen for an external signer, the node is only built to read the block store
+	tmConfig.PrivValidatorListenAddr = ""
+	blockStoreDB, err := openTendermintDB("blockstore", &tmConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer blockStoreDB.Close()
+	stateDB, err := openTendermintDB("state", &tmConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer stateDB.Close()
+	state := sm.LoadState(stateDB)
+	if storeHeight := store.NewBlockStore(blockStoreDB).Height(); storeHeight != state.LastBlockHeight {
+		return nil, fmt.Errorf("the block store (height %d) and the state (height %d) are out of sync, the node was not stopped cleanly: start and stop it first", storeHeight, state.LastBlockHeight)
+	}
+	if to > state.LastBlockHeight {
+		return nil, fmt.Errorf("to (%d) is above the latest stored block (%d)", to, state.LastBlockHeight)
+	}
+	// the scratch copy of the application db
+	scratchDir, err := ioutil.TempDir("", "pocket-replay")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(scratchDir)
+	appDBDir := ApplicationDBName + ".db"
+	if err = copyDBDir(filepath.Join(tmConfig.DBDir(), appDBDir), filepath.Join(scratchDir, appDBDir)); err != nil {
+		return nil, fmt.Errorf("cannot copy the application db: %s", err.Error())
+	}
+	db, err := sdk.NewLevelDB(ApplicationDBName, scratchDir)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	// the side effects of the blocks (webhooks, claim and proof txs...) are disabled and the caches kept in the scratch dir
+	pocketTypes.InitReplica(true)
+	pocketTypes.InitConfig(GlobalConfig.PocketConfig.UserAgent, scratchDir, scratchDir, dbm.MemDBBackend, dbm.MemDBBackend, GlobalConfig.PocketConfig.MaxEvidenceCacheEntires, GlobalConfig.PocketConfig.MaxSessionCacheEntries, GlobalConfig.PocketConfig.EvidenceDBName, GlobalConfig.PocketConfig.SessionDBName)
+	logger := log.NewNopLogger()
+	app := NewPocketCoreApp(nil, nil, getTMClient(), &pocketTypes.HostedBlockchains{M: map[string]pocketTypes.HostedBlockchain{}}, logger, db, bam.SetPruning(PruningOptions()))
+	// the tendermint node provides the block store to the contexts of the app, it is never started: the handshake is
+	// done with an app reporting the stored state, so no block is replayed on creation
+	tmNode, err := node.NewNode(
+		&tmConfig,
+		tmTypes.NewMockPV(),
+		&p2p.NodeKey{PrivKey: ed25519.GenPrivKey()},
+		proxy.NewLocalClientCreator(replayHandshakeApp{state: state}),
+		node.DefaultGenesisDocProviderFunc(&tmConfig),
+		func(ctx *node.DBContext) (dbm.DB, error) {
+			switch ctx.ID {
+			case "blockstore":
+				return blockStoreDB, nil
+			case "state":
+				return stateDB, nil
+			default:
+				return dbm.NewMemDB(), nil
+			}
+		},
+		node.DefaultMetricsProvider(tmConfig.Instrumentation),
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	app.SetTendermintNode(tmNode)
+	return replayBlocks(app, tmNode.BlockStore(), stateDB, state, from, to, logger)
+}
+
+// opens a db of the tendermint node, the db constructor panics when the db is locked (by the running node)
+func openTendermintDB(id string, tmConfig *con.Config) (db dbm.DB, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cannot open the %s db (is the node running?): %v", id, r)
+		}
+	}()
+	return node.DefaultDBProvider(&node.DBContext{ID: id, Config: tmConfig})
+}
+
+// replays the blocks [from, to] on the app, from the state committed at from - 1
+func replayBlocks(app *PocketCoreApp, blockStore *store.BlockStore, stateDB dbm.DB, state sm.State, from, to int64, logger log.Logger) (results []ReplayResult, err error) {
+	// the app is already initialized (with the latest version), only the multistore is rewound
+	if err = app.Store().LoadVersion(from - 1); err != nil {
+		return nil, fmt.Errorf("cannot load the state at height %d (pruned? replay from a height kept by the node): %s", from-1, err.Error())
+	}
+	appConn := proxy.NewAppConnConsensus(abcicli.NewLocalClient(new(sync.Mutex), app))
+	for height := from; height <= to; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return results, fmt.Errorf("block %d not found in the block store", height)
+		}
+		// the app hash of a block is committed in the header of the next one
+		expected := state.AppHash
+		if height < state.LastBlockHeight {
+			expected = blockStore.LoadBlockMeta(height + 1).Header.AppHash
+		}
+		result := ReplayResult{Height: height, Expected: hex.EncodeToString(expected)}
+		appHash, err := execCommitBlock(appConn, block, logger, stateDB)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.AppHash = hex.EncodeToString(appHash)
+			result.Match = bytes.Equal(appHash, expected)
+		}
+		results = append(results, result)
+		if !result.Match {
+			break
+		}
+	}
+	return results, nil
+}
+
+// executes and commits a block, a commit of a different state than the one stored at the height panics in the store
+func execCommitBlock(appConn proxy.AppConnConsensus, block *tmTypes.Block, logger log.Logger, stateDB dbm.DB) (appHash []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("the state diverged: %v", r)
+		}
+	}()
+	return sm.ExecCommitBlock(appConn, block, logger, stateDB)
+}
+
+// the abci app of the replay node handshake, in sync with the stored state
+type replayHandshakeApp struct {
+	abci.BaseApplication
+	state sm.State
+}
+
+func (a replayHandshakeApp) Info(abci.RequestInfo) abci.ResponseInfo {
+	return abci.ResponseInfo{
+		AppVersion:       a.state.Version.Consensus.App.Uint64(),
+		LastBlockHeight:  a.state.LastBlockHeight,
+		LastBlockAppHash: a.state.AppHash,
+	}
+}
+
+// copies a (flat) leveldb directory
+func copyDBDir(src, dst string) error {
+	files, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dst, os.ModePerm); err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.IsDir() || f.Name() == "LOCK" {
+			continue
+		}
+		if err = copyFile(filepath.Join(src, f.Name()), filepath.Join(dst, f.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestReplayBlocks(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	for i := 0; i < 4; i++ {
+		<-evtChan // Wait for block
+	}
+	stopCli()
+	application := PCA
+	cleanup()
+	state := sm.LoadState(memDB)
+	blockStore := application.BlockStore()
+	// replay the stored blocks on the committed state
+	results, err := replayBlocks(application, blockStore, memDB, state, 2, state.LastBlockHeight, log.NewNopLogger())
+	assert.Nil(t, err)
+	assert.Len(t, results, int(state.LastBlockHeight-1))
+	for _, r := range results {
+		assert.True(t, r.Match, r.Error)
+		assert.Equal(t, r.Expected, r.AppHash)
+	}
+	assert.Equal(t, hex.EncodeToString(state.AppHash), results[len(results)-1].AppHash)
+	// a divergent app hash
+	divergent := state
+	divergent.AppHash = []byte("divergent")
+	results, err = replayBlocks(application, blockStore, memDB, divergent, state.LastBlockHeight, state.LastBlockHeight, log.NewNopLogger())
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.False(t, results[0].Match)
+	// a block out of the block store
+	results, err = replayBlocks(application, blockStore, memDB, state, 2, state.LastBlockHeight+1, log.NewNopLogger())
+	assert.NotNil(t, err)
+	assert.Len(t, results, int(state.LastBlockHeight-1))
+	// a state that is not stored
+	_, err = replayBlocks(application, blockStore, memDB, state, state.LastBlockHeight+2, state.LastBlockHeight+2, log.NewNopLogger())
+	assert.NotNil(t, err)
+}
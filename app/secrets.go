@@ -0,0 +1,89 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	log2 "log"
+	"os"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// the environment variables that override the chains secrets file, formatted with the upper case chain id
+const (
+	ChainUsernameEnvFormat    = "POCKET_CHAIN_%s_USERNAME"
+	ChainPasswordEnvFormat    = "POCKET_CHAIN_%s_PASSWORD"
+	ChainBearerTokenEnvFormat = "POCKET_CHAIN_%s_BEARER_TOKEN"
+	ChainAPIKeyParamEnvFormat = "POCKET_CHAIN_%s_API_KEY_PARAM"
+	ChainAPIKeyEnvFormat      = "POCKET_CHAIN_%s_API_KEY"
+)
+
+// "InjectChainCredentials" - Loads the credentials of the hosted chains from the chains secrets file and the
+// environment (environment takes precedence) and injects them into the hosted chains
+func InjectChainCredentials(hostedChains *types.HostedBlockchains) {
+	var secretsPath = GlobalConfig.PocketConfig.DataDir + FS + ConfigDirName + FS + GlobalConfig.PocketConfig.ChainsSecretsName
+	secrets, err := readChainSecrets(secretsPath)
+	if err != nil {
+		log2.Fatal(NewInvalidChainsError(err))
+	}
+	for id := range secrets {
+		if !hostedChains.Contains(id) {
+			log2.Println(fmt.Sprintf("credentials found in %s for %s, which is not a hosted chain", GlobalConfig.PocketConfig.ChainsSecretsName, id))
+		}
+	}
+	for id, chain := range hostedChains.M {
+		credentials := chainCredentialsFromEnv(id, secrets[id])
+		if err := credentials.Validate(); err != nil {
+			log2.Fatal(fmt.Sprintf("invalid credentials for %s: %s", id, err.Error()))
+		}
+		chain.Credentials = credentials
+		hostedChains.M[id] = chain
+	}
+}
+
+// "readChainSecrets" - Reads the chains secrets file (chain id -> credentials), a missing file means no secrets
+func readChainSecrets(path string) (secrets map[string]types.ChainCredentials, err error) {
+	secrets = make(map[string]types.ChainCredentials)
+	fi, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return secrets, nil
+		}
+		return nil, err
+	}
+	if fi.Mode().Perm()&0077 != 0 {
+		log2.Println(fmt.Sprintf("WARNING: %s is accessible by other users, consider restricting it to the owner (0600)", path))
+	}
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(bz, &secrets)
+	if err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// "chainCredentialsFromEnv" - Overrides the credentials with the environment variables of the chain (if set)
+func chainCredentialsFromEnv(id string, credentials types.ChainCredentials) types.ChainCredentials {
+	id = strings.ToUpper(id)
+	if v, ok := os.LookupEnv(fmt.Sprintf(ChainUsernameEnvFormat, id)); ok {
+		credentials.BasicAuth.Username = v
+	}
+	if v, ok := os.LookupEnv(fmt.Sprintf(ChainPasswordEnvFormat, id)); ok {
+		credentials.BasicAuth.Password = v
+	}
+	if v, ok := os.LookupEnv(fmt.Sprintf(ChainBearerTokenEnvFormat, id)); ok {
+		credentials.BearerToken = v
+	}
+	if v, ok := os.LookupEnv(fmt.Sprintf(ChainAPIKeyParamEnvFormat, id)); ok {
+		credentials.APIKeyParam = v
+	}
+	if v, ok := os.LookupEnv(fmt.Sprintf(ChainAPIKeyEnvFormat, id)); ok {
+		credentials.APIKey = v
+	}
+	return credentials
+}
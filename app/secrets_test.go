@@ -0,0 +1,40 @@
+package app
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadChainSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, DefaultChainsSecretsName)
+	// a missing file means no secrets
+	secrets, err := readChainSecrets(path)
+	assert.Nil(t, err)
+	assert.Empty(t, secrets)
+	ethereum := hex.EncodeToString([]byte{01})
+	err = ioutil.WriteFile(path, []byte(`{"`+ethereum+`": {"bearer_token": "foo"}}`), 0600)
+	assert.Nil(t, err)
+	secrets, err = readChainSecrets(path)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ChainCredentials{BearerToken: "foo"}, secrets[ethereum])
+}
+
+func TestChainCredentialsFromEnv(t *testing.T) {
+	chain := "000a"
+	assert.Nil(t, os.Setenv("POCKET_CHAIN_000A_API_KEY_PARAM", "key"))
+	assert.Nil(t, os.Setenv("POCKET_CHAIN_000A_API_KEY", "bar"))
+	defer os.Unsetenv("POCKET_CHAIN_000A_API_KEY_PARAM")
+	defer os.Unsetenv("POCKET_CHAIN_000A_API_KEY")
+	credentials := chainCredentialsFromEnv(chain, types.ChainCredentials{APIKeyParam: "api_key", APIKey: "foo", BearerToken: "baz"})
+	// the environment overrides the secrets file
+	assert.Equal(t, types.ChainCredentials{APIKeyParam: "key", APIKey: "bar", BearerToken: "baz"}, credentials)
+}
@@ -0,0 +1,512 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	log2 "log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/tendermint/tendermint/rpc/client"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	StreamSinkNATS         = "nats"       // the core protocol of a NATS server, e.g. nats://localhost:4222
+	StreamSinkKafkaREST    = "kafka-rest" // the REST proxy of a Kafka cluster, e.g. http://localhost:8082
+	StreamSinkWebhook      = "webhook"    // an http(s) endpoint the records are posted to, e.g. http://localhost:8080/events
+	StreamSinkStdout       = "stdout"     // a record per line on the standard output (no url)
+	StreamRecordBlock      = "block"      // the summary of a committed block
+	StreamRecordTx         = "tx"         // a decoded tx of the block, with its result and events
+	StreamRecordEvents     = "events"     // the begin/end block events (pocket events) of the block
+	DefaultStreamTopic     = "pocket"
+	DefaultStreamOffset    = "stream_offset.json"
+	streamPollInterval     = 5 * time.Second
+	streamMaxRetryInterval = time.Minute
+	streamTimeout          = 10 * time.Second
+	// the max blocks of a replay of the events
+	MaxEventReplayBlocks = 1000
+)
+
+// "StreamRecord" - A record published to the stream, every committed block produces a block record, a tx record
+// per tx and an events record, in this order. The delivery is at least once: the consumers dedupe by
+// (height, type, index)
+type StreamRecord struct {
+	Type   string           `json:"type"`
+	Height int64            `json:"height"`
+	Index  int              `json:"index"` // the index of the tx in the block
+	Block  *StreamBlock     `json:"block,omitempty"`
+	Tx     *StreamTx        `json:"tx,omitempty"`
+	Events sdk.StringEvents `json:"events,omitempty"`
+}
+
+// "StreamBlock" - The summary of a committed block
+type StreamBlock struct {
+	Hash     string    `json:"hash"`
+	Time     time.Time `json:"time"`
+	Proposer string    `json:"proposer"`
+	NumTxs   int64     `json:"num_txs"`
+}
+
+// "StreamTx" - A decoded tx and its result
+type StreamTx struct {
+	Hash      string `json:"hash"`
+	Msg       string `json:"msg"` // the route/type of the message, empty if the tx can't be decoded
+	Memo      string `json:"memo,omitempty"`
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace,omitempty"`
+	Log       string `json:"log,omitempty"`
+}
+
+// "StreamSink" - Where the records are published
+type StreamSink interface {
+	// publishes the records to the topic, returns once all of them are acknowledged
+	Publish(topic string, records [][]byte) error
+	Close() error
+}
+
+// "NewStreamSink" - Returns the sink of the kind (nats, kafka-rest, webhook or stdout) at the url
+func NewStreamSink(kind, rawURL string) (StreamSink, error) {
+	if kind == StreamSinkStdout {
+		return &writerSink{w: os.Stdout}, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid stream url %s", rawURL)
+	}
+	switch kind {
+	case StreamSinkNATS:
+		return &natsSink{addr: u.Host}, nil
+	case StreamSinkKafkaREST:
+		return &kafkaRESTSink{url: strings.TrimSuffix(rawURL, "/"), client: http.Client{Timeout: streamTimeout}}, nil
+	case StreamSinkWebhook:
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("invalid webhook url %s, expected an http(s) url", rawURL)
+		}
+		return &webhookSink{url: rawURL, client: http.Client{Timeout: streamTimeout}}, nil
+	default:
+		return nil, fmt.Errorf("invalid stream sink %s, expected %s, %s, %s or %s", kind, StreamSinkNATS, StreamSinkKafkaREST, StreamSinkWebhook, StreamSinkStdout)
+	}
+}
+
+// "StreamPublisher" - Publishes the committed blocks after the height of its offset file, saving the offset once
+// the records of a block are acknowledged
+type StreamPublisher struct {
+	Sink       StreamSink
+	Topic      string
+	OffsetPath string
+}
+
+type streamOffset struct {
+	Height int64 `json:"height"`
+}
+
+// "StartStreamPublisher" - Publishes the committed blocks to the stream of the config until the node stops
+func StartStreamPublisher() {
+	c := GlobalConfig.PocketConfig
+	if c.StreamSink == "" {
+		return
+	}
+	sink, err := NewStreamSink(c.StreamSink, c.StreamURL)
+	if err != nil {
+		log2.Fatal(err)
+	}
+	topic := c.StreamTopic
+	if topic == "" {
+		topic = DefaultStreamTopic
+	}
+	p := StreamPublisher{Sink: sink, Topic: topic, OffsetPath: c.DataDir + FS + DefaultStreamOffset}
+	retry := streamPollInterval
+	for {
+		if _, err := p.PublishNew(PCA.GetClient()); err != nil {
+			log2.Println(fmt.Sprintf("could not publish to the stream (retrying in %s): %s", retry, err.Error()))
+			time.Sleep(retry)
+			if retry *= 2; retry > streamMaxRetryInterval {
+				retry = streamMaxRetryInterval
+			}
+			continue
+		}
+		retry = streamPollInterval
+		time.Sleep(streamPollInterval)
+	}
+}
+
+// "PublishNew" - Publishes the blocks committed after the offset, returns the height of the last published block. With
+// no offset, the publishing starts from the latest block
+func (p StreamPublisher) PublishNew(c client.Client) (int64, error) {
+	status, err := c.Status()
+	if err != nil {
+		return 0, err
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+	offset, found, err := p.Offset()
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		offset = latest - 1
+	}
+	for height := offset + 1; height <= latest; height++ {
+		records, err := StreamRecords(c, height)
+		if err != nil {
+			return height - 1, err
+		}
+		if err := p.Sink.Publish(p.Topic, records); err != nil {
+			return height - 1, err
+		}
+		if err := p.SaveOffset(height); err != nil {
+			return height, err
+		}
+	}
+	return latest, nil
+}
+
+// "Replay" - Publishes the records of the committed blocks [from, to] again (e.g. for an external indexer to rebuild
+// its index), without moving the offset. Returns the number of published records
+func (p StreamPublisher) Replay(c client.Client, from, to int64) (published int, err error) {
+	for height := from; height <= to; height++ {
+		records, err := StreamRecords(c, height)
+		if err != nil {
+			return published, err
+		}
+		if err := p.Sink.Publish(p.Topic, records); err != nil {
+			return published, err
+		}
+		published += len(records)
+	}
+	return published, nil
+}
+
+// "EventReplay" - The outcome of the replay of the events of the blocks [from, to]
+type EventReplay struct {
+	From    int64             `json:"from"`
+	To      int64             `json:"to"`
+	Records int               `json:"records"`          // the number of published records
+	Output  []json.RawMessage `json:"output,omitempty"` // the records, if replayed to the stdout (of the caller)
+}
+
+// "ReplayEvents" - Publishes the records (blocks, txs and events) of the committed blocks [from, to] to the sink of
+// the kind (see NewStreamSink) at the url, or returns them for the stdout sink. At most MaxEventReplayBlocks blocks
+func (app *PocketCoreApp) ReplayEvents(from, to int64, kind, rawURL, topic string) (res EventReplay, err error) {
+	if from < 1 || to < from {
+		return res, fmt.Errorf("invalid range [%d, %d]: from must be above 0 and to must not be below from", from, to)
+	}
+	if to-from >= MaxEventReplayBlocks {
+		return res, fmt.Errorf("the range [%d, %d] exceeds the max of %d blocks per replay", from, to, MaxEventReplayBlocks)
+	}
+	if latest := app.LastBlockHeight(); to > latest {
+		return res, fmt.Errorf("the block %d is not committed, the latest block is %d", to, latest)
+	}
+	if topic == "" {
+		topic = DefaultStreamTopic
+	}
+	var sink StreamSink
+	output := &memorySink{}
+	if kind == StreamSinkStdout {
+		sink = output
+	} else if sink, err = NewStreamSink(kind, rawURL); err != nil {
+		return res, err
+	}
+	defer sink.Close()
+	res = EventReplay{From: from, To: to}
+	res.Records, err = StreamPublisher{Sink: sink, Topic: topic}.Replay(app.GetClient(), from, to)
+	for _, record := range output.records {
+		res.Output = append(res.Output, record)
+	}
+	return res, err
+}
+
+// "Offset" - Returns the height of the last published block
+func (p StreamPublisher) Offset() (height int64, found bool, err error) {
+	bz, err := ioutil.ReadFile(p.OffsetPath)
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	var o streamOffset
+	if err := json.Unmarshal(bz, &o); err != nil {
+		return 0, false, fmt.Errorf("invalid stream offset file %s: %s", p.OffsetPath, err.Error())
+	}
+	return o.Height, true, nil
+}
+
+// "SaveOffset" - Saves the height of the last published block (replacing the offset file, so it is never half written)
+func (p StreamPublisher) SaveOffset(height int64) error {
+	bz, err := json.Marshal(streamOffset{Height: height})
+	if err != nil {
+		return err
+	}
+	tmp := p.OffsetPath + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p.OffsetPath)
+}
+
+// "StreamRecords" - Returns the (json) records of the committed block
+func StreamRecords(c client.Client, height int64) (records [][]byte, err error) {
+	b, err := c.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.BlockResults(&height)
+	if err != nil {
+		return nil, err
+	}
+	add := func(r StreamRecord) {
+		if err != nil {
+			return
+		}
+		var bz []byte
+		bz, err = json.Marshal(r)
+		records = append(records, bz)
+	}
+	add(StreamRecord{Type: StreamRecordBlock, Height: height, Block: &StreamBlock{
+		Hash:     b.BlockMeta.BlockID.Hash.String(),
+		Time:     b.Block.Time,
+		Proposer: b.Block.ProposerAddress.String(),
+		NumTxs:   b.Block.NumTxs,
+	}})
+	decoder := auth.DefaultTxDecoder(cdc)
+	for i, txBytes := range b.Block.Txs {
+		tx := &StreamTx{Hash: fmt.Sprintf("%X", tmTypes.Tx(txBytes).Hash())}
+		if t, er := decoder(txBytes); er == nil {
+			if msg := t.GetMsg(); msg != nil {
+				tx.Msg = msg.Route() + "/" + msg.Type()
+			}
+			if stdTx, ok := t.(auth.StdTx); ok {
+				tx.Memo = stdTx.Memo
+			}
+		}
+		record := StreamRecord{Type: StreamRecordTx, Height: height, Index: i, Tx: tx}
+		if i < len(res.Results.DeliverTx) && res.Results.DeliverTx[i] != nil {
+			r := res.Results.DeliverTx[i]
+			tx.Code, tx.Codespace, tx.Log = r.Code, r.Codespace, r.Log
+			record.Events = sdk.StringifyEvents(r.Events)
+		}
+		add(record)
+	}
+	events := sdk.StringEvents{}
+	if res.Results.BeginBlock != nil {
+		events = append(events, sdk.StringifyEvents(res.Results.BeginBlock.Events)...)
+	}
+	if res.Results.EndBlock != nil {
+		events = append(events, sdk.StringifyEvents(res.Results.EndBlock.Events)...)
+	}
+	add(StreamRecord{Type: StreamRecordEvents, Height: height, Events: events})
+	return records, err
+}
+
+// publishes through the NATS core protocol in verbose mode, so every message is acknowledged by the server
+type natsSink struct {
+	addr string
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func (s *natsSink) connect() error {
+	conn, err := net.DialTimeout("tcp", s.addr, streamTimeout)
+	if err != nil {
+		return err
+	}
+	s.conn, s.r = conn, bufio.NewReader(conn)
+	// the server greets with its info
+	if _, err := s.readLine(); err != nil {
+		_ = s.Close()
+		return err
+	}
+	if _, err := fmt.Fprintf(conn, "CONNECT {\"verbose\":true,\"pedantic\":false}\r\n"); err != nil {
+		_ = s.Close()
+		return err
+	}
+	return s.ack()
+}
+
+func (s *natsSink) readLine() (string, error) {
+	_ = s.conn.SetReadDeadline(time.Now().Add(streamTimeout))
+	line, err := s.r.ReadString('\n')
+	return strings.TrimSpace(line), err
+}
+
+// waits for the acknowledgement of the last operation, answering the pings of the server
+func (s *natsSink) ack() error {
+	for {
+		line, err := s.readLine()
+		if err != nil {
+			return err
+		}
+		switch {
+		case line == "+OK":
+			return nil
+		case line == "PING":
+			if _, err := fmt.Fprintf(s.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats: %s", line)
+		}
+	}
+}
+
+func (s *natsSink) Publish(topic string, records [][]byte) error {
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			return err
+		}
+	}
+	for _, record := range records {
+		_ = s.conn.SetWriteDeadline(time.Now().Add(streamTimeout))
+		_, err := fmt.Fprintf(s.conn, "PUB %s %d\r\n%s\r\n", topic, len(record), record)
+		if err == nil {
+			err = s.ack()
+		}
+		if err != nil {
+			// reconnect on the next publish
+			_ = s.Close()
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *natsSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn, s.r = nil, nil
+	return err
+}
+
+// publishes through the REST proxy of a Kafka cluster (v2 api)
+type kafkaRESTSink struct {
+	url    string
+	client http.Client
+}
+
+type kafkaRESTRecords struct {
+	Records []kafkaRESTRecord `json:"records"`
+}
+
+type kafkaRESTRecord struct {
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaRESTResponse struct {
+	Offsets []struct {
+		Error string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (s *kafkaRESTSink) Publish(topic string, records [][]byte) error {
+	body := kafkaRESTRecords{Records: make([]kafkaRESTRecord, len(records))}
+	for i, record := range records {
+		body.Records[i] = kafkaRESTRecord{Value: record}
+	}
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url+"/topics/"+url.PathEscape(topic), "application/vnd.kafka.json.v2+json", bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("kafka rest: unexpected status %s", resp.Status)
+	}
+	// the records failed individually are reported in the offsets
+	var res kafkaRESTResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return fmt.Errorf("kafka rest: invalid response: %s", err.Error())
+	}
+	for _, o := range res.Offsets {
+		if o.Error != "" {
+			return fmt.Errorf("kafka rest: %s", o.Error)
+		}
+	}
+	return nil
+}
+
+func (s *kafkaRESTSink) Close() error {
+	return nil
+}
+
+// posts the records to a webhook: {"topic": <topic>, "records": [<record>...]}
+type webhookSink struct {
+	url    string
+	client http.Client
+}
+
+type webhookRecords struct {
+	Topic   string            `json:"topic"`
+	Records []json.RawMessage `json:"records"`
+}
+
+func (s *webhookSink) Publish(topic string, records [][]byte) error {
+	body := webhookRecords{Topic: topic, Records: make([]json.RawMessage, len(records))}
+	for i, record := range records {
+		body.Records[i] = record
+	}
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *webhookSink) Close() error {
+	return nil
+}
+
+// writes a record per line
+type writerSink struct {
+	w io.Writer
+}
+
+func (s *writerSink) Publish(topic string, records [][]byte) error {
+	for _, record := range records {
+		if _, err := fmt.Fprintf(s.w, "%s\n", record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *writerSink) Close() error {
+	return nil
+}
+
+// keeps the records, returned to the caller of a replay
+type memorySink struct {
+	records [][]byte
+}
+
+func (s *memorySink) Publish(topic string, records [][]byte) error {
+	s.records = append(s.records, records...)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	return nil
+}
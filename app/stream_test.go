@@ -0,0 +1,200 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+type memStreamSink struct {
+	records [][]byte
+	fail    bool
+}
+
+func (s *memStreamSink) Publish(topic string, records [][]byte) error {
+	if s.fail {
+		return fmt.Errorf("unavailable")
+	}
+	s.records = append(s.records, records...)
+	return nil
+}
+
+func (s *memStreamSink) Close() error {
+	return nil
+}
+
+func TestStreamPublisher(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for another block
+	dir, err := ioutil.TempDir("", "stream")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	sink := &memStreamSink{}
+	p := StreamPublisher{Sink: sink, Topic: DefaultStreamTopic, OffsetPath: dir + FS + DefaultStreamOffset}
+	// resume from the offset
+	assert.Nil(t, p.SaveOffset(0))
+	latest, err := p.PublishNew(PCA.GetClient())
+	assert.Nil(t, err)
+	assert.True(t, latest >= 2)
+	offset, found, err := p.Offset()
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, latest, offset)
+	// a block and an events record per block (no txs)
+	assert.Len(t, sink.records, int(2*latest))
+	var r StreamRecord
+	assert.Nil(t, json.Unmarshal(sink.records[0], &r))
+	assert.Equal(t, StreamRecordBlock, r.Type)
+	assert.Equal(t, int64(1), r.Height)
+	assert.NotEmpty(t, r.Block.Hash)
+	assert.Nil(t, json.Unmarshal(sink.records[1], &r))
+	assert.Equal(t, StreamRecordEvents, r.Type)
+	// the offset is not moved when the publishing fails
+	sink.fail = true
+	assert.Nil(t, p.SaveOffset(0))
+	_, err = p.PublishNew(PCA.GetClient())
+	assert.NotNil(t, err)
+	offset, _, _ = p.Offset()
+	assert.Equal(t, int64(0), offset)
+
+	cleanup()
+	stopCli()
+}
+
+func TestStreamPublisher_Replay(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for another block
+	sink := &memStreamSink{}
+	p := StreamPublisher{Sink: sink, Topic: DefaultStreamTopic}
+	published, err := p.Replay(PCA.GetClient(), 2, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, published)
+	var r StreamRecord
+	assert.Nil(t, json.Unmarshal(sink.records[0], &r))
+	assert.Equal(t, int64(2), r.Height)
+	// the records of the stdout sink are returned
+	res, err := PCA.ReplayEvents(1, 2, StreamSinkStdout, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, res.Records)
+	assert.Len(t, res.Output, 4)
+	assert.Nil(t, json.Unmarshal(res.Output[2], &r))
+	assert.Equal(t, sink.records[0], []byte(res.Output[2]))
+	// invalid ranges
+	_, err = PCA.ReplayEvents(0, 2, StreamSinkStdout, "", "")
+	assert.NotNil(t, err)
+	_, err = PCA.ReplayEvents(2, 1, StreamSinkStdout, "", "")
+	assert.NotNil(t, err)
+	_, err = PCA.ReplayEvents(1, MaxEventReplayBlocks+1, StreamSinkStdout, "", "")
+	assert.NotNil(t, err)
+	_, err = PCA.ReplayEvents(1, PCA.LastBlockHeight()+10, StreamSinkStdout, "", "")
+	assert.NotNil(t, err)
+
+	cleanup()
+	stopCli()
+}
+
+func TestStreamSinkWebhook(t *testing.T) {
+	var got webhookRecords
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&got))
+		if len(got.Records) > 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	sink, err := NewStreamSink(StreamSinkWebhook, server.URL+"/events")
+	assert.Nil(t, err)
+	assert.Nil(t, sink.Publish("pocket", [][]byte{[]byte(`{"height":1}`)}))
+	assert.Equal(t, "pocket", got.Topic)
+	assert.Equal(t, `{"height":1}`, string(got.Records[0]))
+	assert.NotNil(t, sink.Publish("pocket", [][]byte{[]byte(`{"height":1}`), []byte(`{"height":2}`)}))
+	_, err = NewStreamSink(StreamSinkWebhook, "nats://localhost:4222")
+	assert.NotNil(t, err)
+}
+
+func TestStreamSinkWriter(t *testing.T) {
+	var out strings.Builder
+	sink := &writerSink{w: &out}
+	assert.Nil(t, sink.Publish("pocket", [][]byte{[]byte(`{"height":1}`), []byte(`{"height":2}`)}))
+	assert.Equal(t, "{\"height\":1}\n{\"height\":2}\n", out.String())
+	_, err := NewStreamSink(StreamSinkStdout, "")
+	assert.Nil(t, err)
+}
+
+func TestStreamSinkKafkaREST(t *testing.T) {
+	var got kafkaRESTRecords
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/pocket", r.URL.Path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&got))
+		if len(got.Records) > 1 {
+			_, _ = w.Write([]byte(`{"offsets":[{"offset":0},{"error_code":50002,"error":"leader not available"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":0}]}`))
+	}))
+	defer server.Close()
+	sink, err := NewStreamSink(StreamSinkKafkaREST, server.URL)
+	assert.Nil(t, err)
+	assert.Nil(t, sink.Publish("pocket", [][]byte{[]byte(`{"height":1}`)}))
+	assert.Equal(t, `{"height":1}`, string(got.Records[0].Value))
+	// a record failed individually fails the publishing
+	assert.NotNil(t, sink.Publish("pocket", [][]byte{[]byte(`{"height":1}`), []byte(`{"height":2}`)}))
+	_, err = NewStreamSink("kafka", server.URL)
+	assert.NotNil(t, err)
+}
+
+func TestStreamSinkNATS(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer l.Close()
+	published := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		_, _ = fmt.Fprintf(conn, "INFO {}\r\n")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch {
+			case strings.HasPrefix(line, "CONNECT"):
+				_, _ = fmt.Fprintf(conn, "+OK\r\n")
+			case strings.HasPrefix(line, "PUB"):
+				payload, _ := r.ReadString('\n')
+				// the server may ping before acknowledging
+				_, _ = fmt.Fprintf(conn, "PING\r\n")
+				if pong, _ := r.ReadString('\n'); pong != "PONG\r\n" {
+					return
+				}
+				_, _ = fmt.Fprintf(conn, "+OK\r\n")
+				published <- strings.TrimSpace(line) + " " + strings.TrimSpace(payload)
+			}
+		}
+	}()
+	sink, err := NewStreamSink(StreamSinkNATS, "nats://"+l.Addr().String())
+	assert.Nil(t, err)
+	defer sink.Close()
+	assert.Nil(t, sink.Publish("pocket", [][]byte{[]byte(`{"height":1}`)}))
+	assert.Equal(t, `PUB pocket 12 {"height":1}`, <-published)
+}
@@ -0,0 +1,264 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	log2 "log"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+)
+
+const (
+	UpgradesDirName       = "upgrades"
+	UpgradeInfoFileName   = "upgrade-info.json"
+	currentUpgradeName    = "current"
+	upgradeBinaryName     = "pocket"
+	upgradeChecksumSuffix = ".sha256"
+	// how often the supervisor checks the upgrade plan of the network
+	DefaultUpgradeWatchInterval = time.Minute
+)
+
+// "UpgradeInfo" - The upgrade the node halted for, written to the data directory for the supervisor
+type UpgradeInfo struct {
+	Height  int64  `json:"height"`
+	Version string `json:"version"`
+}
+
+// "WriteUpgradeInfo" - Writes the upgrade info to the data directory
+func WriteUpgradeInfo(datadir string, info UpgradeInfo) error {
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(datadir, UpgradeInfoFileName), bz, 0644)
+}
+
+// "ReadUpgradeInfo" - Reads the upgrade info from the data directory
+func ReadUpgradeInfo(datadir string) (info UpgradeInfo, err error) {
+	bz, err := ioutil.ReadFile(filepath.Join(datadir, UpgradeInfoFileName))
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(bz, &info)
+	if err == nil && info.Version == "" {
+		err = fmt.Errorf("no version in %s", UpgradeInfoFileName)
+	}
+	return
+}
+
+// "Supervisor" - Runs the node as a child process and restarts it with the binary of the upgrade when it halts at the
+// upgrade height. The binaries live in <datadir>/upgrades/<version>/bin/pocket, the current one is linked by
+// <datadir>/upgrades/current (the binary of the supervisor until the first upgrade)
+type Supervisor struct {
+	DataDir     string       // the data directory of the node
+	URLTemplate string       // the url of the binary of an upgrade: {version}, {os} and {arch} are replaced, empty for no download
+	Client      *http.Client // the client downloading the binaries
+}
+
+// "NewSupervisor" - Returns a supervisor of the node in the data directory
+func NewSupervisor(datadir, urlTemplate string) *Supervisor {
+	return &Supervisor{DataDir: datadir, URLTemplate: urlTemplate, Client: &http.Client{Timeout: 10 * time.Minute}}
+}
+
+// "BinaryPath" - Returns the path of the binary of the version
+func (s *Supervisor) BinaryPath(version string) string {
+	return filepath.Join(s.DataDir, UpgradesDirName, version, "bin", upgradeBinaryName)
+}
+
+// "CurrentBinary" - Returns the path of the binary the node runs with
+func (s *Supervisor) CurrentBinary() (string, error) {
+	current := filepath.Join(s.DataDir, UpgradesDirName, currentUpgradeName, "bin", upgradeBinaryName)
+	if _, err := os.Stat(current); err == nil {
+		return current, nil
+	}
+	return os.Executable()
+}
+
+// "SetCurrent" - Links the binary of the version as the current binary
+func (s *Supervisor) SetCurrent(version string) error {
+	if _, err := os.Stat(s.BinaryPath(version)); err != nil {
+		return err
+	}
+	link := filepath.Join(s.DataDir, UpgradesDirName, currentUpgradeName)
+	tmp := link + ".tmp"
+	_ = os.Remove(tmp)
+	if err := os.Symlink(version, tmp); err != nil {
+		return err
+	}
+	// replace the link atomically
+	return os.Rename(tmp, link)
+}
+
+// "Prepare" - Ensures the binary of the version is in the upgrades directory, downloading it (if not placed by the
+// operator) and validating its checksum, then validating it runs the version
+func (s *Supervisor) Prepare(version string) error {
+	path := s.BinaryPath(version)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if s.URLTemplate == "" {
+			return fmt.Errorf("no binary for version %s at %s and no upgrade url template in the config", version, path)
+		}
+		if err := s.download(version, path); err != nil {
+			return err
+		}
+	}
+	out, err := exec.Command(path, "version").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("cannot run the binary of version %s: %s", version, err.Error())
+	}
+	if !strings.Contains(string(out), version) {
+		return fmt.Errorf("the binary at %s is not version %s: %s", path, version, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// downloads the binary of the version to path, verifying its sha256 checksum
+func (s *Supervisor) download(version, path string) error {
+	url := strings.NewReplacer("{version}", version, "{os}", runtime.GOOS, "{arch}", runtime.GOARCH).Replace(s.URLTemplate)
+	checksum, err := s.get(url + upgradeChecksumSuffix)
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(string(checksum))
+	if len(fields) == 0 {
+		return fmt.Errorf("empty checksum at %s", url+upgradeChecksumSuffix)
+	}
+	bin, err := s.get(url)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(bin)
+	if !strings.EqualFold(hex.EncodeToString(sum[:]), fields[0]) {
+		return fmt.Errorf("the checksum of the binary at %s does not match %s", url, fields[0])
+	}
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	tmp := path + ".download"
+	if err := ioutil.WriteFile(tmp, bin, 0755); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *Supervisor) get(url string) ([]byte, error) {
+	resp, err := s.Client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download %s: %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// "Run" - Runs the node with the args until it exits for any reason but an upgrade, returning its exit code. At an
+// upgrade, the binary of the upgrade is prepared and linked as the current binary before restarting the node
+func (s *Supervisor) Run(args []string, stdout, stderr io.Writer) int {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
+	defer signal.Stop(signals)
+	for {
+		bin, err := s.CurrentBinary()
+		if err != nil {
+			log2.Println(fmt.Sprintf("cannot find the current binary: %s", err.Error()))
+			return 1
+		}
+		cmd := exec.Command(bin, args...)
+		cmd.Stdout, cmd.Stderr = stdout, stderr
+		if err := cmd.Start(); err != nil {
+			log2.Println(fmt.Sprintf("cannot start %s: %s", bin, err.Error()))
+			return 1
+		}
+		// forward the kill signals to the node
+		done := make(chan struct{})
+		go func() {
+			for {
+				select {
+				case sig := <-signals:
+					_ = cmd.Process.Signal(sig)
+				case <-done:
+					return
+				}
+			}
+		}()
+		// the exit code is the outcome, not the error
+		_ = cmd.Wait()
+		close(done)
+		code := cmd.ProcessState.ExitCode()
+		if code != UpgradeHaltExitCode {
+			return code
+		}
+		info, err := ReadUpgradeInfo(s.DataDir)
+		if err != nil {
+			log2.Println(fmt.Sprintf("the node halted for an upgrade, but the upgrade info can't be read: %s", err.Error()))
+			return code
+		}
+		if err := s.Prepare(info.Version); err != nil {
+			log2.Println(fmt.Sprintf("cannot prepare the upgrade to version %s: %s", info.Version, err.Error()))
+			return code
+		}
+		if err := s.SetCurrent(info.Version); err != nil {
+			log2.Println(fmt.Sprintf("cannot switch to the binary of version %s: %s", info.Version, err.Error()))
+			return code
+		}
+		_ = os.Remove(filepath.Join(s.DataDir, UpgradeInfoFileName))
+		log2.Println(fmt.Sprintf("upgraded to version %s at height %d, restarting the node", info.Version, info.Height))
+	}
+}
+
+// "Watch" - Checks the upgrade plan of the network with the rpc of the node every interval, preparing the binary of
+// a scheduled upgrade ahead of the upgrade height, until stopped
+func (s *Supervisor) Watch(rpcURL string, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	prepared := ""
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		upgrade, err := s.queryUpgrade(rpcURL)
+		if err != nil || upgrade.Height == 0 || upgrade.Version == prepared || !isOutdatedVersion(AppVersion, upgrade.Version) {
+			continue
+		}
+		if err := s.Prepare(upgrade.Version); err != nil {
+			log2.Println(fmt.Sprintf("cannot prepare the upgrade to version %s at height %d: %s", upgrade.Version, upgrade.Height, err.Error()))
+			continue
+		}
+		prepared = upgrade.Version
+		log2.Println(fmt.Sprintf("the binary of the upgrade to version %s at height %d is ready", upgrade.Version, upgrade.Height))
+	}
+}
+
+func (s *Supervisor) queryUpgrade(rpcURL string) (upgrade govTypes.Upgrade, err error) {
+	resp, err := s.Client.Post(rpcURL+"/v1/query/upgrade", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return upgrade, fmt.Errorf("cannot query the upgrade: %s", resp.Status)
+	}
+	// the upgrade is written as a json string
+	var res string
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return
+	}
+	err = json.Unmarshal([]byte(res), &upgrade)
+	return
+}
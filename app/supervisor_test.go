@@ -0,0 +1,107 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// a script binary printing the version and exiting with the code on start
+func testBinary(version string, startCode int) []byte {
+	script := "#!/bin/sh\nif [ \"$1\" = \"version\" ]; then echo \"AppVersion: " + version + "\"; exit 0; fi\n"
+	// only a halting binary writes the upgrade info
+	if startCode == UpgradeHaltExitCode {
+		script += "if [ -n \"$3\" ]; then echo '{\"height\":10,\"version\":\"'$3'\"}' > \"$2/" + UpgradeInfoFileName + "\"; fi\n"
+	}
+	return []byte(script + "exit " + string(rune('0'+startCode)) + "\n")
+}
+
+func placeTestBinary(t *testing.T, s *Supervisor, version string, bin []byte) {
+	assert.Nil(t, os.MkdirAll(filepath.Dir(s.BinaryPath(version)), os.ModePerm))
+	assert.Nil(t, ioutil.WriteFile(s.BinaryPath(version), bin, 0755))
+}
+
+func TestSupervisor_Prepare(t *testing.T) {
+	dir, err := ioutil.TempDir("", "supervisor")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	bin := testBinary("RC-9.0.0", 0)
+	sum := sha256.Sum256(bin)
+	checksum := hex.EncodeToString(sum[:])
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/RC-9.0.0/pocket", "/RC-9.1.0/pocket":
+			_, _ = w.Write(bin)
+		case "/RC-9.0.0/pocket.sha256":
+			_, _ = w.Write([]byte(checksum + "  pocket\n"))
+		case "/RC-9.1.0/pocket.sha256":
+			_, _ = w.Write([]byte(hex.EncodeToString(make([]byte, 32))))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	s := NewSupervisor(dir, "")
+	// no binary and no url
+	assert.NotNil(t, s.Prepare("RC-9.0.0"))
+	// the binary is downloaded and validated
+	s.URLTemplate = srv.URL + "/{version}/pocket"
+	assert.Nil(t, s.Prepare("RC-9.0.0"))
+	got, err := ioutil.ReadFile(s.BinaryPath("RC-9.0.0"))
+	assert.Nil(t, err)
+	assert.Equal(t, bin, got)
+	// the checksum doesn't match
+	assert.NotNil(t, s.Prepare("RC-9.1.0"))
+	_, err = os.Stat(s.BinaryPath("RC-9.1.0"))
+	assert.True(t, os.IsNotExist(err))
+	// not found
+	assert.NotNil(t, s.Prepare("RC-9.2.0"))
+	// a binary placed by the operator must run the version
+	placeTestBinary(t, s, "RC-9.3.0", testBinary("RC-9.0.0", 0))
+	assert.NotNil(t, s.Prepare("RC-9.3.0"))
+}
+
+func TestSupervisor_Run(t *testing.T) {
+	dir, err := ioutil.TempDir("", "supervisor")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	s := NewSupervisor(dir, "")
+	// v1 halts for the upgrade to v2, v2 exits with 7
+	placeTestBinary(t, s, "v1", testBinary("v1", UpgradeHaltExitCode))
+	placeTestBinary(t, s, "v2", testBinary("v2", 7))
+	assert.Nil(t, s.SetCurrent("v1"))
+	current, err := s.CurrentBinary()
+	assert.Nil(t, err)
+	assert.Contains(t, current, "current")
+	assert.Equal(t, 7, s.Run([]string{"start", dir, "v2"}, ioutil.Discard, ioutil.Discard))
+	// the node runs the binary of the upgrade
+	link, err := os.Readlink(filepath.Join(dir, UpgradesDirName, currentUpgradeName))
+	assert.Nil(t, err)
+	assert.Equal(t, "v2", link)
+	_, err = os.Stat(filepath.Join(dir, UpgradeInfoFileName))
+	assert.True(t, os.IsNotExist(err))
+	// an upgrade without its binary stops the supervisor with the halt code
+	assert.Nil(t, s.SetCurrent("v1"))
+	assert.Equal(t, UpgradeHaltExitCode, s.Run([]string{"start", dir, "v3"}, ioutil.Discard, ioutil.Discard))
+}
+
+func TestSupervisor_QueryUpgrade(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bz, _ := json.Marshal(govTypes.NewUpgrade(100, "RC-9.0.0"))
+		res, _ := json.Marshal(string(bz))
+		_, _ = w.Write(res)
+	}))
+	defer srv.Close()
+	upgrade, err := NewSupervisor("", "").queryUpgrade(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, govTypes.NewUpgrade(100, "RC-9.0.0"), upgrade)
+}
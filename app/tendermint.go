@@ -8,6 +8,7 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 	"io"
 	"os"
@@ -35,10 +36,17 @@ func NewClient(c config, creator AppCreator) (*node.Node, *PocketCoreApp, error)
 	// upgrade the privVal file
 	upgradePrivVal(c.TmConfig)
 	app := creator(c.Logger, db, traceWriter)
+	var privVal types.PrivValidator
+	if GlobalConfig.PocketConfig.Replica {
+		// a replica never signs: an ephemeral key outside of the validator set, even with a copy of the validator key
+		privVal = types.NewMockPV()
+	} else {
+		privVal = pvm.LoadOrGenFilePV(c.TmConfig.PrivValidatorKeyFile(), c.TmConfig.PrivValidatorStateFile())
+	}
 	// create & start tendermint node
 	tmNode, err := node.NewNode(
 		c.TmConfig,
-		pvm.LoadOrGenFilePV(c.TmConfig.PrivValidatorKeyFile(), c.TmConfig.PrivValidatorStateFile()),
+		privVal,
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		node.DefaultGenesisDocProviderFunc(c.TmConfig),
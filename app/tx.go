@@ -1,13 +1,33 @@
 package app
 
 import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	posCrypto "github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
 	"github.com/pokt-network/posmint/x/auth/util"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+	mempl "github.com/tendermint/tendermint/mempool"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	BroadcastModeAsync  = "async"  // returns right after the tx is submitted
+	BroadcastModeSync   = "sync"   // returns the CheckTx result
+	BroadcastModeCommit = "commit" // returns the CheckTx and DeliverTx results, once the tx is included in a block
 )
 
 // SendRawTx - Deliver tx bytes to node
 func (app PocketCoreApp) SendRawTx(fromAddr string, txBytes []byte) (sdk.TxResponse, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	if GlobalConfig.PocketConfig.Replica {
+		return sdk.TxResponse{}, ReplicaBroadcastError
+	}
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return sdk.TxResponse{}, err
 	}
@@ -21,3 +41,231 @@ func (app PocketCoreApp) SendRawTx(fromAddr string, txBytes []byte) (sdk.TxRespo
 	cliCtx.BroadcastMode = util.BroadcastSync
 	return cliCtx.BroadcastTx(txBytes)
 }
+
+// RawTxResponse - The result of broadcasting a signed transaction
+type RawTxResponse struct {
+	TxHash    string       `json:"txhash"`
+	Height    int64        `json:"height,omitempty"`
+	Tx        auth.StdTx   `json:"tx"`
+	CheckTx   *RawTxResult `json:"check_tx,omitempty"`
+	DeliverTx *RawTxResult `json:"deliver_tx,omitempty"`
+}
+
+// RawTxResult - The decoded ABCI result of a transaction
+type RawTxResult struct {
+	Code      uint32           `json:"code"`
+	Codespace string           `json:"codespace,omitempty"`
+	Log       string           `json:"log,omitempty"`
+	Events    sdk.StringEvents `json:"events,omitempty"`
+}
+
+func newRawTxResult(code uint32, codespace, log string, events []abci.Event) *RawTxResult {
+	return &RawTxResult{Code: code, Codespace: codespace, Log: log, Events: sdk.StringifyEvents(events)}
+}
+
+// BroadcastRawTx - Decodes and broadcasts the amino encoded signed tx in the broadcast mode,
+// returning the hash and the decoded results
+func (app PocketCoreApp) BroadcastRawTx(txBytes []byte, mode string) (res RawTxResponse, err error) {
+	if GlobalConfig.PocketConfig.Replica {
+		return res, ReplicaBroadcastError
+	}
+	tx, er := auth.DefaultTxDecoder(cdc)(txBytes)
+	if er != nil {
+		return res, er
+	}
+	stdTx, ok := tx.(auth.StdTx)
+	if !ok {
+		return res, fmt.Errorf("unexpected tx type %T", tx)
+	}
+	res = RawTxResponse{TxHash: fmt.Sprintf("%X", tmTypes.Tx(txBytes).Hash()), Tx: stdTx}
+	switch mode {
+	case BroadcastModeAsync:
+		tmClient := getTMClient()
+		defer func() { _ = tmClient.Stop() }()
+		_, err = tmClient.BroadcastTxAsync(txBytes)
+	case BroadcastModeSync, "":
+		// check the tx through the local mempool, the rpc result doesn't include the events
+		resCh := make(chan *abci.Response, 1)
+		err = app.TMNode().Mempool().CheckTx(txBytes, func(r *abci.Response) {
+			resCh <- r
+		}, mempl.TxInfo{})
+		if err != nil {
+			return res, err
+		}
+		r := (<-resCh).GetCheckTx()
+		res.CheckTx = newRawTxResult(r.Code, r.Codespace, r.Log, r.Events)
+	case BroadcastModeCommit:
+		tmClient := getTMClient()
+		defer func() { _ = tmClient.Stop() }()
+		r, er := tmClient.BroadcastTxCommit(txBytes)
+		if er != nil {
+			return res, er
+		}
+		res.Height = r.Height
+		res.CheckTx = newRawTxResult(r.CheckTx.Code, r.CheckTx.Codespace, r.CheckTx.Log, r.CheckTx.Events)
+		if r.CheckTx.IsOK() {
+			res.DeliverTx = newRawTxResult(r.DeliverTx.Code, r.DeliverTx.Codespace, r.DeliverTx.Log, r.DeliverTx.Events)
+		}
+	default:
+		err = fmt.Errorf("unsupported broadcast mode %s, supported modes: %s, %s, %s", mode, BroadcastModeAsync, BroadcastModeSync, BroadcastModeCommit)
+	}
+	return res, err
+}
+
+// UnsignedTx - A transaction without its signature and the bytes to sign with the key of its signer, to sign it
+// offline (e.g. an air gapped key)
+type UnsignedTx struct {
+	Tx        auth.StdTx `json:"tx"`
+	Signer    string     `json:"signer"`     // the address of the key signing the tx
+	SignBytes string     `json:"sign_bytes"` // the hex bytes to sign
+}
+
+// BuildUnsignedTx - Builds the unsigned tx of the amino json msg (of any type: send, stake, unstake, claim...) with the
+// fee of the msg and a new entropy, for the chain of this node
+func (app PocketCoreApp) BuildUnsignedTx(msgJSON []byte, memo string) (res UnsignedTx, err error) {
+	var msg sdk.Msg
+	if err = cdc.UnmarshalJSON(msgJSON, &msg); err != nil {
+		return res, fmt.Errorf("invalid msg: %s", err.Error())
+	}
+	if er := msg.ValidateBasic(); er != nil {
+		return res, er
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, msg.GetFee()))
+	tx := auth.StdTx{Msg: msg, Fee: fee, Memo: memo, Entropy: common.RandInt64()}
+	signBytes, err := auth.StdSignBytes(app.chainID(), tx.Entropy, tx.Fee, tx.Msg, tx.Memo)
+	if err != nil {
+		return res, err
+	}
+	return UnsignedTx{Tx: tx, Signer: msg.GetSigner().String(), SignBytes: hex.EncodeToString(signBytes)}, nil
+}
+
+// AssembleSignedTx - Attaches the signature produced offline and the public key of the signer to the unsigned tx,
+// verifying them against the signer and the sign bytes of the tx, and returns the amino encoded signed tx
+func (app PocketCoreApp) AssembleSignedTx(tx auth.StdTx, publicKey string, signature []byte) ([]byte, error) {
+	if tx.Msg == nil {
+		return nil, fmt.Errorf("the tx has no msg")
+	}
+	pk, err := posCrypto.NewPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %s", err.Error())
+	}
+	if !bytes.Equal(pk.Address(), tx.GetSigner()) {
+		return nil, fmt.Errorf("the public key (%s) is not the key of the signer of the tx (%s)", sdk.Address(pk.Address()).String(), tx.GetSigner().String())
+	}
+	signBytes, err := auth.StdSignBytes(app.chainID(), tx.Entropy, tx.Fee, tx.Msg, tx.Memo)
+	if err != nil {
+		return nil, err
+	}
+	if !pk.VerifyBytes(signBytes, signature) {
+		return nil, fmt.Errorf("the signature doesn't match the sign bytes of the tx")
+	}
+	tx.Signature = auth.StdSignature{PublicKey: pk, Signature: signature}
+	if er := tx.ValidateBasic(); er != nil {
+		return nil, er
+	}
+	return auth.DefaultTxEncoder(cdc)(tx)
+}
+
+// PartialSignature - The signature of the sign bytes of a multisig tx by one of the keys of the multisig
+type PartialSignature struct {
+	PublicKey string `json:"public_key"` // the hex public key of the signer
+	Signature string `json:"signature"`  // the hex signature of the sign bytes
+}
+
+// AssembleMultiSignedTx - Combines the partial signatures, collected in any order, into the multisignature of the
+// multisig public key of the ordered public keys and attaches it to the unsigned tx, verifying every partial signature
+// against the sign bytes of the tx. Every key of the multisig must sign (n of n), and returns the amino encoded signed tx
+func (app PocketCoreApp) AssembleMultiSignedTx(tx auth.StdTx, publicKeys []string, signatures []PartialSignature) ([]byte, error) {
+	if tx.Msg == nil {
+		return nil, fmt.Errorf("the tx has no msg")
+	}
+	keys := make([]posCrypto.PublicKey, len(publicKeys))
+	for i, publicKey := range publicKeys {
+		pk, err := posCrypto.NewPublicKey(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %s", publicKey, err.Error())
+		}
+		keys[i] = pk
+	}
+	multiKey, err := posCrypto.PublicKeyMultiSignature{}.NewMultiKey(keys...)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(multiKey.Address(), tx.GetSigner()) {
+		return nil, fmt.Errorf("the multisig public key (%s) is not the key of the signer of the tx (%s)", sdk.Address(multiKey.Address()).String(), tx.GetSigner().String())
+	}
+	signBytes, err := auth.StdSignBytes(app.chainID(), tx.Entropy, tx.Fee, tx.Msg, tx.Memo)
+	if err != nil {
+		return nil, err
+	}
+	sigs := make([][]byte, len(keys))
+	for _, s := range signatures {
+		pk, err := posCrypto.NewPublicKey(s.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %s", s.PublicKey, err.Error())
+		}
+		sig, err := hex.DecodeString(s.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature of %s: %s", s.PublicKey, err.Error())
+		}
+		i := -1
+		for j, key := range keys {
+			if key.Equals(pk) {
+				i = j
+				break
+			}
+		}
+		if i == -1 {
+			return nil, fmt.Errorf("the public key %s is not a key of the multisig", s.PublicKey)
+		}
+		if !pk.VerifyBytes(signBytes, sig) {
+			return nil, fmt.Errorf("the signature of %s doesn't match the sign bytes of the tx", s.PublicKey)
+		}
+		sigs[i] = sig
+	}
+	var missing []string
+	for i, sig := range sigs {
+		if sig == nil {
+			missing = append(missing, keys[i].RawString())
+		}
+	}
+	if len(missing) != 0 {
+		return nil, fmt.Errorf("missing the signatures of %v, every key of the multisig must sign", missing)
+	}
+	tx.Signature = auth.StdSignature{PublicKey: multiKey, Signature: posCrypto.MultiSignature{Sigs: sigs}.Marshal()}
+	if er := tx.ValidateBasic(); er != nil {
+		return nil, er
+	}
+	return auth.DefaultTxEncoder(cdc)(tx)
+}
+
+// the chain id of the genesis of this node
+func (app PocketCoreApp) chainID() string {
+	return app.TMNode().GenesisDoc().ChainID
+}
+
+// UpdateScheduledTxs - Schedules the signed txs of the update to be broadcast by this node once the chain reaches
+// their height (e.g. an unstake at the end of a commitment) and cancels the scheduled txs of the update hashes, returns
+// the scheduled txs. The schedule is local (not part of the protocol) and persisted across restarts
+func (app PocketCoreApp) UpdateScheduledTxs(update pocketTypes.ScheduledTxsUpdate) ([]pocketTypes.ScheduledTx, error) {
+	if GlobalConfig.PocketConfig.Replica && len(update.Schedule) != 0 {
+		return nil, ReplicaBroadcastError
+	}
+	for _, scheduled := range update.Schedule {
+		txBytes, err := hex.DecodeString(scheduled.Tx)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex tx: %s", err.Error())
+		}
+		tx, er := auth.DefaultTxDecoder(cdc)(txBytes)
+		if er != nil {
+			return nil, er
+		}
+		if er := tx.ValidateBasic(); er != nil {
+			return nil, er
+		}
+	}
+	if _, err := pocketTypes.UpdateScheduledTxs(update, app.LastBlockHeight()); err != nil {
+		return nil, err
+	}
+	return pocketTypes.GetScheduledTxs(), nil
+}
@@ -287,6 +287,41 @@ func TestChangeParamsTx(t *testing.T) {
 	}
 }
 
+func TestChangeParamsTx_Bounds(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp := getUnstakedAccount(kb)
+	assert.NotNil(t, kp)
+	_, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	memCli, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	// out of the bounds, even for the owner of the param
+	_, err = gov.ChangeParamsTx(memCodec(), memCli, kb, cb.GetAddress(), "pocketcore/SessionNodeCount", 0, "test", 1000000)
+	assert.Nil(t, err)
+	res := (<-evtChan).Data.(tmTypes.EventDataTx)
+	assert.Equal(t, uint32(pocketTypes.CodeParamOutOfBoundsError), res.Result.Code)
+	p, err := PCA.QueryParam(0, "pocketcore/SessionNodeCount")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "0", p.Value)
+	// the bounds are changed by the dao owner only
+	bounds := pocketTypes.ParamBounds{{Key: "pocketcore/SessionNodeCount", Min: "0", Max: "25"}}
+	_, err = gov.ChangeParamsTx(memCodec(), memCli, kb, kp.GetAddress(), "pocketcore/ParamBounds", bounds, "test", 1000000)
+	assert.Nil(t, err)
+	res = (<-evtChan).Data.(tmTypes.EventDataTx)
+	assert.NotZero(t, res.Result.Code)
+	_, err = gov.ChangeParamsTx(memCodec(), memCli, kb, cb.GetAddress(), "pocketcore/ParamBounds", bounds, "test", 1000000)
+	assert.Nil(t, err)
+	res = (<-evtChan).Data.(tmTypes.EventDataTx)
+	assert.Zero(t, res.Result.Code)
+	got, err := PCA.QueryParamBounds(0)
+	assert.Nil(t, err)
+	assert.Equal(t, bounds, got)
+
+	cleanup()
+	stopCli()
+}
+
 func TestUpgrade(t *testing.T) {
 	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
 	cb, err := kb.GetCoinbase()
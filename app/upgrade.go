@@ -0,0 +1,80 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+const (
+	// the exit code of a node halted at the upgrade height for running an outdated version
+	UpgradeHaltExitCode = 4
+)
+
+// exits the node (replaced in tests)
+var haltNode = func(code int) { os.Exit(code) }
+
+// halts the node at (and after) the upgrade height if the version of pocket core is older than the upgrade version,
+// before the block is processed, so the outdated nodes never fork from the network. Restarting with the upgraded
+// version processes the block
+func (app *PocketCoreApp) haltAtUpgradeHeight(ctx sdk.Ctx) {
+	upgrade := app.govKeeper.GetUpgrade(ctx)
+	if upgrade.Height == 0 || ctx.BlockHeight() < upgrade.Height {
+		return
+	}
+	if !isOutdatedVersion(AppVersion, upgrade.Version) {
+		return
+	}
+	ctx.Logger().Error(fmt.Sprintf("UPGRADE REQUIRED: the network upgraded to version %s at height %d, this node runs version %s. "+
+		"Halting at height %d, restart the node with version %s or newer", upgrade.Version, upgrade.Height, AppVersion, ctx.BlockHeight(), upgrade.Version))
+	// the supervisor (if any) restarts the node with the binary of the upgrade
+	if err := WriteUpgradeInfo(GlobalConfig.PocketConfig.DataDir, UpgradeInfo{Height: upgrade.Height, Version: upgrade.Version}); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("cannot write the upgrade info: %s", err.Error()))
+	}
+	haltNode(UpgradeHaltExitCode)
+}
+
+// returns whether the version is older than the upgrade version, any version but the upgrade version is outdated if
+// they can't be compared
+func isOutdatedVersion(version, upgradeVersion string) bool {
+	v, err := parseVersion(version)
+	if err != nil {
+		return version != upgradeVersion
+	}
+	u, err := parseVersion(upgradeVersion)
+	if err != nil {
+		return version != upgradeVersion
+	}
+	for i := 0; i < len(v) || i < len(u); i++ {
+		var a, b int64
+		if i < len(v) {
+			a = v[i]
+		}
+		if i < len(u) {
+			b = u[i]
+		}
+		if a != b {
+			return a < b
+		}
+	}
+	return false
+}
+
+// parses the numbers of a version, ignoring the release prefix (e.g. RC-0.4.0 -> [0 4 0])
+func parseVersion(version string) (numbers []int64, err error) {
+	start := strings.IndexAny(version, "0123456789")
+	if start == -1 {
+		return nil, fmt.Errorf("no version number in %s", version)
+	}
+	for _, s := range strings.Split(version[start:], ".") {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %s: %s", version, err.Error())
+		}
+		numbers = append(numbers, n)
+	}
+	return
+}
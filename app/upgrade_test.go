@@ -0,0 +1,66 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+	"github.com/stretchr/testify/assert"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestIsOutdatedVersion(t *testing.T) {
+	assert.False(t, isOutdatedVersion("RC-0.4.0", "RC-0.4.0"))
+	assert.False(t, isOutdatedVersion("RC-0.4.1", "RC-0.4.0"))
+	assert.False(t, isOutdatedVersion("RC-0.10.0", "RC-0.9.0"))
+	assert.False(t, isOutdatedVersion("RC-1.0", "RC-0.9.9"))
+	assert.True(t, isOutdatedVersion("RC-0.4.0", "RC-0.4.1"))
+	assert.True(t, isOutdatedVersion("RC-0.4.0", "2.0.0"))
+	assert.True(t, isOutdatedVersion("RC-0.4", "RC-0.4.1"))
+	// versions that can't be compared must match
+	assert.False(t, isOutdatedVersion("foo", "foo"))
+	assert.True(t, isOutdatedVersion("RC-0.4.0", "foo"))
+}
+
+func TestHaltAtUpgradeHeight(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	defer func(c Config) { GlobalConfig = c }(GlobalConfig)
+	dir, err := ioutil.TempDir("", "upgrade")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	GlobalConfig.PocketConfig.DataDir = dir
+	var halted []int
+	defaultHalt := haltNode
+	haltNode = func(code int) { halted = append(halted, code) }
+	defer func() { haltNode = defaultHalt }()
+	ctx, err := PCA.NewContext(0)
+	assert.Nil(t, err)
+	// branch the state, the cache is never written
+	ctx = ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+	setUpgrade := func(height int64, version string) {
+		params := PCA.govKeeper.GetParams(ctx)
+		params.Upgrade = govTypes.NewUpgrade(height, version)
+		PCA.govKeeper.SetParams(ctx, params)
+	}
+	// an upgrade to a newer version in the future
+	setUpgrade(ctx.BlockHeight()+1, "RC-99.0.0")
+	PCA.haltAtUpgradeHeight(ctx)
+	assert.Empty(t, halted)
+	// the upgrade height is reached
+	PCA.haltAtUpgradeHeight(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	assert.Equal(t, []int{UpgradeHaltExitCode}, halted)
+	// the upgrade info is written for the supervisor
+	info, err := ReadUpgradeInfo(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, UpgradeInfo{Height: ctx.BlockHeight() + 1, Version: "RC-99.0.0"}, info)
+	// the upgraded version (or newer) processes the blocks
+	setUpgrade(ctx.BlockHeight(), AppVersion)
+	PCA.haltAtUpgradeHeight(ctx)
+	assert.Len(t, halted, 1)
+
+	cleanup()
+	stopCli()
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	pocketKeeper "github.com/pokt-network/pocket-core/x/pocketcore/keeper"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/auth"
@@ -21,8 +22,28 @@ func (app PocketCoreApp) GenerateAAT(appPubKey, clientPubKey string, key crypto.
 	return json.MarshalIndent(aat, "", "  ")
 }
 
+func (app PocketCoreApp) GenerateGatewayAAT(appPubKey, clientPubKey string, gatewayKey crypto.PrivateKey) (aatjson []byte, err error) {
+	aat, er := pocketKeeper.GatewayAATGeneration(appPubKey, clientPubKey, gatewayKey)
+	if er != nil {
+		return nil, er
+	}
+	return json.MarshalIndent(aat, "", "  ")
+}
+
+// "VerifyRelayResponse" - Verifies the signature of the servicer on the relay response and the linkage of its proof with
+// the servicer and, if not nil, with the relay request
+func (app PocketCoreApp) VerifyRelayResponse(response pocketTypes.RelayResponse, servicerPubKey string, relay *pocketTypes.Relay) error {
+	if err := response.Verify(servicerPubKey); err != nil {
+		return err
+	}
+	if relay != nil && response.Proof.RequestHash != relay.RequestHashString() {
+		return pocketTypes.NewRequestHashError(pocketTypes.ModuleName)
+	}
+	return nil
+}
+
 func (app PocketCoreApp) BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.PublicKeyMultiSig, fees int64) ([]byte, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -39,11 +60,13 @@ func (app PocketCoreApp) BuildMultisig(fromAddr, jsonMessage, passphrase, chainI
 		auth.DefaultTxDecoder(cdc),
 		chainID,
 		"", nil).WithKeybase(kb)
-	return txBuilder.BuildAndSignMultisigTransaction(fa, pk, m, passphrase, fees)
+	txBz, err := txBuilder.BuildAndSignMultisigTransaction(fa, pk, m, passphrase, fees)
+	RecordCLIKeyUse(fa, m.Type(), txBz, err)
+	return txBz, err
 }
 
 func (app PocketCoreApp) SignMultisigNext(fromAddr, txHex, passphrase, chainID string) ([]byte, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -60,11 +83,13 @@ func (app PocketCoreApp) SignMultisigNext(fromAddr, txHex, passphrase, chainID s
 		auth.DefaultTxDecoder(cdc),
 		chainID,
 		"", nil).WithKeybase(kb)
-	return txBuilder.SignMultisigTransaction(fa, nil, passphrase, bz)
+	txBz, err := txBuilder.SignMultisigTransaction(fa, nil, passphrase, bz)
+	RecordCLIKeyUse(fa, multisigMsgType(bz), txBz, err)
+	return txBz, err
 }
 
 func (app PocketCoreApp) SignMultisigOutOfOrder(fromAddr, txHex, passphrase, chainID string, keys []crypto.PublicKey) ([]byte, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
+	fa, err := pocketTypes.ParseAddress(fromAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -81,35 +106,56 @@ func (app PocketCoreApp) SignMultisigOutOfOrder(fromAddr, txHex, passphrase, cha
 		auth.DefaultTxDecoder(cdc),
 		chainID,
 		"", nil).WithKeybase(kb)
-	return txBuilder.SignMultisigTransaction(fa, keys, passphrase, bz)
+	txBz, err := txBuilder.SignMultisigTransaction(fa, keys, passphrase, bz)
+	RecordCLIKeyUse(fa, multisigMsgType(bz), txBz, err)
+	return txBz, err
 }
 
+// the message type of the (partially signed) multisig tx, for the key audit log
+func multisigMsgType(txBz []byte) string {
+	tx, err := auth.DefaultTxDecoder(cdc)(txBz)
+	if err != nil || tx.GetMsg() == nil {
+		return ""
+	}
+	return tx.GetMsg().Type()
+}
+
+// "ExportState" - Exports the latest state of the app as a genesis file
 func ExportState() (string, error) {
-	j, err := PCA.ExportAppState(false, nil)
+	return ExportGenesis(0, "")
+}
+
+// "ExportGenesis" - Exports the state of the app at the height (the latest if zero) as a genesis file of the chain id
+// (the chain id of the network if empty), for a network upgrade or the fork of a devnet. The validators of the genesis
+// are the staked validators of the exported state
+func ExportGenesis(height int64, chainID string) (string, error) {
+	if height == 0 {
+		height = PCA.LastBlockHeight()
+	}
+	j, err := PCA.ExportAppStateAtHeight(height)
 	if err != nil {
 		return "", err
 	}
-	j, _ = Codec().MarshalJSONIndent(types.GenesisDoc{
-		GenesisTime: time.Now(),
-		ChainID:     "pocket-test",
-		ConsensusParams: &types.ConsensusParams{
-			Block: types.BlockParams{
-				MaxBytes:   15000,
-				MaxGas:     -1,
-				TimeIotaMs: 1,
-			},
-			Evidence: types.EvidenceParams{
-				MaxAge: 1000000,
-			},
-			Validator: types.ValidatorParams{
-				PubKeyTypes: []string{"ed25519"},
-			},
-		},
-		Validators: nil,
-		AppHash:    nil,
-		AppState:   j,
+	genDoc := PCA.TMNode().GenesisDoc()
+	if chainID == "" {
+		chainID = genDoc.ChainID
+	}
+	genesisTime := time.Now()
+	if meta := PCA.BlockStore().LoadBlockMeta(height); meta != nil {
+		genesisTime = meta.Header.Time
+	}
+	j, err = Codec().MarshalJSONIndent(types.GenesisDoc{
+		GenesisTime:     genesisTime,
+		ChainID:         chainID,
+		ConsensusParams: genDoc.ConsensusParams,
+		Validators:      nil,
+		AppHash:         nil,
+		AppState:        j,
 	}, "", "    ")
-	return SortJSON(j), err
+	if err != nil {
+		return "", err
+	}
+	return SortJSON(j), nil
 }
 
 func SortJSON(toSortJSON []byte) string {
@@ -124,3 +170,21 @@ func SortJSON(toSortJSON []byte) string {
 	}
 	return string(js)
 }
+
+// "FormatAddress" - Encodes the address in the format of the config (hex by default)
+func FormatAddress(addr sdk.Address) string {
+	s, err := pocketTypes.FormatAddress(addr, GlobalConfig.PocketConfig.AddressFormat)
+	if err != nil {
+		return addr.String()
+	}
+	return s
+}
+
+// the hex encoding of an address in either format, as indexed in the transaction events
+func hexAddress(address string) (string, error) {
+	addr, err := pocketTypes.ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
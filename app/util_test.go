@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -63,3 +64,36 @@ func TestExportState(t *testing.T) {
 	cleanup()
 	stopCli()
 }
+
+func TestExportGenesis(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	for i := 0; i < 3; i++ {
+		<-evtChan // Wait for block
+	}
+	res, err := ExportGenesis(2, "")
+	assert.Nil(t, err)
+	genDoc, err := tmTypes.GenesisDocFromJSON([]byte(res))
+	assert.Nil(t, err)
+	assert.Equal(t, PCA.TMNode().GenesisDoc().ChainID, genDoc.ChainID)
+	assert.Equal(t, PCA.BlockStore().LoadBlockMeta(2).Header.Time.Unix(), genDoc.GenesisTime.Unix())
+	var appState map[string]json.RawMessage
+	err = json.Unmarshal(genDoc.AppState, &appState)
+	assert.Nil(t, err)
+	var posState types.GenesisState
+	err = memCodec().UnmarshalJSON(appState[types.ModuleName], &posState)
+	assert.Nil(t, err)
+	assert.Len(t, posState.Validators, 1)
+	// the latest height of a devnet fork
+	res, err = ExportGenesis(0, "pocket-devnet")
+	assert.Nil(t, err)
+	genDoc, err = tmTypes.GenesisDocFromJSON([]byte(res))
+	assert.Nil(t, err)
+	assert.Equal(t, "pocket-devnet", genDoc.ChainID)
+	// a height not committed yet
+	_, err = ExportGenesis(PCA.LastBlockHeight()+10, "")
+	assert.NotNil(t, err)
+
+	cleanup()
+	stopCli()
+}
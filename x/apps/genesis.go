@@ -26,6 +26,7 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 		// set the applications from the data
 		keeper.SetApplication(ctx, application)
 		keeper.SetStakedApplication(ctx, application)
+		keeper.SetStakedApplicationByChains(ctx, application)
 		if application.IsStaked() {
 			stakedTokens = stakedTokens.Add(application.GetTokens())
 		}
@@ -17,6 +17,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginUnstake(ctx, msg, k)
 		case types.MsgAppUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case types.MsgAppDelegateGateway:
+			return handleMsgDelegateGateway(ctx, msg, k)
+		case types.MsgAppRevokeGateway:
+			return handleMsgRevokeGateway(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -106,3 +110,49 @@ func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgAppUnjail, k keeper.Keeper) sdk.R
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Applications delegate a gateway key to sign relays (through the AAT) without exposing the application key
+func handleMsgDelegateGateway(ctx sdk.Ctx, msg types.MsgAppDelegateGateway, k keeper.Keeper) sdk.Result {
+	if _, found := k.GetApplication(ctx, msg.AppAddr); !found {
+		ctx.Logger().Error("App Not Found " + msg.AppAddr.String())
+		return types.ErrNoApplicationFound(k.Codespace()).Result()
+	}
+	ctx.Logger().Info("Delegating Gateway " + msg.GatewayPubKey.RawString() + " for App " + msg.AppAddr.String())
+	k.SetGatewayDelegation(ctx, msg.AppAddr, msg.GatewayPubKey)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegateGateway,
+			sdk.NewAttribute(types.AttributeKeyApplication, msg.AppAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyGateway, msg.GatewayPubKey.RawString()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.AppAddr.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Applications revoke a previously delegated gateway key, relays signed by it are rejected from the next session on
+func handleMsgRevokeGateway(ctx sdk.Ctx, msg types.MsgAppRevokeGateway, k keeper.Keeper) sdk.Result {
+	if !k.IsGatewayDelegated(ctx, msg.AppAddr, msg.GatewayPubKey) {
+		ctx.Logger().Error("Gateway " + msg.GatewayPubKey.RawString() + " Not Delegated For App " + msg.AppAddr.String())
+		return types.ErrGatewayNotDelegated(k.Codespace()).Result()
+	}
+	ctx.Logger().Info("Revoking Gateway " + msg.GatewayPubKey.RawString() + " for App " + msg.AppAddr.String())
+	k.DeleteGatewayDelegation(ctx, msg.AppAddr, msg.GatewayPubKey)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeGateway,
+			sdk.NewAttribute(types.AttributeKeyApplication, msg.AppAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyGateway, msg.GatewayPubKey.RawString()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.AppAddr.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
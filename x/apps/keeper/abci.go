@@ -11,5 +11,7 @@ func BeginBlocker(ctx sdk.Ctx, _ abci.RequestBeginBlock, k Keeper) {}
 func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	// Unstake all mature applications from the unstakeing queue.
 	k.unstakeAllMatureApplications(ctx)
+	// adjust the stake params by the network usage (if an era starts)
+	k.adjustStakeParams(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
+
 	"github.com/pokt-network/pocket-core/x/apps/exported"
 	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/posmint/codec"
 	sdk "github.com/pokt-network/posmint/types"
 )
 
@@ -17,6 +20,89 @@ func (k Keeper) SetStakedApplication(ctx sdk.Ctx, application types.Application)
 	ctx.Logger().Info("Setting App on Staking Set " + application.Address.String())
 }
 
+// SetStakedApplicationByChains - Store staked application using networkId (jailed or not)
+func (k Keeper) SetStakedApplicationByChains(ctx sdk.Ctx, application types.Application) {
+	store := ctx.KVStore(k.storeKey)
+	for _, c := range application.Chains {
+		cBz, err := hex.DecodeString(c)
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("could not hex decode chains for application: %s with network ID: %s", application.Address, c).Error())
+			continue
+		}
+		store.Set(types.KeyForAppByNetworkID(application.Address, cBz), []byte{}) // use empty byte slice to save space
+	}
+}
+
+// GetApplicationsByChain - Retrieve the staked applications (jailed or not) of the network identifier
+func (k Keeper) GetApplicationsByChain(ctx sdk.Ctx, networkID string) (applications types.Applications) {
+	applications = make(types.Applications, 0)
+	cBz, err := hex.DecodeString(networkID)
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf("could not hex decode chains when GetApplicationsByChain: with network ID: %s", networkID).Error())
+		return
+	}
+	iterator := k.appsByChainIterator(ctx, cBz)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		address := types.AddressForAppByNetworkIDKey(iterator.Key(), cBz)
+		application, found := k.GetApplication(ctx, address)
+		if !found {
+			k.Logger(ctx).Error(fmt.Errorf("application %s in chain set but not found in all applications store", address).Error())
+			continue
+		}
+		applications = append(applications, application)
+	}
+	return applications
+}
+
+// deleteApplicationForChains - Remove application from the chains sets
+func (k Keeper) deleteApplicationForChains(ctx sdk.Ctx, application types.Application) {
+	store := ctx.KVStore(k.storeKey)
+	for _, c := range application.Chains {
+		cBz, err := hex.DecodeString(c)
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("could not hex decode chains for application: %s with network ID: %s", application.Address, c).Error())
+			continue
+		}
+		store.Delete(types.KeyForAppByNetworkID(application.Address, cBz))
+	}
+}
+
+// appsByChainIterator - Retrieve an iterator for the staked applications of the network identifier
+func (k Keeper) appsByChainIterator(ctx sdk.Ctx, networkIDBz []byte) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.KeyForAppsByNetworkID(networkIDBz))
+}
+
+// IndexStakedApplicationsByChains - Migrates the applications store, indexing the staked applications staked before
+// the chains index by network id
+func IndexStakedApplicationsByChains(ctx sdk.Ctx, store sdk.KVStore, cdc *codec.Codec) (migrated int64, err error) {
+	iterator := sdk.KVStorePrefixIterator(store, types.AllApplicationsKey)
+	applications := make(types.Applications, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		application, err := types.UnmarshalApplication(cdc, iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return 0, err
+		}
+		if application.IsStaked() {
+			applications = append(applications, application)
+		}
+	}
+	iterator.Close()
+	for _, application := range applications {
+		for _, c := range application.Chains {
+			cBz, err := hex.DecodeString(c)
+			if err != nil {
+				continue
+			}
+			store.Set(types.KeyForAppByNetworkID(application.Address, cBz), []byte{})
+		}
+		migrated++
+	}
+	return migrated, nil
+}
+
 // StakeDenom - Retrieve the denomination of coins.
 func (k Keeper) StakeDenom(ctx sdk.Ctx) string {
 	return k.POSKeeper.StakeDenom(ctx)
@@ -211,3 +211,31 @@ func TestApplicationStaked_IterateAndExecuteOverStakedApps(t *testing.T) {
 		})
 	}
 }
+
+func TestGetApplicationsByChain(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	stakedApplication := getStakedApplication()
+	jailedApplication := getStakedApplication()
+	jailedApplication.Jailed = true
+	otherChainApplication := getStakedApplication()
+	otherChainApplication.Chains = []string{"0002"}
+	for _, application := range []types.Application{stakedApplication, jailedApplication, otherChainApplication} {
+		keeper.SetApplication(context, application)
+		keeper.SetStakedApplicationByChains(context, application)
+	}
+	chain := stakedApplication.Chains[0]
+	assert.ElementsMatch(t, []types.Application{stakedApplication, jailedApplication}, keeper.GetApplicationsByChain(context, chain))
+	// the index gives the same applications as the full scan
+	opts := types.QueryApplicationsWithOpts{StakingStatus: sdk.Staked, Blockchain: chain}
+	assert.ElementsMatch(t, keeper.GetApplicationsByChain(context, chain), keeper.GetAllApplicationsWithOpts(context, opts))
+	// unstaking removes the application from the index
+	keeper.deleteApplicationForChains(context, jailedApplication)
+	assert.Equal(t, types.Applications{stakedApplication}, keeper.GetApplicationsByChain(context, chain))
+	// the migration indexes the applications staked before the index
+	keeper.deleteApplicationForChains(context, stakedApplication)
+	assert.Empty(t, keeper.GetApplicationsByChain(context, chain))
+	migrated, err := IndexStakedApplicationsByChains(context, context.KVStore(keeper.storeKey), keeper.cdc)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), migrated)
+	assert.ElementsMatch(t, []types.Application{stakedApplication, jailedApplication}, keeper.GetApplicationsByChain(context, chain))
+}
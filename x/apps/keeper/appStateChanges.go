@@ -73,6 +73,8 @@ func (k Keeper) StakeApplication(ctx sdk.Ctx, application types.Application, amo
 	k.SetApplication(ctx, application)
 	// save in the staked store
 	k.SetStakedApplication(ctx, application)
+	// save in the network id stores for quick per chain queries
+	k.SetStakedApplicationByChains(ctx, application)
 	return nil
 }
 
@@ -98,6 +100,8 @@ func (k Keeper) BeginUnstakingApplication(ctx sdk.Ctx, application types.Applica
 	params := k.GetParams(ctx)
 	// delete the application from the staking set, as it is technically staked but not going to participate
 	k.deleteApplicationFromStakingSet(ctx, application)
+	// delete the application from each individual chains set
+	k.deleteApplicationForChains(ctx, application)
 	// set the status
 	application = application.UpdateStatus(sdk.Unstaking)
 	// set the unstaking completion time and completion height appropriately
@@ -170,6 +174,8 @@ func (k Keeper) FinishUnstakingApplication(ctx sdk.Ctx, application types.Applic
 func (k Keeper) ForceApplicationUnstake(ctx sdk.Ctx, application types.Application) sdk.Error {
 	// delete the application from staking set as they are unstaked
 	k.deleteApplicationFromStakingSet(ctx, application)
+	// delete the application from each individual chains set
+	k.deleteApplicationForChains(ctx, application)
 	// amount unstaked = stakedTokens
 	err := k.burnStakedTokens(ctx, application.StakedTokens)
 	if err != nil {
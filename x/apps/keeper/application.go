@@ -55,9 +55,19 @@ func (k Keeper) GetAllApplications(ctx sdk.Ctx) (applications types.Applications
 	return applications
 }
 
-// GetAllApplicationsWithOpts - Retrieve the set of all applications with no limits from the main store
+// GetAllApplicationsWithOpts - Retrieve the set of all applications with no limits from the main store (the chain index
+// for the staked applications of a chain)
 func (k Keeper) GetAllApplicationsWithOpts(ctx sdk.Ctx, opts types.QueryApplicationsWithOpts) (applications types.Applications) {
 	applications = make([]types.Application, 0)
+	// the staked applications of a chain are indexed, no need to scan all of the applications
+	if opts.Blockchain != "" && opts.StakingStatus == sdk.Staked {
+		for _, application := range k.GetApplicationsByChain(ctx, opts.Blockchain) {
+			if opts.IsValid(application) {
+				applications = append(applications, application)
+			}
+		}
+		return applications
+	}
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.AllApplicationsKey)
 	defer iterator.Close()
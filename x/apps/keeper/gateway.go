@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// SetGatewayDelegation - Authorizes the gateway key to sign application authentication tokens for the application
+func (k Keeper) SetGatewayDelegation(ctx sdk.Ctx, appAddr sdk.Address, gatewayPubKey crypto.PublicKey) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForGateway(appAddr, gatewayPubKey), gatewayPubKey.RawBytes())
+}
+
+// DeleteGatewayDelegation - Revokes the gateway key delegated by the application
+func (k Keeper) DeleteGatewayDelegation(ctx sdk.Ctx, appAddr sdk.Address, gatewayPubKey crypto.PublicKey) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForGateway(appAddr, gatewayPubKey))
+}
+
+// IsGatewayDelegated - Returns true if the gateway key is delegated by the application
+func (k Keeper) IsGatewayDelegated(ctx sdk.Ctx, appAddr sdk.Address, gatewayPubKey crypto.PublicKey) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyForGateway(appAddr, gatewayPubKey))
+}
+
+// GetGatewayDelegations - Retrieve the gateway keys delegated by the application
+func (k Keeper) GetGatewayDelegations(ctx sdk.Ctx, appAddr sdk.Address) (gateways []crypto.PublicKey) {
+	gateways = make([]crypto.PublicKey, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForGateways(appAddr))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		pk, err := crypto.NewPublicKeyBz(iterator.Value())
+		if err != nil {
+			k.Logger(ctx).Error("couldn't unmarshal gateway public key in GetGatewayDelegations call: " + err.Error())
+			continue
+		}
+		gateways = append(gateways, pk)
+	}
+	return gateways
+}
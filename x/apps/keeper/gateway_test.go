@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayDelegations(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	gateway := getRandomPubKey()
+	other := getRandomPubKey()
+	assert.False(t, keeper.IsGatewayDelegated(context, application.Address, gateway))
+	assert.Empty(t, keeper.GetGatewayDelegations(context, application.Address))
+	keeper.SetGatewayDelegation(context, application.Address, gateway)
+	keeper.SetGatewayDelegation(context, application.Address, other)
+	assert.True(t, keeper.IsGatewayDelegated(context, application.Address, gateway))
+	assert.False(t, keeper.IsGatewayDelegated(context, getRandomApplicationAddress(), gateway))
+	assert.ElementsMatch(t, []crypto.PublicKey{gateway, other}, keeper.GetGatewayDelegations(context, application.Address))
+	keeper.DeleteGatewayDelegation(context, application.Address, gateway)
+	assert.False(t, keeper.IsGatewayDelegated(context, application.Address, gateway))
+	assert.Equal(t, []crypto.PublicKey{other}, keeper.GetGatewayDelegations(context, application.Address))
+}
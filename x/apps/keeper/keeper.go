@@ -19,6 +19,7 @@ type Keeper struct {
 	cdc                  *codec.Codec
 	AccountsKeeper       types.AuthKeeper
 	POSKeeper            types.PosKeeper
+	PocketKeeper         types.PocketKeeper // the settled relays adjusting the stake params (set after pocket core)
 	Paramstore           sdk.Subspace
 	applicationCache     map[string]cachedApplication
 	applicationCacheList *list.List
@@ -59,6 +59,13 @@ func (k Keeper) MaxChains(ctx sdk.Ctx) (res int64) {
 	return
 }
 
+// StakeAdjustment - Retrieve the adjustment of the min stake and the base relays per POKT by the network usage
+func (k Keeper) StakeAdjustment(ctx sdk.Ctx) (res types.StakeAdjustment) {
+	// not in the paramstore of chains started before the stake adjustment
+	k.Paramstore.GetIfExists(ctx, types.KeyStakeAdjustment, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
@@ -69,6 +76,7 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 		ParticipationRateOn: k.ParticipationRateOn(ctx),
 		StabilityAdjustment: k.StakingAdjustment(ctx),
 		MaxChains:           k.MaxChains(ctx),
+		StakeAdjustment:     k.StakeAdjustment(ctx),
 	}
 }
 
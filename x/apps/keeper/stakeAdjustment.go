@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// eraRelays - Retrieve the relays settled network wide for the sessions of the era ending before the height
+func (k Keeper) eraRelays(ctx sdk.Ctx, adjustment types.StakeAdjustment, eraEnd int64) int64 {
+	if k.PocketKeeper == nil {
+		return 0
+	}
+	return k.PocketKeeper.SettledRelays(ctx, eraEnd-adjustment.EraLength, eraEnd-1)
+}
+
+// StakeAdjustmentStatus - Retrieve the stake adjustment and the next adjustment of the params, projected with the
+// relays settled so far for the sessions of the current era
+func (k Keeper) StakeAdjustmentStatus(ctx sdk.Ctx) types.StakeAdjustmentStatus {
+	adjustment := k.StakeAdjustment(ctx)
+	status := types.StakeAdjustmentStatus{
+		Adjustment:        adjustment,
+		AppStakeMin:       k.MinimumStake(ctx),
+		BaseRelaysPerPOKT: k.BaselineThroughputStakeRate(ctx),
+	}
+	if !adjustment.IsEnabled() {
+		return status
+	}
+	status.NextAdjustmentHeight = adjustment.NextEraStart(ctx.BlockHeight())
+	status.EraRelays = k.eraRelays(ctx, adjustment, status.NextAdjustmentHeight)
+	status.NextAppStakeMin, status.NextBaseRelaysPerPOKT = adjustment.Adjust(status.AppStakeMin, status.BaseRelaysPerPOKT, status.EraRelays)
+	return status
+}
+
+// adjustStakeParams - Adjusts the app minimum stake and the base relays per POKT by the relays settled in the previous
+// era (if an era starts at this height)
+func (k Keeper) adjustStakeParams(ctx sdk.Ctx) {
+	adjustment := k.StakeAdjustment(ctx)
+	if !adjustment.IsEraStart(ctx.BlockHeight()) {
+		return
+	}
+	relays := k.eraRelays(ctx, adjustment, ctx.BlockHeight())
+	stake, base := adjustment.Adjust(k.MinimumStake(ctx), k.BaselineThroughputStakeRate(ctx), relays)
+	k.Paramstore.Set(ctx, types.KeyApplicationMinStake, stake)
+	k.Paramstore.Set(ctx, types.BaseRelaysPerPOKT, base)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStakeAdjustment,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyEraRelays, strconv.FormatInt(relays, 10)),
+		sdk.NewAttribute(types.AttributeKeyAppStakeMinimum, strconv.FormatInt(stake, 10)),
+		sdk.NewAttribute(types.AttributeKeyBaseRelaysPerPOKT, strconv.FormatInt(base, 10)),
+	))
+	k.Logger(ctx).Info(fmt.Sprintf("the app minimum stake was adjusted to %d and the base relays per POKT to %d by the %d relays of the era", stake, base, relays))
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// the relays settled per session height
+type settledRelays map[int64]int64
+
+func (s settledRelays) SettledRelays(ctx sdk.Ctx, fromSessionHeight, toSessionHeight int64) (total int64) {
+	for height, relays := range s {
+		if height >= fromSessionHeight && height <= toSessionHeight {
+			total += relays
+		}
+	}
+	return
+}
+
+func TestKeeper_AdjustStakeParams(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	keeper.PocketKeeper = settledRelays{1: 400, 5: 700, 11: 100000}
+	stake, base := keeper.MinimumStake(context), keeper.BaselineThroughputStakeRate(context)
+	// no adjustment by default
+	keeper.adjustStakeParams(context.WithBlockHeight(10))
+	assert.Equal(t, stake, keeper.MinimumStake(context))
+	status := keeper.StakeAdjustmentStatus(context)
+	assert.Zero(t, status.NextAdjustmentHeight)
+	params := keeper.GetParams(context)
+	params.StakeAdjustment = types.StakeAdjustment{StartHeight: 10, EraLength: 10, TargetRelays: 1000, MaxChangePercent: 50,
+		MinAppStake: types.DefaultMinStake, MaxAppStake: 10 * types.DefaultMinStake, MinBaseRelaysPerPOKT: 1, MaxBaseRelaysPerPOKT: 1000}
+	keeper.SetParams(context, params)
+	// the next adjustment is projected with the relays settled so far
+	status = keeper.StakeAdjustmentStatus(context.WithBlockHeight(5))
+	assert.Equal(t, int64(10), status.NextAdjustmentHeight)
+	assert.Equal(t, int64(1100), status.EraRelays)
+	assert.Equal(t, stake*11/10, status.NextAppStakeMin)
+	// between eras
+	keeper.adjustStakeParams(context.WithBlockHeight(15))
+	assert.Equal(t, stake, keeper.MinimumStake(context))
+	// the relays of the sessions of the previous era (0 to 9)
+	keeper.adjustStakeParams(context.WithBlockHeight(10))
+	assert.Equal(t, stake*11/10, keeper.MinimumStake(context))
+	assert.Equal(t, sdk.NewDec(base).Quo(sdk.NewDecWithPrec(11, 1)).TruncateInt64(), keeper.BaselineThroughputStakeRate(context))
+	// capped to the max change
+	keeper.adjustStakeParams(context.WithBlockHeight(20))
+	assert.Equal(t, stake*11/10*3/2, keeper.MinimumStake(context))
+}
@@ -9,6 +9,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAppStake{}, "apps/MsgAppStake", nil)
 	cdc.RegisterConcrete(MsgBeginAppUnstake{}, "apps/MsgAppBeginUnstake", nil)
 	cdc.RegisterConcrete(MsgAppUnjail{}, "apps/MsgAppUnjail", nil)
+	cdc.RegisterConcrete(MsgAppDelegateGateway{}, "apps/MsgAppDelegateGateway", nil)
+	cdc.RegisterConcrete(MsgAppRevokeGateway{}, "apps/MsgAppRevokeGateway", nil)
 }
 
 var ModuleCdc *codec.Codec // generic sealed codec to be used throughout this module
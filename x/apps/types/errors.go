@@ -1,4 +1,3 @@
-//
 package types
 
 import (
@@ -24,6 +23,8 @@ const (
 	CodeNoChains              CodeType          = 116
 	CodeInvalidNetworkID      CodeType          = 117
 	CodeTooManyChains         CodeType          = 118
+	CodeInvalidGateway        CodeType          = 119
+	CodeGatewayNotDelegated   CodeType          = 120
 )
 
 func ErrTooManyChains(Codespace sdk.CodespaceType) sdk.Error {
@@ -91,3 +92,15 @@ func ErrStakeTooLow(codespace sdk.CodespaceType) sdk.Error {
 func ErrInvalidNetworkIdentifier(codespace sdk.CodespaceType, err error) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidNetworkID, "the applications network identifier is not valid: "+err.Error())
 }
+
+func ErrNilGatewayPubKey(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGateway, "gateway public key is nil")
+}
+
+func ErrSelfGateway(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGateway, "an application cannot delegate to its own key")
+}
+
+func ErrGatewayNotDelegated(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeGatewayNotDelegated, "the gateway is not delegated by the application")
+}
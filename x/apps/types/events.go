@@ -2,11 +2,18 @@ package types
 
 // pos module event types
 const (
-	EventTypeCompleteUnstaking = "complete_unstaking"
-	EventTypeCreateApplication = "create_application"
-	EventTypeStake             = "stake"
-	EventTypeBeginUnstake      = "begin_unstake"
-	EventTypeUnstake           = "unstake"
-	AttributeKeyApplication    = "application"
-	AttributeValueCategory     = ModuleName
+	EventTypeCompleteUnstaking    = "complete_unstaking"
+	EventTypeCreateApplication    = "create_application"
+	EventTypeStake                = "stake"
+	EventTypeBeginUnstake         = "begin_unstake"
+	EventTypeUnstake              = "unstake"
+	EventTypeDelegateGateway      = "delegate_gateway"
+	EventTypeRevokeGateway        = "revoke_gateway"
+	EventTypeStakeAdjustment      = "stake_adjustment"
+	AttributeKeyApplication       = "application"
+	AttributeKeyGateway           = "gateway"
+	AttributeKeyEraRelays         = "era_relays"
+	AttributeKeyAppStakeMinimum   = "app_stake_minimum"
+	AttributeKeyBaseRelaysPerPOKT = "base_relays_per_pokt"
+	AttributeValueCategory        = ModuleName
 )
@@ -12,6 +12,12 @@ type PosKeeper interface {
 	GetStakedTokens(ctx sdk.Ctx) sdk.Int
 }
 
+// PocketKeeper defines the expected pocket core keeper (noalias)
+type PocketKeeper interface {
+	// the relays settled network wide for the sessions between the heights (inclusive)
+	SettledRelays(ctx sdk.Ctx, fromSessionHeight, toSessionHeight int64) int64
+}
+
 // AuthKeeper defines the expected supply Keeper (noalias)
 type AuthKeeper interface {
 	// get total supply of tokens
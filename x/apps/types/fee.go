@@ -4,12 +4,15 @@ const (
 	StakeFee   = 10000
 	UnstakeFee = 10000
 	UnjailFee  = 10000
+	GatewayFee = 10000
 )
 
 var (
 	AppFeeMap = map[string]int64{
-		MsgAppStakeName:   StakeFee,
-		MsgAppUnstakeName: UnstakeFee,
-		MsgAppUnjailName:  UnjailFee,
+		MsgAppStakeName:           StakeFee,
+		MsgAppUnstakeName:         UnstakeFee,
+		MsgAppUnjailName:          UnjailFee,
+		MsgAppDelegateGatewayName: GatewayFee,
+		MsgAppRevokeGatewayName:   GatewayFee,
 	}
 )
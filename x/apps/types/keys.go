@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 	"time"
 )
@@ -15,10 +16,12 @@ const (
 )
 
 var (
-	AllApplicationsKey = []byte{0x01} // prefix for each key to a application
-	StakedAppsKey      = []byte{0x02} // prefix for each key to a staked application index, sorted by power
-	UnstakingAppsKey   = []byte{0x03} // prefix for unstaking application
-	BurnApplicationKey = []byte{0x04} // prefix for awarding applications
+	AllApplicationsKey   = []byte{0x01} // prefix for each key to a application
+	StakedAppsKey        = []byte{0x02} // prefix for each key to a staked application index, sorted by power
+	UnstakingAppsKey     = []byte{0x03} // prefix for unstaking application
+	BurnApplicationKey   = []byte{0x04} // prefix for awarding applications
+	GatewayKey           = []byte{0x05} // prefix for the gateways delegated by the applications
+	StakedAppsByNetIDKey = []byte{0x06} // prefix for applications staked by networkID
 )
 
 // Removes the prefix bytes from a key to expose true address
@@ -31,6 +34,22 @@ func KeyForAppByAllApps(addr sdk.Address) []byte {
 	return append(AllApplicationsKey, addr.Bytes()...)
 }
 
+// generates the key for the application with address staked for the networkID
+func KeyForAppByNetworkID(addr sdk.Address, networkID []byte) []byte {
+	return append(KeyForAppsByNetworkID(networkID), addr.Bytes()...)
+}
+
+// generates the key for the applications staked for the networkID
+func KeyForAppsByNetworkID(networkID []byte) []byte {
+	return append(append([]byte{}, StakedAppsByNetIDKey...), networkID...)
+}
+
+// exposes the address of the key for the application staked for the networkID
+func AddressForAppByNetworkIDKey(key, networkID []byte) sdk.Address {
+	i := len(StakedAppsByNetIDKey) + len(networkID)
+	return key[i:]
+}
+
 // generates the key for unstaking applications by the unstakingtime
 func KeyForUnstakingApps(unstakingTime time.Time) []byte {
 	bz := sdk.FormatTimeBytes(unstakingTime)
@@ -47,6 +66,16 @@ func KeyForAppBurn(address sdk.Address) []byte {
 	return append(BurnApplicationKey, address...)
 }
 
+// generates the key for a gateway delegated by the application with address
+func KeyForGateway(address sdk.Address, gatewayPubKey crypto.PublicKey) []byte {
+	return append(KeyForGateways(address), gatewayPubKey.RawBytes()...)
+}
+
+// generates the key for the gateways delegated by the application with address
+func KeyForGateways(address sdk.Address) []byte {
+	return append(append([]byte{}, GatewayKey...), address.Bytes()...)
+}
+
 // get the power ranking key of a application
 // NOTE the larger values are of higher value
 func getStakedValPowerRankKey(application Application) []byte {
@@ -10,12 +10,16 @@ var (
 	_ sdk.Msg = &MsgAppStake{}
 	_ sdk.Msg = &MsgBeginAppUnstake{}
 	_ sdk.Msg = &MsgAppUnjail{}
+	_ sdk.Msg = &MsgAppDelegateGateway{}
+	_ sdk.Msg = &MsgAppRevokeGateway{}
 )
 
 const (
-	MsgAppStakeName   = "app_stake"
-	MsgAppUnstakeName = "app_begin_unstake"
-	MsgAppUnjailName  = "app_unjail"
+	MsgAppStakeName           = "app_stake"
+	MsgAppUnstakeName         = "app_begin_unstake"
+	MsgAppUnjailName          = "app_unjail"
+	MsgAppDelegateGatewayName = "app_delegate_gateway"
+	MsgAppRevokeGatewayName   = "app_revoke_gateway"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -141,3 +145,87 @@ func (msg MsgAppUnjail) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgAppDelegateGateway - struct for authorizing a gateway key to sign AATs on behalf of the application
+type MsgAppDelegateGateway struct {
+	AppAddr       sdk.Address      `json:"address" yaml:"address"`               // address of the application
+	GatewayPubKey crypto.PublicKey `json:"gateway_pubkey" yaml:"gateway_pubkey"` // public key of the gateway
+}
+
+// Route provides router key for msg
+func (msg MsgAppDelegateGateway) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgAppDelegateGateway) Type() string { return MsgAppDelegateGatewayName }
+
+// GetFee get fee for msg
+func (msg MsgAppDelegateGateway) GetFee() sdk.Int {
+	return sdk.NewInt(AppFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAppDelegateGateway) GetSigner() sdk.Address {
+	return msg.AppAddr
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAppDelegateGateway) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for delegating a gateway
+func (msg MsgAppDelegateGateway) ValidateBasic() sdk.Error {
+	return validateGatewayMsg(msg.AppAddr, msg.GatewayPubKey)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgAppRevokeGateway - struct for revoking a gateway key delegated by the application
+type MsgAppRevokeGateway struct {
+	AppAddr       sdk.Address      `json:"address" yaml:"address"`               // address of the application
+	GatewayPubKey crypto.PublicKey `json:"gateway_pubkey" yaml:"gateway_pubkey"` // public key of the gateway
+}
+
+// Route provides router key for msg
+func (msg MsgAppRevokeGateway) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgAppRevokeGateway) Type() string { return MsgAppRevokeGatewayName }
+
+// GetFee get fee for msg
+func (msg MsgAppRevokeGateway) GetFee() sdk.Int {
+	return sdk.NewInt(AppFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAppRevokeGateway) GetSigner() sdk.Address {
+	return msg.AppAddr
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAppRevokeGateway) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for revoking a gateway
+func (msg MsgAppRevokeGateway) ValidateBasic() sdk.Error {
+	return validateGatewayMsg(msg.AppAddr, msg.GatewayPubKey)
+}
+
+// validateGatewayMsg - quick validity check of the application address and the gateway public key
+func validateGatewayMsg(appAddr sdk.Address, gatewayPubKey crypto.PublicKey) sdk.Error {
+	if appAddr.Empty() {
+		return ErrNilApplicationAddr(DefaultCodespace)
+	}
+	if gatewayPubKey == nil || gatewayPubKey.RawString() == "" {
+		return ErrNilGatewayPubKey(DefaultCodespace)
+	}
+	if appAddr.Equals(sdk.Address(gatewayPubKey.Address())) {
+		return ErrSelfGateway(DefaultCodespace)
+	}
+	return nil
+}
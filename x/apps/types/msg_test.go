@@ -414,3 +414,47 @@ func TestMsgAppUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgAppDelegateGateway_ValidateBasic(t *testing.T) {
+	var app, gateway crypto.Ed25519PublicKey
+	rand.Read(app[:])
+	rand.Read(gateway[:])
+	tests := []struct {
+		name string
+		msg  MsgAppDelegateGateway
+		want sdk.Error
+	}{
+		{
+			name: "errs if no Address",
+			msg:  MsgAppDelegateGateway{GatewayPubKey: gateway},
+			want: ErrNilApplicationAddr(DefaultCodespace),
+		},
+		{
+			name: "errs if no gateway",
+			msg:  MsgAppDelegateGateway{AppAddr: sdk.Address(app.Address())},
+			want: ErrNilGatewayPubKey(DefaultCodespace),
+		},
+		{
+			name: "errs if the gateway is the application",
+			msg:  MsgAppDelegateGateway{AppAddr: sdk.Address(app.Address()), GatewayPubKey: app},
+			want: ErrSelfGateway(DefaultCodespace),
+		},
+		{
+			name: "returns nil if valid",
+			msg:  MsgAppDelegateGateway{AppAddr: sdk.Address(app.Address()), GatewayPubKey: gateway},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.msg.ValidateBasic()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+			// revocations share the validation
+			revoke := MsgAppRevokeGateway(tt.msg)
+			if got := revoke.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
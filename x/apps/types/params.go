@@ -30,6 +30,7 @@ var (
 	StabilityAdjustment    = []byte("StabilityAdjustment")
 	ParticipationRateOn    = []byte("ParticipationRateOn")
 	KeyMaximumChains       = []byte("MaximumChains")
+	KeyStakeAdjustment     = []byte("StakeAdjustment")
 )
 
 var _ types.ParamSet = (*Params)(nil)
@@ -43,6 +44,8 @@ type Params struct {
 	StabilityAdjustment int64         `json:"stability_adjustment" yaml:"stability_adjustment"`   // the stability adjustment from the governance
 	ParticipationRateOn bool          `json:"participation_rate_on" yaml:"participation_rate_on"` // the participation rate affects the amount minted based on staked ratio
 	MaxChains           int64         `json:"maximum_chains" yaml:"maximum_chains"`               // the maximum number of chains an app can stake for
+	// adjustment params
+	StakeAdjustment StakeAdjustment `json:"stake_adjustment" yaml:"stake_adjustment"` // the adjustment of the min stake and the base relays per POKT by the network usage
 }
 
 // Implements params.ParamSet
@@ -55,6 +58,7 @@ func (p *Params) ParamSetPairs() types.ParamSetPairs {
 		{Key: StabilityAdjustment, Value: &p.StabilityAdjustment},
 		{Key: ParticipationRateOn, Value: &p.ParticipationRateOn},
 		{Key: KeyMaximumChains, Value: &p.MaxChains},
+		{Key: KeyStakeAdjustment, Value: &p.StakeAdjustment},
 	}
 }
 
@@ -68,6 +72,7 @@ func DefaultParams() Params {
 		StabilityAdjustment: DefaultStabilityAdjustment,
 		ParticipationRateOn: DefaultParticipationRateOn,
 		MaxChains:           DefaultMaxChains,
+		StakeAdjustment:     DefaultStakeAdjustment(),
 	}
 }
 
@@ -82,6 +87,9 @@ func (p Params) Validate() error {
 	if p.BaseRelaysPerPOKT < 0 {
 		return fmt.Errorf("invalid baseline throughput stake rate, must be above 0")
 	}
+	if err := p.StakeAdjustment.Validate(); err != nil {
+		return err
+	}
 	// todo
 	return nil
 }
@@ -102,12 +110,14 @@ func (p Params) String() string {
   BaseRelaysPerPOKT            %d
   Stability Adjustment         %d
   Participation Rate On        %v
-  MaxChains                    %d,`,
+  MaxChains                    %d,
+  Stake Adjustment             %+v`,
 		p.UnstakingTime,
 		p.MaxApplications,
 		p.AppStakeMin,
 		p.BaseRelaysPerPOKT,
 		p.StabilityAdjustment,
 		p.ParticipationRateOn,
-		p.MaxChains)
+		p.MaxChains,
+		p.StakeAdjustment)
 }
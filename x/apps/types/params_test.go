@@ -21,6 +21,7 @@ func TestDefaultParams(t *testing.T) {
 				StabilityAdjustment: DefaultStabilityAdjustment,
 				ParticipationRateOn: DefaultParticipationRateOn,
 				MaxChains:           DefaultMaxChains,
+				StakeAdjustment:     DefaultStakeAdjustment(),
 			},
 		}}
 	for _, tt := range tests {
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// StakeAdjustment - The formula adjusting the application minimum stake and the base relays per POKT at the start of
+// every era, by the ratio of the relays settled network wide for the sessions of the previous era to a target. Each
+// adjustment is capped to a percentage and the params are kept within bounds. Set through the ACL
+// (application/StakeAdjustment), disabled when the era length is zero
+type StakeAdjustment struct {
+	StartHeight          int64 `json:"start_height" yaml:"start_height"`                         // the height of the first adjustment
+	EraLength            int64 `json:"era_length" yaml:"era_length"`                             // the number of blocks per era, zero disables the adjustment
+	TargetRelays         int64 `json:"target_relays" yaml:"target_relays"`                       // the relays settled per era at which the params are left as is
+	MaxChangePercent     int64 `json:"max_change_percent" yaml:"max_change_percent"`             // the maximum change of the params per era
+	MinAppStake          int64 `json:"min_app_stake" yaml:"min_app_stake"`                       // the lowest application minimum stake
+	MaxAppStake          int64 `json:"max_app_stake" yaml:"max_app_stake"`                       // the highest application minimum stake
+	MinBaseRelaysPerPOKT int64 `json:"min_base_relays_per_pokt" yaml:"min_base_relays_per_pokt"` // the lowest base relays per POKT
+	MaxBaseRelaysPerPOKT int64 `json:"max_base_relays_per_pokt" yaml:"max_base_relays_per_pokt"` // the highest base relays per POKT
+}
+
+// DefaultStakeAdjustment - No adjustment
+func DefaultStakeAdjustment() StakeAdjustment {
+	return StakeAdjustment{}
+}
+
+// IsEnabled - Returns true if the params are adjusted
+func (sa StakeAdjustment) IsEnabled() bool {
+	return sa.EraLength > 0
+}
+
+// Validate - Validates the stake adjustment
+func (sa StakeAdjustment) Validate() error {
+	if !sa.IsEnabled() {
+		if sa.EraLength < 0 {
+			return fmt.Errorf("the stake adjustment era length must not be negative")
+		}
+		return nil
+	}
+	if sa.TargetRelays <= 0 {
+		return fmt.Errorf("the stake adjustment target relays must be positive")
+	}
+	if sa.MaxChangePercent <= 0 || sa.MaxChangePercent > 100 {
+		return fmt.Errorf("the stake adjustment max change must be a percent between 1 and 100")
+	}
+	if sa.MinAppStake < DefaultMinStake || sa.MaxAppStake < sa.MinAppStake {
+		return fmt.Errorf("the stake adjustment app stake bounds must be above %d and ordered", DefaultMinStake)
+	}
+	if sa.MinBaseRelaysPerPOKT < 0 || sa.MaxBaseRelaysPerPOKT < sa.MinBaseRelaysPerPOKT {
+		return fmt.Errorf("the stake adjustment base relays per POKT bounds must not be negative and ordered")
+	}
+	return nil
+}
+
+// IsEraStart - Returns true if the params are adjusted at the height
+func (sa StakeAdjustment) IsEraStart(height int64) bool {
+	if !sa.IsEnabled() || height < sa.StartHeight {
+		return false
+	}
+	return (height-sa.StartHeight)%sa.EraLength == 0
+}
+
+// NextEraStart - Returns the height of the first adjustment after the height
+func (sa StakeAdjustment) NextEraStart(height int64) int64 {
+	if height < sa.StartHeight {
+		return sa.StartHeight
+	}
+	return height + sa.EraLength - (height-sa.StartHeight)%sa.EraLength
+}
+
+// Adjust - Returns the app minimum stake and the base relays per POKT adjusted by the relays settled in an era: the
+// stake follows the ratio of the relays to the target and the base relays per POKT follow its inverse, both capped by
+// the max change and kept within the bounds
+func (sa StakeAdjustment) Adjust(appStakeMin, baseRelaysPerPOKT, relays int64) (int64, int64) {
+	maxChange := sdk.NewDec(sa.MaxChangePercent).QuoInt64(100)
+	ratio := sdk.NewDec(relays).QuoInt64(sa.TargetRelays)
+	if low := sdk.OneDec().Sub(maxChange); ratio.LT(low) {
+		ratio = low
+	}
+	if high := sdk.OneDec().Add(maxChange); ratio.GT(high) {
+		ratio = high
+	}
+	stake := sdk.NewDec(appStakeMin).Mul(ratio).TruncateInt64()
+	base := baseRelaysPerPOKT
+	if ratio.IsPositive() {
+		base = sdk.NewDec(baseRelaysPerPOKT).Quo(ratio).TruncateInt64()
+	}
+	return bound(stake, sa.MinAppStake, sa.MaxAppStake), bound(base, sa.MinBaseRelaysPerPOKT, sa.MaxBaseRelaysPerPOKT)
+}
+
+func bound(value, min, max int64) int64 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// StakeAdjustmentStatus - The stake adjustment, the current params and their next adjustment projected with the relays
+// settled so far for the sessions of the current era
+type StakeAdjustmentStatus struct {
+	Adjustment            StakeAdjustment `json:"adjustment"`
+	AppStakeMin           int64           `json:"app_stake_minimum"`
+	BaseRelaysPerPOKT     int64           `json:"base_relays_per_pokt"`
+	NextAdjustmentHeight  int64           `json:"next_adjustment_height"` // zero when disabled
+	EraRelays             int64           `json:"era_relays"`             // settled so far for the sessions of the current era
+	NextAppStakeMin       int64           `json:"next_app_stake_minimum"`
+	NextBaseRelaysPerPOKT int64           `json:"next_base_relays_per_pokt"`
+}
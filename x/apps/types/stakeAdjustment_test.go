@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStakeAdjustment_Validate(t *testing.T) {
+	valid := StakeAdjustment{StartHeight: 100, EraLength: 10, TargetRelays: 1000, MaxChangePercent: 10,
+		MinAppStake: DefaultMinStake, MaxAppStake: DefaultMinStake * 10, MinBaseRelaysPerPOKT: 10, MaxBaseRelaysPerPOKT: 1000}
+	assert.Nil(t, valid.Validate())
+	assert.Nil(t, DefaultStakeAdjustment().Validate())
+	invalid := valid
+	invalid.TargetRelays = 0
+	assert.NotNil(t, invalid.Validate())
+	invalid = valid
+	invalid.MaxChangePercent = 101
+	assert.NotNil(t, invalid.Validate())
+	invalid = valid
+	invalid.MinAppStake = DefaultMinStake - 1
+	assert.NotNil(t, invalid.Validate())
+	invalid = valid
+	invalid.MaxBaseRelaysPerPOKT = 1
+	assert.NotNil(t, invalid.Validate())
+	invalid = DefaultStakeAdjustment()
+	invalid.EraLength = -1
+	assert.NotNil(t, invalid.Validate())
+}
+
+func TestStakeAdjustment_Eras(t *testing.T) {
+	sa := StakeAdjustment{StartHeight: 100, EraLength: 10}
+	assert.False(t, sa.IsEraStart(90))
+	assert.True(t, sa.IsEraStart(100))
+	assert.False(t, sa.IsEraStart(105))
+	assert.True(t, sa.IsEraStart(110))
+	assert.Equal(t, int64(100), sa.NextEraStart(50))
+	assert.Equal(t, int64(110), sa.NextEraStart(100))
+	assert.Equal(t, int64(110), sa.NextEraStart(105))
+	assert.False(t, DefaultStakeAdjustment().IsEraStart(0))
+}
+
+func TestStakeAdjustment_Adjust(t *testing.T) {
+	sa := StakeAdjustment{StartHeight: 100, EraLength: 10, TargetRelays: 1000, MaxChangePercent: 10,
+		MinAppStake: DefaultMinStake, MaxAppStake: 2 * DefaultMinStake, MinBaseRelaysPerPOKT: 80, MaxBaseRelaysPerPOKT: 200}
+	stake := int64(1500000)
+	// on target
+	s, b := sa.Adjust(stake, 100, 1000)
+	assert.Equal(t, stake, s)
+	assert.Equal(t, int64(100), b)
+	// within the max change
+	s, b = sa.Adjust(stake, 100, 1050)
+	assert.Equal(t, int64(1575000), s)
+	assert.Equal(t, int64(95), b)
+	// capped to the max change
+	s, b = sa.Adjust(stake, 100, 5000)
+	assert.Equal(t, int64(1650000), s)
+	assert.Equal(t, int64(90), b)
+	s, b = sa.Adjust(stake, 100, 0)
+	assert.Equal(t, int64(1350000), s)
+	assert.Equal(t, int64(111), b)
+	// bounded
+	s, b = sa.Adjust(1900000, 85, 5000)
+	assert.Equal(t, 2*DefaultMinStake, s)
+	assert.Equal(t, int64(80), b)
+	s, b = sa.Adjust(DefaultMinStake, 190, 0)
+	assert.Equal(t, DefaultMinStake, s)
+	assert.Equal(t, int64(200), b)
+}
@@ -0,0 +1,77 @@
+package nodes
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/keeper"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/tendermint/tendermint/node"
+)
+
+// NewAnteHandler - Wraps the auth ante handler: a transaction signed by another key than the signer of its message is
+// only valid for a spender key with an allowance of the signer, charged with the fees and the sent tokens
+func NewAnteHandler(authAnteHandler sdk.AnteHandler, k keeper.Keeper) sdk.AnteHandler {
+	return func(ctx sdk.Ctx, tx sdk.Tx, txBz []byte, tmNode *node.Node, simulate bool) (newCtx sdk.Ctx, res sdk.Result, abort bool) {
+		newCtx, res, abort = authAnteHandler(ctx, tx, txBz, tmNode, simulate)
+		if abort {
+			return
+		}
+		// the auth ante handler only accepts a std tx
+		stdTx := tx.(auth.StdTx)
+		pk := stdTx.Signature.PublicKey
+		// signed with the public key of the signer account
+		if pk == nil || len(pk.RawBytes()) == 0 {
+			return
+		}
+		granter, spender := stdTx.GetSigner(), sdk.Address(pk.Address())
+		if spender.Equals(granter) {
+			return
+		}
+		spent := stdTx.Fee.AmountOf(sdk.DefaultStakeDenom)
+		if msg, ok := stdTx.Msg.(types.MsgSend); ok {
+			spent = spent.Add(msg.Amount)
+		}
+		if err := k.UseAllowance(newCtx, granter, spender, stdTx.Msg.Type(), spent); err != nil {
+			return newCtx, err.Result(), true
+		}
+		return
+	}
+}
+
+// NewPostHandler - Wraps the handler of a message: once the message is handled, the fee paid above the fee of the
+// message is refunded from the fee collector to the signer, when the fee refund is enabled by the governance
+// (pos/FeeRefund). The charged and refunded amounts are recorded in a fee refund event
+func NewPostHandler(handler sdk.Handler, ak auth.Keeper, k keeper.Keeper, txDecoder sdk.TxDecoder) sdk.Handler {
+	return func(ctx sdk.Ctx, msg sdk.Msg) sdk.Result {
+		res := handler(ctx, msg)
+		if !res.IsOK() || !k.FeeRefund(ctx) {
+			return res
+		}
+		tx, err := txDecoder(ctx.TxBytes())
+		if err != nil {
+			return res
+		}
+		stdTx, ok := tx.(auth.StdTx)
+		if !ok {
+			return res
+		}
+		paid := stdTx.Fee.AmountOf(sdk.DefaultStakeDenom)
+		charged := ak.GetParams(ctx).FeeMultiplier.GetFee(msg)
+		if !paid.GT(charged) {
+			return res
+		}
+		refunded := paid.Sub(charged)
+		signer := stdTx.GetSigner()
+		if err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, auth.FeeCollectorName, signer, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, refunded))); err != nil {
+			return err.Result()
+		}
+		res.Events = res.Events.AppendEvent(sdk.NewEvent(
+			types.EventTypeFeeRefund,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAddress, signer.String()),
+			sdk.NewAttribute(types.AttributeKeyCharged, charged.String()),
+			sdk.NewAttribute(types.AttributeKeyRefunded, refunded.String()),
+		))
+		return res
+	}
+}
@@ -0,0 +1,94 @@
+package nodes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/node"
+)
+
+func TestAnteHandler_Allowance(t *testing.T) {
+	context, _, k := createTestInput(t, false)
+	authAnteHandler := func(ctx sdk.Ctx, tx sdk.Tx, txBz []byte, tmNode *node.Node, simulate bool) (sdk.Ctx, sdk.Result, bool) {
+		return ctx, sdk.Result{}, false
+	}
+	anteHandler := NewAnteHandler(authAnteHandler, k)
+	granter, spender := getRandomPubKey(), getRandomPubKey()
+	send := types.MsgSend{FromAddress: sdk.Address(granter.Address()), ToAddress: getRandomValidatorAddress(), Amount: sdk.NewInt(50)}
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10)))
+	// signed by the granter
+	tx := auth.StdTx{Msg: send, Fee: fee, Signature: auth.StdSignature{PublicKey: granter}}
+	_, _, abort := anteHandler(context, tx, nil, nil, false)
+	assert.False(t, abort)
+	// signed by the spender without an allowance
+	tx.Signature.PublicKey = spender
+	_, res, abort := anteHandler(context, tx, nil, nil, false)
+	assert.True(t, abort)
+	assert.Equal(t, types.CodeNoAllowance, res.Code)
+	// charged with the fee and the sent tokens
+	k.SetAllowance(context, types.Allowance{
+		Granter:    sdk.Address(granter.Address()),
+		Spender:    sdk.Address(spender.Address()),
+		SpendLimit: sdk.NewInt(100),
+		Expiration: context.BlockTime().Add(time.Hour),
+		Msgs:       []string{types.MsgSendName},
+	})
+	_, _, abort = anteHandler(context, tx, nil, nil, false)
+	assert.False(t, abort)
+	allowance, found := k.GetAllowance(context, sdk.Address(granter.Address()), sdk.Address(spender.Address()))
+	assert.True(t, found)
+	assert.True(t, sdk.NewInt(40).Equal(allowance.SpendLimit))
+	_, res, abort = anteHandler(context, tx, nil, nil, false)
+	assert.True(t, abort)
+	assert.Equal(t, types.CodeAllowanceExceeded, res.Code)
+}
+
+func TestPostHandler_FeeRefund(t *testing.T) {
+	context, accs, k := createTestInput(t, false)
+	ak := k.AccountKeeper.(auth.Keeper)
+	signer := accs[0].GetAddress()
+	send := types.MsgSend{FromAddress: signer, ToAddress: getRandomValidatorAddress(), Amount: sdk.NewInt(50)}
+	charged := ak.GetParams(context).FeeMultiplier.GetFee(send)
+	paid := charged.Add(sdk.NewInt(5))
+	tx := auth.StdTx{Msg: send, Fee: sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, paid))}
+	txDecoder := func(txBytes []byte) (sdk.Tx, sdk.Error) { return tx, nil }
+	handled := sdk.Result{}
+	postHandler := NewPostHandler(func(ctx sdk.Ctx, msg sdk.Msg) sdk.Result { return handled }, ak, k, txDecoder)
+	// the fees paid by the signer are held by the fee collector
+	err := k.AccountKeeper.SendCoinsFromAccountToModule(context, signer, auth.FeeCollectorName, tx.Fee)
+	assert.Nil(t, err)
+	balance := k.AccountKeeper.GetCoins(context, signer).AmountOf(sdk.DefaultStakeDenom)
+	// not refunded when disabled
+	res := postHandler(context, send)
+	assert.True(t, res.IsOK())
+	assert.Empty(t, res.Events)
+	assert.True(t, balance.Equal(k.AccountKeeper.GetCoins(context, signer).AmountOf(sdk.DefaultStakeDenom)))
+	params := k.GetParams(context)
+	params.FeeRefund = true
+	k.SetParams(context, params)
+	// not refunded when the message fails
+	handled = types.ErrNoAllowance(types.DefaultCodespace, types.MsgSendName).Result()
+	res = postHandler(context, send)
+	assert.False(t, res.IsOK())
+	assert.True(t, balance.Equal(k.AccountKeeper.GetCoins(context, signer).AmountOf(sdk.DefaultStakeDenom)))
+	// the fee paid above the fee of the message is refunded
+	handled = sdk.Result{}
+	res = postHandler(context, send)
+	assert.True(t, res.IsOK())
+	assert.True(t, balance.Add(sdk.NewInt(5)).Equal(k.AccountKeeper.GetCoins(context, signer).AmountOf(sdk.DefaultStakeDenom)))
+	assert.Len(t, res.Events, 1)
+	assert.Equal(t, types.EventTypeFeeRefund, res.Events[0].Type)
+	attributes := sdk.StringifyEvent(abci.Event(res.Events[0])).Attributes
+	assert.Contains(t, attributes, sdk.Attribute{Key: types.AttributeKeyCharged, Value: charged.String()})
+	assert.Contains(t, attributes, sdk.Attribute{Key: types.AttributeKeyRefunded, Value: "5"})
+	// nothing is refunded for the exact fee
+	tx.Fee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, charged))
+	res = postHandler(context, send)
+	assert.True(t, res.IsOK())
+	assert.Empty(t, res.Events)
+}
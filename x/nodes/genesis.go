@@ -22,6 +22,8 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 	// set the parameters from the data
 	keeper.SetParams(ctx, data.Params)
+	// if time based sessions from genesis, the first session starts at genesis time
+	keeper.InitSessionBoundary(ctx)
 	// set the 'previous state total power' from the data
 	keeper.SetPrevStateValidatorsPower(ctx, data.PrevStateTotalPower)
 	// for each validator in validators, setup based on genesis file
@@ -117,6 +119,7 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 	if data.PreviousProposer != nil {
 		keeper.SetPreviousProposer(ctx, data.PreviousProposer)
 	}
+	keeper.SetDAOEmission(ctx, data.DAOEmission)
 	return res
 }
 
@@ -156,6 +159,7 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		DAOEmission:              keeper.GetDAOEmission(ctx),
 	}
 }
 
@@ -166,6 +170,9 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	if err = data.DAOEmission.Validate(); err != nil {
+		return err
+	}
 	err = data.Params.Validate()
 	if err != nil {
 		return err
@@ -19,6 +19,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgUnjail(ctx, msg, k)
 		case types.MsgSend:
 			return handleMsgSend(ctx, msg, k)
+		case types.MsgGrantAllowance:
+			return handleMsgGrantAllowance(ctx, msg, k)
+		case types.MsgRevokeAllowance:
+			return handleMsgRevokeAllowance(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -29,10 +33,16 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 func handleStake(ctx sdk.Ctx, msg types.MsgStake, k keeper.Keeper) sdk.Result {
 	// create validator object using the message fields
 	validator := types.NewValidator(sdk.Address(msg.PublicKey.Address()), msg.PublicKey, msg.Chains, msg.ServiceURL, sdk.ZeroInt())
+	validator.OutputAddress = msg.OutputAddress
+	validator.RewardShares = msg.RewardShares
 	// check if they can stake
 	if err := k.ValidateValidatorStaking(ctx, validator, msg.Value); err != nil {
 		return err.Result()
 	}
+	// a staked validator edits its stake
+	if currentValidator, found := k.GetValidator(ctx, validator.Address); found && currentValidator.IsStaked() {
+		return handleEditStake(ctx, msg, currentValidator, validator, k)
+	}
 	// change the validator state to staked
 	err := k.StakeValidator(ctx, validator, msg.Value)
 	if err != nil {
@@ -55,6 +65,27 @@ func handleStake(ctx sdk.Ctx, msg types.MsgStake, k keeper.Keeper) sdk.Result {
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleEditStake(ctx sdk.Ctx, msg types.MsgStake, currentValidator, validator types.Validator, k keeper.Keeper) sdk.Result {
+	added := msg.Value.Sub(currentValidator.StakedTokens)
+	if err := k.EditStakeValidator(ctx, currentValidator, validator, msg.Value); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEditStake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, validator.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, added.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, validator.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keeper) sdk.Result {
 	ctx.Logger().Info("Begin Unstaking Message received from " + msg.Address.String())
 	// move coins from the msg.Address account to a (self-delegation) delegator account
@@ -118,3 +149,54 @@ func handleMsgSend(ctx sdk.Ctx, msg types.MsgSend, k keeper.Keeper) sdk.Result {
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Accounts grant a spender key a capped and expiring allowance to sign their transactions (e.g. an automated claim bot)
+func handleMsgGrantAllowance(ctx sdk.Ctx, msg types.MsgGrantAllowance, k keeper.Keeper) sdk.Result {
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return types.ErrAllowanceExpired(k.Codespace()).Result()
+	}
+	ctx.Logger().Info("Granting Allowance of " + msg.SpendLimit.String() + " to " + msg.Spender.String() + " from " + msg.Granter.String())
+	k.SetAllowance(ctx, types.Allowance{
+		Granter:    msg.Granter,
+		Spender:    msg.Spender,
+		SpendLimit: msg.SpendLimit,
+		Expiration: msg.Expiration,
+		Msgs:       msg.Msgs,
+	})
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeySpender, msg.Spender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.SpendLimit.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Accounts revoke the allowance of a spender key, the transactions it signs for the account are rejected right away
+func handleMsgRevokeAllowance(ctx sdk.Ctx, msg types.MsgRevokeAllowance, k keeper.Keeper) sdk.Result {
+	if _, found := k.GetAllowance(ctx, msg.Granter, msg.Spender); !found {
+		return types.ErrInvalidAllowance(k.Codespace(), "the spender has no allowance from the granter").Result()
+	}
+	ctx.Logger().Info("Revoking Allowance of " + msg.Spender.String() + " from " + msg.Granter.String())
+	k.DeleteAllowance(ctx, msg.Granter, msg.Spender)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeySpender, msg.Spender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
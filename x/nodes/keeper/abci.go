@@ -13,12 +13,15 @@ import (
 // 2) mint any custom awards for each validator
 // 3) set new proposer
 // 4) check block sigs and byzantine evidence to slash
+// 5) mint the era emission to the dao
 func BeginBlocker(ctx sdk.Ctx, req abci.RequestBeginBlock, k Keeper) {
 	// reward the proposer with fees
 	if ctx.BlockHeight() > 1 {
 		previousProposer := k.GetPreviousProposer(ctx)
 		k.blockReward(ctx, previousProposer)
 	}
+	// mint the dao emission of the era (if any)
+	k.mintDAOEmission(ctx)
 	// record the new proposer for when we payout on the next block
 	addr := sdk.Address(req.Header.ProposerAddress)
 	k.SetPreviousProposer(ctx, addr)
@@ -43,6 +46,8 @@ func BeginBlocker(ctx sdk.Ctx, req abci.RequestBeginBlock, k Keeper) {
 
 // EndBlocker - Called at the end of every block, update validator set
 func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
+	// NOTE: UpdateSessionBoundary has to come before UpdateTendermintValidators (needs to know if the session ends)
+	k.UpdateSessionBoundary(ctx)
 	// NOTE: UpdateTendermintValidators has to come before unstakeAllMatureValidators.
 	validatorUpdates := k.UpdateTendermintValidators(ctx)
 	// Unstake all mature validators from the unstakeing queue.
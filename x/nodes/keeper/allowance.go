@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// SetAllowance - Store the allowance granted by the granter to the spender, replacing the previous one
+func (k Keeper) SetAllowance(ctx sdk.Ctx, allowance types.Allowance) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForAllowance(allowance.Granter, allowance.Spender), k.cdc.MustMarshalBinaryLengthPrefixed(allowance))
+}
+
+// GetAllowance - Retrieve the allowance granted by the granter to the spender
+func (k Keeper) GetAllowance(ctx sdk.Ctx, granter, spender sdk.Address) (allowance types.Allowance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForAllowance(granter, spender))
+	if bz == nil {
+		return allowance, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &allowance)
+	return allowance, true
+}
+
+// DeleteAllowance - Remove the allowance granted by the granter to the spender
+func (k Keeper) DeleteAllowance(ctx sdk.Ctx, granter, spender sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForAllowance(granter, spender))
+}
+
+// GetAllowances - Retrieve the allowances granted by the granter
+func (k Keeper) GetAllowances(ctx sdk.Ctx, granter sdk.Address) (allowances []types.Allowance) {
+	allowances = make([]types.Allowance, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForAllowances(granter))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.Allowance
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &allowance)
+		allowances = append(allowances, allowance)
+	}
+	return allowances
+}
+
+// UseAllowance - Charge the uPOKT spent by a message of the granter signed by the spender on the allowance of the
+// spender, the allowance is removed once spent
+func (k Keeper) UseAllowance(ctx sdk.Ctx, granter, spender sdk.Address, msgType string, spent sdk.Int) sdk.Error {
+	allowance, found := k.GetAllowance(ctx, granter, spender)
+	if !found || !allowance.AllowsMsg(msgType) {
+		return types.ErrNoAllowance(k.codespace, msgType)
+	}
+	if allowance.IsExpired(ctx.BlockTime()) {
+		return types.ErrAllowanceExpired(k.codespace)
+	}
+	if spent.GT(allowance.SpendLimit) {
+		return types.ErrAllowanceExceeded(k.codespace, spent, allowance.SpendLimit)
+	}
+	allowance.SpendLimit = allowance.SpendLimit.Sub(spent)
+	if allowance.SpendLimit.IsZero() {
+		k.DeleteAllowance(ctx, granter, spender)
+		return nil
+	}
+	k.SetAllowance(ctx, allowance)
+	return nil
+}
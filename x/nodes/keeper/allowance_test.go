@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_Allowances(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	granter, spender, other := getRandomValidatorAddress(), getRandomValidatorAddress(), getRandomValidatorAddress()
+	allowance := types.Allowance{
+		Granter:    granter,
+		Spender:    spender,
+		SpendLimit: sdk.NewInt(100),
+		Expiration: context.BlockTime().Add(time.Hour),
+		Msgs:       []string{types.MsgSendName},
+	}
+	_, found := keeper.GetAllowance(context, granter, spender)
+	assert.False(t, found)
+	assert.Empty(t, keeper.GetAllowances(context, granter))
+	keeper.SetAllowance(context, allowance)
+	keeper.SetAllowance(context, types.Allowance{Granter: granter, Spender: other, SpendLimit: sdk.OneInt(), Expiration: allowance.Expiration, Msgs: allowance.Msgs})
+	got, found := keeper.GetAllowance(context, granter, spender)
+	assert.True(t, found)
+	assert.True(t, allowance.SpendLimit.Equal(got.SpendLimit))
+	assert.Equal(t, allowance.Msgs, got.Msgs)
+	assert.Len(t, keeper.GetAllowances(context, granter), 2)
+	assert.Empty(t, keeper.GetAllowances(context, spender))
+	keeper.DeleteAllowance(context, granter, other)
+	assert.Len(t, keeper.GetAllowances(context, granter), 1)
+}
+
+func TestKeeper_UseAllowance(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	granter, spender := getRandomValidatorAddress(), getRandomValidatorAddress()
+	keeper.SetAllowance(context, types.Allowance{
+		Granter:    granter,
+		Spender:    spender,
+		SpendLimit: sdk.NewInt(100),
+		Expiration: context.BlockTime().Add(time.Hour),
+		Msgs:       []string{types.MsgSendName},
+	})
+	// not allowed
+	assert.NotNil(t, keeper.UseAllowance(context, spender, granter, types.MsgSendName, sdk.OneInt()))
+	assert.NotNil(t, keeper.UseAllowance(context, granter, spender, types.MsgStakeName, sdk.OneInt()))
+	// above the spend limit
+	err := keeper.UseAllowance(context, granter, spender, types.MsgSendName, sdk.NewInt(101))
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeAllowanceExceeded, err.Code())
+	// charged on the allowance
+	assert.Nil(t, keeper.UseAllowance(context, granter, spender, types.MsgSendName, sdk.NewInt(60)))
+	allowance, found := keeper.GetAllowance(context, granter, spender)
+	assert.True(t, found)
+	assert.True(t, sdk.NewInt(40).Equal(allowance.SpendLimit))
+	// expired
+	err = keeper.UseAllowance(context.WithBlockTime(allowance.Expiration), granter, spender, types.MsgSendName, sdk.OneInt())
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeAllowanceExpired, err.Code())
+	// removed once spent
+	assert.Nil(t, keeper.UseAllowance(context, granter, spender, types.MsgSendName, sdk.NewInt(40)))
+	_, found = keeper.GetAllowance(context, granter, spender)
+	assert.False(t, found)
+}
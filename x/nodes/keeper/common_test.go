@@ -8,6 +8,7 @@ import (
 	"github.com/pokt-network/posmint/crypto"
 	"github.com/pokt-network/posmint/types/module"
 	"github.com/pokt-network/posmint/x/gov"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
@@ -69,9 +70,10 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Context, []auth.Account,
 	cdc := makeTestCodec()
 
 	maccPerms := map[string][]string{
-		auth.FeeCollectorName: nil,
-		types.StakedPoolName:  {auth.Burner, auth.Staking, auth.Minter},
-		types.ModuleName:      {auth.Burner, auth.Staking, auth.Minter},
+		auth.FeeCollectorName:   nil,
+		types.StakedPoolName:    {auth.Burner, auth.Staking, auth.Minter},
+		types.ModuleName:        {auth.Burner, auth.Staking, auth.Minter},
+		govTypes.DAOAccountName: {auth.Burner, auth.Staking, auth.Minter},
 	}
 	modAccAddrs := make(map[string]bool)
 	for acc := range maccPerms {
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+)
+
+// GetDAOEmission - Retrieve the hard cap and the total minted of the dao emission
+func (k Keeper) GetDAOEmission(ctx sdk.Ctx) (emission types.DAOEmission) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DAOEmissionKey)
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &emission)
+	return
+}
+
+// SetDAOEmission - Store the hard cap and the total minted of the dao emission
+func (k Keeper) SetDAOEmission(ctx sdk.Ctx, emission types.DAOEmission) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DAOEmissionKey, k.cdc.MustMarshalBinaryLengthPrefixed(emission))
+}
+
+// EmissionScheduleStatus - Retrieve the dao emission schedule and its progress
+func (k Keeper) EmissionScheduleStatus(ctx sdk.Ctx) types.EmissionScheduleStatus {
+	schedule := k.DAOEmissionSchedule(ctx)
+	emission := k.GetDAOEmission(ctx)
+	status := types.EmissionScheduleStatus{
+		Schedule:  schedule,
+		HardCap:   emission.HardCap,
+		Minted:    emission.Minted,
+		Remaining: emission.Remaining(schedule),
+	}
+	if status.Remaining > 0 && schedule.AmountPerEra > 0 {
+		status.NextEmissionHeight = schedule.NextEraStart(ctx.BlockHeight())
+		status.NextEmissionAmount = schedule.AmountPerEra
+		if status.NextEmissionAmount > status.Remaining {
+			status.NextEmissionAmount = status.Remaining
+		}
+	}
+	return status
+}
+
+// mintDAOEmission - Mints the emission of the era to the dao (if an era starts at this height), never over the caps
+func (k Keeper) mintDAOEmission(ctx sdk.Ctx) {
+	schedule := k.DAOEmissionSchedule(ctx)
+	if !schedule.IsEraStart(ctx.BlockHeight()) {
+		return
+	}
+	emission := k.GetDAOEmission(ctx)
+	amount := schedule.AmountPerEra
+	if remaining := emission.Remaining(schedule); amount > remaining {
+		amount = remaining
+	}
+	if amount <= 0 {
+		return
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), sdk.NewInt(amount)))
+	if err := k.AccountKeeper.MintCoins(ctx, types.StakedPoolName, coins); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("unable to mint %d of the dao emission: %s", amount, err.Error()))
+		return
+	}
+	if err := k.AccountKeeper.SendCoinsFromModuleToModule(ctx, types.StakedPoolName, govTypes.DAOAccountName, coins); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("unable to send %d of the dao emission to the dao: %s", amount, err.Error()))
+		return
+	}
+	emission.Minted += amount
+	k.SetDAOEmission(ctx, emission)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDAOEmission,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewInt(amount).String()),
+	))
+	k.Logger(ctx).Info(fmt.Sprintf("a dao emission of %d was minted, %d minted in total", amount, emission.Minted))
+}
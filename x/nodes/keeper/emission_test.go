@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_MintDAOEmission(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	daoBalance := func() sdk.Int {
+		return keeper.AccountKeeper.GetModuleAccount(context, govTypes.DAOAccountName).GetCoins().AmountOf(keeper.StakeDenom(context))
+	}
+	// no emission by default
+	keeper.mintDAOEmission(context.WithBlockHeight(1))
+	assert.True(t, daoBalance().IsZero())
+	params := keeper.GetParams(context)
+	params.DAOEmissionSchedule = types.EmissionSchedule{StartHeight: 10, EraLength: 5, AmountPerEra: 100, Cap: 250}
+	keeper.SetParams(context, params)
+	// no hard cap set in genesis
+	keeper.mintDAOEmission(context.WithBlockHeight(10))
+	assert.True(t, daoBalance().IsZero())
+	keeper.SetDAOEmission(context, types.DAOEmission{HardCap: 1000})
+	// before the start and between eras
+	keeper.mintDAOEmission(context.WithBlockHeight(5))
+	keeper.mintDAOEmission(context.WithBlockHeight(12))
+	assert.True(t, daoBalance().IsZero())
+	keeper.mintDAOEmission(context.WithBlockHeight(10))
+	keeper.mintDAOEmission(context.WithBlockHeight(15))
+	assert.Equal(t, sdk.NewInt(200), daoBalance())
+	status := keeper.EmissionScheduleStatus(context.WithBlockHeight(16))
+	assert.Equal(t, int64(50), status.Remaining)
+	assert.Equal(t, int64(20), status.NextEmissionHeight)
+	assert.Equal(t, int64(50), status.NextEmissionAmount)
+	// capped by the schedule
+	keeper.mintDAOEmission(context.WithBlockHeight(20))
+	keeper.mintDAOEmission(context.WithBlockHeight(25))
+	assert.Equal(t, sdk.NewInt(250), daoBalance())
+	assert.Equal(t, int64(250), keeper.GetDAOEmission(context).Minted)
+	// raising the schedule cap (via the acl) is still bounded by the hard cap
+	params.DAOEmissionSchedule.Cap = 5000
+	params.DAOEmissionSchedule.AmountPerEra = 1000
+	keeper.SetParams(context, params)
+	keeper.mintDAOEmission(context.WithBlockHeight(30))
+	keeper.mintDAOEmission(context.WithBlockHeight(35))
+	assert.Equal(t, sdk.NewInt(1000), daoBalance())
+	status = keeper.EmissionScheduleStatus(context.WithBlockHeight(36))
+	assert.Equal(t, int64(0), status.Remaining)
+	assert.Equal(t, int64(0), status.NextEmissionHeight)
+}
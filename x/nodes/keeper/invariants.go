@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	authexported "github.com/pokt-network/posmint/x/auth/exported"
+)
+
+// RegisterInvariants - Registers all of the pos module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply", SupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nonnegative-balances", NonNegativeBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "staked-pool", StakedPoolInvariant(k))
+}
+
+// AllInvariants - Runs all of the pos module invariants
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Ctx) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			SupplyInvariant(k),
+			NonNegativeBalancesInvariant(k),
+			StakedPoolInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// SupplyInvariant - Checks that the total supply equals the sum of the tokens held by every account (module accounts included)
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Ctx) (string, bool) {
+		denom := k.StakeDenom(ctx)
+		total := sdk.ZeroInt()
+		k.AccountKeeper.IterateAccounts(ctx, func(acc authexported.Account) bool {
+			total = total.Add(acc.GetCoins().AmountOf(denom))
+			return false
+		})
+		supply := k.TotalTokens(ctx)
+		broken := !total.Equal(supply)
+		return sdk.FormatInvariant(types.ModuleName, "supply",
+			fmt.Sprintf("\tsum of account tokens: %v\n\tsupply: %v\n", total, supply)), broken
+	}
+}
+
+// NonNegativeBalancesInvariant - Checks that no account holds negative coins and no validator has negative staked tokens
+func NonNegativeBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Ctx) (string, bool) {
+		var msg string
+		var count int
+		k.AccountKeeper.IterateAccounts(ctx, func(acc authexported.Account) bool {
+			if acc.GetCoins().IsAnyNegative() {
+				count++
+				msg += fmt.Sprintf("\taccount %s has a negative balance: %v\n", acc.GetAddress(), acc.GetCoins())
+			}
+			return false
+		})
+		for _, validator := range k.GetAllValidators(ctx) {
+			if validator.StakedTokens.IsNegative() {
+				count++
+				msg += fmt.Sprintf("\tvalidator %s has negative staked tokens: %v\n", validator.Address, validator.StakedTokens)
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "nonnegative balances",
+			fmt.Sprintf("found %d negative balances\n%s", count, msg)), broken
+	}
+}
+
+// StakedPoolInvariant - Checks that the staked pool holds exactly the tokens staked by the validators
+func StakedPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Ctx) (string, bool) {
+		staked := sdk.ZeroInt()
+		for _, validator := range k.GetAllValidators(ctx) {
+			staked = staked.Add(validator.StakedTokens)
+		}
+		pool := k.GetStakedTokens(ctx)
+		broken := !pool.Equal(staked)
+		return sdk.FormatInvariant(types.ModuleName, "staked pool",
+			fmt.Sprintf("\tstaked pool tokens: %v\n\tsum of validator tokens: %v\n", pool, staked)), broken
+	}
+}
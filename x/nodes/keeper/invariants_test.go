@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvariants(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	// the test accounts are funded without minting
+	keeper.AccountKeeper.SetSupply(context, keeper.AccountKeeper.GetSupply(context).SetTotal(
+		sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(context), accs[0].GetCoins().AmountOf(keeper.StakeDenom(context)).MulRaw(int64(len(accs)))))))
+	_, broken := AllInvariants(keeper)(context)
+	assert.False(t, broken)
+	// staking moves tokens into the staked pool
+	validator := getStakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	addMintedCoinsToModule(t, context, &keeper, types.ModuleName)
+	sendFromModuleToAccount(t, context, &keeper, types.ModuleName, validator.Address, sdk.NewInt(100000000000))
+	err := keeper.StakeValidator(context, validator, sdk.NewInt(100000000000))
+	assert.Nil(t, err)
+	_, broken = AllInvariants(keeper)(context)
+	assert.False(t, broken)
+	// tokens out of thin air
+	_ = keeper.AccountKeeper.SetCoins(context, accs[0].GetAddress(), accs[0].GetCoins().Add(sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(context), sdk.OneInt()))))
+	_, broken = SupplyInvariant(keeper)(context)
+	assert.True(t, broken)
+	// staked tokens not backed by the staked pool
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	validator.StakedTokens = validator.StakedTokens.AddRaw(1)
+	keeper.SetValidator(context, validator)
+	_, broken = StakedPoolInvariant(keeper)(context)
+	assert.True(t, broken)
+	_, broken = NonNegativeBalancesInvariant(keeper)(context)
+	assert.False(t, broken)
+}
@@ -122,6 +122,27 @@ func (k Keeper) BlocksPerSession(ctx sdk.Ctx) (res int64) {
 	k.Paramstore.Get(ctx, types.KeySessionBlock, &res)
 	return
 }
+// SessionDuration - Retrieve the target wall clock duration of a session (zero means block frequency based sessions)
+func (k Keeper) SessionDuration(ctx sdk.Ctx) (res time.Duration) {
+	// not in the paramstore of chains started before time based sessions
+	k.Paramstore.GetIfExists(ctx, types.KeySessionDuration, &res)
+	return
+}
+
+// DAOEmissionSchedule - Retrieve the schedule of the uPOKT minted to the dao
+func (k Keeper) DAOEmissionSchedule(ctx sdk.Ctx) (res types.EmissionSchedule) {
+	// not in the paramstore of chains started before the emission schedule
+	k.Paramstore.GetIfExists(ctx, types.KeyDAOEmissionSchedule, &res)
+	return
+}
+
+// FeeRefund - Retrieve whether the fee paid above the fee of the message is refunded
+func (k Keeper) FeeRefund(ctx sdk.Ctx) (res bool) {
+	// not in the paramstore of chains started before the fee refund
+	k.Paramstore.GetIfExists(ctx, types.KeyFeeRefund, &res)
+	return
+}
+
 func (k Keeper) MaxChains(ctx sdk.Ctx) (res int64) {
 	k.Paramstore.Get(ctx, types.KeyMaxChains, &res)
 	return
@@ -140,6 +161,7 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 		StakeMinimum:            k.MinimumStake(ctx),
 		ProposerAllocation:      k.ProposerAllocation(ctx),
 		SessionBlockFrequency:   k.BlocksPerSession(ctx),
+		SessionDuration:         k.SessionDuration(ctx),
 		DAOAllocation:           k.DAOAllocation(ctx),
 		MaxEvidenceAge:          k.MaxEvidenceAge(ctx),
 		SignedBlocksWindow:      k.SignedBlocksWindow(ctx),
@@ -149,6 +171,8 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 		SlashFractionDowntime:   k.SlashFractionDowntime(ctx),
 		MaximumChains:           k.MaxChains(ctx),
 		MaxJailedBlocks:         k.MaxJailedBlocks(ctx),
+		DAOEmissionSchedule:     k.DAOEmissionSchedule(ctx),
+		FeeRefund:               k.FeeRefund(ctx),
 	}
 }
 
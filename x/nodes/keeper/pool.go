@@ -24,12 +24,12 @@ func (k Keeper) GetStakedPool(ctx sdk.Ctx) (stakedPool exported.ModuleAccountI)
 	return k.AccountKeeper.GetModuleAccount(ctx, types.StakedPoolName)
 }
 
-// coinsFromStakedToUnstaked - Transfer coins from the module account to the validator -> used in unstaking
+// coinsFromStakedToUnstaked - Transfer coins from the module account to the output address of the validator -> used in unstaking
 func (k Keeper) coinsFromStakedToUnstaked(ctx sdk.Ctx, validator types.Validator) error {
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), validator.StakedTokens))
-	err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, validator.Address, coins)
+	err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, validator.GetOutputAddress(), coins)
 	if err != nil {
-		return fmt.Errorf("unable to send coins from staked to unstaked for address: %s", validator.Address)
+		return fmt.Errorf("unable to send coins from staked to unstaked for address: %s", validator.GetOutputAddress())
 	}
 	return nil
 }
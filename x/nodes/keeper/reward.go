@@ -9,16 +9,42 @@ import (
 	govTypes "github.com/pokt-network/posmint/x/gov/types"
 )
 
-// RewardForRelays - Award coins to an address (will be called at the beginning of the next block)
-func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.Address) {
+// RewardForRelays - Award coins to an address (will be called at the beginning of the next block), returns the total minted.
+// The reward of a validator is split between its reward shares and its output address
+func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.Address) (minted sdk.Int) {
+	minted = sdk.ZeroInt()
 	coins := k.RelaysToTokensMultiplier(ctx).Mul(relays)
 	toNode, toFeeCollector := k.NodeReward(ctx, coins)
-	if toNode.IsPositive() {
-		k.mint(ctx, toNode, address)
+	cuts := []types.RewardCut{{Address: address, Amount: toNode}}
+	if validator, found := k.GetValidator(ctx, address); found {
+		cuts = validator.SplitReward(toNode)
+	}
+	for _, cut := range cuts {
+		if !cut.Amount.IsPositive() {
+			continue
+		}
+		if res := k.mint(ctx, cut.Amount, cut.Address); res.IsOK() {
+			minted = minted.Add(cut.Amount)
+		}
 	}
 	if toFeeCollector.IsPositive() {
-		k.mint(ctx, toFeeCollector, k.getFeePool(ctx).GetAddress())
+		if res := k.mint(ctx, toFeeCollector, k.getFeePool(ctx).GetAddress()); res.IsOK() {
+			minted = minted.Add(toFeeCollector)
+		}
+	}
+	return
+}
+
+// RewardForChallengeReport - Mints the reward for a proven challenge to the reporter, returns the total minted
+func (k Keeper) RewardForChallengeReport(ctx sdk.Ctx, amount sdk.Int, address sdk.Address) (minted sdk.Int) {
+	minted = sdk.ZeroInt()
+	if !amount.IsPositive() || address.Empty() {
+		return
+	}
+	if res := k.mint(ctx, amount, address); res.IsOK() {
+		minted = amount
 	}
+	return
 }
 
 // blockReward - Handles distribution of the collected fees
@@ -45,10 +71,25 @@ func (k Keeper) blockReward(ctx sdk.Ctx, previousProposer sdk.Address) {
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("unable to send %s cut of block reward to the dao: %s", daoCut.String(), err.Error()))
 	}
-	err = k.AccountKeeper.SendCoins(ctx, feeAddr, previousProposer, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, proposerCut)))
-	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("unable to send %s cut of block reward to the proposer: %s", proposerCut.String(), err.Error()))
+	// the proposer cut is split between the reward shares and the output address of the proposer
+	cuts := []types.RewardCut{{Address: previousProposer, Amount: proposerCut}}
+	if proposer, found := k.GetValidator(ctx, previousProposer); found {
+		cuts = proposer.SplitReward(proposerCut)
+	}
+	for _, cut := range cuts {
+		if !cut.Amount.IsPositive() {
+			continue
+		}
+		err = k.AccountKeeper.SendCoins(ctx, feeAddr, cut.Address, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, cut.Amount)))
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("unable to send %s cut of block reward to %s: %s", cut.Amount.String(), cut.Address.String(), err.Error()))
+		}
 	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposerReward,
+		sdk.NewAttribute(types.AttributeKeyValidator, previousProposer.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, proposerCut.String()),
+	))
 }
 
 // "mint" - takes an amount and mints it to the node staking pool, then sends the coins to the address
@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	"github.com/pokt-network/pocket-core/x/nodes/types"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -85,6 +86,32 @@ func TestMint(t *testing.T) {
 	}
 }
 
+func TestKeeper_RewardForChallengeReport(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	reporter := getRandomValidatorAddress()
+	minted := keeper.RewardForChallengeReport(context, sdk.NewInt(100), reporter)
+	assert.Equal(t, sdk.NewInt(100), minted)
+	assert.Equal(t, sdk.NewInt(100), keeper.AccountKeeper.GetCoins(context, reporter).AmountOf(keeper.StakeDenom(context)))
+	// nothing to reward
+	assert.True(t, keeper.RewardForChallengeReport(context, sdk.ZeroInt(), reporter).IsZero())
+	assert.True(t, keeper.RewardForChallengeReport(context, sdk.NewInt(100), nil).IsZero())
+}
+
+func TestKeeper_RewardForRelaysShares(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	delegate := getRandomValidatorAddress()
+	validator.RewardShares = []types.RewardShare{{Address: delegate, Percentage: 25}}
+	keeper.SetValidator(context, validator)
+	minted := keeper.RewardForRelays(context, sdk.NewInt(100), validator.Address)
+	assert.True(t, minted.IsPositive())
+	toNode, _ := keeper.NodeReward(context, keeper.RelaysToTokensMultiplier(context).Mul(sdk.NewInt(100)))
+	delegateCut := toNode.MulRaw(25).QuoRaw(100)
+	assert.True(t, delegateCut.IsPositive())
+	assert.True(t, delegateCut.Equal(keeper.GetBalance(context, delegate)))
+	assert.True(t, toNode.Sub(delegateCut).Equal(keeper.GetBalance(context, validator.Address)))
+}
+
 func TestKeeper_rewardFromFees(t *testing.T) {
 	type fields struct {
 		keeper Keeper
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// InitSessionBoundary - Records the first session as time based, if time based sessions are enabled at genesis
+func (k Keeper) InitSessionBoundary(ctx sdk.Ctx) {
+	if k.SessionDuration(ctx) > 0 {
+		k.setSessionBoundary(ctx, types.SessionBoundary{Height: 1, Time: ctx.BlockTime(), TimeBased: true})
+	}
+}
+
+// UpdateSessionBoundary - Decides if the next block is the first block of a new session and records it.
+// A boundary is recorded when the session mode changes (migration), ending the current session, or when a time based
+// session reached its duration. Called at the end of every block, so the session end is known one block ahead
+func (k Keeper) UpdateSessionBoundary(ctx sdk.Ctx) {
+	duration := k.SessionDuration(ctx)
+	timeBased := duration > 0
+	current := k.getLatestSessionBoundary(ctx, ctx.BlockHeight())
+	if timeBased == current.TimeBased && (!timeBased || ctx.BlockTime().Before(current.Time.Add(duration))) {
+		return
+	}
+	k.setSessionBoundary(ctx, types.SessionBoundary{Height: ctx.BlockHeight() + 1, Time: ctx.BlockTime(), TimeBased: timeBased})
+}
+
+// GetLatestSessionBlockHeight - Returns the latest session block height (first block of the session)
+func (k Keeper) GetLatestSessionBlockHeight(ctx sdk.Ctx) int64 {
+	return k.sessionBlockHeightAt(ctx, ctx.BlockHeight())
+}
+
+// IsSessionBlock - Returns true if the current block is a session block (first block of a session)
+func (k Keeper) IsSessionBlock(ctx sdk.Ctx) bool {
+	return k.GetLatestSessionBlockHeight(ctx) == ctx.BlockHeight()
+}
+
+// IsSessionEndBlock - Returns true if the current block is the last block of a session
+func (k Keeper) IsSessionEndBlock(ctx sdk.Ctx) bool {
+	next := ctx.BlockHeight() + 1
+	return k.sessionBlockHeightAt(ctx, next) == next
+}
+
+// NextSessionBlockHeight - Returns the first block of the session after the session that starts at sessionBlockHeight.
+// Not found if the session is time based and still in progress
+func (k Keeper) NextSessionBlockHeight(ctx sdk.Ctx, sessionBlockHeight int64) (height int64, found bool) {
+	current := k.getLatestSessionBoundary(ctx, sessionBlockHeight)
+	next, found := k.getNextSessionBoundary(ctx, sessionBlockHeight)
+	if current.TimeBased {
+		return next.Height, found
+	}
+	// block frequency based sessions end early if the mode changed
+	height = sessionBlockHeight + k.BlocksPerSession(ctx)
+	if found && next.Height < height {
+		return next.Height, true
+	}
+	return height, true
+}
+
+// SessionBlockHeightAfter - Returns the first block of the session n sessions after the session that starts at sessionBlockHeight
+func (k Keeper) SessionBlockHeightAfter(ctx sdk.Ctx, sessionBlockHeight int64, n int64) (height int64, found bool) {
+	height = sessionBlockHeight
+	for i := int64(0); i < n; i++ {
+		height, found = k.NextSessionBlockHeight(ctx, height)
+		if !found {
+			return 0, false
+		}
+	}
+	return height, true
+}
+
+// sessionBlockHeightAt - Returns the first block of the session that height is in
+func (k Keeper) sessionBlockHeightAt(ctx sdk.Ctx, height int64) int64 {
+	boundary := k.getLatestSessionBoundary(ctx, height)
+	if boundary.TimeBased {
+		return boundary.Height
+	}
+	blocksPerSession := k.BlocksPerSession(ctx)
+	return boundary.Height + ((height-boundary.Height)/blocksPerSession)*blocksPerSession
+}
+
+// setSessionBoundary - Stores a session boundary
+func (k Keeper) setSessionBoundary(ctx sdk.Ctx, boundary types.SessionBoundary) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForSessionBoundary(boundary.Height), k.cdc.MustMarshalBinaryBare(boundary))
+}
+
+// getLatestSessionBoundary - Returns the latest session boundary at or before height (defaults to the genesis boundary)
+func (k Keeper) getLatestSessionBoundary(ctx sdk.Ctx, height int64) (boundary types.SessionBoundary) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.SessionBoundaryKey, types.KeyForSessionBoundary(height+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.GenesisSessionBoundary()
+	}
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &boundary)
+	return
+}
+
+// getNextSessionBoundary - Returns the first session boundary after height
+func (k Keeper) getNextSessionBoundary(ctx sdk.Ctx, height int64) (boundary types.SessionBoundary, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyForSessionBoundary(height+1), sdk.PrefixEndBytes(types.SessionBoundaryKey))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.SessionBoundary{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &boundary)
+	return boundary, true
+}
+
+// NewSessionSnapshot - Returns the snapshot of the validators and reward params in the state of the context, the state
+// sessions are generated from with the context of a session block
+func (k Keeper) NewSessionSnapshot(ctx sdk.Ctx) types.SessionSnapshot {
+	snapshot := types.SessionSnapshot{
+		SessionBlockHeight:       k.GetLatestSessionBlockHeight(ctx),
+		RelaysToTokensMultiplier: k.RelaysToTokensMultiplier(ctx),
+		DAOAllocation:            k.DAOAllocation(ctx),
+		ProposerAllocation:       k.ProposerAllocation(ctx),
+		TotalStaked:              sdk.ZeroInt(),
+		Validators:               make([]types.ValidatorSnapshot, 0),
+	}
+	for _, v := range k.GetStakedValidators(ctx) {
+		validator := v.(types.Validator)
+		if validator.IsJailed() {
+			continue
+		}
+		snapshot.Validators = append(snapshot.Validators, types.NewValidatorSnapshot(validator))
+		snapshot.TotalStaked = snapshot.TotalStaked.Add(validator.StakedTokens)
+	}
+	return snapshot
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionBlockHeights_BlockFrequency(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	blocksPerSession := keeper.BlocksPerSession(context)
+	for height := int64(1); height <= 3*blocksPerSession; height++ {
+		ctx := context.WithBlockHeight(height)
+		// the legacy calculation
+		var expected int64
+		if height%blocksPerSession == 0 {
+			expected = height - blocksPerSession + 1
+		} else {
+			expected = (height/blocksPerSession)*blocksPerSession + 1
+		}
+		assert.Equal(t, expected, keeper.GetLatestSessionBlockHeight(ctx))
+		assert.Equal(t, height%blocksPerSession == 1, keeper.IsSessionBlock(ctx))
+		assert.Equal(t, height%blocksPerSession == 0, keeper.IsSessionEndBlock(ctx))
+		keeper.UpdateSessionBoundary(ctx)
+	}
+	next, found := keeper.NextSessionBlockHeight(context, 1)
+	assert.True(t, found)
+	assert.Equal(t, blocksPerSession+1, next)
+	after, found := keeper.SessionBlockHeightAfter(context, 1, 3)
+	assert.True(t, found)
+	assert.Equal(t, 3*blocksPerSession+1, after)
+}
+
+func TestSessionBlockHeights_TimeBased(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	start := time.Unix(1000, 0).UTC()
+	blockTime := time.Minute
+	// run a few block frequency based blocks before switching modes
+	height := int64(1)
+	for ; height <= 3; height++ {
+		keeper.UpdateSessionBoundary(context.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * blockTime)))
+	}
+	// enable time based sessions by governance
+	params := keeper.GetParams(context)
+	params.SessionDuration = 5 * time.Minute
+	keeper.SetParams(context, params)
+	ctx := context.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * blockTime))
+	assert.False(t, keeper.IsSessionEndBlock(ctx))
+	// migration: the current session ends and the next one is time based
+	keeper.UpdateSessionBoundary(ctx)
+	assert.True(t, keeper.IsSessionEndBlock(ctx))
+	next, found := keeper.NextSessionBlockHeight(ctx, 1)
+	assert.True(t, found)
+	assert.Equal(t, height+1, next)
+	timeBasedStart := height + 1
+	var sessionBlocks []int64
+	for height = timeBasedStart; height <= timeBasedStart+20; height++ {
+		ctx = context.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * blockTime))
+		if keeper.IsSessionBlock(ctx) {
+			sessionBlocks = append(sessionBlocks, height)
+		}
+		keeper.UpdateSessionBoundary(ctx)
+	}
+	// five one minute blocks per five minute session
+	assert.Equal(t, []int64{timeBasedStart, timeBasedStart + 5, timeBasedStart + 10, timeBasedStart + 15, timeBasedStart + 20}, sessionBlocks)
+	after, found := keeper.SessionBlockHeightAfter(ctx, timeBasedStart, 2)
+	assert.True(t, found)
+	assert.Equal(t, timeBasedStart+10, after)
+	// the current session is still in progress
+	_, found = keeper.NextSessionBlockHeight(ctx, timeBasedStart+20)
+	assert.False(t, found)
+	// disable time based sessions, block frequency based sessions continue after the current session
+	params.SessionDuration = 0
+	keeper.SetParams(context, params)
+	keeper.UpdateSessionBoundary(ctx)
+	blockBasedStart := height
+	ctx = context.WithBlockHeight(blockBasedStart)
+	assert.True(t, keeper.IsSessionBlock(ctx))
+	next, found = keeper.NextSessionBlockHeight(ctx, blockBasedStart)
+	assert.True(t, found)
+	assert.Equal(t, blockBasedStart+keeper.BlocksPerSession(ctx), next)
+	assert.Equal(t, blockBasedStart, keeper.GetLatestSessionBlockHeight(context.WithBlockHeight(next-1)))
+}
+
+func TestSessionSnapshot(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	ctx := context.WithBlockHeight(1)
+	staked := getStakedValidator()
+	staked.Chains = []string{"0001"}
+	other := getStakedValidator()
+	other.Chains = []string{"0002"}
+	jailed := getStakedValidator()
+	jailed.Jailed = true
+	for _, v := range []types.Validator{staked, other, jailed} {
+		keeper.SetValidator(ctx, v)
+		keeper.SetStakedValidator(ctx, v)
+	}
+	snapshot := keeper.NewSessionSnapshot(ctx)
+	assert.Equal(t, int64(1), snapshot.SessionBlockHeight)
+	assert.Equal(t, keeper.RelaysToTokensMultiplier(ctx), snapshot.RelaysToTokensMultiplier)
+	assert.Equal(t, keeper.DAOAllocation(ctx), snapshot.DAOAllocation)
+	// the jailed validator is not part of the sessions
+	assert.Len(t, snapshot.Validators, 2)
+	assert.True(t, staked.StakedTokens.Add(other.StakedTokens).Equal(snapshot.TotalStaked))
+	forChain := snapshot.ForChain("0001")
+	assert.Equal(t, []types.ValidatorSnapshot{types.NewValidatorSnapshot(staked)}, forChain.Validators)
+	assert.True(t, staked.StakedTokens.Equal(forChain.TotalStaked))
+	assert.Empty(t, snapshot.ForChain("ffff").Validators)
+}
@@ -11,15 +11,16 @@ import (
 	sdk "github.com/pokt-network/posmint/types"
 )
 
-// BurnForChallenge - Tries to remove coins from account & supply for a challenged validator
-func (k Keeper) BurnForChallenge(ctx sdk.Ctx, challenges sdk.Int, address sdk.Address) {
+// BurnForChallenge - Tries to remove coins from account & supply for a challenged validator, returns the total burned
+func (k Keeper) BurnForChallenge(ctx sdk.Ctx, challenges sdk.Int, address sdk.Address) (burned sdk.Int) {
 	coins := k.RelaysToTokensMultiplier(ctx).Mul(challenges)
-	k.simpleSlash(ctx, address, coins)
+	return k.simpleSlash(ctx, address, coins)
 }
 
 // simpleSlash - Slash validator for an infraction committed at a known height
 // Find the contributing stake at that height and burn the specified slashFactor
-func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.Int) {
+func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.Int) (burned sdk.Int) {
+	burned = sdk.ZeroInt()
 	// error check slash
 	validator := k.validateSimpleSlash(ctx, addr, amount)
 	if validator.Address.Empty() {
@@ -38,6 +39,7 @@ func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.Int) {
 		k.Logger(ctx).Error("could not burn staked tokens in simpleSlash: " + err.Error() + "\nfor validator " + addr.String())
 		return
 	}
+	burned = tokensToBurn
 	// if falls below minimum force burn all of the stake
 	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		err := k.ForceValidatorUnstake(ctx, validator)
@@ -45,10 +47,12 @@ func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.Int) {
 			k.Logger(ctx).Error("could not burn forceUnstake in simpleSlash: " + err.Error() + "\nfor validator " + addr.String())
 			return
 		}
+		k.emitTombstoneEvent(ctx, validator, types.AttributeValueBelowMinimumStake)
 	}
 	// Log that a slash occurred
 	ctx.Logger().Info(fmt.Sprintf("validator %s simple slashed; burned %s tokens",
 		validator.GetAddress(), amount.String()))
+	return
 }
 
 // validateSimpleSlash - Check if simpleSlash is possible
@@ -74,8 +78,10 @@ func (k Keeper) validateSimpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.In
 }
 
 // slash - Slash a validator for an infraction committed at a known height
-// Find the contributing stake at that height and burn the specified slashFactor
-func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int64, slashFactor sdk.Dec) {
+// Find the contributing stake at that height and burn the specified slashFactor, returns the burned tokens and the
+// resulting stake of the validator
+func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int64, slashFactor sdk.Dec) (burned, stake sdk.Int) {
+	burned, stake = sdk.ZeroInt(), sdk.ZeroInt()
 	// error check slash
 	validator := k.validateSlash(ctx, addr, infractionHeight, power, slashFactor)
 	if validator.Address == nil {
@@ -100,6 +106,7 @@ func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int
 		k.Logger(ctx).Error("could not burn staked tokens in slash: " + err.Error() + "\nfor validator " + addr.String())
 		return
 	}
+	burned, stake = tokensToBurn, validator.GetTokens()
 	// if falls below minimum force burn all of the stake
 	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		err := k.ForceValidatorUnstake(ctx, validator)
@@ -107,10 +114,26 @@ func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int
 			k.Logger(ctx).Error("could not forceUnstake in slash: " + err.Error() + "\nfor validator " + addr.String())
 			return
 		}
+		stake = sdk.ZeroInt()
+		k.emitTombstoneEvent(ctx, validator, types.AttributeValueBelowMinimumStake)
 	}
 	// Log that a slash occurred
 	logger.Info(fmt.Sprintf("validator %s slashed by slash factor of %s; burned %v tokens",
 		validator.GetAddress(), slashFactor.String(), tokensToBurn))
+	return
+}
+
+// emitTombstoneEvent - Emits the event of a validator whose whole stake was burned (force unstaked) for the reason
+func (k Keeper) emitTombstoneEvent(ctx sdk.Ctx, validator types.Validator, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTombstone,
+			sdk.NewAttribute(types.AttributeKeyAddress, validator.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, validator.StakedTokens.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
 }
 
 // validateSlash - Check if slash  is possible
@@ -154,15 +177,19 @@ func (k Keeper) handleDoubleSign(ctx sdk.Ctx, addr crypto.Address, infractionHei
 	// slash validator
 	// `power` is the int64 power of the validator as provided to/by Tendermint. This value is validator.StakedTokens as
 	// sent to Tendermint via ABCI, and now received as evidence. The fraction is passed in to separately to slash
+	burned, stake := k.slash(ctx, address, distributionHeight, power, fraction)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
 			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
 			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", infractionHeight)),
+			sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyStake, stake.String()),
 		),
 	)
-	k.slash(ctx, address, distributionHeight, power, fraction)
 	// todo fix once tendermint is patched
 }
 
@@ -266,6 +293,8 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, address crypto.Address, po
 			// Note that this *can* result in a negative "distributionHeight" up to -ValidatorUpdateDelay-1,
 			// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+			fraction := k.SlashFractionDowntime(ctx)
+			burned, stake := k.slash(ctx, addr, distributionHeight, power, fraction)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -273,9 +302,12 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, address crypto.Address, po
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, addr.String()),
+					sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", height)),
+					sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+					sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+					sdk.NewAttribute(types.AttributeKeyStake, stake.String()),
 				),
 			)
-			k.slash(ctx, addr, distributionHeight, power, k.SlashFractionDowntime(ctx))
 			k.JailValidator(ctx, addr)
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon restaking.
@@ -297,6 +329,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, address crypto.Address, po
 				k.Logger(ctx).Error("could not forceUnstake jailed validator: " + err.Error() + "\nfor validator " + addr.String())
 			} else {
 				signInfo.JailedBlocksCounter = 0
+				k.emitTombstoneEvent(ctx, val, types.AttributeValueMaxJailedBlocks)
 			}
 		}
 		// Set the updated signing info
@@ -464,12 +464,14 @@ func TestSlash(t *testing.T) {
 				fraction = keeper.SlashFractionDoubleSign(context)
 			}
 
-			keeper.slash(context, sdk.Address(cryptoAddr), infractionHeight, test.args.power, fraction)
+			burned, stake := keeper.slash(context, sdk.Address(cryptoAddr), infractionHeight, test.args.power, fraction)
 			validator, found := keeper.GetValidator(context, sdk.Address(cryptoAddr))
 			if !found {
 				t.Fail()
 			}
 			assert.True(t, validator.StakedTokens.Equal(test.expected.stakedTokens), "tokens were not slashed")
+			assert.True(t, stake.Equal(validator.StakedTokens))
+			assert.True(t, burned.Equal(test.args.validator.StakedTokens.Sub(validator.StakedTokens)))
 		})
 	}
 }
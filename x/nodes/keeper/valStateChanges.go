@@ -21,7 +21,7 @@ func (k Keeper) UpdateTendermintValidators(ctx sdk.Ctx) (updates []abci.Validato
 	// get the world state
 	store := ctx.KVStore(k.storeKey)
 	// allow all waiting to begin unstaking to begin unstaking
-	if ctx.BlockHeight()%k.BlocksPerSession(ctx) == 0 { // one block before new session (mod 1 would be session block)
+	if k.IsSessionEndBlock(ctx) { // one block before new session
 		k.ReleaseWaitingValidators(ctx)
 	}
 	maxValidators := k.GetParams(ctx).MaxValidators
@@ -102,6 +102,16 @@ func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validator types.Validator,
 	// check to see if teh public key has already been register for that validator
 	val, found := k.GetValidator(ctx, validator.Address)
 	if found {
+		// a staked validator edits its stake
+		if val.IsStaked() {
+			if err := k.ValidateOutputAddressEdit(ctx, val, validator.OutputAddress); err != nil {
+				return err
+			}
+			if err := k.ValidateRewardSharesEdit(ctx, val, validator.RewardShares); err != nil {
+				return err
+			}
+			return k.ValidateEditStake(ctx, val, amount)
+		}
 		if !val.IsUnstaked() {
 			return types.ErrValidatorStatus(k.codespace)
 		}
@@ -165,6 +175,77 @@ func (k Keeper) StakeValidator(ctx sdk.Ctx, validator types.Validator, amount sd
 	return nil
 }
 
+// ValidateEditStake - Check a staked validator before editing its stake (amount is the new total stake)
+func (k Keeper) ValidateEditStake(ctx sdk.Ctx, currentValidator types.Validator, amount sdk.Int) sdk.Error {
+	if currentValidator.IsJailed() {
+		return types.ErrValidatorJailed(k.codespace)
+	}
+	diff := amount.Sub(currentValidator.StakedTokens)
+	if diff.IsNegative() {
+		return types.ErrMinimumEditStake(k.codespace)
+	}
+	if !k.AccountKeeper.HasCoins(ctx, currentValidator.Address, sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), diff))) {
+		return types.ErrNotEnoughCoins(k.codespace)
+	}
+	return nil
+}
+
+// ValidateOutputAddressEdit - Check the output address of an edit stake: the output address can be set while it is
+// the operator address (e.g. the validators staked before the output addresses), but never redirected afterwards as the
+// operator key (a hot key) could otherwise take the rewards and the stake
+func (k Keeper) ValidateOutputAddressEdit(ctx sdk.Ctx, currentValidator types.Validator, outputAddress sdk.Address) sdk.Error {
+	if outputAddress.Empty() || outputAddress.Equals(currentValidator.GetOutputAddress()) {
+		return nil
+	}
+	if !currentValidator.GetOutputAddress().Equals(currentValidator.Address) {
+		return types.ErrOutputAddressEdit(k.codespace)
+	}
+	return nil
+}
+
+// ValidateRewardSharesEdit - Check the reward shares of an edit stake: like the output address, they can only be changed
+// while the output address is the operator address, the operator key could otherwise route the rewards elsewhere
+// (omitted shares keep the current ones)
+func (k Keeper) ValidateRewardSharesEdit(ctx sdk.Ctx, currentValidator types.Validator, rewardShares []types.RewardShare) sdk.Error {
+	if len(rewardShares) == 0 || types.RewardSharesEqual(currentValidator.RewardShares, rewardShares) {
+		return nil
+	}
+	if !currentValidator.GetOutputAddress().Equals(currentValidator.Address) {
+		return types.ErrRewardSharesEdit(k.codespace)
+	}
+	return nil
+}
+
+// EditStakeValidator - Store ops when a staked validator edits its chains, service url and/or adds to its stake
+func (k Keeper) EditStakeValidator(ctx sdk.Ctx, currentValidator, updatedValidator types.Validator, amount sdk.Int) sdk.Error {
+	diff := amount.Sub(currentValidator.StakedTokens)
+	// send the added coins from address to staked module account
+	if err := k.coinsFromUnstakedToStaked(ctx, currentValidator, diff); err != nil {
+		return err
+	}
+	// the staking set is indexed by power and the chains are indexed by network id, remove the old entries
+	k.deleteValidatorFromStakingSet(ctx, currentValidator)
+	k.deleteValidatorForChains(ctx, currentValidator)
+	validator, er := currentValidator.AddStakedTokens(diff)
+	if er != nil {
+		return sdk.ErrInternal(er.Error())
+	}
+	validator.Chains = updatedValidator.Chains
+	validator.ServiceURL = updatedValidator.ServiceURL
+	if !updatedValidator.OutputAddress.Empty() {
+		validator.OutputAddress = updatedValidator.OutputAddress
+	}
+	if len(updatedValidator.RewardShares) != 0 {
+		validator.RewardShares = updatedValidator.RewardShares
+	}
+	// save in the validator store
+	k.SetValidator(ctx, validator)
+	// save in the network id stores for quick session generations
+	k.SetStakedValidatorByChains(ctx, validator)
+	ctx.Logger().Info(fmt.Sprintf("Successfully edited the stake of validator: %s, added %s", validator.Address.String(), diff.String()))
+	return nil
+}
+
 // ValidateValidatorBeginUnstaking - Check for validator status
 func (k Keeper) ValidateValidatorBeginUnstaking(ctx sdk.Ctx, validator types.Validator) sdk.Error {
 	// must be staked to begin unstaking
@@ -346,8 +427,18 @@ func (k Keeper) JailValidator(ctx sdk.Ctx, addr sdk.Address) {
 	// clear caching for sesssions
 	k.ClearValidatorCache()
 	k.deleteValidatorFromStakingSet(ctx, validator)
+	power := validator.ConsensusPower()
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJail,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyStake, validator.StakedTokens.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("validator %s jailed", addr))
 }
@@ -330,3 +330,148 @@ func TestKeeper_WaitToBeginUnstakingValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_EditStakeValidator(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	stakeAmount := sdk.NewInt(keeper.MinimumStake(context))
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount.MulRaw(2))
+	assert.Nil(t, keeper.ValidateValidatorStaking(context, validator, stakeAmount))
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	staked, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	// the new stake must be greater than or equal to the current stake
+	assert.Equal(t, types.ErrMinimumEditStake(types.ModuleName), keeper.ValidateValidatorStaking(context, staked, stakeAmount.SubRaw(1)))
+	// not enough coins
+	assert.Equal(t, types.ErrNotEnoughCoins(types.ModuleName), keeper.ValidateValidatorStaking(context, staked, stakeAmount.MulRaw(3)))
+	// add to the stake and change the chains
+	updated := staked
+	updated.Chains = []string{"0002"}
+	newStake := stakeAmount.Add(stakeAmount.QuoRaw(2))
+	assert.Nil(t, keeper.ValidateValidatorStaking(context, updated, newStake))
+	assert.Nil(t, keeper.EditStakeValidator(context, staked, updated, newStake))
+	edited, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.True(t, edited.IsStaked())
+	assert.Equal(t, newStake, edited.StakedTokens)
+	assert.Equal(t, []string{"0002"}, edited.Chains)
+	assert.Len(t, keeper.GetValidatorsByChain(context, "0002"), 1)
+	assert.Len(t, keeper.GetValidatorsByChain(context, staked.Chains[0]), 0)
+	assert.Len(t, keeper.getStakedValidators(context), 1)
+	// jailed validators can't edit their stake
+	edited.Jailed = true
+	keeper.SetValidator(context, edited)
+	assert.Equal(t, types.ErrValidatorJailed(types.ModuleName), keeper.ValidateValidatorStaking(context, edited, newStake))
+}
+
+func TestKeeper_OutputAddress(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	stakeAmount := sdk.NewInt(keeper.MinimumStake(context))
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount.MulRaw(2))
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	staked, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	// without an output address the operator address receives the rewards
+	assert.True(t, staked.GetOutputAddress().Equals(staked.Address))
+	// a custodial validator sets its output address
+	output := getRandomValidatorAddress()
+	updated := staked
+	updated.OutputAddress = output
+	assert.Nil(t, keeper.ValidateValidatorStaking(context, updated, stakeAmount))
+	assert.Nil(t, keeper.EditStakeValidator(context, staked, updated, stakeAmount))
+	edited, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.True(t, edited.GetOutputAddress().Equals(output))
+	// but can't redirect it afterwards
+	updated.OutputAddress = getRandomValidatorAddress()
+	assert.Equal(t, types.ErrOutputAddressEdit(types.ModuleName), keeper.ValidateValidatorStaking(context, updated, stakeAmount))
+	// an edit without output address keeps it
+	updated.OutputAddress = nil
+	assert.Nil(t, keeper.ValidateValidatorStaking(context, updated, stakeAmount))
+	// the rewards and the unstaked tokens go to the output address
+	operatorBalance := keeper.GetBalance(context, validator.Address)
+	minted := keeper.RewardForRelays(context, sdk.NewInt(10), validator.Address)
+	assert.True(t, minted.IsPositive())
+	assert.True(t, keeper.GetBalance(context, output).IsPositive())
+	assert.Equal(t, operatorBalance, keeper.GetBalance(context, validator.Address))
+	rewards := keeper.GetBalance(context, output)
+	keeper.FinishUnstakingValidator(context, edited.UpdateStatus(sdk.Unstaking))
+	assert.Equal(t, rewards.Add(stakeAmount), keeper.GetBalance(context, output))
+	assert.Equal(t, operatorBalance, keeper.GetBalance(context, validator.Address))
+}
+
+func TestSetValidatorsOutputAddress(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	migrated, err := SetValidatorsOutputAddress(context, context.KVStore(keeper.storeKey), keeper.cdc)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), migrated)
+	// the migration writes the store directly
+	v := types.MustUnmarshalValidator(keeper.cdc, context.KVStore(keeper.storeKey).Get(types.KeyForValByAllVals(validator.Address)))
+	assert.Equal(t, validator.Address, v.OutputAddress)
+	// already migrated
+	migrated, err = SetValidatorsOutputAddress(context, context.KVStore(keeper.storeKey), keeper.cdc)
+	assert.Nil(t, err)
+	assert.Zero(t, migrated)
+}
+
+func TestKeeper_ValidateRewardSharesEdit(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	shares := []types.RewardShare{{Address: getRandomValidatorAddress(), Percentage: 10}}
+	// the operator changes the shares while it receives the rewards
+	assert.Nil(t, keeper.ValidateRewardSharesEdit(context, validator, shares))
+	// but not once an output address is set
+	validator.OutputAddress = getRandomValidatorAddress()
+	assert.Nil(t, keeper.ValidateRewardSharesEdit(context, validator, nil))
+	err := keeper.ValidateRewardSharesEdit(context, validator, shares)
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeRewardSharesEdit, err.Code())
+	validator.RewardShares = shares
+	assert.Nil(t, keeper.ValidateRewardSharesEdit(context, validator, shares))
+}
+
+func TestKeeper_EditStakeValidatorKeepsRewardShares(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	shares := []types.RewardShare{{Address: getRandomValidatorAddress(), Percentage: 10}}
+	validator.RewardShares = shares
+	stakeAmount := sdk.NewInt(keeper.MinimumStake(context))
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount.MulRaw(3))
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	staked, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.Equal(t, shares, staked.RewardShares)
+	// a restake without reward shares (like the auto restake) keeps them, while the operator receives the rewards
+	restake := staked
+	restake.RewardShares = nil
+	newStake := stakeAmount.Add(stakeAmount.QuoRaw(2))
+	assert.Nil(t, keeper.ValidateValidatorStaking(context, restake, newStake))
+	assert.Nil(t, keeper.EditStakeValidator(context, staked, restake, newStake))
+	edited, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.Equal(t, shares, edited.RewardShares)
+	// and once an output address is set
+	updated := edited
+	updated.OutputAddress = getRandomValidatorAddress()
+	assert.Nil(t, keeper.EditStakeValidator(context, edited, updated, newStake))
+	edited, found = keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	restake = edited
+	restake.OutputAddress = nil
+	restake.RewardShares = nil
+	assert.Nil(t, keeper.ValidateValidatorStaking(context, restake, stakeAmount.MulRaw(2)))
+	assert.Nil(t, keeper.EditStakeValidator(context, edited, restake, stakeAmount.MulRaw(2)))
+	edited, found = keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.Equal(t, shares, edited.RewardShares)
+	assert.True(t, edited.GetOutputAddress().Equals(updated.OutputAddress))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
 	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/posmint/codec"
 	sdk "github.com/pokt-network/posmint/types"
 )
 
@@ -113,3 +114,27 @@ func (k Keeper) IterateAndExecuteOverVals(
 		i++
 	}
 }
+
+// SetValidatorsOutputAddress - Migration setting the output address of the validators staked before the output
+// addresses to their operator address (where their rewards and unstaked tokens already go)
+func SetValidatorsOutputAddress(ctx sdk.Ctx, store sdk.KVStore, cdc *codec.Codec) (migrated int64, err error) {
+	iterator := sdk.KVStorePrefixIterator(store, types.AllValidatorsKey)
+	validators := make([]types.Validator, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		validator, err := types.UnmarshalValidator(cdc, iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return 0, err
+		}
+		if validator.OutputAddress.Empty() {
+			validators = append(validators, validator)
+		}
+	}
+	iterator.Close()
+	for _, validator := range validators {
+		validator.OutputAddress = validator.Address
+		store.Set(types.KeyForValByAllVals(validator.Address), types.MustMarshalValidator(cdc, validator))
+		migrated++
+	}
+	return migrated, nil
+}
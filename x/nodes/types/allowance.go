@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// Allowance - A capped and expiring spending allowance granted by an account to a spender key, so automated services
+// (e.g. claim bots and payout services) sign the transactions of the account without holding its key
+type Allowance struct {
+	Granter    sdk.Address `json:"granter" yaml:"granter"`         // the account paying the fees and the sends
+	Spender    sdk.Address `json:"spender" yaml:"spender"`         // the key signing the transactions of the granter
+	SpendLimit sdk.Int     `json:"spend_limit" yaml:"spend_limit"` // the uPOKT (fees and sends) the spender may still spend
+	Expiration time.Time   `json:"expiration" yaml:"expiration"`   // the block time the allowance expires at
+	Msgs       []string    `json:"msgs" yaml:"msgs"`               // the message types the spender may sign for the granter
+}
+
+// IsExpired - Returns true if the allowance is expired at the block time
+func (a Allowance) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(a.Expiration)
+}
+
+// AllowsMsg - Returns true if the spender may sign the message type for the granter
+func (a Allowance) AllowsMsg(msgType string) bool {
+	for _, m := range a.Msgs {
+		if m == msgType {
+			return true
+		}
+	}
+	return false
+}
@@ -10,6 +10,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBeginUnstake{}, "pos/MsgBeginUnstake", nil)
 	cdc.RegisterConcrete(MsgUnjail{}, "pos/MsgUnjail", nil)
 	cdc.RegisterConcrete(MsgSend{}, "pos/Send", nil)
+	cdc.RegisterConcrete(MsgGrantAllowance{}, "pos/MsgGrantAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeAllowance{}, "pos/MsgRevokeAllowance", nil)
 }
 
 var ModuleCdc *codec.Codec // generic sealed codec to be used throughout this module
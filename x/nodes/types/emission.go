@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+)
+
+// EmissionSchedule - The schedule of the uPOKT minted to the dao, adjustable through the ACL (pos/DAOEmissionSchedule)
+// but never minting more than the cap of the schedule and the hard cap set in genesis
+type EmissionSchedule struct {
+	StartHeight  int64 `json:"start_height" yaml:"start_height"`     // the height of the first era
+	EraLength    int64 `json:"era_length" yaml:"era_length"`         // the number of blocks per era (1 mints every block)
+	AmountPerEra int64 `json:"amount_per_era" yaml:"amount_per_era"` // the uPOKT minted to the dao at the start of each era
+	Cap          int64 `json:"cap" yaml:"cap"`                       // the total uPOKT the schedule may mint
+}
+
+// DefaultEmissionSchedule - No emission to the dao
+func DefaultEmissionSchedule() EmissionSchedule {
+	return EmissionSchedule{
+		StartHeight: 1,
+		EraLength:   1,
+	}
+}
+
+// Validate - Validates the emission schedule
+func (es EmissionSchedule) Validate() error {
+	if es.AmountPerEra < 0 || es.Cap < 0 {
+		return fmt.Errorf("the emission schedule amount per era and cap must not be negative")
+	}
+	if es.AmountPerEra > 0 && es.EraLength < 1 {
+		return fmt.Errorf("the emission schedule era length must be positive")
+	}
+	return nil
+}
+
+// IsEraStart - Returns true if an era of the schedule (with a positive emission) starts at the height
+func (es EmissionSchedule) IsEraStart(height int64) bool {
+	if es.AmountPerEra <= 0 || es.EraLength < 1 || height < es.StartHeight {
+		return false
+	}
+	return (height-es.StartHeight)%es.EraLength == 0
+}
+
+// NextEraStart - Returns the height of the first era start after the height
+func (es EmissionSchedule) NextEraStart(height int64) int64 {
+	if height < es.StartHeight {
+		return es.StartHeight
+	}
+	return height + es.EraLength - (height-es.StartHeight)%es.EraLength
+}
+
+// DAOEmission - The hard cap of the dao emission (only set in genesis) and the total minted so far
+type DAOEmission struct {
+	HardCap int64 `json:"hard_cap" yaml:"hard_cap"`
+	Minted  int64 `json:"minted" yaml:"minted"`
+}
+
+// Validate - Validates the dao emission
+func (de DAOEmission) Validate() error {
+	if de.HardCap < 0 || de.Minted < 0 {
+		return fmt.Errorf("the dao emission hard cap and minted must not be negative")
+	}
+	if de.Minted > de.HardCap {
+		return fmt.Errorf("the dao emission minted %d is over the hard cap %d", de.Minted, de.HardCap)
+	}
+	return nil
+}
+
+// Remaining - Returns what is left to mint under the lowest of the schedule cap and the hard cap
+func (de DAOEmission) Remaining(es EmissionSchedule) int64 {
+	limit := de.HardCap
+	if es.Cap < limit {
+		limit = es.Cap
+	}
+	if remaining := limit - de.Minted; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// EmissionScheduleStatus - The emission schedule of the dao and its progress
+type EmissionScheduleStatus struct {
+	Schedule           EmissionSchedule `json:"schedule"`
+	HardCap            int64            `json:"hard_cap"`
+	Minted             int64            `json:"minted"`
+	Remaining          int64            `json:"remaining"`            // left to mint under the schedule cap and the hard cap
+	NextEmissionHeight int64            `json:"next_emission_height"` // zero when nothing is left to mint
+	NextEmissionAmount int64            `json:"next_emission_amount"`
+}
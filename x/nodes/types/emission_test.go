@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmissionSchedule(t *testing.T) {
+	assert.Nil(t, DefaultEmissionSchedule().Validate())
+	assert.False(t, DefaultEmissionSchedule().IsEraStart(1))
+	es := EmissionSchedule{StartHeight: 10, EraLength: 5, AmountPerEra: 100, Cap: 1000}
+	assert.Nil(t, es.Validate())
+	assert.False(t, es.IsEraStart(5))
+	assert.True(t, es.IsEraStart(10))
+	assert.False(t, es.IsEraStart(11))
+	assert.True(t, es.IsEraStart(15))
+	assert.Equal(t, int64(10), es.NextEraStart(1))
+	assert.Equal(t, int64(15), es.NextEraStart(10))
+	assert.Equal(t, int64(15), es.NextEraStart(14))
+	es.EraLength = 0
+	assert.NotNil(t, es.Validate())
+	es.EraLength, es.Cap = 1, -1
+	assert.NotNil(t, es.Validate())
+}
+
+func TestDAOEmission(t *testing.T) {
+	es := EmissionSchedule{StartHeight: 1, EraLength: 1, AmountPerEra: 100, Cap: 500}
+	assert.Equal(t, int64(300), DAOEmission{HardCap: 1000, Minted: 200}.Remaining(es))
+	assert.Equal(t, int64(100), DAOEmission{HardCap: 300, Minted: 200}.Remaining(es))
+	assert.Equal(t, int64(0), DAOEmission{HardCap: 1000, Minted: 600}.Remaining(es))
+	assert.Nil(t, DAOEmission{HardCap: 1000, Minted: 200}.Validate())
+	assert.NotNil(t, DAOEmission{HardCap: 100, Minted: 200}.Validate())
+	assert.NotNil(t, DAOEmission{HardCap: -1}.Validate())
+}
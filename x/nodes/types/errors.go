@@ -31,8 +31,53 @@ const (
 	CodeInvalidServiceURL        CodeType          = 118
 	CodeInvalidNetworkIdentifier CodeType          = 119
 	CodeTooManyChains            CodeType          = 120
+	CodeMinimumEditStake         CodeType          = 121
+	CodeInvalidOutputAddress     CodeType          = 122
+	CodeOutputAddressEdit        CodeType          = 123
+	CodeInvalidAllowance         CodeType          = 124
+	CodeNoAllowance              CodeType          = 125
+	CodeAllowanceExpired         CodeType          = 126
+	CodeAllowanceExceeded        CodeType          = 127
+	CodeInvalidRewardShares      CodeType          = 128
+	CodeRewardSharesEdit         CodeType          = 129
 )
 
+func ErrInvalidRewardShares(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRewardShares, "the reward shares are not valid: "+err.Error())
+}
+
+func ErrRewardSharesEdit(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRewardSharesEdit, "the reward shares of a validator can't be changed once its output address is set to another address than the operator address")
+}
+
+func ErrInvalidAllowance(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAllowance, "the allowance is not valid: "+reason)
+}
+
+func ErrNoAllowance(codespace sdk.CodespaceType, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAllowance, fmt.Sprintf("the transaction is not signed by its signer, nor by a spender allowed to sign %s messages for it", msgType))
+}
+
+func ErrAllowanceExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAllowanceExpired, "the allowance of the spender is expired")
+}
+
+func ErrAllowanceExceeded(codespace sdk.CodespaceType, spent, limit sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeAllowanceExceeded, fmt.Sprintf("the transaction spends %s uPOKT, above the %s uPOKT left on the allowance", spent, limit))
+}
+
+func ErrInvalidOutputAddress(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidOutputAddress, "the output address is not valid: "+err.Error())
+}
+
+func ErrOutputAddressEdit(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeOutputAddressEdit, "the output address of a validator can't be changed once it is set to another address than the operator address")
+}
+
+func ErrMinimumEditStake(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMinimumEditStake, "validator must edit stake with a stake greater than or equal to the current stake")
+}
+
 func ErrTooManyChains(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeTooManyChains, "can't stake for this many chains")
 }
@@ -5,21 +5,38 @@ const (
 	EventTypeCompleteUnstaking       = "complete_unstaking"
 	EventTypeCreateValidator         = "create_validator"
 	EventTypeStake                   = "stake"
+	EventTypeEditStake               = "edit_stake"
 	EventTypeBeginUnstake            = "begin_unstake"
 	EventTypeWaitingToBeginUnstaking = "waiting_to_begin_unstaking"
 	EventTypeUnstake                 = "unstake"
 	EventTypeProposerReward          = "proposer_reward"
 	EventTypeDAOAllocation           = "dao_allocation"
+	EventTypeDAOEmission             = "dao_emission"
 	EventTypeSlash                   = "slash"
 	EventTypeLiveness                = "liveness"
+	EventTypeJail                    = "jail"
+	EventTypeTombstone               = "tombstone" // the stake of a slashed or jailed validator is burned (force unstaked)
+	EventTypeGrantAllowance          = "grant_allowance"
+	EventTypeRevokeAllowance         = "revoke_allowance"
+	EventTypeFeeRefund               = "fee_refund"
 	AttributeKeyAddress              = "address"
 	AttributeKeyHeight               = "height"
 	AttributeKeyPower                = "power"
 	AttributeKeyReason               = "reason"
 	AttributeKeyJailed               = "jailed"
 	AttributeKeyMissedBlocks         = "missed_blocks"
+	AttributeKeyInfractionHeight     = "infraction_height"
+	AttributeKeyFraction             = "fraction"
+	AttributeKeyBurned               = "burned"
+	AttributeKeyStake                = "stake" // the resulting stake of the validator
 	AttributeValueDoubleSign         = "double_sign"
 	AttributeValueMissingSignature   = "missing_signature"
+	AttributeValueBelowMinimumStake  = "below_minimum_stake"
+	AttributeValueMaxJailedBlocks    = "max_jailed_blocks"
 	AttributeKeyValidator            = "validator"
+	AttributeKeyGranter              = "granter"
+	AttributeKeySpender              = "spender"
+	AttributeKeyCharged              = "charged"
+	AttributeKeyRefunded             = "refunded"
 	AttributeValueCategory           = ModuleName
 )
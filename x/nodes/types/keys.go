@@ -29,6 +29,9 @@ var ( // Keys for store prefixes
 	AwardValidatorKey               = []byte{0x51} // prefix for awarding validators
 	BurnValidatorKey                = []byte{0x52} // prefix for awarding validators
 	WaitingToBeginUnstakingKey      = []byte{0x43} // prefix for waiting validators
+	SessionBoundaryKey              = []byte{0x61} // prefix for the recorded session boundaries
+	DAOEmissionKey                  = []byte{0x71} // key for the hard cap and the total minted of the dao emission
+	AllowanceKey                    = []byte{0x81} // prefix for the spending allowances granted by the accounts
 )
 
 func KeyForValidatorByNetworkID(addr sdk.Address, networkID []byte) []byte {
@@ -79,6 +82,23 @@ func KeyForValidatorBurn(address sdk.Address) []byte {
 	return append(BurnValidatorKey, address...)
 }
 
+// generates the key for the session boundary (first block of a session) at height
+func KeyForSessionBoundary(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(SessionBoundaryKey, bz...)
+}
+
+// generates the key for the allowance granted by the granter to the spender
+func KeyForAllowance(granter, spender sdk.Address) []byte {
+	return append(KeyForAllowances(granter), spender.Bytes()...)
+}
+
+// generates the key for the allowances granted by the granter
+func KeyForAllowances(granter sdk.Address) []byte {
+	return append(append([]byte{}, AllowanceKey...), granter.Bytes()...)
+}
+
 // Removes the prefix bytes from a key to expose true address
 func AddressFromKey(key []byte) []byte {
 	return key[1:] // remove prefix bytes
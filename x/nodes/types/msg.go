@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 )
@@ -11,13 +13,17 @@ var (
 	_ sdk.Msg = &MsgBeginUnstake{}
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgGrantAllowance{}
+	_ sdk.Msg = &MsgRevokeAllowance{}
 )
 
 const (
-	MsgStakeName   = "stake_validator"
-	MsgUnstakeName = "begin_unstake_validator"
-	MsgUnjailName  = "unjail_validator"
-	MsgSendName    = "send"
+	MsgStakeName           = "stake_validator"
+	MsgUnstakeName         = "begin_unstake_validator"
+	MsgUnjailName          = "unjail_validator"
+	MsgSendName            = "send"
+	MsgGrantAllowanceName  = "grant_allowance"
+	MsgRevokeAllowanceName = "revoke_allowance"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -28,6 +34,10 @@ type MsgStake struct {
 	Chains     []string         `json:"chains" yaml:"chains"`
 	Value      sdk.Int          `json:"value" yaml:"value"`
 	ServiceURL string           `json:"service_url" yaml:"service_url"`
+	// the address receiving the rewards and the unstaked tokens (a cold wallet), the operator address if empty
+	OutputAddress sdk.Address `json:"output_address,omitempty" yaml:"output_address"`
+	// the percentages of the rewards routed to delegate addresses, the rest goes to the output address
+	RewardShares []RewardShare `json:"reward_shares,omitempty" yaml:"reward_shares"`
 }
 
 // GetSigners retrun address(es) that must sign over msg.GetSignBytes()
@@ -61,6 +71,14 @@ func (msg MsgStake) ValidateBasic() sdk.Error {
 	if err := ValidateServiceURL(msg.ServiceURL); err != nil {
 		return err
 	}
+	if !msg.OutputAddress.Empty() {
+		if err := sdk.VerifyAddressFormat(msg.OutputAddress); err != nil {
+			return ErrInvalidOutputAddress(DefaultCodespace, err)
+		}
+	}
+	if err := ValidateRewardShares(msg.RewardShares); err != nil {
+		return ErrInvalidRewardShares(DefaultCodespace, err)
+	}
 	return nil
 }
 
@@ -193,3 +211,106 @@ func (msg MsgSend) Type() string { return MsgSendName }
 func (msg MsgSend) GetFee() sdk.Int {
 	return sdk.NewInt(NodeFeeMap[msg.Type()])
 }
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgGrantAllowance - struct for granting a spender key a capped and expiring spending allowance, replacing the
+// previous allowance of the spender if any
+type MsgGrantAllowance struct {
+	Granter    sdk.Address `json:"granter" yaml:"granter"`         // the account granting the allowance
+	Spender    sdk.Address `json:"spender" yaml:"spender"`         // the key allowed to sign for the granter
+	SpendLimit sdk.Int     `json:"spend_limit" yaml:"spend_limit"` // the uPOKT (fees and sends) the spender may spend
+	Expiration time.Time   `json:"expiration" yaml:"expiration"`   // the block time the allowance expires at
+	Msgs       []string    `json:"msgs" yaml:"msgs"`               // the message types the spender may sign
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgGrantAllowance) GetSigner() sdk.Address {
+	return msg.Granter
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgGrantAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgGrantAllowance) ValidateBasic() sdk.Error {
+	if err := validateAllowanceAddresses(msg.Granter, msg.Spender); err != nil {
+		return err
+	}
+	if !msg.SpendLimit.IsPositive() {
+		return ErrInvalidAllowance(DefaultCodespace, "the spend limit must be positive")
+	}
+	if msg.Expiration.IsZero() {
+		return ErrInvalidAllowance(DefaultCodespace, "the expiration is not set")
+	}
+	if len(msg.Msgs) == 0 {
+		return ErrInvalidAllowance(DefaultCodespace, "no message type is allowed")
+	}
+	for _, m := range msg.Msgs {
+		// a spender never manages the allowances of the granter
+		if m == "" || m == MsgGrantAllowanceName || m == MsgRevokeAllowanceName {
+			return ErrInvalidAllowance(DefaultCodespace, "the message type "+m+" can't be allowed")
+		}
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgGrantAllowance) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgGrantAllowance) Type() string { return MsgGrantAllowanceName }
+
+// GetFee get fee for msg
+func (msg MsgGrantAllowance) GetFee() sdk.Int {
+	return sdk.NewInt(NodeFeeMap[msg.Type()])
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgRevokeAllowance - struct for revoking the allowance granted to a spender key
+type MsgRevokeAllowance struct {
+	Granter sdk.Address `json:"granter" yaml:"granter"` // the account that granted the allowance
+	Spender sdk.Address `json:"spender" yaml:"spender"` // the key allowed to sign for the granter
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRevokeAllowance) GetSigner() sdk.Address {
+	return msg.Granter
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgRevokeAllowance) ValidateBasic() sdk.Error {
+	return validateAllowanceAddresses(msg.Granter, msg.Spender)
+}
+
+// Route provides router key for msg
+func (msg MsgRevokeAllowance) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRevokeAllowance) Type() string { return MsgRevokeAllowanceName }
+
+// GetFee get fee for msg
+func (msg MsgRevokeAllowance) GetFee() sdk.Int {
+	return sdk.NewInt(NodeFeeMap[msg.Type()])
+}
+
+// validateAllowanceAddresses - quick validity check of the granter and the spender of an allowance
+func validateAllowanceAddresses(granter, spender sdk.Address) sdk.Error {
+	if granter.Empty() || spender.Empty() {
+		return ErrInvalidAllowance(DefaultCodespace, "the granter and the spender must be set")
+	}
+	if granter.Equals(spender) {
+		return ErrInvalidAllowance(DefaultCodespace, "an account can't grant an allowance to itself")
+	}
+	return nil
+}
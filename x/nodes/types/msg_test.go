@@ -7,6 +7,7 @@ import (
 	"math/rand"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestMsgBeginUnstake_GetSignBytes(t *testing.T) {
@@ -753,3 +754,33 @@ func TestMsgUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgGrantAllowance_ValidateBasic(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	granter := sdk.Address(pub.Address())
+	rand.Read(pub[:])
+	spender := sdk.Address(pub.Address())
+	expiration := time.Unix(1000, 0).UTC()
+
+	tests := []struct {
+		name    string
+		msg     MsgGrantAllowance
+		wantErr bool
+	}{
+		{"Test ValidateBasic OK", MsgGrantAllowance{granter, spender, sdk.OneInt(), expiration, []string{MsgSendName}}, false},
+		{"Test ValidateBasic no spender", MsgGrantAllowance{granter, nil, sdk.OneInt(), expiration, []string{MsgSendName}}, true},
+		{"Test ValidateBasic self allowance", MsgGrantAllowance{granter, granter, sdk.OneInt(), expiration, []string{MsgSendName}}, true},
+		{"Test ValidateBasic no spend limit", MsgGrantAllowance{granter, spender, sdk.ZeroInt(), expiration, []string{MsgSendName}}, true},
+		{"Test ValidateBasic no expiration", MsgGrantAllowance{granter, spender, sdk.OneInt(), time.Time{}, []string{MsgSendName}}, true},
+		{"Test ValidateBasic no msgs", MsgGrantAllowance{granter, spender, sdk.OneInt(), expiration, nil}, true},
+		{"Test ValidateBasic allowance msgs", MsgGrantAllowance{granter, spender, sdk.OneInt(), expiration, []string{MsgGrantAllowanceName}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); (got != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ const (
 	DefaultDAOAllocation                  = 10
 	DefaultMaxChains                      = 15
 	DefaultMaxJailedBlocks                = 1000
+	DefaultFeeRefund                      = false
 )
 
 //  - Keys for parameter access
@@ -41,10 +42,13 @@ var (
 	KeySlashFractionDowntime       = []byte("SlashFractionDowntime")
 	KeyRelaysToTokensMultiplier    = []byte("RelaysToTokensMultiplier")
 	KeySessionBlock                = []byte("BlocksPerSession")
+	KeySessionDuration             = []byte("SessionDuration")
 	KeyDAOAllocation               = []byte("DAOAllocation")
 	KeyProposerAllocation          = []byte("ProposerPercentage")
 	KeyMaxChains                   = []byte("MaximumChains")
 	KeyMaxJailedBlocks             = []byte("MaxJailedBlocks")
+	KeyDAOEmissionSchedule         = []byte("DAOEmissionSchedule")
+	KeyFeeRefund                   = []byte("FeeRefund")
 	DoubleSignJailEndTime          = time.Unix(253402300799, 0) // forever
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
@@ -61,6 +65,7 @@ type Params struct {
 	StakeDenom               string        `json:"stake_denom" yaml:"stake_denom"`                         // the monetary denomination of the coins in the network `uPOKT` or `uAtom` or `Wei`
 	StakeMinimum             int64         `json:"stake_minimum" yaml:"stake_minimum"`                     // minimum amount of `uPOKT` needed to stake in the network as a node
 	SessionBlockFrequency    int64         `json:"session_block_frequency" yaml:"session_block_frequency"` // how many blocks are in a session (pocket network unit)
+	SessionDuration          time.Duration `json:"session_duration" yaml:"session_duration"`               // if set, sessions target this wall clock duration (using block timestamps) instead of the block frequency
 	DAOAllocation            int64         `json:"dao_allocation" yaml:"dao_allocation"`
 	ProposerAllocation       int64         `json:"proposer_allocation" yaml:"proposer_allocation"`
 	MaximumChains            int64         `json:"maximum_chains" yaml:"maximum_chains"`
@@ -72,6 +77,10 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`         // minimum amount of time node must spend in jail after missing blocks
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"` // the factor of which a node is slashed for a double sign
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`       // the factor of which a node is slashed for missing blocks
+	// dao emission params
+	DAOEmissionSchedule EmissionSchedule `json:"dao_emission_schedule" yaml:"dao_emission_schedule"` // the uPOKT minted to the dao each era (capped)
+	// fee params
+	FeeRefund bool `json:"fee_refund" yaml:"fee_refund"` // refunds the fee paid above the fee of the message once it is handled
 }
 
 // Implements sdk.ParamSet
@@ -88,11 +97,14 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: KeySlashFractionDoubleSign, Value: &p.SlashFractionDoubleSign},
 		{Key: KeySlashFractionDowntime, Value: &p.SlashFractionDowntime},
 		{Key: KeySessionBlock, Value: &p.SessionBlockFrequency},
+		{Key: KeySessionDuration, Value: &p.SessionDuration},
 		{Key: KeyDAOAllocation, Value: &p.DAOAllocation},
 		{Key: KeyProposerAllocation, Value: &p.ProposerAllocation},
 		{Key: KeyRelaysToTokensMultiplier, Value: &p.RelaysToTokensMultiplier},
 		{Key: KeyMaxChains, Value: &p.MaximumChains},
 		{Key: KeyMaxJailedBlocks, Value: &p.MaxJailedBlocks},
+		{Key: KeyDAOEmissionSchedule, Value: &p.DAOEmissionSchedule},
+		{Key: KeyFeeRefund, Value: &p.FeeRefund},
 	}
 }
 
@@ -115,6 +127,8 @@ func DefaultParams() Params {
 		RelaysToTokensMultiplier: DefaultRelaysToTokensMultiplier,
 		MaximumChains:            DefaultMaxChains,
 		MaxJailedBlocks:          DefaultMaxJailedBlocks,
+		DAOEmissionSchedule:      DefaultEmissionSchedule(),
+		FeeRefund:                DefaultFeeRefund,
 	}
 }
 
@@ -132,6 +146,9 @@ func (p Params) Validate() error {
 	if p.SessionBlockFrequency < 2 {
 		return fmt.Errorf("session block must be greater than 1")
 	}
+	if p.SessionDuration < 0 {
+		return fmt.Errorf("the session duration must not be negative")
+	}
 	if p.DAOAllocation < 0 {
 		return fmt.Errorf("the dao allocation must not be negative")
 	}
@@ -141,6 +158,9 @@ func (p Params) Validate() error {
 	if p.ProposerAllocation+p.DAOAllocation > 100 {
 		return fmt.Errorf("the combo of proposer allocation and dao allocation mnust not be greater than 100")
 	}
+	if err := p.DAOEmissionSchedule.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -165,10 +185,13 @@ func (p Params) String() string {
   SlashFractionDoubleSign: %s
   SlashFractionDowntime:   %s
   BlocksPerSession         %d
+  SessionDuration          %s
   Proposer Allocation      %d
   DAO allocation           %d
   Maximum Chains           %d
-  Max Jailed Blocks        %d`,
+  Max Jailed Blocks        %d
+  DAO Emission Schedule    %+v
+  Fee Refund               %v`,
 		p.UnstakingTime,
 		p.MaxValidators,
 		p.StakeDenom,
@@ -180,10 +203,13 @@ func (p Params) String() string {
 		p.SlashFractionDoubleSign,
 		p.SlashFractionDowntime,
 		p.SessionBlockFrequency,
+		p.SessionDuration,
 		p.ProposerAllocation,
 		p.DAOAllocation,
 		p.MaximumChains,
-		p.MaxJailedBlocks)
+		p.MaxJailedBlocks,
+		p.DAOEmissionSchedule,
+		p.FeeRefund)
 }
 
 // unmarshal the current pos params value from store key
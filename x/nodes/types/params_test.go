@@ -34,6 +34,8 @@ func TestDefaultParams(t *testing.T) {
 				RelaysToTokensMultiplier: DefaultRelaysToTokensMultiplier,
 				MaximumChains:            DefaultMaxChains,
 				MaxJailedBlocks:          DefaultMaxJailedBlocks,
+				DAOEmissionSchedule:      DefaultEmissionSchedule(),
+				FeeRefund:                DefaultFeeRefund,
 			},
 		}}
 	for _, tt := range tests {
@@ -447,10 +449,13 @@ func TestParams_String(t *testing.T) {
   SlashFractionDoubleSign: %s
   SlashFractionDowntime:   %s
   BlocksPerSession         %d
+  SessionDuration          %s
   Proposer Allocation      %d
   DAO allocation           %d
   Maximum Chains           %d
-  Max Jailed Blocks        %d`,
+  Max Jailed Blocks        %d
+  DAO Emission Schedule    %+v
+  Fee Refund               %v`,
 			DefaultUnstakingTime,
 			DefaultMaxValidators,
 			types.DefaultStakeDenom,
@@ -462,10 +467,13 @@ func TestParams_String(t *testing.T) {
 			DefaultSlashFractionDoubleSign,
 			DefaultSlashFractionDowntime,
 			DefaultSessionBlocktime,
+			time.Duration(0),
 			DefaultProposerAllocation,
 			DefaultDAOAllocation,
 			DefaultMaxChains,
-			DefaultMaxJailedBlocks)},
+			DefaultMaxJailedBlocks,
+			EmissionSchedule{},
+			DefaultFeeRefund)},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
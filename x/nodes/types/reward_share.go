@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// the maximum number of reward shares of a validator
+const MaxRewardShares = 10
+
+// RewardShare - A percentage of the rewards of a validator (relays and proposer cut) routed to a delegate address at
+// mint time (e.g. the hosting provider of the node)
+type RewardShare struct {
+	Address    sdk.Address `json:"address" yaml:"address"`       // the delegate address receiving the share
+	Percentage int64       `json:"percentage" yaml:"percentage"` // the percentage of the rewards, between 1 and 100
+}
+
+// RewardCut - The cut of a reward going to an address
+type RewardCut struct {
+	Address sdk.Address
+	Amount  sdk.Int
+}
+
+// ValidateRewardShares - Validates the reward shares of a validator, their percentages can't exceed 100 in total
+func ValidateRewardShares(shares []RewardShare) error {
+	if len(shares) > MaxRewardShares {
+		return fmt.Errorf("too many reward shares: %d, the maximum is %d", len(shares), MaxRewardShares)
+	}
+	total := int64(0)
+	seen := make(map[string]struct{}, len(shares))
+	for _, share := range shares {
+		if err := sdk.VerifyAddressFormat(share.Address); err != nil {
+			return fmt.Errorf("invalid reward share address: %s", err.Error())
+		}
+		if _, ok := seen[share.Address.String()]; ok {
+			return fmt.Errorf("duplicate reward share address: %s", share.Address.String())
+		}
+		seen[share.Address.String()] = struct{}{}
+		if share.Percentage < 1 || share.Percentage > 100 {
+			return fmt.Errorf("the reward share percentage of %s must be between 1 and 100", share.Address.String())
+		}
+		total += share.Percentage
+	}
+	if total > 100 {
+		return fmt.Errorf("the reward share percentages add up to %d, above 100", total)
+	}
+	return nil
+}
+
+// RewardSharesEqual - Returns true if the reward shares are the same, in the same order
+func RewardSharesEqual(shares, shares2 []RewardShare) bool {
+	if len(shares) != len(shares2) {
+		return false
+	}
+	for i := range shares {
+		if !shares[i].Address.Equals(shares2[i].Address) || shares[i].Percentage != shares2[i].Percentage {
+			return false
+		}
+	}
+	return true
+}
+
+// SplitReward - Splits a reward of the validator between its reward shares (truncated) and its output address (the rest)
+func (v Validator) SplitReward(reward sdk.Int) (cuts []RewardCut) {
+	rest := reward
+	for _, share := range v.RewardShares {
+		amount := reward.MulRaw(share.Percentage).QuoRaw(100)
+		if !amount.IsPositive() {
+			continue
+		}
+		cuts = append(cuts, RewardCut{Address: share.Address, Amount: amount})
+		rest = rest.Sub(amount)
+	}
+	if rest.IsPositive() {
+		cuts = append(cuts, RewardCut{Address: v.GetOutputAddress(), Amount: rest})
+	}
+	return
+}
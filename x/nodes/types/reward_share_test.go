@@ -0,0 +1,55 @@
+package types
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func getRandomAddress() sdk.Address {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	return sdk.Address(pub.Address())
+}
+
+func TestValidateRewardShares(t *testing.T) {
+	a, b := getRandomAddress(), getRandomAddress()
+	tests := []struct {
+		name    string
+		shares  []RewardShare
+		wantErr bool
+	}{
+		{"no shares", nil, false},
+		{"valid shares", []RewardShare{{a, 20}, {b, 80}}, false},
+		{"above 100", []RewardShare{{a, 20}, {b, 81}}, true},
+		{"zero percentage", []RewardShare{{a, 0}}, true},
+		{"duplicate address", []RewardShare{{a, 10}, {a, 10}}, true},
+		{"invalid address", []RewardShare{{sdk.Address{0x01}, 10}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, ValidateRewardShares(tt.shares) != nil)
+		})
+	}
+	tooMany := make([]RewardShare, MaxRewardShares+1)
+	for i := range tooMany {
+		tooMany[i] = RewardShare{getRandomAddress(), 1}
+	}
+	assert.NotNil(t, ValidateRewardShares(tooMany))
+}
+
+func TestValidator_SplitReward(t *testing.T) {
+	operator, output, delegate := getRandomAddress(), getRandomAddress(), getRandomAddress()
+	v := Validator{Address: operator}
+	assert.Equal(t, []RewardCut{{operator, sdk.NewInt(99)}}, v.SplitReward(sdk.NewInt(99)))
+	v.OutputAddress = output
+	v.RewardShares = []RewardShare{{delegate, 10}}
+	// the shares are truncated, the rest goes to the output address
+	assert.Equal(t, []RewardCut{{delegate, sdk.NewInt(9)}, {output, sdk.NewInt(90)}}, v.SplitReward(sdk.NewInt(99)))
+	assert.Equal(t, []RewardCut{{output, sdk.NewInt(9)}}, v.SplitReward(sdk.NewInt(9)))
+	v.RewardShares = []RewardShare{{delegate, 100}}
+	assert.Equal(t, []RewardCut{{delegate, sdk.NewInt(99)}}, v.SplitReward(sdk.NewInt(99)))
+}
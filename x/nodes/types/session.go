@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// SessionBoundary - The recorded start of a session
+// Boundaries are only recorded when the session mode changes and for every time based session,
+// block frequency based sessions are calculated from the latest boundary
+type SessionBoundary struct {
+	Height    int64     `json:"height" yaml:"height"`         // the first block of the session
+	Time      time.Time `json:"time" yaml:"time"`             // the block time when the boundary was decided (last block of the previous session)
+	TimeBased bool      `json:"time_based" yaml:"time_based"` // if the session ends after the session duration instead of the block frequency
+}
+
+// GenesisSessionBoundary - The implicit boundary of the first session of the chain
+func GenesisSessionBoundary() SessionBoundary {
+	return SessionBoundary{Height: 1}
+}
+
+// String returns a human readable string representation of the session boundary
+func (sb SessionBoundary) String() string {
+	return fmt.Sprintf("SessionBoundary:\n  Height: %d\n  Time: %s\n  TimeBased: %v\n", sb.Height, sb.Time, sb.TimeBased)
+}
+
+// SessionSnapshot - The compact state relevant to session membership and relay rewards, recorded at the session block
+type SessionSnapshot struct {
+	SessionBlockHeight       int64               `json:"session_height" yaml:"session_height"`                           // the first block of the session
+	RelaysToTokensMultiplier sdk.Int             `json:"relays_to_tokens_multiplier" yaml:"relays_to_tokens_multiplier"` // the relay reward multiplier for the session
+	DAOAllocation            int64               `json:"dao_allocation" yaml:"dao_allocation"`                           // the % of the relay rewards going to the dao
+	ProposerAllocation       int64               `json:"proposer_allocation" yaml:"proposer_allocation"`                 // the % of the relay rewards going to the proposer
+	TotalStaked              sdk.Int             `json:"total_staked" yaml:"total_staked"`                               // the tokens staked by the validators of the session
+	Validators               []ValidatorSnapshot `json:"validators" yaml:"validators"`                                   // the staked (not jailed) validators
+}
+
+// ValidatorSnapshot - The session relevant fields of a validator
+type ValidatorSnapshot struct {
+	Address      sdk.Address `json:"address" yaml:"address"`       // address of the validator
+	PublicKey    string      `json:"public_key" yaml:"public_key"` // the public key of the validator in hex
+	Chains       []string    `json:"chains" yaml:"chains"`         // validator non native blockchains
+	StakedTokens sdk.Int     `json:"tokens" yaml:"tokens"`         // tokens staked in the network
+}
+
+// NewValidatorSnapshot - Returns the session relevant fields of the validator
+func NewValidatorSnapshot(validator Validator) ValidatorSnapshot {
+	return ValidatorSnapshot{
+		Address:      validator.Address,
+		PublicKey:    validator.PublicKey.RawString(),
+		Chains:       validator.Chains,
+		StakedTokens: validator.StakedTokens,
+	}
+}
+
+// HasChain - Returns true if the validator was staked for the chain
+func (vs ValidatorSnapshot) HasChain(chain string) bool {
+	for _, c := range vs.Chains {
+		if c == chain {
+			return true
+		}
+	}
+	return false
+}
+
+// ForChain - Returns the snapshot with only the validators staked for the chain (totals are recalculated)
+func (ss SessionSnapshot) ForChain(chain string) SessionSnapshot {
+	res := ss
+	res.TotalStaked = sdk.ZeroInt()
+	res.Validators = make([]ValidatorSnapshot, 0)
+	for _, v := range ss.Validators {
+		if v.HasChain(chain) {
+			res.Validators = append(res.Validators, v)
+			res.TotalStaked = res.TotalStaked.Add(v.StakedTokens)
+		}
+	}
+	return res
+}
+
+// String returns a human readable string representation of the session snapshot
+func (ss SessionSnapshot) String() string {
+	return fmt.Sprintf("SessionSnapshot:\n  SessionBlockHeight: %d\n  RelaysToTokensMultiplier: %s\n  DAOAllocation: %d\n  ProposerAllocation: %d\n  TotalStaked: %s\n  Validators: %d\n",
+		ss.SessionBlockHeight, ss.RelaysToTokensMultiplier, ss.DAOAllocation, ss.ProposerAllocation, ss.TotalStaked, len(ss.Validators))
+}
@@ -29,9 +29,9 @@ func (v Validators) String() (out string) {
 // String returns a human readable string representation of a validator.
 func (v Validator) String() string {
 	return fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\n"+
-		"ServiceURL:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v"+
+		"ServiceURL:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v\nOutput Address:\t\t%s"+
 		"\n----\n",
-		v.Address, v.PublicKey.RawString(), v.Jailed, v.Status, v.StakedTokens, v.ServiceURL, v.Chains, v.UnstakingCompletionTime,
+		v.Address, v.PublicKey.RawString(), v.Jailed, v.Status, v.StakedTokens, v.ServiceURL, v.Chains, v.UnstakingCompletionTime, v.GetOutputAddress(),
 	)
 }
 
@@ -58,14 +58,16 @@ func UnmarshalValidator(cdc *codec.Codec, valBytes []byte) (validator Validator,
 
 // this is a helper struct used for JSON de- and encoding only
 type hexValidator struct {
-	Address                 sdk.Address     `json:"address" yaml:"address"`               // the hex address of the validator
-	PublicKey               string          `json:"public_key" yaml:"public_key"`         // the hex consensus public key of the validator
-	Jailed                  bool            `json:"jailed" yaml:"jailed"`                 // has the validator been jailed from staked status?
-	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                 // validator status (staked/unstaking/unstaked)
-	StakedTokens            sdk.Int         `json:"tokens" yaml:"tokens"`                 // how many staked tokens
-	ServiceURL              string          `json:"service_url" yaml:"service_url"`       // the url of the pocket-api
-	Chains                  []string        `json:"chains" yaml:"chains"`                 // the non-native (external) chains hosted
-	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the validator to complete unstaking
+	Address                 sdk.Address     `json:"address" yaml:"address"`                         // the hex address of the validator
+	PublicKey               string          `json:"public_key" yaml:"public_key"`                   // the hex consensus public key of the validator
+	Jailed                  bool            `json:"jailed" yaml:"jailed"`                           // has the validator been jailed from staked status?
+	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                           // validator status (staked/unstaking/unstaked)
+	StakedTokens            sdk.Int         `json:"tokens" yaml:"tokens"`                           // how many staked tokens
+	ServiceURL              string          `json:"service_url" yaml:"service_url"`                 // the url of the pocket-api
+	Chains                  []string        `json:"chains" yaml:"chains"`                           // the non-native (external) chains hosted
+	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"`           // if unstaking, min time for the validator to complete unstaking
+	OutputAddress           sdk.Address     `json:"output_address,omitempty" yaml:"output_address"` // the address receiving the rewards and the unstaked tokens
+	RewardShares            []RewardShare   `json:"reward_shares,omitempty" yaml:"reward_shares"`   // the percentages of the rewards routed to delegate addresses
 }
 
 // Marshals struct into JSON
@@ -85,6 +87,8 @@ func (v Validator) MarshalJSON() ([]byte, error) {
 		Chains:                  v.Chains,
 		StakedTokens:            v.StakedTokens,
 		UnstakingCompletionTime: v.UnstakingCompletionTime,
+		OutputAddress:           v.OutputAddress,
+		RewardShares:            v.RewardShares,
 	})
 }
 
@@ -107,6 +111,8 @@ func (v *Validator) UnmarshalJSON(data []byte) error {
 		StakedTokens:            bv.StakedTokens,
 		Status:                  bv.Status,
 		UnstakingCompletionTime: bv.UnstakingCompletionTime,
+		OutputAddress:           bv.OutputAddress,
+		RewardShares:            bv.RewardShares,
 	}
 	return nil
 }
@@ -22,6 +22,8 @@ type Validator struct {
 	ServiceURL              string           `json:"service_url" yaml:"service_url"`       // url where the pocket service api is hosted
 	StakedTokens            sdk.Int          `json:"tokens" yaml:"tokens"`                 // tokens staked in the network
 	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the validator to complete unstaking
+	OutputAddress           sdk.Address      `json:"output_address" yaml:"output_address"` // the address receiving the rewards and the unstaked tokens (the operator address if empty)
+	RewardShares            []RewardShare    `json:"reward_shares" yaml:"reward_shares"`   // the percentages of the rewards routed to delegate addresses
 }
 
 type ValidatorsPage struct {
@@ -120,3 +122,11 @@ func (v Validator) GetAddress() sdk.Address        { return v.Address }
 func (v Validator) GetPublicKey() crypto.PublicKey { return v.PublicKey }
 func (v Validator) GetTokens() sdk.Int             { return v.StakedTokens }
 func (v Validator) GetConsensusPower() int64       { return v.ConsensusPower() }
+
+// GetOutputAddress returns the address receiving the rewards and the unstaked tokens of the validator
+func (v Validator) GetOutputAddress() sdk.Address {
+	if v.OutputAddress.Empty() {
+		return v.Address
+	}
+	return v.OutputAddress
+}
@@ -61,7 +61,7 @@ func handleProofMsg(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Resu
 		return err.Result()
 	}
 	// valid claim message so execute according to type
-	err = k.ExecuteProof(ctx, proof, claim)
+	settlement, err := k.ExecuteProof(ctx, proof, claim)
 	if err != nil {
 		return err.Result()
 	}
@@ -75,6 +75,11 @@ func handleProofMsg(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Resu
 	if er != nil {
 		return sdk.ErrInternal(er.Error()).Result()
 	}
+	// record the economic outcome alongside the receipt
+	er = k.SetSettlement(ctx, addr, claim.SessionHeader, claim.EvidenceType, settlement)
+	if er != nil {
+		return sdk.ErrInternal(er.Error()).Result()
+	}
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
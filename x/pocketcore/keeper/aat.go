@@ -28,3 +28,43 @@ func AATGeneration(appPubKey string, clientPubKey string, key crypto.PrivateKey)
 	aat.ApplicationSignature = hex.EncodeToString(sig)
 	return aat, nil
 }
+
+// "GatewayAATGeneration" - Generates an application authentication token signed by a gateway key on behalf of the
+// application. The token is only valid for relays if the application delegated the gateway key on chain.
+func GatewayAATGeneration(appPubKey string, clientPubKey string, gatewayKey crypto.PrivateKey) (pc.AAT, sdk.Error) {
+	// create the aat object
+	aat := pc.AAT{
+		Version:              pc.SupportedTokenVersions[0],
+		ApplicationPublicKey: appPubKey,
+		ClientPublicKey:      clientPubKey,
+		GatewayPublicKey:     gatewayKey.PublicKey().RawString(),
+		ApplicationSignature: "",
+	}
+	// the gateway signs over the token (gateway key included)
+	sig, err := gatewayKey.Sign(aat.Hash())
+	if err != nil {
+		return pc.AAT{}, pc.NewSignatureError(pc.ModuleName, err)
+	}
+	// stringify the signature into hex
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	return aat, nil
+}
+
+// "ValidateTokenDelegation" - Confirms the gateway that signed the AAT (if any) is delegated by the application
+func (k Keeper) ValidateTokenDelegation(ctx sdk.Ctx, token pc.AAT) sdk.Error {
+	if !token.IsGatewaySigned() {
+		return nil
+	}
+	appPubKey, err := crypto.NewPublicKey(token.ApplicationPublicKey)
+	if err != nil {
+		return pc.NewPubKeyError(pc.ModuleName, err)
+	}
+	gatewayPubKey, err := crypto.NewPublicKey(token.GatewayPublicKey)
+	if err != nil {
+		return pc.NewPubKeyError(pc.ModuleName, err)
+	}
+	if !k.appKeeper.IsGatewayDelegated(ctx, sdk.Address(appPubKey.Address()), gatewayPubKey) {
+		return pc.NewUndelegatedGatewayError(pc.ModuleName)
+	}
+	return nil
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"testing"
 
+	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
 	"github.com/pokt-network/posmint/crypto/keys/mintkey"
+	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,3 +22,35 @@ func TestAATGeneration(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Nil(t, res.Validate())
 }
+
+func TestGatewayAATGeneration(t *testing.T) {
+	appPubKey := getRandomPubKey().RawString()
+	clientPubKey := getRandomPubKey().RawString()
+	gatewayKey := getRandomPrivateKey()
+	res, err := GatewayAATGeneration(appPubKey, clientPubKey, gatewayKey)
+	assert.Nil(t, err)
+	assert.Equal(t, gatewayKey.PublicKey().RawString(), res.GatewayPublicKey)
+	assert.Nil(t, res.Validate())
+}
+
+func TestKeeper_ValidateTokenDelegation(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	ak := keeper.appKeeper.(appsKeeper.Keeper)
+	appKey := getRandomPrivateKey()
+	gatewayKey := getRandomPrivateKey()
+	clientPubKey := getRandomPubKey().RawString()
+	appAddr := sdk.Address(appKey.PublicKey().Address())
+	// tokens signed by the app are not affected by delegations
+	aat, err := AATGeneration(appKey.PublicKey().RawString(), clientPubKey, appKey)
+	assert.Nil(t, err)
+	assert.Nil(t, keeper.ValidateTokenDelegation(ctx, aat))
+	// tokens signed by a gateway require the delegation
+	gatewayAAT, err := GatewayAATGeneration(appKey.PublicKey().RawString(), clientPubKey, gatewayKey)
+	assert.Nil(t, err)
+	assert.NotNil(t, keeper.ValidateTokenDelegation(ctx, gatewayAAT))
+	ak.SetGatewayDelegation(ctx, appAddr, gatewayKey.PublicKey())
+	assert.Nil(t, keeper.ValidateTokenDelegation(ctx, gatewayAAT))
+	// revoked
+	ak.DeleteGatewayDelegation(ctx, appAddr, gatewayKey.PublicKey())
+	assert.NotNil(t, keeper.ValidateTokenDelegation(ctx, gatewayAAT))
+}
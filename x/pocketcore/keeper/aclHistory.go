@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// "SetACLChange" - Records the change of the acl or of the dao owner in the history
+func (k Keeper) SetACLChange(ctx sdk.Ctx, change pc.ACLChange) {
+	txHash, _ := hex.DecodeString(change.TxHash)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(pc.KeyForACLChange(change.Height, txHash), k.cdc.MustMarshalBinaryBare(change))
+}
+
+// "GetACLChanges" - Returns the history of the acl and dao owner changes, ordered by height
+func (k Keeper) GetACLChanges(ctx sdk.Ctx) (changes []pc.ACLChange) {
+	changes = make([]pc.ACLChange, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, pc.ACLChangeKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var change pc.ACLChange
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+	return
+}
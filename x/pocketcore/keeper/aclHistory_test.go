@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_GetACLChanges(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	assert.Empty(t, keeper.GetACLChanges(ctx))
+	changes := []types.ACLChange{
+		{ParamKey: "gov/daoOwner", Value: json.RawMessage(`"a"`), Sender: "b", Height: 256, TxHash: "0A"},
+		{ParamKey: "gov/acl", Value: json.RawMessage(`[]`), Sender: "a", Height: 3, TxHash: "0C"},
+		{ParamKey: "gov/daoOwner", Value: json.RawMessage(`"b"`), Sender: "a", Height: 3, TxHash: "0B"},
+	}
+	for _, change := range changes {
+		keeper.SetACLChange(ctx, change)
+	}
+	// ordered by height (then by tx hash)
+	assert.Equal(t, []types.ACLChange{changes[2], changes[1], changes[0]}, keeper.GetACLChanges(ctx))
+}
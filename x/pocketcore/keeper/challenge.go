@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// "SetChallenge" - Sets the settled challenge against the accused servicer in the state storage
+func (k Keeper) SetChallenge(ctx sdk.Ctx, c pc.Challenge) error {
+	// retrieve the store
+	store := ctx.KVStore(k.storeKey)
+	// generate the key for the challenge
+	key, err := pc.KeyForChallenge(c.Accused, c.SessionHeader, c.Reporter)
+	if err != nil {
+		return err
+	}
+	// marshal the challenge into amino bz and set in the store
+	store.Set(key, k.cdc.MustMarshalBinaryBare(c))
+	return nil
+}
+
+// "GetChallengesAgainst" - Returns the settled challenges against the accused servicer
+func (k Keeper) GetChallengesAgainst(ctx sdk.Ctx, accused sdk.Address) (challenges []pc.Challenge, err error) {
+	// retrieve the store
+	store := ctx.KVStore(k.storeKey)
+	// generate the key for the challenges
+	key, err := pc.KeyForChallengesAgainst(accused)
+	if err != nil {
+		return nil, err
+	}
+	// iterate through all of the kv pairs and unmarshal into challenge objects
+	iterator := sdk.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var c pc.Challenge
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &c)
+		challenges = append(challenges, c)
+	}
+	return
+}
+
+// "GetOpenChallenges" - Returns the open challenges (pending claims of challenge evidence)
+func (k Keeper) GetOpenChallenges(ctx sdk.Ctx) (challenges []pc.Challenge) {
+	for _, claim := range k.GetAllClaims(ctx) {
+		if claim.EvidenceType == pc.ChallengeEvidence {
+			challenges = append(challenges, pc.NewOpenChallenge(claim))
+		}
+	}
+	return
+}
+
+// "GetOpenChallengesAgainst" - Returns the open challenges reported in the sessions the servicer is part of
+// (the accused servicer is only revealed by the proof, so every open challenge of the session concerns the servicer)
+func (k Keeper) GetOpenChallengesAgainst(ctx sdk.Ctx, servicer sdk.Address) (challenges []pc.Challenge, err error) {
+	for _, c := range k.GetOpenChallenges(ctx) {
+		// a node can't challenge itself
+		if c.Reporter.Equals(servicer) {
+			continue
+		}
+		// get the session context
+		sessionCtx, er := ctx.PrevCtx(c.SessionBlockHeight)
+		if er != nil {
+			return nil, er
+		}
+		// check cache
+		session, found := pc.GetSession(c.SessionHeader)
+		// if not found generate the session
+		if !found {
+			session, err = pc.NewSession(sessionCtx, ctx, k.posKeeper, c.SessionHeader, pc.BlockHash(sessionCtx), int(k.SessionNodeCount(sessionCtx)), k.SessionWeight)
+			if err != nil {
+				return nil, err
+			}
+			// add to cache
+			pc.SetSession(session)
+		}
+		if session.SessionNodes.ContainsAddress(servicer) {
+			challenges = append(challenges, c)
+		}
+	}
+	return
+}
+
+// "GetChallengeRewards" - Returns the settled challenges reported by the client (along with the reward of each one)
+func (k Keeper) GetChallengeRewards(ctx sdk.Ctx, reporter sdk.Address) (challenges []pc.Challenge) {
+	// retrieve the store
+	store := ctx.KVStore(k.storeKey)
+	// the settled challenges are indexed by the accused servicer, so iterate through all of them
+	iterator := sdk.KVStorePrefixIterator(store, pc.ChallengeKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var c pc.Challenge
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &c)
+		if c.ClientReporter.Equals(reporter) {
+			challenges = append(challenges, c)
+		}
+	}
+	return
+}
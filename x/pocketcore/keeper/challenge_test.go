@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_GetSetChallenges(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	header := types.SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              getTestSupportedBlockchain(),
+		SessionBlockHeight: 1,
+	}
+	reporter := getRandomValidatorAddress()
+	accused := getRandomValidatorAddress()
+	client := getRandomValidatorAddress()
+	// an open challenge (pending claim of challenge evidence)
+	claim := types.MsgClaim{
+		SessionHeader:    header,
+		MerkleRoot:       types.HashSum{Hash: types.Hash([]byte("root")), Sum: 10},
+		TotalProofs:      10,
+		FromAddress:      reporter,
+		EvidenceType:     types.ChallengeEvidence,
+		ExpirationHeight: 100,
+	}
+	err := keeper.SetClaim(ctx, claim)
+	assert.Nil(t, err)
+	// relay claims are not challenges
+	relayClaim := claim
+	relayClaim.EvidenceType = types.RelayEvidence
+	err = keeper.SetClaim(ctx, relayClaim)
+	assert.Nil(t, err)
+	open := keeper.GetOpenChallenges(ctx)
+	assert.Len(t, open, 1)
+	assert.Equal(t, types.NewOpenChallenge(claim), open[0])
+	assert.False(t, open[0].Settled)
+	assert.Empty(t, open[0].Accused)
+	// a settled challenge
+	settled := types.Challenge{
+		SessionHeader:    header,
+		Reporter:         reporter,
+		Accused:          accused,
+		TotalChallenges:  10,
+		Settled:          true,
+		Burned:           sdk.NewInt(10000),
+		ClientReporter:   client,
+		ReporterReward:   sdk.NewInt(1000),
+		SettlementHeight: 976,
+	}
+	err = keeper.SetChallenge(ctx, settled)
+	assert.Nil(t, err)
+	challenges, err := keeper.GetChallengesAgainst(ctx, accused)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Challenge{settled}, challenges)
+	challenges, err = keeper.GetChallengesAgainst(ctx, reporter)
+	assert.Nil(t, err)
+	assert.Empty(t, challenges)
+	// the challenges rewarded to the client reporter
+	assert.Equal(t, []types.Challenge{settled}, keeper.GetChallengeRewards(ctx, client))
+	assert.Empty(t, keeper.GetChallengeRewards(ctx, reporter))
+}
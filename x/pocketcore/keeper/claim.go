@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/codec"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/auth"
@@ -16,69 +18,99 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, n client.Client, claimTx func(pk crypto
 	// get the private val key (main) account from the keybase
 	kp, err := k.GetPKFromFile(ctx)
 	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the private key from file for the claim transaction:\n%s", err.Error()))
+		k.Logger(ctx).Error("unable to retrieve the private key from file for the claim transaction", "err", err.Error())
 		return
 	}
 	// retrieve the iterator to go through each piece of evidence in storage
 	iter := pc.EvidenceIterator()
-	defer iter.Close()
-	// loop through each evidence
+	var evidences []pc.Evidence
 	for ; iter.Valid(); iter.Next() {
-		evidence := iter.Value()
-		evidenceLength := len(evidence.Proofs)
-		// if the number of proofs in the evidence object is zero
-		if evidenceLength == 0 {
-			ctx.Logger().Error("evidence of length zero was found in evidence storage")
-			continue
-		}
-		// get the type of the first piece of evidence to know if we are dealing with challenge or relays
-		evidenceType := evidence.EvidenceType
-		// if the evidence length is less than 5, it would not satisfy our merkle tree needs
-		if evidenceLength < 5 {
-			if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
-				ctx.Logger().Debug(err.Error())
-			}
-			continue
-		}
-		// get the session context
-		sessionCtx, er := ctx.PrevCtx(evidence.SessionHeader.SessionBlockHeight)
-		if er != nil {
-			ctx.Logger().Error("could not get sessionCtx")
-			continue
-		}
-		// if the blockchain in the evidence is not supported then delete it because nodes don't get paid/challenged for unsupported blockchains
-		if !k.IsPocketSupportedBlockchain(sessionCtx.WithBlockHeight(evidence.SessionHeader.SessionBlockHeight), evidence.SessionHeader.Chain) && evidence.NumOfProofs > 0 {
-			ctx.Logger().Info(fmt.Sprintf("claim for %s blockchain isn't pocket supported, so will not send. Deleting evidence\n", evidence.SessionHeader.Chain))
-			if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
-				ctx.Logger().Debug(err.Error())
-			}
-			continue
+		evidences = append(evidences, iter.Value())
+	}
+	iter.Close()
+	// claim the evidence of the high priority chains first, in case the balance or the block space runs out
+	pc.SortByClaimPriority(evidences)
+	// loop through each evidence
+	for _, evidence := range evidences {
+		k.claimEvidence(ctx, n, kp, evidence, claimTx)
+	}
+}
+
+// claims the evidence (if claimable)
+func (k Keeper) claimEvidence(ctx sdk.Ctx, n client.Client, kp crypto.PrivateKey, evidence pc.Evidence, claimTx func(pk crypto.PrivateKey, cliCtx util.CLIContext, txBuilder auth.TxBuilder, header pc.SessionHeader, totalProofs int64, root pc.HashSum, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
+	evidenceLength := len(evidence.Proofs)
+	// if the number of proofs in the evidence object is zero
+	if evidenceLength == 0 {
+		k.Logger(ctx).Error("evidence of length zero was found in evidence storage", evidenceKeyvals(evidence.SessionHeader)...)
+		return
+	}
+	// get the type of the first piece of evidence to know if we are dealing with challenge or relays
+	evidenceType := evidence.EvidenceType
+	// if the evidence length is less than 5, it would not satisfy our merkle tree needs
+	if evidenceLength < 5 {
+		if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
+			ctx.Logger().Debug(err.Error())
 		}
-		// check the current state to see if the unverified evidence has already been sent and processed (if so, then skip this evidence)
-		if _, found := k.GetClaim(ctx, sdk.Address(kp.PublicKey().Address()), evidence.SessionHeader, evidenceType); found {
-			continue
+		return
+	}
+	// get the session context
+	sessionCtx, er := ctx.PrevCtx(evidence.SessionHeader.SessionBlockHeight)
+	if er != nil {
+		k.Logger(ctx).Error("unable to get the session context for the claim transaction", append(evidenceKeyvals(evidence.SessionHeader), "err", er.Error())...)
+		return
+	}
+	// the evidence orphaned by a reorg of the local chain would be rejected, so it is quarantined instead of claimed
+	if k.QuarantineIfOrphaned(ctx, evidence, pc.BlockHash(sessionCtx)) {
+		return
+	}
+	// if the blockchain in the evidence is not supported then delete it because nodes don't get paid/challenged for unsupported blockchains
+	if !k.IsPocketSupportedBlockchain(sessionCtx.WithBlockHeight(evidence.SessionHeader.SessionBlockHeight), evidence.SessionHeader.Chain) && evidence.NumOfProofs > 0 {
+		k.Logger(ctx).Info("the blockchain of the evidence isn't pocket supported, deleting the evidence", evidenceKeyvals(evidence.SessionHeader)...)
+		if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
+			ctx.Logger().Debug(err.Error())
 		}
-		// if the claim is mature, delete it because we cannot submit a mature claim
-		if k.ClaimIsMature(ctx, evidence.SessionBlockHeight) {
-			fmt.Println("claim is mature @ ", ctx.BlockHeight(), evidence)
-			if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
-				ctx.Logger().Debug(err.Error())
-			}
-			continue
+		return
+	}
+	// check the current state to see if the unverified evidence has already been sent and processed (if so, then skip this evidence)
+	if _, found := k.GetClaim(ctx, sdk.Address(kp.PublicKey().Address()), evidence.SessionHeader, evidenceType); found {
+		return
+	}
+	// if the claim is mature, delete it because we cannot submit a mature claim
+	if k.ClaimIsMature(ctx, evidence.SessionBlockHeight, evidence.Chain) {
+		k.Logger(ctx).Info("the claim of the evidence is mature, deleting the evidence", evidenceKeyvals(evidence.SessionHeader)...)
+		if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
+			ctx.Logger().Debug(err.Error())
 		}
-		// generate the merkle root for this evidence
-		root := evidence.GenerateMerkleRoot()
-		// generate the auto txbuilder and clictx
+		return
+	}
+	// a failed claim is only re-attempted past its backoff
+	if !pc.IsTxRetryDue(evidence.SessionHeader, evidenceType, pc.MsgClaimName, time.Now()) {
+		return
+	}
+	// generate the merkle root for this evidence
+	root := evidence.GenerateMerkleRoot()
+	// send in the evidence header, the total relays completed, and the merkle root (ensures data integrity), with a new
+	// auto txbuilder and clictx per broadcast (signed with a new entropy)
+	_, err := pc.BroadcastWithRetry(k.Logger(ctx), pc.MsgClaimName, func() (*sdk.TxResponse, error) {
 		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, pc.MsgClaimName, n, kp, k)
 		if err != nil {
-			ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the claim tx:\n%s", err.Error()))
-			return
-		}
-		// send in the evidence header, the total relays completed, and the merkle root (ensures data integrity)
-		if _, err := claimTx(kp, cliCtx, txBuilder, evidence.SessionHeader, evidence.NumOfProofs, root, evidenceType); err != nil {
-			ctx.Logger().Error(fmt.Sprintf("an error occured executing the claim transaciton: \n%s", err.Error()))
+			return nil, err
 		}
+		return claimTx(kp, cliCtx, txBuilder, evidence.SessionHeader, evidence.NumOfProofs, root, evidenceType)
+	}, append(evidenceKeyvals(evidence.SessionHeader), "total_proofs", evidence.NumOfProofs)...)
+	if err != nil {
+		e := pc.RecordTxFailure(evidence.SessionHeader, evidenceType, pc.MsgClaimName, err)
+		k.Logger(ctx).Error("the claim transaction is queued for retry", append(evidenceKeyvals(evidence.SessionHeader), "attempts", e.Attempts, "next_attempt", e.NextAttempt)...)
+		return
 	}
+	pc.RecordTxSuccess(evidence.SessionHeader, evidenceType, pc.MsgClaimName)
+	// build the merkle tree during the waiting period, so the proof only has to read the branches
+	pc.PrebuildMerkleTree(evidence)
+}
+
+// the keyvals logged for the evidence of a session
+func evidenceKeyvals(header pc.SessionHeader) []interface{} {
+	return []interface{}{"app", header.ApplicationPubKey, "chain", header.Chain, "session_height", header.SessionBlockHeight}
 }
 
 // "ValidateClaim" - Validates a claim message and returns an sdk error if invalid
@@ -93,7 +125,11 @@ func (k Keeper) ValidateClaim(ctx sdk.Ctx, claim pc.MsgClaim) (err sdk.Error) {
 		return sdk.ErrInternal(er.Error())
 	}
 	// ensure that session ended
-	sessionEndHeight := sessionContext.BlockHeight() + k.BlocksPerSession(sessionContext) - 1
+	nextSessionBlockHeight, found := k.posKeeper.SessionBlockHeightAfter(ctx, claim.SessionBlockHeight, 1)
+	if !found {
+		return pc.NewInvalidBlockHeightError(pc.ModuleName)
+	}
+	sessionEndHeight := nextSessionBlockHeight - 1
 	if ctx.BlockHeight() <= sessionEndHeight {
 		return pc.NewInvalidBlockHeightError(pc.ModuleName)
 	}
@@ -125,7 +161,7 @@ func (k Keeper) ValidateClaim(ctx sdk.Ctx, claim pc.MsgClaim) (err sdk.Error) {
 			return sdk.ErrInternal("could not get prev context: " + er.Error())
 		}
 		// create a new session to validate
-		session, err = pc.NewSession(sessionContext, sessionEndCtx, k.posKeeper, claim.SessionHeader, pc.BlockHash(sessionContext), sessionNodeCount)
+		session, err = pc.NewSession(sessionContext, sessionEndCtx, k.posKeeper, claim.SessionHeader, pc.BlockHash(sessionContext), sessionNodeCount, k.SessionWeight)
 		if err != nil {
 			ctx.Logger().Error(fmt.Errorf("could not generate session with public key: %s, for chain: %s", app.GetPublicKey().RawString(), claim.Chain).Error())
 			return err
@@ -137,7 +173,7 @@ func (k Keeper) ValidateClaim(ctx sdk.Ctx, claim pc.MsgClaim) (err sdk.Error) {
 		return err
 	}
 	// check if the proof is ready to be claimed, if it's already ready to be claimed, then it's too late to submit cause the secret is revealed
-	if k.ClaimIsMature(ctx, claim.SessionBlockHeight) {
+	if k.ClaimIsMature(ctx, claim.SessionBlockHeight, claim.Chain) {
 		return pc.NewExpiredProofsSubmissionError(pc.ModuleName)
 	}
 	return nil
@@ -153,6 +189,7 @@ func (k Keeper) SetClaim(ctx sdk.Ctx, msg pc.MsgClaim) error {
 		return err
 	}
 	// generate the expiration height upon setting
+	// (with time based sessions, the blocks per session is used as the nominal length of a session)
 	if msg.ExpirationHeight == 0 {
 		sessionCtx, err := ctx.PrevCtx(msg.SessionBlockHeight)
 		if err != nil {
@@ -160,10 +197,16 @@ func (k Keeper) SetClaim(ctx sdk.Ctx, msg pc.MsgClaim) error {
 		}
 		msg.ExpirationHeight = ctx.BlockHeight() + k.ClaimExpiration(sessionCtx)*k.BlocksPerSession(sessionCtx)
 	}
+	// generate the key indexing the claim
+	indexKey, err := pc.KeyForClaimIndex(msg.FromAddress, msg.SessionHeader, msg.EvidenceType)
+	if err != nil {
+		return err
+	}
 	// marshal the message into amino
 	bz := k.cdc.MustMarshalBinaryBare(msg)
 	// set in the store
 	store.Set(key, bz)
+	store.Set(indexKey, []byte{}) // use empty byte slice to save space
 	return nil
 }
 
@@ -200,8 +243,11 @@ func (k Keeper) SetClaims(ctx sdk.Ctx, claims []pc.MsgClaim) {
 	}
 }
 
-// "GetClaims" - Gets all of the claim messages in the state storage for an address
-func (k Keeper) GetClaims(ctx sdk.Ctx, address sdk.Address) (claims []pc.MsgClaim, err error) {
+// "GetClaims" - Gets all of the claim messages in the state storage for an address matching the filter
+func (k Keeper) GetClaims(ctx sdk.Ctx, address sdk.Address, filter pc.EvidenceFilter) (claims []pc.MsgClaim, err error) {
+	if !filter.IsEmpty() {
+		return k.getFilteredClaims(ctx, address, filter)
+	}
 	// retrieve the store
 	store := ctx.KVStore(k.storeKey)
 	// generate the key for the claims
@@ -220,6 +266,30 @@ func (k Keeper) GetClaims(ctx sdk.Ctx, address sdk.Address) (claims []pc.MsgClai
 	return
 }
 
+// iterates the claim index of the address from the longest prefix of the filter
+func (k Keeper) getFilteredClaims(ctx sdk.Ctx, address sdk.Address, filter pc.EvidenceFilter) (claims []pc.MsgClaim, err error) {
+	store := ctx.KVStore(k.storeKey)
+	key, err := pc.KeyForClaimIndexes(address, filter)
+	if err != nil {
+		return nil, err
+	}
+	iterator := sdk.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if !filter.MatchIndexKey(iterator.Key()) {
+			continue
+		}
+		bz := store.Get(pc.ClaimKeyFromIndex(iterator.Key()))
+		if bz == nil {
+			continue
+		}
+		var claim pc.MsgClaim
+		k.cdc.MustUnmarshalBinaryBare(bz, &claim)
+		claims = append(claims, claim)
+	}
+	return
+}
+
 // "GetAllClaims" - Gets all of the claim messages held in the state storage.
 func (k Keeper) GetAllClaims(ctx sdk.Ctx) (claims []pc.MsgClaim) {
 	// retrieve the store
@@ -244,11 +314,57 @@ func (k Keeper) DeleteClaim(ctx sdk.Ctx, address sdk.Address, header pc.SessionH
 	if err != nil {
 		return err
 	}
+	indexKey, err := pc.KeyForClaimIndex(address, header, evidenceType)
+	if err != nil {
+		return err
+	}
 	// delete it from the state storage
 	store.Delete(key)
+	store.Delete(indexKey)
 	return nil
 }
 
+// "IndexReceiptsAndClaims" - Migrates the pocketcore store, indexing the receipts and claims set before the evidence
+// index by evidence type, chain and app
+func IndexReceiptsAndClaims(ctx sdk.Ctx, store sdk.KVStore, cdc *codec.Codec) (migrated int64, err error) {
+	var indexKeys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, pc.ReceiptKey)
+	for ; iterator.Valid(); iterator.Next() {
+		var receipt pc.Receipt
+		if err = cdc.UnmarshalBinaryBare(iterator.Value(), &receipt); err != nil {
+			iterator.Close()
+			return 0, err
+		}
+		// the address of the servicer is part of the receipt key
+		address := sdk.Address(iterator.Key()[len(pc.ReceiptKey) : len(pc.ReceiptKey)+pc.AddrLength])
+		indexKey, er := pc.KeyForReceiptIndex(address, receipt.SessionHeader, receipt.EvidenceType)
+		if er != nil {
+			continue
+		}
+		indexKeys = append(indexKeys, indexKey)
+	}
+	iterator.Close()
+	iterator = sdk.KVStorePrefixIterator(store, pc.ClaimKey)
+	for ; iterator.Valid(); iterator.Next() {
+		var claim pc.MsgClaim
+		if err = cdc.UnmarshalBinaryBare(iterator.Value(), &claim); err != nil {
+			iterator.Close()
+			return 0, err
+		}
+		indexKey, er := pc.KeyForClaimIndex(claim.FromAddress, claim.SessionHeader, claim.EvidenceType)
+		if er != nil {
+			continue
+		}
+		indexKeys = append(indexKeys, indexKey)
+	}
+	iterator.Close()
+	for _, indexKey := range indexKeys {
+		store.Set(indexKey, []byte{})
+		migrated++
+	}
+	return migrated, nil
+}
+
 // "GetMatureClaims" - Returns the mature (ready to be proved, past its security waiting period)
 func (k Keeper) GetMatureClaims(ctx sdk.Ctx, address sdk.Address) (matureProofs []pc.MsgClaim, err error) {
 	// retrieve the store
@@ -263,32 +379,115 @@ func (k Keeper) GetMatureClaims(ctx sdk.Ctx, address sdk.Address) (matureProofs
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var msg pc.MsgClaim
-		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &msg)
+		if err = k.cdc.UnmarshalBinaryBare(iterator.Value(), &msg); err != nil {
+			return nil, err
+		}
 		// if the claim is mature, add it to the list
-		if k.ClaimIsMature(ctx, msg.SessionBlockHeight) {
+		if k.ClaimIsMature(ctx, msg.SessionBlockHeight, msg.Chain) {
 			matureProofs = append(matureProofs, msg)
 		}
 	}
 	return
 }
 
-// "ClaimIsMature" - Returns if the claim is past its security waiting period
-func (k Keeper) ClaimIsMature(ctx sdk.Ctx, sessionBlockHeight int64) bool {
-	waitingPeriodInBlocks := k.ClaimSubmissionWindow(ctx) * k.BlocksPerSession(ctx)
-	return ctx.BlockHeight() > waitingPeriodInBlocks+sessionBlockHeight
+// "GetClaimsMaturity" - Returns the height each claim of the address is mature at and the blocks until then
+func (k Keeper) GetClaimsMaturity(ctx sdk.Ctx, address sdk.Address) (claims []pc.ClaimMaturity, err error) {
+	store := ctx.KVStore(k.storeKey)
+	key, err := pc.KeyForClaims(address)
+	if err != nil {
+		return nil, err
+	}
+	iterator := sdk.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var msg pc.MsgClaim
+		if err = k.cdc.UnmarshalBinaryBare(iterator.Value(), &msg); err != nil {
+			return nil, err
+		}
+		claim := pc.ClaimMaturity{
+			SessionHeader:     msg.SessionHeader,
+			EvidenceType:      msg.EvidenceType,
+			TotalProofs:       msg.TotalProofs,
+			ExpirationHeight:  msg.ExpirationHeight,
+			BlocksUntilMature: -1,
+		}
+		if matureHeight, found := k.ClaimMatureHeight(ctx, msg.SessionBlockHeight, msg.Chain); found {
+			claim.MatureHeight = matureHeight
+			claim.BlocksUntilMature = 0
+			if matureHeight > ctx.BlockHeight() {
+				claim.BlocksUntilMature = matureHeight - ctx.BlockHeight()
+			}
+		}
+		claims = append(claims, claim)
+	}
+	return
+}
+
+// "ClaimMatureHeight" - Returns the height the claim is mature at (the block after the first block of the proof session).
+// Not found while a time based session of the claim submission window is in progress
+func (k Keeper) ClaimMatureHeight(ctx sdk.Ctx, sessionBlockHeight int64, chain string) (height int64, found bool) {
+	proofSessionBlockHeight, found := k.posKeeper.SessionBlockHeightAfter(ctx, sessionBlockHeight, k.ClaimSubmissionWindowForChain(ctx, chain))
+	if !found {
+		return 0, false
+	}
+	return proofSessionBlockHeight + 1, true
+}
+
+// "ClaimIsMature" - Returns if the claim is past its security waiting period (claim submission window sessions of the chain)
+func (k Keeper) ClaimIsMature(ctx sdk.Ctx, sessionBlockHeight int64, chain string) bool {
+	matureHeight, found := k.ClaimMatureHeight(ctx, sessionBlockHeight, chain)
+	return found && ctx.BlockHeight() >= matureHeight
 }
 
 // "DeleteExpiredClaims" - Deletes the expired (claim expiration > # of session passed since claim genesis) claims
 func (k Keeper) DeleteExpiredClaims(ctx sdk.Ctx) {
-	var msg = pc.MsgClaim{}
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, pc.ClaimKey)
-	defer iterator.Close()
+	var expiredKeys [][]byte
+	var expiredClaims []pc.MsgClaim
 	for ; iterator.Valid(); iterator.Next() {
+		var msg = pc.MsgClaim{}
 		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &msg)
 		// if more sessions has passed than the expiration of the claim's genesis, delete it from the set
 		if msg.ExpirationHeight <= ctx.BlockHeight() {
-			store.Delete(iterator.Key())
+			expiredKeys = append(expiredKeys, iterator.Key())
+			expiredClaims = append(expiredClaims, msg)
 		}
 	}
+	iterator.Close()
+	for i, key := range expiredKeys {
+		store.Delete(key)
+		if indexKey, err := pc.KeyForClaimIndex(expiredClaims[i].FromAddress, expiredClaims[i].SessionHeader, expiredClaims[i].EvidenceType); err == nil {
+			store.Delete(indexKey)
+		}
+		// tally the expired claim of the servicer
+		k.addExpiredClaim(ctx, expiredClaims[i])
+	}
+}
+
+// "GetExpiredClaims" - Returns the tally of the claims of the servicer that expired before their proof was submitted
+func (k Keeper) GetExpiredClaims(ctx sdk.Ctx, address sdk.Address) (tally pc.ClaimsTally, err error) {
+	store := ctx.KVStore(k.storeKey)
+	key, err := pc.KeyForExpiredClaims(address)
+	if err != nil {
+		return
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &tally)
+	return
+}
+
+// adds the expired claim to the tally of its servicer
+func (k Keeper) addExpiredClaim(ctx sdk.Ctx, claim pc.MsgClaim) {
+	tally, err := k.GetExpiredClaims(ctx, claim.FromAddress)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not tally the expired claim of %s: %s", claim.FromAddress.String(), err.Error()))
+		return
+	}
+	tally.Add(claim.TotalProofs)
+	key, _ := pc.KeyForExpiredClaims(claim.FromAddress)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(tally))
 }
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"testing"
 
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
@@ -64,6 +66,72 @@ func TestKeeper_GetSetDeleteClaims(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestKeeper_GetFilteredClaims(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	servicer := sdk.Address(getRandomPubKey().Address())
+	appPubKey := getRandomPrivateKey().PublicKey().RawString()
+	appPubKey2 := getRandomPrivateKey().PublicKey().RawString()
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	newClaim := func(chain, appPubKey string, evidenceType types.EvidenceType) types.MsgClaim {
+		return types.MsgClaim{
+			SessionHeader:    types.SessionHeader{ApplicationPubKey: appPubKey, Chain: chain, SessionBlockHeight: 1},
+			MerkleRoot:       types.HashSum{Hash: types.Hash([]byte(chain + appPubKey)), Sum: 10},
+			TotalProofs:      9,
+			FromAddress:      servicer,
+			EvidenceType:     evidenceType,
+			ExpirationHeight: 100,
+		}
+	}
+	relay := newClaim(ethereum, appPubKey, types.RelayEvidence)
+	challenge := newClaim(ethereum, appPubKey, types.ChallengeEvidence)
+	relay2 := newClaim(bitcoin, appPubKey2, types.RelayEvidence)
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("Logger").Return(ctx.Logger())
+	keeper.SetClaims(mockCtx, []types.MsgClaim{relay, challenge, relay2})
+	tests := []struct {
+		name   string
+		filter types.EvidenceFilter
+		claims []types.MsgClaim
+	}{
+		{"evidence type", types.EvidenceFilter{EvidenceType: types.ChallengeEvidence}, []types.MsgClaim{challenge}},
+		{"evidence type and chain", types.EvidenceFilter{EvidenceType: types.RelayEvidence, Chain: bitcoin}, []types.MsgClaim{relay2}},
+		{"chain", types.EvidenceFilter{Chain: ethereum}, []types.MsgClaim{relay, challenge}},
+		{"app", types.EvidenceFilter{AppPubKey: appPubKey2}, []types.MsgClaim{relay2}},
+		{"no match", types.EvidenceFilter{Chain: bitcoin, AppPubKey: appPubKey}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := keeper.GetClaims(mockCtx, servicer, tt.filter)
+			assert.Nil(t, err)
+			assert.ElementsMatch(t, tt.claims, claims)
+		})
+	}
+	claims, err := keeper.GetClaims(mockCtx, servicer, types.EvidenceFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, claims, 3)
+	// deleted claims are removed from the index
+	assert.Nil(t, keeper.DeleteClaim(mockCtx, servicer, challenge.SessionHeader, challenge.EvidenceType))
+	claims, err = keeper.GetClaims(mockCtx, servicer, types.EvidenceFilter{EvidenceType: types.ChallengeEvidence})
+	assert.Nil(t, err)
+	assert.Empty(t, claims)
+	// the claims set before the index are indexed by the migration
+	store := ctx.KVStore(keeper.storeKey)
+	indexKey, err := types.KeyForClaimIndex(servicer, relay2.SessionHeader, relay2.EvidenceType)
+	assert.Nil(t, err)
+	store.Delete(indexKey)
+	claims, err = keeper.GetClaims(mockCtx, servicer, types.EvidenceFilter{Chain: bitcoin})
+	assert.Nil(t, err)
+	assert.Empty(t, claims)
+	migrated, err := IndexReceiptsAndClaims(ctx, store, keeper.cdc)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), migrated)
+	claims, err = keeper.GetClaims(mockCtx, servicer, types.EvidenceFilter{Chain: bitcoin})
+	assert.Nil(t, err)
+	assert.Equal(t, []types.MsgClaim{relay2}, claims)
+}
+
 func TestKeeper_GetMatureClaims(t *testing.T) {
 	ctx, _, _, _, keeper, keys, _ := createTestInput(t, false)
 	npk, header, _, _ := simulateRelays(t, keeper, &ctx, 5)
@@ -91,6 +159,7 @@ func TestKeeper_GetMatureClaims(t *testing.T) {
 	mockCtx := new(Ctx)
 	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
 	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys[nodesTypes.StoreKey]).Return(ctx.KVStore(keys[nodesTypes.StoreKey]))
 	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
 	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
 
@@ -102,13 +171,70 @@ func TestKeeper_GetMatureClaims(t *testing.T) {
 	mockCtx = new(Ctx)
 	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
 	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys[nodesTypes.StoreKey]).Return(ctx.KVStore(keys[nodesTypes.StoreKey]))
 	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
 	mockCtx.On("BlockHeight").Return(int64(1))
 
 	c2, err := keeper.GetMatureClaims(mockCtx, sdk.Address(npk2.Address()))
 	assert.Nil(t, err)
 	assert.Len(t, c1, 1)
+	// the claim is decoded (its expiration height is set on insertion)
+	assert.Equal(t, matureClaim.SessionHeader, c1[0].SessionHeader)
+	assert.Equal(t, matureClaim.MerkleRoot, c1[0].MerkleRoot)
+	assert.Equal(t, matureClaim.TotalProofs, c1[0].TotalProofs)
+	assert.Equal(t, matureClaim.FromAddress, c1[0].FromAddress)
+	assert.Equal(t, matureClaim.EvidenceType, c1[0].EvidenceType)
+	assert.NotZero(t, c1[0].ExpirationHeight)
 	assert.Nil(t, c2)
+	// an undecodable claim is an error
+	key, err := types.KeyForClaim(ctx, sdk.Address(npk.Address()), header, types.RelayEvidence)
+	assert.Nil(t, err)
+	ctx.KVStore(keeper.storeKey).Set(key, []byte("invalid"))
+	_, err = keeper.GetMatureClaims(ctx, sdk.Address(npk.Address()))
+	assert.NotNil(t, err)
+}
+
+func TestKeeper_GetClaimsMaturity(t *testing.T) {
+	ctx, _, _, _, keeper, keys, _ := createTestInput(t, false)
+	npk, header, _, _ := simulateRelays(t, keeper, &ctx, 5)
+	i, err := types.GetEvidence(header, types.RelayEvidence, sdk.NewInt(1000))
+	assert.Nil(t, err)
+	claim := types.MsgClaim{
+		SessionHeader:    header,
+		MerkleRoot:       i.GenerateMerkleRoot(),
+		TotalProofs:      9,
+		FromAddress:      sdk.Address(npk.Address()),
+		EvidenceType:     types.RelayEvidence,
+		ExpirationHeight: 1000,
+	}
+	assert.Nil(t, keeper.SetClaim(ctx, claim))
+	matureHeight, found := keeper.ClaimMatureHeight(ctx, header.SessionBlockHeight, header.Chain)
+	assert.True(t, found)
+	assert.True(t, matureHeight > header.SessionBlockHeight)
+	for _, height := range []int64{1, matureHeight - 1, matureHeight, matureHeight + 1} {
+		mockCtx := new(Ctx)
+		mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+		mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+		mockCtx.On("KVStore", keys[nodesTypes.StoreKey]).Return(ctx.KVStore(keys[nodesTypes.StoreKey]))
+		mockCtx.On("BlockHeight").Return(height)
+		claims, err := keeper.GetClaimsMaturity(mockCtx, sdk.Address(npk.Address()))
+		assert.Nil(t, err)
+		assert.Len(t, claims, 1)
+		assert.Equal(t, header, claims[0].SessionHeader)
+		assert.Equal(t, int64(9), claims[0].TotalProofs)
+		assert.Equal(t, int64(1000), claims[0].ExpirationHeight)
+		assert.Equal(t, matureHeight, claims[0].MatureHeight)
+		if height >= matureHeight {
+			assert.Zero(t, claims[0].BlocksUntilMature)
+		} else {
+			assert.Equal(t, matureHeight-height, claims[0].BlocksUntilMature)
+		}
+		assert.Equal(t, height >= matureHeight, keeper.ClaimIsMature(mockCtx, header.SessionBlockHeight, header.Chain))
+	}
+	// no claims for another address
+	claims, err := keeper.GetClaimsMaturity(ctx, getRandomValidatorAddress())
+	assert.Nil(t, err)
+	assert.Empty(t, claims)
 }
 
 func TestKeeper_DeleteExpiredClaims(t *testing.T) {
@@ -138,6 +264,7 @@ func TestKeeper_DeleteExpiredClaims(t *testing.T) {
 	mockCtx := new(Ctx)
 	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
 	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys[nodesTypes.StoreKey]).Return(ctx.KVStore(keys[nodesTypes.StoreKey]))
 	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
 	mockCtx.On("PrevCtx", header2.SessionBlockHeight).Return(ctx, nil)
 	mockCtx.On("BlockHeight").Return(int64(1))
@@ -150,4 +277,15 @@ func TestKeeper_DeleteExpiredClaims(t *testing.T) {
 	notExpired.ExpirationHeight = 2501
 	assert.Contains(t, c1, notExpired, "does not contain notExpired claim")
 	assert.NotContains(t, c1, expiredClaim, "contains expired claim")
+	// the expired claim is tallied for its servicer
+	expiredClaim.ExpirationHeight = 1
+	assert.Nil(t, keeper.SetClaim(mockCtx, expiredClaim))
+	keeper.DeleteExpiredClaims(mockCtx)
+	assert.NotContains(t, keeper.GetAllClaims(mockCtx), expiredClaim, "contains expired claim")
+	tally, err := keeper.GetExpiredClaims(mockCtx, expiredClaim.FromAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ClaimsTally{Count: 1, TotalRelays: 9}, tally)
+	tally, err = keeper.GetExpiredClaims(mockCtx, notExpired.FromAddress)
+	assert.Nil(t, err)
+	assert.Zero(t, tally.Count)
 }
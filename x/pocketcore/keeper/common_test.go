@@ -126,7 +126,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, []nodesTypes.Valida
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName:     nil,
 		appsTypes.StakedPoolName:  {auth.Burner, auth.Staking, auth.Minter},
-		nodesTypes.StakedPoolName: {auth.Burner, auth.Staking},
+		nodesTypes.StakedPoolName: {auth.Burner, auth.Minter, auth.Staking},
 		govTypes.DAOAccountName:   {auth.Burner, auth.Staking},
 	}
 
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// the sessions dispatched for the latest session block (keyed by the header hash), dropped at the session rollover
+type dispatchCache struct {
+	l                  sync.RWMutex
+	sessionBlockHeight int64
+	sessions           map[string]types.Session
+}
+
+func newDispatchCache() *dispatchCache {
+	return &dispatchCache{sessions: make(map[string]types.Session)}
+}
+
+// returns the session of the header if dispatched during the latest session
+func (dc *dispatchCache) get(header types.SessionHeader) (session types.Session, found bool) {
+	if dc == nil {
+		return
+	}
+	dc.l.RLock()
+	defer dc.l.RUnlock()
+	if header.SessionBlockHeight != dc.sessionBlockHeight {
+		return
+	}
+	session, found = dc.sessions[header.HashString()]
+	return
+}
+
+// caches the session, a session of a newer session block invalidates the sessions of the previous one
+func (dc *dispatchCache) set(session types.Session) {
+	if dc == nil {
+		return
+	}
+	dc.l.Lock()
+	defer dc.l.Unlock()
+	switch height := session.SessionHeader.SessionBlockHeight; {
+	case height < dc.sessionBlockHeight:
+		return
+	case height > dc.sessionBlockHeight:
+		dc.sessionBlockHeight = height
+		dc.sessions = make(map[string]types.Session)
+	}
+	dc.sessions[session.SessionHeader.HashString()] = session
+}
+
+// invalidates all of the sessions (the session nodes changed)
+func (dc *dispatchCache) clear() {
+	if dc == nil {
+		return
+	}
+	dc.l.Lock()
+	defer dc.l.Unlock()
+	dc.sessions = make(map[string]types.Session)
+}
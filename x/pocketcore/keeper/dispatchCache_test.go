@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatchCache(t *testing.T) {
+	dc := newDispatchCache()
+	header := types.SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 5,
+	}
+	session := types.Session{SessionHeader: header}
+	_, found := dc.get(header)
+	assert.False(t, found)
+	dc.set(session)
+	got, found := dc.get(header)
+	assert.True(t, found)
+	assert.Equal(t, session, got)
+	// the sessions of older session blocks are never cached
+	oldHeader := header
+	oldHeader.SessionBlockHeight = 1
+	dc.set(types.Session{SessionHeader: oldHeader})
+	_, found = dc.get(oldHeader)
+	assert.False(t, found)
+	// the session rollover invalidates the previous sessions
+	newHeader := header
+	newHeader.SessionBlockHeight = 9
+	dc.set(types.Session{SessionHeader: newHeader})
+	_, found = dc.get(header)
+	assert.False(t, found)
+	_, found = dc.get(newHeader)
+	assert.True(t, found)
+	dc.clear()
+	_, found = dc.get(newHeader)
+	assert.False(t, found)
+}
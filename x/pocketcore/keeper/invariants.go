@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// "RegisterInvariants" - Registers all of the pocketcore module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(pc.ModuleName, "claim-receipt", ClaimReceiptInvariant(k))
+	ir.RegisterRoute(pc.ModuleName, "settlement", SettlementInvariant(k))
+}
+
+// "AllInvariants" - Runs all of the pocketcore module invariants
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Ctx) (string, bool) {
+		res, stop := ClaimReceiptInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return SettlementInvariant(k)(ctx)
+	}
+}
+
+// "ClaimReceiptInvariant" - Checks that no pending claim has already been proven (a proven claim is replaced by its receipt)
+func ClaimReceiptInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Ctx) (string, bool) {
+		var msg string
+		var count int
+		store := ctx.KVStore(k.storeKey)
+		iterator := sdk.KVStorePrefixIterator(store, pc.ClaimKey)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var claim pc.MsgClaim
+			k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &claim)
+			if claim.TotalProofs <= 0 {
+				count++
+				msg += fmt.Sprintf("\tclaim of %s for session %d has %d proofs\n", claim.FromAddress, claim.SessionBlockHeight, claim.TotalProofs)
+			}
+			// the claim and the receipt share the same identifiers
+			if store.Has(append(pc.ReceiptKey, iterator.Key()[len(pc.ClaimKey):]...)) {
+				count++
+				msg += fmt.Sprintf("\tclaim of %s for session %d has a receipt\n", claim.FromAddress, claim.SessionBlockHeight)
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(pc.ModuleName, "claim receipt",
+			fmt.Sprintf("found %d inconsistent claims\n%s", count, msg)), broken
+	}
+}
+
+// "SettlementInvariant" - Checks that every settlement belongs to a receipt and never mints or burns a negative amount
+func SettlementInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Ctx) (string, bool) {
+		var msg string
+		var count int
+		store := ctx.KVStore(k.storeKey)
+		iterator := sdk.KVStorePrefixIterator(store, pc.SettlementKey)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var settlement pc.Settlement
+			k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &settlement)
+			if settlement.Minted.IsNegative() || settlement.Burned.IsNegative() {
+				count++
+				msg += fmt.Sprintf("\tsettlement %X has a negative amount: minted %v, burned %v\n", iterator.Key(), settlement.Minted, settlement.Burned)
+			}
+			// the settlement and the receipt share the same identifiers
+			if !store.Has(append(pc.ReceiptKey, iterator.Key()[len(pc.SettlementKey):]...)) {
+				count++
+				msg += fmt.Sprintf("\tsettlement %X has no receipt\n", iterator.Key())
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(pc.ModuleName, "settlement",
+			fmt.Sprintf("found %d inconsistent settlements\n%s", count, msg)), broken
+	}
+}
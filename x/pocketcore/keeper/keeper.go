@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/codec"
 	sdk "github.com/pokt-network/posmint/types"
+	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/rpc/client"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 )
@@ -15,8 +18,10 @@ type Keeper struct {
 	TmNode            client.Client
 	hostedBlockchains *types.HostedBlockchains
 	Paramstore        sdk.Subspace
-	storeKey          sdk.StoreKey // Unexposed key to access store from sdk.Context
-	cdc               *codec.Codec // The wire codec for binary encoding/decoding.
+	storeKey          sdk.StoreKey   // Unexposed key to access store from sdk.Context
+	cdc               *codec.Codec   // The wire codec for binary encoding/decoding.
+	dispatchCache     *dispatchCache // The sessions dispatched for the latest session block
+	relayMeter        *relayMeter    // The relays served per app and chain for the latest session block
 }
 
 // NewKeeper creates new instances of the pocketcore module Keeper
@@ -28,9 +33,16 @@ func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec, posKeeper types.PosKeepe
 		appKeeper:         appKeeper,
 		hostedBlockchains: hostedChains,
 		Paramstore:        paramstore.WithKeyTable(ParamKeyTable()),
+		dispatchCache:     newDispatchCache(),
+		relayMeter:        newRelayMeter(),
 	}
 }
 
+// "Logger" - Returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Ctx) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // "GetBlock" returns the block from the tendermint node at a certain height
 func (k Keeper) GetBlock(height int) (*core_types.ResultBlock, error) {
 	h := int64(height)
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// adds the relays of the settled claim to the network wide tally of its chain and session
+func (k Keeper) addNetworkRelays(ctx sdk.Ctx, claim pc.MsgClaim) {
+	store := ctx.KVStore(k.storeKey)
+	key := pc.KeyForNetworkRelays(claim.SessionBlockHeight, claim.Chain)
+	var tally pc.ClaimsTally
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &tally)
+	}
+	tally.Add(claim.TotalProofs)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(tally))
+}
+
+// "GetNetworkRelays" - Returns the relays settled network wide per chain and session, for the sessions between the
+// heights (inclusive) and the chain (all the chains if empty), ordered by session height and chain
+func (k Keeper) GetNetworkRelays(ctx sdk.Ctx, fromSessionHeight, toSessionHeight int64, chain string) (relays []pc.NetworkRelays) {
+	relays = make([]pc.NetworkRelays, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(pc.KeyForNetworkRelaysAt(fromSessionHeight), pc.KeyForNetworkRelaysAt(toSessionHeight+1))
+	defer iterator.Close()
+	prefixLen := len(pc.KeyForNetworkRelaysAt(0))
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		c := string(key[prefixLen:])
+		if chain != "" && c != chain {
+			continue
+		}
+		var tally pc.ClaimsTally
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &tally)
+		relays = append(relays, pc.NetworkRelays{
+			SessionBlockHeight: int64(binary.BigEndian.Uint64(key[len(pc.NetworkRelaysKey):prefixLen])),
+			Chain:              c,
+			Proofs:             tally.Count,
+			Relays:             tally.TotalRelays,
+		})
+	}
+	return
+}
+
+// "SettledRelays" - Returns the relays settled network wide for the sessions between the heights (inclusive)
+func (k Keeper) SettledRelays(ctx sdk.Ctx, fromSessionHeight, toSessionHeight int64) (total int64) {
+	for _, r := range k.GetNetworkRelays(ctx, fromSessionHeight, toSessionHeight, "") {
+		total += r.Relays
+	}
+	return
+}
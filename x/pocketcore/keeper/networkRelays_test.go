@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_GetNetworkRelays(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	claim := func(height int64, chain string, relays int64) types.MsgClaim {
+		return types.MsgClaim{SessionHeader: types.SessionHeader{SessionBlockHeight: height, Chain: chain}, TotalProofs: relays}
+	}
+	keeper.addNetworkRelays(ctx, claim(1, "0001", 10))
+	keeper.addNetworkRelays(ctx, claim(1, "0001", 5))
+	keeper.addNetworkRelays(ctx, claim(1, "0002", 7))
+	keeper.addNetworkRelays(ctx, claim(5, "0001", 3))
+	keeper.addNetworkRelays(ctx, claim(256, "0001", 1))
+	assert.Equal(t, []types.NetworkRelays{
+		{SessionBlockHeight: 1, Chain: "0001", Proofs: 2, Relays: 15},
+		{SessionBlockHeight: 1, Chain: "0002", Proofs: 1, Relays: 7},
+		{SessionBlockHeight: 5, Chain: "0001", Proofs: 1, Relays: 3},
+	}, keeper.GetNetworkRelays(ctx, 1, 5, ""))
+	assert.Equal(t, []types.NetworkRelays{
+		{SessionBlockHeight: 5, Chain: "0001", Proofs: 1, Relays: 3},
+		{SessionBlockHeight: 256, Chain: "0001", Proofs: 1, Relays: 1},
+	}, keeper.GetNetworkRelays(ctx, 2, 300, "0001"))
+	assert.Empty(t, keeper.GetNetworkRelays(ctx, 2, 300, "0002"))
+	assert.Equal(t, int64(25), keeper.SettledRelays(ctx, 1, 5))
+	assert.Equal(t, int64(4), keeper.SettledRelays(ctx, 2, 300))
+	assert.Zero(t, keeper.SettledRelays(ctx, 6, 255))
+}
@@ -37,12 +37,18 @@ func (k Keeper) GetSelfNode(ctx sdk.Ctx) (node exported.ValidatorI, er sdk.Error
 	return self, nil
 }
 
-// "AwardCoinsForRelays" - Award coins to nodes for relays completed using the nodes keeper
-func (k Keeper) AwardCoinsForRelays(ctx sdk.Ctx, relays int64, toAddr sdk.Address) {
-	k.posKeeper.RewardForRelays(ctx, sdk.NewInt(relays), toAddr)
+// "AwardCoinsForRelays" - Award coins to nodes for relays completed using the nodes keeper, returns the total minted
+func (k Keeper) AwardCoinsForRelays(ctx sdk.Ctx, relays int64, toAddr sdk.Address) sdk.Int {
+	return k.posKeeper.RewardForRelays(ctx, sdk.NewInt(relays), toAddr)
 }
 
-// "BurnCoinsForChallenges" - Executes the burn for challenge function in the nodes module
-func (k Keeper) BurnCoinsForChallenges(ctx sdk.Ctx, relays int64, toAddr sdk.Address) {
-	k.posKeeper.BurnForChallenge(ctx, sdk.NewInt(relays), toAddr)
+// "BurnCoinsForChallenges" - Executes the burn for challenge function in the nodes module, returns the total burned
+func (k Keeper) BurnCoinsForChallenges(ctx sdk.Ctx, relays int64, toAddr sdk.Address) sdk.Int {
+	return k.posKeeper.BurnForChallenge(ctx, sdk.NewInt(relays), toAddr)
+}
+
+// "AwardCoinsForChallengeReport" - Awards the reporter of a proven challenge its share of the burned tokens, returns the total minted
+func (k Keeper) AwardCoinsForChallengeReport(ctx sdk.Ctx, burned sdk.Int, reporter sdk.Address) sdk.Int {
+	reward := burned.Mul(sdk.NewInt(k.ChallengeReporterReward(ctx))).Quo(sdk.NewInt(100))
+	return k.posKeeper.RewardForChallengeReport(ctx, reward, reporter)
 }
@@ -45,6 +45,19 @@ func (k Keeper) ClaimSubmissionWindow(ctx sdk.Ctx) (res int64) {
 	return
 }
 
+// "ClaimSubmissionWindowByChain" - Returns the claim submission windows of the chains from the paramstore
+// The claim submission window overridden per chain
+func (k Keeper) ClaimSubmissionWindowByChain(ctx sdk.Ctx) (res types.ChainClaimWindows) {
+	// not in the paramstore of chains started before the per chain windows
+	k.Paramstore.GetIfExists(ctx, types.KeyClaimSubmissionWindowByChain, &res)
+	return
+}
+
+// "ClaimSubmissionWindowForChain" - Returns the claim submission window of the chain (the default one if not overridden)
+func (k Keeper) ClaimSubmissionWindowForChain(ctx sdk.Ctx, chain string) int64 {
+	return k.ClaimSubmissionWindowByChain(ctx).Window(chain, k.ClaimSubmissionWindow(ctx))
+}
+
 // "SupportedBlockchains" - Returns a supported blockchain parameter from the paramstore
 // What blockchains are supported in pocket network (list of network identifier hashes)
 func (k Keeper) SupportedBlockchains(ctx sdk.Ctx) (res []string) {
@@ -59,15 +72,75 @@ func (k Keeper) MinimumNumberOfProofs(ctx sdk.Ctx) (res int64) {
 	return
 }
 
+// "ChallengeReporterReward" - Returns the challenge reporter reward parameter from the paramstore
+// The percentage of the tokens burned from the accused servicer that is awarded to the reporter of a challenge
+func (k Keeper) ChallengeReporterReward(ctx sdk.Ctx) (res int64) {
+	// not in the paramstore of chains started before the challenge reporter reward (no reward)
+	k.Paramstore.GetIfExists(ctx, types.KeyChallengeReporterReward, &res)
+	return
+}
+
+// "ChainRegistry" - Returns the chain registry parameter from the paramstore
+// The human readable metadata (name, description) of the network identifiers
+func (k Keeper) ChainRegistry(ctx sdk.Ctx) (res types.ChainRegistry) {
+	// not in the paramstore of chains started before the chain registry
+	k.Paramstore.GetIfExists(ctx, types.KeyChainRegistry, &res)
+	return
+}
+
+// "ReputationWeightedSessions" - Returns the reputation weighted sessions parameter from the paramstore
+// Weights the selection of the session nodes by their reputation score
+func (k Keeper) ReputationWeightedSessions(ctx sdk.Ctx) (res bool) {
+	// not in the paramstore of chains started before the reputation
+	k.Paramstore.GetIfExists(ctx, types.KeyReputationWeightedSessions, &res)
+	return
+}
+
+// "ReputationWindow" - Returns the reputation window parameter from the paramstore
+// The sessions the reputation of a servicer is recorded over
+func (k Keeper) ReputationWindow(ctx sdk.Ctx) (res int64) {
+	// not in the paramstore of chains started before the reputation
+	k.Paramstore.GetIfExists(ctx, types.KeyReputationWindow, &res)
+	return
+}
+
+// "ParamBounds" - Returns the param bounds parameter from the paramstore
+// The bounds of the params enforced on the param changes (see ParamBounds.OrDefault)
+func (k Keeper) ParamBounds(ctx sdk.Ctx) (res types.ParamBounds) {
+	// not in the paramstore of chains started before the param bounds
+	k.Paramstore.GetIfExists(ctx, types.KeyParamBounds, &res)
+	return
+}
+
+// "SetParamBounds" - Sets the param bounds parameter in the paramstore
+func (k Keeper) SetParamBounds(ctx sdk.Ctx, bounds types.ParamBounds) {
+	k.Paramstore.Set(ctx, types.KeyParamBounds, bounds)
+}
+
+// "SupportedBlockchainsMetadata" - Returns the supported blockchains along with their metadata in the chain registry
+func (k Keeper) SupportedBlockchainsMetadata(ctx sdk.Ctx) (res []types.ChainMetadata) {
+	registry := k.ChainRegistry(ctx)
+	for _, chain := range k.SupportedBlockchains(ctx) {
+		res = append(res, registry.Metadata(chain))
+	}
+	return
+}
+
 // "GetParams" - Returns all module parameters in a `Params` struct
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
-		SessionNodeCount:           k.SessionNodeCount(ctx),
-		ClaimSubmissionWindow:      k.ClaimSubmissionWindow(ctx),
-		SupportedBlockchains:       k.SupportedBlockchains(ctx),
-		ClaimExpiration:            k.ClaimExpiration(ctx),
-		ReplayAttackBurnMultiplier: k.ReplayAttackBurnMultiplier(ctx),
-		MinimumNumberOfProofs:      k.MinimumNumberOfProofs(ctx),
+		SessionNodeCount:             k.SessionNodeCount(ctx),
+		ClaimSubmissionWindow:        k.ClaimSubmissionWindow(ctx),
+		SupportedBlockchains:         k.SupportedBlockchains(ctx),
+		ClaimExpiration:              k.ClaimExpiration(ctx),
+		ReplayAttackBurnMultiplier:   k.ReplayAttackBurnMultiplier(ctx),
+		MinimumNumberOfProofs:        k.MinimumNumberOfProofs(ctx),
+		ChallengeReporterReward:      k.ChallengeReporterReward(ctx),
+		ChainRegistry:                k.ChainRegistry(ctx),
+		ClaimSubmissionWindowByChain: k.ClaimSubmissionWindowByChain(ctx),
+		ReputationWeightedSessions:   k.ReputationWeightedSessions(ctx),
+		ReputationWindow:             k.ReputationWindow(ctx),
+		ParamBounds:                  k.ParamBounds(ctx),
 	}
 }
 
@@ -38,6 +38,34 @@ func TestKeeper_ReplayAttackBurnMultiplier(t *testing.T) {
 	assert.Equal(t, types.DefaultReplayAttackBurnMultiplier, rabm)
 }
 
+func TestKeeper_ChallengeReporterReward(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	assert.Equal(t, types.DefaultChallengeReporterReward, keeper.ChallengeReporterReward(ctx))
+}
+
+func TestKeeper_SupportedBlockchainsMetadata(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	chain := getTestSupportedBlockchain()
+	// not in the registry
+	assert.Equal(t, []types.ChainMetadata{{ID: chain}}, keeper.SupportedBlockchainsMetadata(ctx))
+	p := keeper.GetParams(ctx)
+	p.ChainRegistry = types.ChainRegistry{{ID: chain, Name: "Ethereum", Description: "mainnet"}}
+	keeper.SetParams(ctx, p)
+	assert.Equal(t, []types.ChainMetadata{{ID: chain, Name: "Ethereum", Description: "mainnet"}}, keeper.SupportedBlockchainsMetadata(ctx))
+}
+
+func TestKeeper_ClaimSubmissionWindowForChain(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	chain := getTestSupportedBlockchain()
+	// not overridden
+	assert.Equal(t, keeper.ClaimSubmissionWindow(ctx), keeper.ClaimSubmissionWindowForChain(ctx, chain))
+	p := keeper.GetParams(ctx)
+	p.ClaimSubmissionWindowByChain = types.ChainClaimWindows{{Chain: chain, Window: 5}}
+	keeper.SetParams(ctx, p)
+	assert.Equal(t, int64(5), keeper.ClaimSubmissionWindowForChain(ctx, chain))
+	assert.Equal(t, keeper.ClaimSubmissionWindow(ctx), keeper.ClaimSubmissionWindowForChain(ctx, "0002"))
+}
+
 func TestKeeper_SessionFrequency(t *testing.T) {
 	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
 	sessFrequency := keeper.BlocksPerSession(ctx)
@@ -63,12 +91,18 @@ func TestKeeper_SupportedBlockchains(t *testing.T) {
 func TestKeeper_GetParams(t *testing.T) {
 	ctx, _, _, _, k, _, _ := createTestInput(t, false)
 	p := types.Params{
-		SessionNodeCount:           k.SessionNodeCount(ctx),
-		ClaimSubmissionWindow:      k.ClaimSubmissionWindow(ctx),
-		SupportedBlockchains:       k.SupportedBlockchains(ctx),
-		ClaimExpiration:            k.ClaimExpiration(ctx),
-		ReplayAttackBurnMultiplier: k.ReplayAttackBurnMultiplier(ctx),
-		MinimumNumberOfProofs:      k.MinimumNumberOfProofs(ctx),
+		SessionNodeCount:             k.SessionNodeCount(ctx),
+		ClaimSubmissionWindow:        k.ClaimSubmissionWindow(ctx),
+		SupportedBlockchains:         k.SupportedBlockchains(ctx),
+		ClaimExpiration:              k.ClaimExpiration(ctx),
+		ReplayAttackBurnMultiplier:   k.ReplayAttackBurnMultiplier(ctx),
+		MinimumNumberOfProofs:        k.MinimumNumberOfProofs(ctx),
+		ChallengeReporterReward:      k.ChallengeReporterReward(ctx),
+		ChainRegistry:                k.ChainRegistry(ctx),
+		ClaimSubmissionWindowByChain: k.ClaimSubmissionWindowByChain(ctx),
+		ReputationWeightedSessions:   k.ReputationWeightedSessions(ctx),
+		ReputationWindow:             k.ReputationWindow(ctx),
+		ParamBounds:                  k.ParamBounds(ctx),
 	}
 	paramz := k.GetParams(ctx)
 	assert.NotNil(t, paramz)
@@ -84,8 +118,20 @@ func TestKeeper_SetParams(t *testing.T) {
 		SessionNodeCount:      sessionNodeCount,
 		ClaimSubmissionWindow: pwp,
 		SupportedBlockchains:  sb,
+		ParamBounds:           types.ParamBounds{{Key: "pocketcore/SessionNodeCount", Min: "1", Max: "20"}},
 	}
 	k.SetParams(ctx, p)
 	paramz := k.GetParams(ctx)
 	assert.Equal(t, paramz, p)
 }
+
+func TestKeeper_ParamBounds(t *testing.T) {
+	ctx, _, _, _, k, _, _ := createTestInput(t, false)
+	bounds := types.ParamBounds{{Key: "pocketcore/SessionNodeCount", Min: "1", Max: "20"}}
+	k.SetParamBounds(ctx, bounds)
+	assert.Equal(t, bounds, k.ParamBounds(ctx))
+	// the default bounds are enforced if empty
+	k.SetParamBounds(ctx, types.ParamBounds{})
+	assert.Empty(t, k.ParamBounds(ctx))
+	assert.Equal(t, types.DefaultParamBounds, k.ParamBounds(ctx).OrDefault())
+}
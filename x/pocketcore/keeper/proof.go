@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
+	"time"
 
 	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
@@ -18,7 +20,7 @@ import (
 func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, branches [2]pc.MerkleProof, leafNode, cousin pc.Proof, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
 	kp, err := k.GetPKFromFile(ctx)
 	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the pk from the file for the Proof Transaction:\n%v", err))
+		k.Logger(ctx).Error("unable to retrieve the private key from file for the proof transaction", "err", err.Error())
 		return
 	}
 	// get the self address
@@ -26,54 +28,70 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx ut
 	// get all mature (waiting period has passed) claims for your address
 	claims, err := k.GetMatureClaims(ctx, addr)
 	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("an error occured getting the mature claims in the Proof Transaction:\n%v", err))
+		k.Logger(ctx).Error("unable to get the mature claims for the proof transaction", "err", err.Error())
 		return
 	}
 	// for every claim of the mature set
 	for _, claim := range claims {
-		// if the claim is found to be verified in the world state, you can delete it from the cache and not send again
-		if _, found := k.GetReceipt(ctx, addr, claim.SessionHeader, claim.EvidenceType); found {
-			// remove from the local cache
-			if err := pc.DeleteEvidence(claim.SessionHeader, claim.EvidenceType); err != nil {
-				ctx.Logger().Debug(err.Error())
-			}
-			continue
-		}
-		// check to see if evidence is stored in cache
-		evidence, err := pc.GetEvidence(claim.SessionHeader, claim.EvidenceType, sdk.ZeroInt())
-		if err != nil || evidence.Proofs == nil || len(evidence.Proofs) == 0 {
-			ctx.Logger().Info(fmt.Sprintf("the evidence object for evidence is not found, ignoring pending claim for app: %s, at sessionHeight: %d", claim.ApplicationPubKey, claim.SessionBlockHeight))
-			continue
-		}
-		// get the session context
-		sessionCtx, err := ctx.PrevCtx(claim.SessionBlockHeight)
-		if err != nil {
-			ctx.Logger().Info(fmt.Sprintf("could not get Session Context, ignoring pending claim for app: %s, at sessionHeight: %d", claim.ApplicationPubKey, claim.SessionBlockHeight))
-			continue
-		}
-		// generate the needed pseudorandom index using the information found in the first transaction
-		index, err := k.getPseudorandomIndex(ctx, claim.TotalProofs, claim.SessionHeader, sessionCtx)
-		if err != nil {
-			ctx.Logger().Error(err.Error())
-			continue
+		k.proveClaim(ctx, n, kp, addr, claim, proofTx)
+	}
+}
+
+// proves the mature claim of the address (if not already proven)
+func (k Keeper) proveClaim(ctx sdk.Ctx, n client.Client, kp crypto.PrivateKey, addr sdk.Address, claim pc.MsgClaim, proofTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, branches [2]pc.MerkleProof, leafNode, cousin pc.Proof, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
+	// if the claim is found to be verified in the world state, you can delete it from the cache and not send again
+	if _, found := k.GetReceipt(ctx, addr, claim.SessionHeader, claim.EvidenceType); found {
+		// remove from the local cache
+		if err := pc.DeleteEvidence(claim.SessionHeader, claim.EvidenceType); err != nil {
+			ctx.Logger().Debug(err.Error())
 		}
-		// get the merkle proof object for the pseudorandom index
-		branch, cousinIndex := evidence.GenerateMerkleProof(int(index))
-		// get the leaf and cousin for the required pseudorandom index
-		leaf := pc.GetProof(claim.SessionHeader, claim.EvidenceType, index)
-		cousin := pc.GetProof(claim.SessionHeader, claim.EvidenceType, int64(cousinIndex))
-		// generate the auto txbuilder and clictx
+		return
+	}
+	// check to see if evidence is stored in cache
+	evidence, err := pc.GetEvidence(claim.SessionHeader, claim.EvidenceType, sdk.ZeroInt())
+	if err != nil || evidence.Proofs == nil || len(evidence.Proofs) == 0 {
+		k.Logger(ctx).Info("the evidence of the claim is not found, ignoring the pending claim", evidenceKeyvals(claim.SessionHeader)...)
+		return
+	}
+	// get the session context
+	sessionCtx, err := ctx.PrevCtx(claim.SessionBlockHeight)
+	if err != nil {
+		k.Logger(ctx).Info("unable to get the session context, ignoring the pending claim", append(evidenceKeyvals(claim.SessionHeader), "err", err.Error())...)
+		return
+	}
+	// the evidence orphaned by a reorg of the local chain can't prove the claim
+	if k.QuarantineIfOrphaned(ctx, evidence, pc.BlockHash(sessionCtx)) {
+		return
+	}
+	// a failed proof is only re-attempted past its backoff
+	if !pc.IsTxRetryDue(claim.SessionHeader, claim.EvidenceType, pc.MsgProofName, time.Now()) {
+		return
+	}
+	// generate the needed pseudorandom index using the information found in the first transaction
+	index, err := k.getPseudorandomIndex(ctx, claim.TotalProofs, claim.SessionHeader, sessionCtx)
+	if err != nil {
+		k.Logger(ctx).Error("unable to generate the pseudorandom index of the proof", append(evidenceKeyvals(claim.SessionHeader), "err", err.Error())...)
+		return
+	}
+	// get the merkle proof object for the pseudorandom index
+	branch, cousinIndex := evidence.GenerateMerkleProof(int(index))
+	// get the leaf and cousin for the required pseudorandom index
+	leaf := pc.GetProof(claim.SessionHeader, claim.EvidenceType, index)
+	cousin := pc.GetProof(claim.SessionHeader, claim.EvidenceType, int64(cousinIndex))
+	// send the proof TX, with a new auto txbuilder and clictx per broadcast (signed with a new entropy)
+	_, err = pc.BroadcastWithRetry(k.Logger(ctx), pc.MsgProofName, func() (*sdk.TxResponse, error) {
 		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, pc.MsgProofName, n, kp, k)
 		if err != nil {
-			ctx.Logger().Error(fmt.Sprintf("an error occured in the transaction process of the Proof Transaction:\n%v", err))
-			return
-		}
-		// send the proof TX
-		_, err = proofTx(cliCtx, txBuilder, branch, leaf, cousin, evidence.EvidenceType)
-		if err != nil {
-			ctx.Logger().Error(err.Error())
+			return nil, err
 		}
+		return proofTx(cliCtx, txBuilder, branch, leaf, cousin, evidence.EvidenceType)
+	}, append(evidenceKeyvals(claim.SessionHeader), "index", index)...)
+	if err != nil {
+		e := pc.RecordTxFailure(claim.SessionHeader, claim.EvidenceType, pc.MsgProofName, err)
+		k.Logger(ctx).Error("the proof transaction is queued for retry", append(evidenceKeyvals(claim.SessionHeader), "attempts", e.Attempts, "next_attempt", e.NextAttempt)...)
+		return
 	}
+	pc.RecordTxSuccess(claim.SessionHeader, claim.EvidenceType, pc.MsgProofName)
 }
 
 func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.Address, claim pc.MsgClaim, sdkError sdk.Error) {
@@ -129,39 +147,100 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.
 	if er != nil {
 		return nil, pc.MsgClaim{}, er
 	}
+	// ensure the gateway that signed the relay token (if any) was delegated by the app for the session
+	if relayProof, ok := proof.Leaf.(pc.RelayProof); ok {
+		if er := k.ValidateTokenDelegation(sessionCtx, relayProof.Token); er != nil {
+			return nil, pc.MsgClaim{}, er
+		}
+	}
 	// return the needed info to the handler
 	return addr, claim, nil
 }
 
-func (k Keeper) ExecuteProof(ctx sdk.Ctx, proof pc.MsgProof, claim pc.MsgClaim) sdk.Error {
+func (k Keeper) ExecuteProof(ctx sdk.Ctx, proof pc.MsgProof, claim pc.MsgClaim) (settlement pc.Settlement, er sdk.Error) {
+	settlement = pc.Settlement{
+		Minted:                   sdk.ZeroInt(),
+		Burned:                   sdk.ZeroInt(),
+		ReporterReward:           sdk.ZeroInt(),
+		RelaysToTokensMultiplier: k.posKeeper.RelaysToTokensMultiplier(ctx),
+	}
 	switch proof.Leaf.(type) {
 	case pc.RelayProof:
 		ctx.Logger().Info(fmt.Sprintf("reward coins to %s, for %d relays", claim.FromAddress.String(), claim.TotalProofs))
-		k.AwardCoinsForRelays(ctx, claim.TotalProofs, claim.FromAddress)
+		settlement.Minted = k.AwardCoinsForRelays(ctx, claim.TotalProofs, claim.FromAddress)
+		// the reward of the servicer, for the accounting of its earnings (see the rewards query)
+		nodeReward, _ := k.posKeeper.NodeReward(ctx, settlement.RelaysToTokensMultiplier.MulRaw(claim.TotalProofs))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			pc.EventTypeRelayReward,
+			sdk.NewAttribute(pc.AttributeKeyValidator, claim.FromAddress.String()),
+			sdk.NewAttribute(pc.AttributeKeyChain, claim.Chain),
+			sdk.NewAttribute(pc.AttributeKeySessionHeight, strconv.FormatInt(claim.SessionBlockHeight, 10)),
+			sdk.NewAttribute(pc.AttributeKeyRelays, strconv.FormatInt(claim.TotalProofs, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, nodeReward.String()),
+		))
+		// tally the settled relays network wide
+		k.addNetworkRelays(ctx, claim)
+		// record the proven claim in the reputation of the servicer
+		k.addReputation(ctx, claim.FromAddress, 1, 0)
 		err := k.DeleteClaim(ctx, claim.FromAddress, claim.SessionHeader, pc.RelayEvidence)
 		if err != nil {
-			return sdk.ErrInternal(err.Error())
+			return settlement, sdk.ErrInternal(err.Error())
 		}
 	case pc.ChallengeProofInvalidData:
 		ctx.Logger().Info(fmt.Sprintf("burning coins from %s, for %d valid challenges", claim.FromAddress.String(), claim.TotalProofs))
 		proof, ok := proof.Leaf.(pc.ChallengeProofInvalidData)
 		if !ok {
-			return pc.NewInvalidProofsError(pc.ModuleName)
+			return settlement, pc.NewInvalidProofsError(pc.ModuleName)
 		}
 		pk := proof.MinorityResponse.Proof.ServicerPubKey
 		pubKey, err := crypto.NewPublicKey(pk)
 		if err != nil {
-			return sdk.ErrInvalidPubKey(err.Error())
+			return settlement, sdk.ErrInvalidPubKey(err.Error())
+		}
+		accused := sdk.Address(pubKey.Address())
+		settlement.Burned = k.BurnCoinsForChallenges(ctx, claim.TotalProofs, accused)
+		// reward the client that reported the challenge with a share of the burned tokens
+		// (the client comes from the signed token of the minority response, never from the unsigned reporter address)
+		reporter, err := proof.ClientReporter()
+		if err != nil {
+			return settlement, sdk.ErrInvalidPubKey(err.Error())
+		}
+		if !reporter.Equals(accused) && !reporter.Equals(claim.FromAddress) {
+			settlement.ReporterReward = k.AwardCoinsForChallengeReport(ctx, settlement.Burned, reporter)
+		}
+		if settlement.ReporterReward.IsPositive() {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				pc.EventTypeChallengeReward,
+				sdk.NewAttribute(pc.AttributeKeyReporter, reporter.String()),
+				sdk.NewAttribute(pc.AttributeKeyAccused, accused.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, settlement.ReporterReward.String()),
+			))
 		}
-		k.BurnCoinsForChallenges(ctx, claim.TotalProofs, sdk.Address(pubKey.Address()))
 		err = k.DeleteClaim(ctx, claim.FromAddress, claim.SessionHeader, pc.ChallengeEvidence)
 		if err != nil {
-			return sdk.ErrInternal(err.Error())
+			return settlement, sdk.ErrInternal(err.Error())
+		}
+		// record the settled challenge against the accused servicer
+		err = k.SetChallenge(ctx, pc.Challenge{
+			SessionHeader:    claim.SessionHeader,
+			Reporter:         claim.FromAddress,
+			Accused:          accused,
+			TotalChallenges:  claim.TotalProofs,
+			Settled:          true,
+			Burned:           settlement.Burned,
+			ClientReporter:   reporter,
+			ReporterReward:   settlement.ReporterReward,
+			SettlementHeight: ctx.BlockHeight(),
+		})
+		if err != nil {
+			return settlement, sdk.ErrInternal(err.Error())
 		}
+		// record the settled challenge in the reputation of the accused servicer
+		k.addReputation(ctx, accused, 0, 1)
 		// small reward for the challenge proof invalid data
-		k.AwardCoinsForRelays(ctx, claim.TotalProofs/100, claim.FromAddress)
+		settlement.Minted = k.AwardCoinsForRelays(ctx, claim.TotalProofs/100, claim.FromAddress)
 	}
-	return nil
+	return settlement, nil
 }
 
 // struct used for creating the psuedorandom index
@@ -173,7 +252,11 @@ type pseudorandomGenerator struct {
 // generates the required pseudorandom index for the zero knowledge proof
 func (k Keeper) getPseudorandomIndex(ctx sdk.Ctx, totalRelays int64, header pc.SessionHeader, sessionCtx sdk.Ctx) (int64, error) {
 	// get the context for the proof (the proof context is X sessions after the session began)
-	proofContext, err := ctx.PrevCtx(header.SessionBlockHeight + k.ClaimSubmissionWindow(sessionCtx)*k.BlocksPerSession(sessionCtx)) // next session block hash
+	proofSessionBlockHeight, found := k.posKeeper.SessionBlockHeightAfter(ctx, header.SessionBlockHeight, k.ClaimSubmissionWindowForChain(sessionCtx, header.Chain))
+	if !found {
+		return 0, fmt.Errorf("the proof session for the session at %d has not started", header.SessionBlockHeight)
+	}
+	proofContext, err := ctx.PrevCtx(proofSessionBlockHeight) // next session block hash
 	if err != nil {
 		return 0, err
 	}
@@ -196,6 +279,11 @@ func (k Keeper) HandleReplayAttack(ctx sdk.Ctx, address sdk.Address, numberOfCha
 }
 
 func newTxBuilderAndCliCtx(ctx sdk.Ctx, msgType string, n client.Client, key crypto.PrivateKey, k Keeper) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
+	return newTxBuilderAndCliCtxWithFee(ctx, msgType, pc.PocketFeeMap[msgType], n, key, k)
+}
+
+// "newTxBuilderAndCliCtxWithFee" - Creates the auto txbuilder and clictx for a message type with the fee given
+func newTxBuilderAndCliCtxWithFee(ctx sdk.Ctx, msgType string, msgFee int64, n client.Client, key crypto.PrivateKey, k Keeper) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
 	// get the from address from the pkf
 	fromAddr := sdk.Address(key.PublicKey().Address())
 	// get the genesis doc from the node for the chainID
@@ -209,7 +297,8 @@ func newTxBuilderAndCliCtx(ctx sdk.Ctx, msgType string, n client.Client, key cry
 	if err != nil {
 		return txBuilder, cliCtx, err
 	}
-	cliCtx.PrivateKey = pk
+	// signed by the external sealer if enabled
+	cliCtx.PrivateKey = pc.SealingKey(pk, msgType)
 	// broadcast synchronously
 	cliCtx.BroadcastMode = util.BroadcastSync
 	// get the account to ensure balance
@@ -219,7 +308,7 @@ func newTxBuilderAndCliCtx(ctx sdk.Ctx, msgType string, n client.Client, key cry
 		return txBuilder, cliCtx, err
 	}
 	// check the fee amount
-	fee := sdk.NewInt(pc.PocketFeeMap[msgType])
+	fee := sdk.NewInt(msgFee)
 	if account.GetCoins().AmountOf(k.posKeeper.StakeDenom(ctx)).LTE(fee) {
 		ctx.Logger().Error(fmt.Sprintf("insufficient funds for the auto %s transaction: the fee needed is %v ", msgType, fee))
 	}
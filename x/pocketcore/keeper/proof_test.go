@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
@@ -32,6 +34,7 @@ func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
 	mockCtx := &Ctx{}
 	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
 	mockCtx.On("KVStore", keys[sdk.ParamsKey.Name()]).Return(ctx.KVStore(keys[sdk.ParamsKey.Name()]))
+	mockCtx.On("KVStore", keys[nodesTypes.StoreKey]).Return(ctx.KVStore(keys[nodesTypes.StoreKey]))
 	mockCtx.On("KVStore", keys[appsTypes.StoreKey]).Return(ctx.KVStore(keys[appsTypes.StoreKey]))
 	mockCtx.On("Logger").Return(ctx.Logger())
 	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
@@ -76,6 +79,7 @@ func TestKeeper_GetPsuedorandomIndex(t *testing.T) {
 		mockCtx := new(Ctx)
 		mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
 		mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+		mockCtx.On("KVStore", keys[nodesTypes.StoreKey]).Return(ctx.KVStore(keys[nodesTypes.StoreKey]))
 		mockCtx.On("PrevCtx", header.SessionBlockHeight+keeper.ClaimSubmissionWindow(ctx)*keeper.BlocksPerSession(ctx)).Return(ctx, nil)
 
 		// generate the pseudorandom proof
@@ -115,6 +119,102 @@ func TestKeeper_GetSetReceipt(t *testing.T) {
 	assert.Equal(t, inv, receipt)
 }
 
+func TestKeeper_GetSetSettlement(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	appPrivateKey := getRandomPrivateKey()
+	appPubKey := appPrivateKey.PublicKey().RawString()
+	npk := getRandomPubKey()
+	ethereum := hex.EncodeToString([]byte{01})
+	// create a session header
+	validHeader := types.SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              ethereum,
+		SessionBlockHeight: 976,
+	}
+	receipt := types.Receipt{
+		SessionHeader:   validHeader,
+		ServicerAddress: sdk.Address(npk.Address()).String(),
+		Total:           2000,
+		EvidenceType:    types.RelayEvidence,
+	}
+	settlement := types.Settlement{
+		Minted:                   sdk.NewInt(2000000),
+		RelaysToTokensMultiplier: sdk.NewInt(1000),
+		Burned:                   sdk.ZeroInt(),
+		ReporterReward:           sdk.ZeroInt(),
+	}
+	addr := sdk.Address(npk.Address())
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("PrevCtx", validHeader.SessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("Logger").Return(ctx.Logger())
+	// no receipt, no settled receipt
+	_, found := keeper.GetSettledReceipt(mockCtx, addr, validHeader, types.RelayEvidence)
+	assert.False(t, found)
+	_ = keeper.SetReceipt(mockCtx, addr, receipt)
+	// receipt without settlement
+	sr, found := keeper.GetSettledReceipt(mockCtx, addr, validHeader, types.RelayEvidence)
+	assert.True(t, found)
+	assert.Nil(t, sr.Settlement)
+	assert.Equal(t, receipt, sr.Receipt())
+	err := keeper.SetSettlement(mockCtx, addr, validHeader, types.RelayEvidence, settlement)
+	assert.Nil(t, err)
+	s, found := keeper.GetSettlement(mockCtx, addr, validHeader, types.RelayEvidence)
+	assert.True(t, found)
+	assert.Equal(t, settlement, s)
+	sr, found = keeper.GetSettledReceipt(mockCtx, addr, validHeader, types.RelayEvidence)
+	assert.True(t, found)
+	assert.Equal(t, receipt, sr.Receipt())
+	assert.Equal(t, &settlement, sr.Settlement)
+	// settlements don't show up as receipts
+	receipts, err := keeper.GetReceipts(mockCtx, addr, types.EvidenceFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, receipts, 1)
+}
+
+func TestKeeper_ExecuteProofChallengeReporter(t *testing.T) {
+	ctx, vals, _, _, keeper, _, _ := createTestInput(t, false)
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	accused, servicer := vals[0], vals[1]
+	// stake the accused servicer so the challenge has tokens to burn
+	stake := sdk.NewInt(1000000000)
+	assert.Nil(t, nk.AccountKeeper.MintCoins(ctx, nodesTypes.StakedPoolName, sdk.NewCoins(sdk.NewCoin(nk.StakeDenom(ctx), stake))))
+	accused.StakedTokens = stake
+	nk.SetValidator(ctx, accused)
+	clientKey := getRandomPrivateKey()
+	client := sdk.Address(clientKey.PublicKey().Address())
+	tampered := getRandomValidatorAddress()
+	header := types.SessionHeader{
+		ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 1,
+	}
+	challenge := types.ChallengeProofInvalidData{
+		MinorityResponse: types.RelayResponse{
+			Proof: createProof(getTestApplicationPrivateKey(), clientKey, accused.PublicKey, header.Chain, 0).(types.RelayProof),
+		},
+		// the unsigned reporter address is rewritten to an address other than the client
+		ReporterAddress: tampered,
+	}
+	claim := types.MsgClaim{
+		SessionHeader: header,
+		TotalProofs:   10,
+		FromAddress:   servicer.Address,
+		EvidenceType:  types.ChallengeEvidence,
+	}
+	settlement, err := keeper.ExecuteProof(ctx, types.MsgProof{Leaf: challenge, EvidenceType: types.ChallengeEvidence}, claim)
+	assert.Nil(t, err)
+	assert.True(t, settlement.Burned.IsPositive())
+	assert.True(t, settlement.ReporterReward.IsPositive())
+	// the tampered address earns nothing, the reward goes to the client of the signed token
+	assert.True(t, nk.GetBalance(ctx, tampered).IsZero())
+	assert.Equal(t, settlement.ReporterReward, nk.GetBalance(ctx, client))
+	challenges, er := keeper.GetChallengesAgainst(ctx, accused.Address)
+	assert.Nil(t, er)
+	assert.Len(t, challenges, 1)
+	assert.Equal(t, client, challenges[0].ClientReporter)
+}
+
 func TestKeeper_GetSetReceipts(t *testing.T) {
 	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
 	appPrivateKey := getRandomPrivateKey()
@@ -153,10 +253,17 @@ func TestKeeper_GetSetReceipts(t *testing.T) {
 	mockCtx.On("PrevCtx", validHeader.SessionBlockHeight).Return(ctx, nil)
 	mockCtx.On("Logger").Return(ctx.Logger())
 	keeper.SetReceipts(mockCtx, receipts)
-	inv, er := keeper.GetReceipts(mockCtx, sdk.Address(sdk.Address(npk.Address())))
+	inv, er := keeper.GetReceipts(mockCtx, sdk.Address(sdk.Address(npk.Address())), types.EvidenceFilter{})
 	assert.Nil(t, er)
 	assert.Contains(t, inv, receipt)
 	assert.Contains(t, inv, receipt2)
+	// filtered through the receipt index
+	inv, er = keeper.GetReceipts(mockCtx, sdk.Address(npk.Address()), types.EvidenceFilter{AppPubKey: appPubKey2})
+	assert.Nil(t, er)
+	assert.Equal(t, []types.Receipt{receipt2}, inv)
+	inv, er = keeper.GetReceipts(mockCtx, sdk.Address(npk.Address()), types.EvidenceFilter{EvidenceType: types.ChallengeEvidence})
+	assert.Nil(t, er)
+	assert.Empty(t, inv)
 }
 
 func TestKeeper_GetAllReceipts(t *testing.T) {
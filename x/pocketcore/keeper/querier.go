@@ -169,7 +169,7 @@ func queryReceipts(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Er
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 	// get the receipts object for that address
-	receipts, err := k.GetReceipts(ctx, params.Address)
+	receipts, err := k.GetReceipts(ctx, params.Address, params.Filter)
 	if err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("an error occured retrieving the receipts: %s", err))
 	}
@@ -18,8 +18,14 @@ func (k Keeper) SetReceipt(ctx sdk.Ctx, address sdk.Address, p pc.Receipt) error
 	if err != nil {
 		return err
 	}
+	// generate the key indexing the receipt
+	indexKey, err := pc.KeyForReceiptIndex(address, p.SessionHeader, p.EvidenceType)
+	if err != nil {
+		return err
+	}
 	// set kv into store
 	store.Set(key, bz)
+	store.Set(indexKey, []byte{}) // use empty byte slice to save space
 	return nil
 }
 
@@ -63,11 +69,21 @@ func (k Keeper) SetReceipts(ctx sdk.Ctx, receipts []pc.Receipt) {
 		}
 		// set it in the store
 		store.Set(key, bz)
+		// index it
+		indexKey, err := pc.KeyForReceiptIndex(addr, receipt.SessionHeader, receipt.EvidenceType)
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf("an error occured indexing the receipts:\n%v", err).Error())
+			continue
+		}
+		store.Set(indexKey, []byte{})
 	}
 }
 
-// "GetReceipts" - Retrieves all the receipt objects for a certain address
-func (k Keeper) GetReceipts(ctx sdk.Ctx, address sdk.Address) (receipts []pc.Receipt, err error) {
+// "GetReceipts" - Retrieves all the receipt objects for a certain address matching the filter
+func (k Keeper) GetReceipts(ctx sdk.Ctx, address sdk.Address, filter pc.EvidenceFilter) (receipts []pc.Receipt, err error) {
+	if !filter.IsEmpty() {
+		return k.getFilteredReceipts(ctx, address, filter)
+	}
 	// retrieve the store
 	store := ctx.KVStore(k.storeKey)
 	// generate the key for the address
@@ -88,6 +104,30 @@ func (k Keeper) GetReceipts(ctx sdk.Ctx, address sdk.Address) (receipts []pc.Rec
 	return
 }
 
+// iterates the receipt index of the address from the longest prefix of the filter
+func (k Keeper) getFilteredReceipts(ctx sdk.Ctx, address sdk.Address, filter pc.EvidenceFilter) (receipts []pc.Receipt, err error) {
+	store := ctx.KVStore(k.storeKey)
+	key, err := pc.KeyForReceiptIndexes(address, filter)
+	if err != nil {
+		return nil, err
+	}
+	iterator := sdk.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if !filter.MatchIndexKey(iterator.Key()) {
+			continue
+		}
+		bz := store.Get(pc.ReceiptKeyFromIndex(iterator.Key()))
+		if bz == nil {
+			continue
+		}
+		var receipt pc.Receipt
+		k.cdc.MustUnmarshalBinaryBare(bz, &receipt)
+		receipts = append(receipts, receipt)
+	}
+	return
+}
+
 // "GetAllReceipts" - Retrieves all the receipt objects in the storage
 func (k Keeper) GetAllReceipts(ctx sdk.Ctx) (receipts []pc.Receipt) {
 	// get the store
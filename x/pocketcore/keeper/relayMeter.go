@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// the relays served per app and chain (keyed by the header hash) and per client of the session for the latest session
+// block, dropped at the session rollover. Reserving a relay is atomic, so the concurrent relays of an app can't exceed
+// its allocation
+type relayMeter struct {
+	l                  sync.Mutex
+	sessionBlockHeight int64
+	served             map[string]int64
+	clients            map[string]map[string]int64
+}
+
+func newRelayMeter() *relayMeter {
+	return &relayMeter{served: make(map[string]int64), clients: make(map[string]map[string]int64)}
+}
+
+// reserves the relays (the requests of a batch) of the client in the session, rejected if they exceed the max relays of
+// the app (for this node) or the max relays of the client (if not zero). The relays served to the app before the meter
+// was started are counted from the relays metered with the evidence, the relays served to the client are not
+func (rm *relayMeter) reserve(header types.SessionHeader, client string, relays int64, maxRelays sdk.Int, maxClientRelays int64) sdk.Error {
+	if rm == nil {
+		return nil
+	}
+	rm.l.Lock()
+	defer rm.l.Unlock()
+	switch height := header.SessionBlockHeight; {
+	case height < rm.sessionBlockHeight:
+		return types.NewRelayLimitExceededError(types.ModuleName)
+	case height > rm.sessionBlockHeight:
+		rm.sessionBlockHeight = height
+		rm.served = make(map[string]int64)
+		rm.clients = make(map[string]map[string]int64)
+	}
+	key := header.HashString()
+	served, found := rm.served[key]
+	if !found {
+		served = types.GetTotalRelays(header, types.RelayEvidence, maxRelays)
+	}
+	if served+relays > maxRelays.Int64() {
+		return types.NewRelayLimitExceededError(types.ModuleName)
+	}
+	clientServed := rm.clients[key][client]
+	if maxClientRelays != 0 && clientServed+relays > maxClientRelays {
+		return types.NewClientRelayLimitExceededError(types.ModuleName, client)
+	}
+	if rm.clients[key] == nil {
+		rm.clients[key] = make(map[string]int64)
+	}
+	rm.served[key] = served + relays
+	rm.clients[key][client] = clientServed + relays
+	return nil
+}
+
+// returns the relays served for the session
+func (rm *relayMeter) get(header types.SessionHeader) int64 {
+	if rm == nil {
+		return 0
+	}
+	rm.l.Lock()
+	defer rm.l.Unlock()
+	if header.SessionBlockHeight != rm.sessionBlockHeight {
+		return 0
+	}
+	return rm.served[header.HashString()]
+}
+
+// returns the relays served to the client for the session
+func (rm *relayMeter) getClient(header types.SessionHeader, client string) int64 {
+	if rm == nil {
+		return 0
+	}
+	rm.l.Lock()
+	defer rm.l.Unlock()
+	if header.SessionBlockHeight != rm.sessionBlockHeight {
+		return 0
+	}
+	return rm.clients[header.HashString()][client]
+}
+
+// restarts the metering of the session from its evidence (the evidence was quarantined)
+func (rm *relayMeter) reset(header types.SessionHeader) {
+	if rm == nil {
+		return
+	}
+	rm.l.Lock()
+	defer rm.l.Unlock()
+	delete(rm.served, header.HashString())
+	delete(rm.clients, header.HashString())
+}
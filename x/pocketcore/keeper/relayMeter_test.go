@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"sync"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelayMeter(t *testing.T) {
+	rm := newRelayMeter()
+	header := types.SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 5,
+	}
+	client := getRandomPubKey().RawString()
+	maxRelays := sdk.NewInt(10)
+	// the concurrent relays never exceed the max relays
+	var wg sync.WaitGroup
+	var l sync.Mutex
+	rejected := 0
+	for i := 0; i < 15; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := rm.reserve(header, client, 1, maxRelays, 0); err != nil {
+				assert.Equal(t, types.CodeRelayLimitExceededError, int(err.Code()))
+				l.Lock()
+				rejected++
+				l.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 5, rejected)
+	assert.Equal(t, int64(10), rm.get(header))
+	// a batch is reserved as a whole
+	rm.reset(header)
+	assert.NotNil(t, rm.reserve(header, client, 11, maxRelays, 0))
+	assert.Nil(t, rm.reserve(header, client, 10, maxRelays, 0))
+	assert.Equal(t, int64(10), rm.get(header))
+	// the relays of an older session are rejected
+	oldHeader := header
+	oldHeader.SessionBlockHeight = 1
+	assert.NotNil(t, rm.reserve(oldHeader, client, 1, maxRelays, 0))
+	// the reset restarts from the evidence
+	rm.reset(header)
+	assert.Equal(t, int64(0), rm.get(header))
+	assert.Nil(t, rm.reserve(header, client, 1, maxRelays, 0))
+	// the session rollover restarts the metering
+	newHeader := header
+	newHeader.SessionBlockHeight = 9
+	assert.Nil(t, rm.reserve(newHeader, client, 1, maxRelays, 0))
+	assert.Equal(t, int64(1), rm.get(newHeader))
+	assert.Equal(t, int64(0), rm.get(header))
+}
+
+func TestRelayMeter_Restart(t *testing.T) {
+	header := types.SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 5,
+	}
+	client := getRandomPubKey().RawString()
+	maxRelays := sdk.NewInt(10)
+	// a batch of 4 requests served before the restart
+	types.RelayProof{Entropy: 1, SessionBlockHeight: 5, Blockchain: header.Chain, Token: types.AAT{ApplicationPublicKey: header.ApplicationPubKey}}.StoreRelays(maxRelays, 4)
+	defer func() { _ = types.DeleteEvidence(header, types.RelayEvidence) }()
+	// the restarted meter counts the requests of the batch, not its proof
+	rm := newRelayMeter()
+	assert.NotNil(t, rm.reserve(header, client, 7, maxRelays, 0))
+	assert.Nil(t, rm.reserve(header, client, 6, maxRelays, 0))
+	assert.Equal(t, int64(10), rm.get(header))
+}
+
+func TestRelayMeter_ClientLimit(t *testing.T) {
+	rm := newRelayMeter()
+	header := types.SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 5,
+	}
+	client, otherClient := getRandomPubKey().RawString(), getRandomPubKey().RawString()
+	maxRelays := sdk.NewInt(10)
+	// a client can't exceed its share of the session
+	assert.Nil(t, rm.reserve(header, client, 4, maxRelays, 5))
+	err := rm.reserve(header, client, 2, maxRelays, 5)
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeClientRelayLimitExceededError, int(err.Code()))
+	assert.Nil(t, rm.reserve(header, client, 1, maxRelays, 5))
+	assert.Equal(t, int64(5), rm.getClient(header, client))
+	// the other clients of the app are still served
+	assert.Nil(t, rm.reserve(header, otherClient, 5, maxRelays, 5))
+	// up to the allocation of the app
+	err = rm.reserve(header, getRandomPubKey().RawString(), 1, maxRelays, 5)
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeRelayLimitExceededError, int(err.Code()))
+	assert.Equal(t, int64(10), rm.get(header))
+	// the session rollover restarts the metering of the clients
+	newHeader := header
+	newHeader.SessionBlockHeight = 9
+	assert.Nil(t, rm.reserve(newHeader, client, 5, maxRelays, 5))
+	assert.Equal(t, int64(0), rm.getClient(header, client))
+}
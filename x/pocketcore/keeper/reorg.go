@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"fmt"
+
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// "QuarantineIfOrphaned" - Quarantines the evidence collected under a session block hash orphaned by a reorg of the local chain
+// (claiming it would be rejected), returns true if quarantined
+func (k Keeper) QuarantineIfOrphaned(ctx sdk.Ctx, evidence pc.Evidence, sessionBlockHash string) bool {
+	if !evidence.IsOrphaned(sessionBlockHash) {
+		return false
+	}
+	if err := pc.QuarantineEvidence(evidence, sessionBlockHash); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not quarantine the orphaned evidence: %s", err.Error()))
+	}
+	k.handleReorg(ctx, evidence.SessionHeader, evidence.SessionBlockHash, sessionBlockHash)
+	return true
+}
+
+// "handleReorg" - Logs the reorg of the local chain detected for the session and drops the dispatched (stale) sessions
+func (k Keeper) handleReorg(ctx sdk.Ctx, header pc.SessionHeader, orphanedHash, sessionBlockHash string) {
+	k.dispatchCache.clear()
+	k.relayMeter.reset(header)
+	ctx.Logger().Error(fmt.Sprintf("reorg detected for the session of app: %s, chain: %s, at height: %d, block hash %s is now %s; the evidence was quarantined",
+		header.ApplicationPubKey, header.Chain, header.SessionBlockHeight, orphanedHash, sessionBlockHash))
+}
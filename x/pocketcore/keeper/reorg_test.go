@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_QuarantineIfOrphaned(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	header := types.SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              hex.EncodeToString([]byte{0001}),
+		SessionBlockHeight: 1,
+	}
+	hash := hex.EncodeToString(types.Hash([]byte("block")))
+	_, err := types.SetEvidenceSessionBlockHash(header, types.RelayEvidence, hash, sdk.NewInt(1000))
+	assert.Nil(t, err)
+	types.SetProof(header, types.RelayEvidence, types.RelayProof{Entropy: 1}, sdk.NewInt(1000))
+	evidence, err := types.GetEvidence(header, types.RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	// the evidence of the local chain is kept
+	assert.False(t, keeper.QuarantineIfOrphaned(ctx, evidence, hash))
+	_, err = types.GetEvidence(header, types.RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	// the evidence orphaned by a reorg is removed
+	assert.True(t, keeper.QuarantineIfOrphaned(ctx, evidence, hex.EncodeToString(types.Hash([]byte("reorg block")))))
+	_, err = types.GetEvidence(header, types.RelayEvidence, sdk.ZeroInt())
+	assert.NotNil(t, err)
+}
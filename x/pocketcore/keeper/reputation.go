@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"fmt"
+
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// "GetReputation" - Returns the reputation of the servicer over the current reputation window
+func (k Keeper) GetReputation(ctx sdk.Ctx, address sdk.Address) (reputation pc.Reputation, err error) {
+	key, err := pc.KeyForReputation(address)
+	if err != nil {
+		return
+	}
+	latestSessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
+	window := k.ReputationWindow(ctx)
+	if window == 0 {
+		window = pc.DefaultReputationWindow
+	}
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &reputation)
+	}
+	// start a new window once the previous one ended
+	if bz == nil || reputation.IsExpired(latestSessionBlockHeight, window, k.posKeeper.BlocksPerSession(ctx)) {
+		reputation = pc.Reputation{WindowStart: latestSessionBlockHeight}
+	}
+	return
+}
+
+// "ReputationScore" - Returns the reputation score of the servicer (see Reputation.Score)
+func (k Keeper) ReputationScore(ctx sdk.Ctx, address sdk.Address) int64 {
+	reputation, err := k.GetReputation(ctx, address)
+	if err != nil {
+		return pc.MaxReputationScore
+	}
+	return reputation.Score()
+}
+
+// "SessionWeight" - Returns the weight of the node in the session selection: its reputation score if the sessions are
+// weighted by reputation (see the ReputationWeightedSessions param), the max score otherwise
+func (k Keeper) SessionWeight(sessionCtx sdk.Ctx, address sdk.Address) int64 {
+	if !k.ReputationWeightedSessions(sessionCtx) {
+		return pc.MaxReputationScore
+	}
+	return k.ReputationScore(sessionCtx, address)
+}
+
+// records the proven relay claims and the settled challenges of the servicer in its reputation
+func (k Keeper) addReputation(ctx sdk.Ctx, address sdk.Address, proofs, challenges int64) {
+	reputation, err := k.GetReputation(ctx, address)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not record the reputation of %s: %s", address.String(), err.Error()))
+		return
+	}
+	reputation.Proofs += proofs
+	reputation.Challenges += challenges
+	key, _ := pc.KeyForReputation(address)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(reputation))
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_Reputation(t *testing.T) {
+	ctx, nodes, _, _, keeper, _, _ := createTestInput(t, false)
+	addr := nodes[0].Address
+	// no record
+	assert.Equal(t, types.MaxReputationScore, keeper.ReputationScore(ctx, addr))
+	keeper.addReputation(ctx, addr, 3, 0)
+	keeper.addReputation(ctx, addr, 0, 1)
+	reputation, err := keeper.GetReputation(ctx, addr)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), reputation.Proofs)
+	assert.Equal(t, int64(1), reputation.Challenges)
+	assert.Equal(t, int64(75), keeper.ReputationScore(ctx, addr))
+	// unweighted sessions
+	assert.Equal(t, types.MaxReputationScore, keeper.SessionWeight(ctx, addr))
+	p := keeper.GetParams(ctx)
+	p.ReputationWeightedSessions = true
+	keeper.SetParams(ctx, p)
+	assert.Equal(t, int64(75), keeper.SessionWeight(ctx, addr))
+	// a new window starts once the reputation window ended
+	windowEnd := reputation.WindowStart + keeper.ReputationWindow(ctx)*keeper.posKeeper.BlocksPerSession(ctx)
+	reputation, err = keeper.GetReputation(ctx.WithBlockHeight(windowEnd), addr)
+	assert.Nil(t, err)
+	assert.Equal(t, types.Reputation{WindowStart: windowEnd}, reputation)
+	assert.Equal(t, types.MaxReputationScore, keeper.ReputationScore(ctx.WithBlockHeight(windowEnd), addr))
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	nodesexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/pokt-network/posmint/x/auth/util"
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// "SendRestakeTx" - Automatically rolls the earned rewards of the node into its stake (if enabled in the config)
+func (k Keeper) SendRestakeTx(ctx sdk.Ctx, n client.Client, msgType string, fee int64, restakeTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, validator nodesexported.ValidatorI, amount sdk.Int) (*sdk.TxResponse, error)) {
+	config := pc.GetAutoRestake()
+	if !config.Enabled {
+		return
+	}
+	// get the private val key (main) account from the keybase
+	kp, err := k.GetPKFromFile(ctx)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the private key from file for the restake transaction:\n%s", err.Error()))
+		return
+	}
+	// only staked (and not jailed) validators can add to their stake
+	validator := k.posKeeper.Validator(ctx, sdk.Address(kp.PublicKey().Address()))
+	if validator == nil || !validator.IsStaked() || validator.IsJailed() {
+		ctx.Logger().Debug("skipping the auto restake, the node is not staked or is jailed")
+		return
+	}
+	// generate the auto txbuilder and clictx
+	txBuilder, cliCtx, err := newTxBuilderAndCliCtxWithFee(ctx, msgType, fee, n, kp, k)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the restake tx:\n%s", err.Error()))
+		return
+	}
+	account, err := cliCtx.GetAccount(validator.GetAddress())
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the account for the restake tx:\n%s", err.Error()))
+		return
+	}
+	amount, ok := config.RestakeAmount(account.GetCoins().AmountOf(k.posKeeper.StakeDenom(ctx)), sdk.NewInt(fee))
+	if !ok {
+		ctx.Logger().Info(fmt.Sprintf("skipping the auto restake, the rewards %s are below the threshold %s", amount.String(), config.Threshold.String()))
+		return
+	}
+	res, err := restakeTx(cliCtx, txBuilder, validator, validator.GetTokens().Add(amount))
+	pc.RecordNodeKeyUse(msgType, res, err)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured executing the restake transaction: \n%s", err.Error()))
+		return
+	}
+	ctx.Logger().Info(fmt.Sprintf("auto restaked %s of the earned rewards in tx %s", amount.String(), res.TxHash))
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// whether the scheduled transactions are being broadcast (a broadcast may outlast a block)
+var broadcastingScheduledTxs int32
+
+// "BroadcastScheduledTxs" - Broadcasts the transactions scheduled by the operator for the height of the block (or
+// below), the transactions that can't be broadcast are re-attempted at the next block
+func (k Keeper) BroadcastScheduledTxs(ctx sdk.Ctx, n client.Client) {
+	if !atomic.CompareAndSwapInt32(&broadcastingScheduledTxs, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&broadcastingScheduledTxs, 0)
+	for _, tx := range pc.DueScheduledTxs(ctx.BlockHeight()) {
+		bz, err := hex.DecodeString(tx.Tx)
+		if err != nil {
+			k.Logger(ctx).Error("dropping the undecodable scheduled transaction", "hash", tx.Hash, "err", err.Error())
+			pc.RecordScheduledTxBroadcast(tx.Hash)
+			continue
+		}
+		res, err := n.BroadcastTxSync(bz)
+		if err != nil {
+			k.Logger(ctx).Error("unable to broadcast the scheduled transaction", "hash", tx.Hash, "height", tx.Height, "attempts", tx.Attempts+1, "err", err.Error())
+			pc.RecordScheduledTxFailure(tx.Hash, err)
+			continue
+		}
+		pc.RecordScheduledTxBroadcast(tx.Hash)
+		if res.Code != 0 {
+			k.Logger(ctx).Error("the scheduled transaction was rejected", "hash", tx.Hash, "height", tx.Height, "code", fmt.Sprintf("%d", res.Code), "log", res.Log)
+			continue
+		}
+		k.Logger(ctx).Info("broadcast the scheduled transaction", "hash", tx.Hash, "height", tx.Height)
+	}
+}
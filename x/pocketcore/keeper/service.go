@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	sdk "github.com/pokt-network/posmint/types"
@@ -10,6 +11,14 @@ import (
 
 // "HandleRelay" - Handles an api (read/write) request to a non-native (external) blockchain
 func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk.Error) {
+	// a read only replica collects no evidence
+	if pc.IsReplica() {
+		return nil, pc.NewReadOnlyReplicaError(pc.ModuleName)
+	}
+	// reject the relays of the apps and clients blocked by this node
+	if err := pc.ValidateNotBlocked(relay.Proof.Token); err != nil {
+		return nil, err
+	}
 	// get the latest session block height because this relay will correspond with the latest session
 	sessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
 	// get self node (your validator) from the current state
@@ -29,24 +38,48 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 	if er != nil {
 		return nil, sdk.ErrInternal(er.Error())
 	}
+	// ensure the gateway that signed the token (if any) is delegated by the app for this session
+	if err := k.ValidateTokenDelegation(sessionCtx, relay.Proof.Token); err != nil {
+		return nil, err
+	}
 	sessionNodeCount := k.SessionNodeCount(sessionCtx)
 	// ensure the validity of the relay
-	maxPossibleRelays, err := relay.Validate(ctx, k.posKeeper, selfNode, hostedBlockchains, sessionBlockHeight, int(sessionNodeCount), app)
+	maxPossibleRelays, err := relay.Validate(ctx, k.posKeeper, selfNode, hostedBlockchains, sessionBlockHeight, int(sessionNodeCount), app, k.SessionWeight)
 	if err != nil {
-		ctx.Logger().Error(fmt.Errorf("could not validate relay for %v, %v, %v %v, %v", selfNode, hostedBlockchains, sessionBlockHeight, int(k.SessionNodeCount(sessionCtx)), app).Error())
+		ctx.Logger().Error(fmt.Errorf("could not validate relay with payload %s for %v, %v, %v %v, %v", relay.Payload.Redacted(relay.Proof.Blockchain), selfNode, hostedBlockchains, sessionBlockHeight, int(k.SessionNodeCount(sessionCtx)), app).Error())
 		return nil, err
 	}
-	// store the proof before execution, because the proof corresponds to the previous relay
-	relay.Proof.Store(maxPossibleRelays)
+	// quarantine the evidence of the session collected before a reorg of the local chain
+	sessionBlockHash := pc.BlockHash(sessionCtx)
+	orphanedHash, er := pc.SetEvidenceSessionBlockHash(relay.Proof.SessionHeader(), pc.RelayEvidence, sessionBlockHash, maxPossibleRelays)
+	if er != nil {
+		return nil, sdk.ErrInternal(er.Error())
+	}
+	if orphanedHash != "" {
+		k.handleReorg(ctx, relay.Proof.SessionHeader(), orphanedHash, sessionBlockHash)
+	}
+	// meter the relay (every request of a batch) against the allocation of the app and of the client before hitting the chain
+	numOfRelays, err := relay.Payload.NumOfRelays()
+	if err != nil {
+		return nil, err
+	}
+	maxClientRelays := pc.MaxClientRelays(relay.Proof.Token, maxPossibleRelays)
+	if err := k.relayMeter.reserve(relay.Proof.SessionHeader(), relay.Proof.Token.ClientPublicKey, numOfRelays, maxPossibleRelays, maxClientRelays); err != nil {
+		return nil, err
+	}
+	// store the proof before execution, because the proof corresponds to the previous relay, along with the relays metered
+	relay.Proof.StoreRelays(maxPossibleRelays, numOfRelays)
 	// attempt to execute
-	respPayload, err := relay.Execute(hostedBlockchains)
+	respPayload, respHeaders, err := relay.ExecuteWithHeaders(hostedBlockchains)
 	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not execute relay with payload %s: %s", relay.Payload.Redacted(relay.Proof.Blockchain), err.Error()))
 		return nil, err
 	}
 	// generate response object
 	resp := &pc.RelayResponse{
 		Response: respPayload,
 		Proof:    relay.Proof,
+		Headers:  respHeaders,
 	}
 	// get the private key from the private validator file
 	pk, er := k.GetPKFromFile(ctx)
@@ -62,11 +95,27 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 	}
 	// attach the signature in hex to the response
 	resp.Signature = hex.EncodeToString(sig)
+	// notify the subscribers of the served relay
+	pc.PublishEvent(pc.SubscriptionEvent{
+		Type:   pc.SubscriptionEventRelayServed,
+		Height: ctx.BlockHeight(),
+		Attributes: map[string]string{
+			"app_public_key":       relay.Proof.Token.ApplicationPublicKey,
+			"client_public_key":    relay.Proof.Token.ClientPublicKey,
+			"chain":                relay.Proof.Blockchain,
+			"session_block_height": strconv.FormatInt(relay.Proof.SessionBlockHeight, 10),
+			"servicer":             selfNode.GetAddress().String(),
+		},
+	})
 	return resp, nil
 }
 
 // "HandleChallenge" - Handles a client relay response challenge request
 func (k Keeper) HandleChallenge(ctx sdk.Ctx, challenge pc.ChallengeProofInvalidData) (*pc.ChallengeResponse, sdk.Error) {
+	// a read only replica collects no evidence
+	if pc.IsReplica() {
+		return nil, pc.NewReadOnlyReplicaError(pc.ModuleName)
+	}
 	// get self node (your validator) from the current state
 	selfNode, err := k.GetSelfNode(ctx)
 	if err != nil {
@@ -94,7 +143,7 @@ func (k Keeper) HandleChallenge(ctx sdk.Ctx, challenge pc.ChallengeProofInvalidD
 	// if not found generate the session
 	if !found {
 		var err sdk.Error
-		session, err = pc.NewSession(sessionCtx, ctx, k.posKeeper, header, pc.BlockHash(sessionCtx), int(k.SessionNodeCount(sessionCtx)))
+		session, err = pc.NewSession(sessionCtx, ctx, k.posKeeper, header, pc.BlockHash(sessionCtx), int(k.SessionNodeCount(sessionCtx)), k.SessionWeight)
 		if err != nil {
 			return nil, err
 		}
@@ -106,6 +155,14 @@ func (k Keeper) HandleChallenge(ctx sdk.Ctx, challenge pc.ChallengeProofInvalidD
 	if err != nil {
 		return nil, err
 	}
+	// quarantine the challenges of the session collected before a reorg of the local chain
+	orphanedHash, er := pc.SetEvidenceSessionBlockHash(challenge.SessionHeader(), pc.ChallengeEvidence, pc.BlockHash(sessionCtx), app.GetMaxRelays())
+	if er != nil {
+		return nil, sdk.ErrInternal(er.Error())
+	}
+	if orphanedHash != "" {
+		k.handleReorg(ctx, challenge.SessionHeader(), orphanedHash, pc.BlockHash(sessionCtx))
+	}
 	// store the challenge in memory
 	challenge.Store(app.GetMaxRelays())
 	return &pc.ChallengeResponse{Response: fmt.Sprintf("successfully stored challenge proof for %s", challenge.MinorityResponse.Proof.ServicerPubKey)}, nil
@@ -87,4 +87,22 @@ func TestKeeper_HandleRelay(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.NotEmpty(t, resp)
 	assert.Equal(t, resp.Response, "bar")
+	// relays of a blocked client are rejected
+	assert.Nil(t, types.UpdateBlocklist(types.BlocklistUpdate{BlockClients: []string{clientPubKey}}))
+	defer func() { _ = types.UpdateBlocklist(types.BlocklistUpdate{UnblockClients: []string{clientPubKey}}) }()
+	_, err = keeper.HandleRelay(mockCtx, validRelay)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeBlockedClientError), err.Code())
+}
+
+func TestKeeper_HandleRelayReplica(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	types.InitReplica(true)
+	defer types.InitReplica(false)
+	_, err := keeper.HandleRelay(ctx, types.Relay{})
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeReadOnlyReplicaError), err.Code())
+	_, err = keeper.HandleChallenge(ctx, types.ChallengeProofInvalidData{})
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeReadOnlyReplicaError), err.Code())
 }
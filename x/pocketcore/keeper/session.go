@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	sdk "github.com/pokt-network/posmint/types"
 )
@@ -16,45 +18,68 @@ func (k Keeper) HandleDispatch(ctx sdk.Ctx, header types.SessionHeader) (*types.
 	if err != nil {
 		return nil, err
 	}
-	// get the session context
-	sessionCtx, er := ctx.PrevCtx(latestSessionBlockHeight)
-	if er != nil {
-		return nil, sdk.ErrInternal(er.Error())
+	// check the sessions dispatched for the latest session block first (no need to load the session context)
+	if session, found := k.dispatchCache.get(header); found {
+		return &types.DispatchResponse{Session: session, BlockHeight: ctx.BlockHeight()}, nil
 	}
-	// check cache
+	// check the session storage
 	session, found := types.GetSession(header)
 	// if not found generate the session
 	if !found {
+		// get the session context
+		sessionCtx, er := ctx.PrevCtx(latestSessionBlockHeight)
+		if er != nil {
+			return nil, sdk.ErrInternal(er.Error())
+		}
 		var err sdk.Error
-		session, err = types.NewSession(sessionCtx, ctx, k.posKeeper, header, types.BlockHash(sessionCtx), int(k.SessionNodeCount(sessionCtx)))
+		session, err = types.NewSession(sessionCtx, ctx, k.posKeeper, header, types.BlockHash(sessionCtx), int(k.SessionNodeCount(sessionCtx)), k.SessionWeight)
 		if err != nil {
 			return nil, err
 		}
 		// add to cache
 		types.SetSession(session)
 	}
+	k.dispatchCache.set(session)
+	return &types.DispatchResponse{Session: session, BlockHeight: ctx.BlockHeight()}, nil
+}
+
+// "GetSession" - Returns the session of the app for the chain at the block height of the context, generated as it is
+// dispatched (without needing to be one of the session nodes)
+func (k Keeper) GetSession(ctx sdk.Ctx, appPubKey, chain string) (*types.DispatchResponse, sdk.Error) {
+	header := types.SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              chain,
+		SessionBlockHeight: k.GetLatestSessionBlockHeight(ctx),
+	}
+	// validate the header
+	if err := header.ValidateHeader(); err != nil {
+		return nil, err
+	}
+	// check the session storage
+	session, found := types.GetSession(header)
+	if !found {
+		// get the session context
+		sessionCtx, er := ctx.PrevCtx(header.SessionBlockHeight)
+		if er != nil {
+			return nil, sdk.ErrInternal(er.Error())
+		}
+		var err sdk.Error
+		session, err = types.NewSession(sessionCtx, ctx, k.posKeeper, header, types.BlockHash(sessionCtx), int(k.SessionNodeCount(sessionCtx)), k.SessionWeight)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &types.DispatchResponse{Session: session, BlockHeight: ctx.BlockHeight()}, nil
 }
 
 // "IsSessionBlock" - Returns true if current block, is a session block (beginning of a session)
 func (k Keeper) IsSessionBlock(ctx sdk.Ctx) bool {
-	return ctx.BlockHeight()%k.posKeeper.BlocksPerSession(ctx) == 1
+	return k.posKeeper.IsSessionBlock(ctx)
 }
 
-// "GetLatestSessionBlockHeight" - Returns the latest session block height (first block of the session, (see blocksPerSession))
+// "GetLatestSessionBlockHeight" - Returns the latest session block height (first block of the session, (see blocksPerSession / sessionDuration))
 func (k Keeper) GetLatestSessionBlockHeight(ctx sdk.Ctx) (sessionBlockHeight int64) {
-	// get the latest block height
-	blockHeight := ctx.BlockHeight()
-	// get the blocks per session
-	blocksPerSession := k.posKeeper.BlocksPerSession(ctx)
-	// if block height / blocks per session remainder is zero, just subtract blocks per session and add 1
-	if blockHeight%blocksPerSession == 0 {
-		sessionBlockHeight = blockHeight - k.posKeeper.BlocksPerSession(ctx) + 1
-	} else {
-		// calculate the latest session block height by diving the current block height by the blocksPerSession
-		sessionBlockHeight = (blockHeight/blocksPerSession)*blocksPerSession + 1
-	}
-	return
+	return k.posKeeper.GetLatestSessionBlockHeight(ctx)
 }
 
 // "IsPocketSupportedBlockchain" - Returns true if network identifier param is supported by pocket
@@ -70,6 +95,60 @@ func (k Keeper) IsPocketSupportedBlockchain(ctx sdk.Ctx, chain string) bool {
 	return false
 }
 
-func (Keeper) ClearSessionCache() {
+func (k Keeper) ClearSessionCache() {
 	types.ClearSessionCache()
+	k.dispatchCache.clear()
+}
+
+// "NotifySessionWebhooks" - Notifies the registered webhooks of the apps whose new sessions (of the session block)
+// include this node
+func (k Keeper) NotifySessionWebhooks(ctx sdk.Ctx) {
+	pks := types.SessionWebhookApps()
+	if len(pks) == 0 {
+		return
+	}
+	kp, err := k.GetPKFromFile(ctx)
+	if err != nil {
+		ctx.Logger().Error(types.NewKeybaseError(types.ModuleName, err).Error())
+		return
+	}
+	self := sdk.Address(kp.PublicKey().Address())
+	// the session block is the latest one
+	sessionCtx, er := ctx.PrevCtx(ctx.BlockHeight())
+	if er != nil {
+		ctx.Logger().Error(er.Error())
+		return
+	}
+	sessionNodeCount := int(k.SessionNodeCount(sessionCtx))
+	for _, pk := range pks {
+		app, found := k.GetAppFromPublicKey(sessionCtx, pk)
+		if !found {
+			continue
+		}
+		for _, chain := range app.GetChains() {
+			header := types.SessionHeader{
+				ApplicationPubKey:  pk,
+				Chain:              chain,
+				SessionBlockHeight: ctx.BlockHeight(),
+			}
+			session, err := types.NewSession(sessionCtx, ctx, k.posKeeper, header, types.BlockHash(sessionCtx), sessionNodeCount, k.SessionWeight)
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("could not generate the session of the app %s for the webhooks: %s", pk, err.Error()))
+				continue
+			}
+			if !session.SessionNodes.ContainsAddress(self) {
+				continue
+			}
+			nodes := make([]string, 0, len(session.SessionNodes))
+			for _, n := range session.SessionNodes {
+				nodes = append(nodes, n.GetAddress().String())
+			}
+			types.NotifySessionWebhooks(types.SessionNotification{
+				Header:      header,
+				Nodes:       nodes,
+				Servicer:    self.String(),
+				BlockHeight: ctx.BlockHeight(),
+			})
+		}
+	}
 }
@@ -40,10 +40,50 @@ func TestKeeper_Dispatch(t *testing.T) {
 	assert.Equal(t, res.Session.SessionHeader.ApplicationPubKey, appPubKey)
 	assert.Equal(t, res.Session.SessionHeader, validHeader)
 	assert.Len(t, res.Session.SessionNodes, 5)
+	// the dispatched session is served without loading the session context again
+	types.DeleteSession(validHeader)
+	cached, err := keeper.HandleDispatch(mockCtx, validHeader)
+	assert.Nil(t, err)
+	assert.Equal(t, res.Session, cached.Session)
+	mockCtx.AssertNumberOfCalls(t, "PrevCtx", 1)
+	// clearing the session cache (the session nodes changed) regenerates the session
+	keeper.ClearSessionCache()
+	_, err = keeper.HandleDispatch(mockCtx, validHeader)
+	assert.Nil(t, err)
+	mockCtx.AssertNumberOfCalls(t, "PrevCtx", 2)
 	_, err = keeper.HandleDispatch(mockCtx, invalidHeader)
 	assert.NotNil(t, err)
 }
 
+func TestKeeper_GetSession(t *testing.T) {
+	ctx, _, _, _, keeper, keys, _ := createTestInput(t, false)
+	appPubKey := getRandomPrivateKey().PublicKey().RawString()
+	ethereum := hex.EncodeToString([]byte{01})
+	header := types.SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              ethereum,
+		SessionBlockHeight: 976,
+	}
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	keeper.ClearSessionCache()
+	res, err := keeper.GetSession(mockCtx, appPubKey, ethereum)
+	assert.Nil(t, err)
+	assert.Equal(t, header, res.Session.SessionHeader)
+	assert.Len(t, res.Session.SessionNodes, 5)
+	// the same session is dispatched
+	dispatched, err := keeper.HandleDispatch(mockCtx, header)
+	assert.Nil(t, err)
+	assert.Equal(t, dispatched.Session, res.Session)
+	_, err = keeper.GetSession(mockCtx, appPubKey, "invalid")
+	assert.NotNil(t, err)
+}
+
 func TestKeeper_IsSessionBlock(t *testing.T) {
 	notSessionContext, _, _, _, keeper, _, _ := createTestInput(t, false)
 	assert.False(t, keeper.IsSessionBlock(notSessionContext.WithBlockHeight(977)))
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// "SetSettlement" - Sets the settlement object for a certain address in the state storage
+func (k Keeper) SetSettlement(ctx sdk.Ctx, address sdk.Address, header pc.SessionHeader, evidenceType pc.EvidenceType, s pc.Settlement) error {
+	// retrieve the store
+	store := ctx.KVStore(k.storeKey)
+	// marshal the settlement object into amino bz
+	bz := k.cdc.MustMarshalBinaryBare(s)
+	// generate the key for the settlement
+	key, err := pc.KeyForSettlement(ctx, address, header, evidenceType)
+	if err != nil {
+		return err
+	}
+	// set kv into store
+	store.Set(key, bz)
+	return nil
+}
+
+// "GetSettlement" - Retrieves the settlement object for a certain address in the state storage
+func (k Keeper) GetSettlement(ctx sdk.Ctx, address sdk.Address, header pc.SessionHeader, evidenceType pc.EvidenceType) (settlement pc.Settlement, found bool) {
+	// retrieve the store
+	store := ctx.KVStore(k.storeKey)
+	// generate the key for the settlement
+	key, err := pc.KeyForSettlement(ctx, address, header, evidenceType)
+	if err != nil {
+		ctx.Logger().Error("There was a problem creating a key for the settlement:\n" + err.Error())
+		return pc.Settlement{}, false
+	}
+	// get the bytes from the store
+	res := store.Get(key)
+	if res == nil {
+		return pc.Settlement{}, false
+	}
+	// unmarshal bytes into amino-json
+	k.cdc.MustUnmarshalBinaryBare(res, &settlement)
+	return settlement, true
+}
+
+// "GetSettledReceipt" - Retrieves the receipt object along with its settlement (if any) for a certain address
+func (k Keeper) GetSettledReceipt(ctx sdk.Ctx, address sdk.Address, header pc.SessionHeader, evidenceType pc.EvidenceType) (receipt pc.SettledReceipt, found bool) {
+	r, found := k.GetReceipt(ctx, address, header, evidenceType)
+	if !found {
+		return pc.SettledReceipt{}, false
+	}
+	receipt = pc.SettledReceipt{
+		SessionHeader:   r.SessionHeader,
+		ServicerAddress: r.ServicerAddress,
+		Total:           r.Total,
+		EvidenceType:    r.EvidenceType,
+	}
+	if s, found := k.GetSettlement(ctx, address, header, evidenceType); found {
+		receipt.Settlement = &s
+	}
+	return receipt, true
+}
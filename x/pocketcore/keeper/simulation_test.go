@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/nodes"
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	authTypes "github.com/pokt-network/posmint/x/auth/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	simulationSeed       = 42
+	simulationBlocks     = 2000
+	simulationShortBlock = 200
+)
+
+// the weights of the simulated operations (relative to each other)
+var simulationWeights = []struct {
+	op     string
+	weight int
+}{
+	{"stake", 10},
+	{"unstake", 3},
+	{"send", 10},
+	{"fee", 5},
+	{"relay", 40},
+	{"claim", 15},
+	{"proof", 15},
+	{"slash", 2},
+}
+
+// the relays serviced (but not claimed yet) by a servicer for a session
+type simulatedSession struct {
+	servicer sdk.Address
+	header   types.SessionHeader
+	relays   int64
+}
+
+type simulation struct {
+	t        *testing.T
+	r        *rand.Rand
+	ctx      sdk.Ctx
+	k        Keeper
+	nk       nodesKeeper.Keeper
+	handler  sdk.Handler
+	accounts []auth.BaseAccount
+	sessions []simulatedSession
+	ops      map[string]int
+}
+
+// runs thousands of blocks of weighted random stake, unstake, send, relay, claim, proof and slash operations,
+// asserting the economic invariants of the nodes and pocketcore modules after every block
+func TestSimulation_Invariants(t *testing.T) {
+	blocks := simulationBlocks
+	if testing.Short() {
+		blocks = simulationShortBlock
+	}
+	ctx, _, _, accs, keeper, _, _ := createTestInput(t, false)
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	// the test accounts are funded without minting, so start from a consistent supply
+	total := sdk.ZeroInt()
+	nk.AccountKeeper.IterateAccounts(ctx, func(acc auth.Account) bool {
+		total = total.Add(acc.GetCoins().AmountOf(nk.StakeDenom(ctx)))
+		return false
+	})
+	nk.AccountKeeper.SetSupply(ctx, authTypes.NewSupply(sdk.NewCoins(sdk.NewCoin(nk.StakeDenom(ctx), total))))
+	s := simulation{
+		t:        t,
+		r:        rand.New(rand.NewSource(simulationSeed)),
+		ctx:      ctx.WithBlockHeight(1).WithBlockTime(time.Unix(0, 0)),
+		k:        keeper,
+		nk:       nk,
+		handler:  nodes.NewHandler(nk),
+		accounts: accs,
+		ops:      make(map[string]int),
+	}
+	s.checkInvariants()
+	for i := 0; i < blocks; i++ {
+		s.block()
+		s.checkInvariants()
+	}
+	t.Logf("simulated %d blocks (seed %d): %v", blocks, simulationSeed, s.ops)
+}
+
+// simulates a single block: begin block, a random number of operations and end block
+func (s *simulation) block() {
+	proposer := s.randomAccount().Address
+	nodesKeeper.BeginBlocker(s.ctx, abci.RequestBeginBlock{Header: abci.Header{ProposerAddress: proposer}}, s.nk)
+	for i, n := 0, s.r.Intn(10); i < n; i++ {
+		op := s.randomOperation()
+		if s.execute(op) {
+			s.ops[op]++
+		}
+	}
+	s.k.DeleteExpiredClaims(s.ctx)
+	nodesKeeper.EndBlocker(s.ctx, s.nk)
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(time.Minute))
+}
+
+func (s *simulation) checkInvariants() {
+	for _, invariant := range []sdk.Invariant{nodesKeeper.AllInvariants(s.nk), AllInvariants(s.k)} {
+		if msg, broken := invariant(s.ctx); broken {
+			s.t.Fatalf("invariant broken at height %d (seed %d): %s", s.ctx.BlockHeight(), simulationSeed, msg)
+		}
+	}
+}
+
+func (s *simulation) randomOperation() string {
+	var total int
+	for _, w := range simulationWeights {
+		total += w.weight
+	}
+	n := s.r.Intn(total)
+	for _, w := range simulationWeights {
+		if n < w.weight {
+			return w.op
+		}
+		n -= w.weight
+	}
+	return simulationWeights[0].op
+}
+
+func (s *simulation) randomAccount() auth.BaseAccount {
+	return s.accounts[s.r.Intn(len(s.accounts))]
+}
+
+// returns a random staked validator (if any)
+func (s *simulation) randomStakedValidator() (nodesTypes.Validator, bool) {
+	var staked []nodesTypes.Validator
+	for _, v := range s.nk.GetAllValidators(s.ctx) {
+		if v.IsStaked() && !v.IsJailed() {
+			staked = append(staked, v)
+		}
+	}
+	if len(staked) == 0 {
+		return nodesTypes.Validator{}, false
+	}
+	return staked[s.r.Intn(len(staked))], true
+}
+
+// returns a random amount in [min, max)
+func (s *simulation) randomAmount(min, max int64) sdk.Int {
+	return sdk.NewInt(min + s.r.Int63n(max-min))
+}
+
+// executes the operation, returns false if it was skipped or rejected
+func (s *simulation) execute(op string) bool {
+	minStake := s.nk.MinimumStake(s.ctx)
+	switch op {
+	case "stake":
+		acc := s.randomAccount()
+		return s.handler(s.ctx, nodesTypes.MsgStake{
+			PublicKey:  acc.PubKey,
+			Chains:     []string{hex.EncodeToString([]byte{01})},
+			Value:      s.randomAmount(minStake, 10*minStake),
+			ServiceURL: "https://www.google.com:443",
+		}).IsOK()
+	case "unstake":
+		v, ok := s.randomStakedValidator()
+		if !ok {
+			return false
+		}
+		return s.handler(s.ctx, nodesTypes.MsgBeginUnstake{Address: v.Address}).IsOK()
+	case "send":
+		return s.handler(s.ctx, nodesTypes.MsgSend{
+			FromAddress: s.randomAccount().Address,
+			ToAddress:   s.randomAccount().Address,
+			Amount:      s.randomAmount(1, minStake),
+		}).IsOK()
+	case "fee":
+		fee := sdk.NewCoins(sdk.NewCoin(s.nk.StakeDenom(s.ctx), s.randomAmount(1, 100000)))
+		return s.nk.AccountKeeper.SendCoinsFromAccountToModule(s.ctx, s.randomAccount().Address, auth.FeeCollectorName, fee) == nil
+	case "relay":
+		v, ok := s.randomStakedValidator()
+		if !ok {
+			return false
+		}
+		header := types.SessionHeader{
+			ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+			Chain:              getTestSupportedBlockchain(),
+			SessionBlockHeight: s.k.GetLatestSessionBlockHeight(s.ctx),
+		}
+		relays := 1 + s.r.Int63n(100)
+		for i, session := range s.sessions {
+			if session.servicer.Equals(v.Address) && session.header == header {
+				s.sessions[i].relays += relays
+				return true
+			}
+		}
+		s.sessions = append(s.sessions, simulatedSession{servicer: v.Address, header: header, relays: relays})
+		return true
+	case "claim":
+		// only sessions that are over can be claimed
+		for i, session := range s.sessions {
+			if session.header.SessionBlockHeight == s.k.GetLatestSessionBlockHeight(s.ctx) {
+				continue
+			}
+			s.sessions = append(s.sessions[:i], s.sessions[i+1:]...)
+			if _, found := s.k.GetReceipt(s.ctx, session.servicer, session.header, types.RelayEvidence); found {
+				return false
+			}
+			err := s.k.SetClaim(s.ctx, types.MsgClaim{
+				SessionHeader:    session.header,
+				MerkleRoot:       types.HashSum{Hash: types.Hash([]byte(session.servicer.String())), Sum: uint64(session.relays)},
+				TotalProofs:      session.relays,
+				FromAddress:      session.servicer,
+				EvidenceType:     types.RelayEvidence,
+				ExpirationHeight: s.ctx.BlockHeight() + s.k.ClaimExpiration(s.ctx)*s.k.BlocksPerSession(s.ctx),
+			})
+			return err == nil
+		}
+		return false
+	case "proof":
+		// the merkle verification is covered by the proof tests, apply the outcome of a valid proof like the handler does
+		for _, claim := range s.k.GetAllClaims(s.ctx) {
+			if !s.k.ClaimIsMature(s.ctx, claim.SessionBlockHeight, claim.Chain) {
+				continue
+			}
+			settlement, err := s.k.ExecuteProof(s.ctx, types.MsgProof{Leaf: types.RelayProof{}, EvidenceType: claim.EvidenceType}, claim)
+			if err != nil {
+				return false
+			}
+			receipt := types.Receipt{
+				SessionHeader:   claim.SessionHeader,
+				ServicerAddress: claim.FromAddress.String(),
+				Total:           claim.TotalProofs,
+				EvidenceType:    claim.EvidenceType,
+			}
+			if s.k.SetReceipt(s.ctx, claim.FromAddress, receipt) != nil {
+				return false
+			}
+			return s.k.SetSettlement(s.ctx, claim.FromAddress, claim.SessionHeader, claim.EvidenceType, settlement) == nil
+		}
+		return false
+	case "slash":
+		// a replay attack burns the stake of the servicer and removes the claim
+		claims := s.k.GetAllClaims(s.ctx)
+		if len(claims) == 0 {
+			return false
+		}
+		claim := claims[s.r.Intn(len(claims))]
+		s.k.HandleReplayAttack(s.ctx, claim.FromAddress, sdk.NewInt(claim.TotalProofs))
+		return s.k.DeleteClaim(s.ctx, claim.FromAddress, claim.SessionHeader, claim.EvidenceType) == nil
+	}
+	return false
+}
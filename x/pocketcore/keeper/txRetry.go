@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"sync/atomic"
+	"time"
+
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/pokt-network/posmint/x/auth/util"
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// whether the failed transactions are being retried (a retry may outlast a block)
+var retryingTxs int32
+
+// "RetryFailedTxs" - Re-attempts the queued claim and proof transactions past their backoff, between the session blocks
+func (k Keeper) RetryFailedTxs(ctx sdk.Ctx, n client.Client, claimTx func(pk crypto.PrivateKey, cliCtx util.CLIContext, txBuilder auth.TxBuilder, header pc.SessionHeader, totalProofs int64, root pc.HashSum, evidenceType pc.EvidenceType) (*sdk.TxResponse, error), proofTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, branches [2]pc.MerkleProof, leafNode, cousin pc.Proof, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
+	if !atomic.CompareAndSwapInt32(&retryingTxs, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&retryingTxs, 0)
+	kp, err := k.GetPKFromFile(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("unable to retrieve the private key from file to retry the failed transactions", "err", err.Error())
+		return
+	}
+	addr := sdk.Address(kp.PublicKey().Address())
+	for _, retry := range pc.DueTxRetries(time.Now()) {
+		k.Logger(ctx).Info("retrying the failed transaction", append(evidenceKeyvals(retry.SessionHeader), "tx", retry.Tx, "attempts", retry.Attempts, "last_error", retry.LastError)...)
+		switch retry.Tx {
+		case pc.MsgClaimName:
+			evidence, err := pc.GetEvidence(retry.SessionHeader, retry.EvidenceType, sdk.ZeroInt())
+			if err != nil || evidence.NumOfProofs == 0 {
+				// nothing left to claim
+				_ = pc.DeleteEvidence(retry.SessionHeader, retry.EvidenceType)
+				continue
+			}
+			k.claimEvidence(ctx, n, kp, evidence, claimTx)
+		case pc.MsgProofName:
+			claim, found := k.GetClaim(ctx, addr, retry.SessionHeader, retry.EvidenceType)
+			if !found {
+				// the claim expired (or was proven and its receipt deleted), so the evidence can't prove it anymore
+				_ = pc.DeleteEvidence(retry.SessionHeader, retry.EvidenceType)
+				continue
+			}
+			k.proveClaim(ctx, n, kp, addr, claim, proofTx)
+		}
+	}
+}
@@ -3,8 +3,10 @@ package pocketcore
 import (
 	"encoding/json"
 	"math/rand"
+	"strconv"
 	"time"
 
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/keeper"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/codec"
@@ -62,8 +64,10 @@ func NewAppModule(keeper keeper.Keeper) AppModule {
 	}
 }
 
-// "RegisterInvariants" - Unused crisis checking
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+// "RegisterInvariants" - Registers the claim / receipt consistency invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // "Route" - returns the route of the module
 func (am AppModule) Route() string {
@@ -88,19 +92,46 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 // "BeginBlock" - Functionality that is called at the beginning of (every) block
 func (am AppModule) BeginBlock(ctx sdk.Ctx, req abci.RequestBeginBlock) {
 	if am.keeper.IsSessionBlock(ctx) && ctx.BlockHeight() != 1 {
+		// notify the webhooks of the new sessions (the sessions are generated with the state of the block, the
+		// notifications are delivered asynchronously)
+		if !types.IsReplica() {
+			types.WithRecovery("session-webhooks", func() { am.keeper.NotifySessionWebhooks(ctx) }, "height", strconv.FormatInt(ctx.BlockHeight(), 10))
+		}
 		go func() {
+			height := strconv.FormatInt(ctx.BlockHeight(), 10)
+			// a read only replica only clears its session cache
+			if types.IsReplica() {
+				types.WithRecovery("clear-session-cache", types.ClearSessionCache, "height", height)
+				return
+			}
 			// use this sleep timer to bypass the beginBlock lock over transactions
 			time.Sleep(time.Duration(rand.Intn(5000)) * time.Millisecond)
-			// auto send the proofs
-			am.keeper.SendClaimTx(ctx, am.keeper.TmNode, ClaimTx)
+			// auto send the proofs (a failure must not take down the node or skip the proofs)
+			types.WithRecovery("claim-tx", func() { am.keeper.SendClaimTx(ctx, am.keeper.TmNode, ClaimTx) }, "height", height)
 			// auto claim the proofs
-			am.keeper.SendProofTx(ctx, am.keeper.TmNode, ProofTx)
+			types.WithRecovery("proof-tx", func() { am.keeper.SendProofTx(ctx, am.keeper.TmNode, ProofTx) }, "height", height)
+			// auto restake the earned rewards (if enabled)
+			types.WithRecovery("restake-tx", func() {
+				am.keeper.SendRestakeTx(ctx, am.keeper.TmNode, nodesTypes.MsgStakeName, nodesTypes.NodeFeeMap[nodesTypes.MsgStakeName], RestakeTx)
+			}, "height", height)
 			// clear session cache and db
-			types.ClearSessionCache()
+			types.WithRecovery("clear-session-cache", types.ClearSessionCache, "height", height)
 		}()
+	} else if !types.IsReplica() && len(types.DueTxRetries(time.Now())) > 0 {
+		// re-attempt the failed claim and proof transactions past their backoff
+		go types.WithRecovery("tx-retry", func() {
+			am.keeper.RetryFailedTxs(ctx, am.keeper.TmNode, ClaimTx, ProofTx)
+		}, "height", strconv.FormatInt(ctx.BlockHeight(), 10))
+	}
+	if !types.IsReplica() && len(types.DueScheduledTxs(ctx.BlockHeight())) > 0 {
+		// broadcast the transactions scheduled by the operator for this height
+		go types.WithRecovery("scheduled-tx", func() {
+			am.keeper.BroadcastScheduledTxs(ctx, am.keeper.TmNode)
+		}, "height", strconv.FormatInt(ctx.BlockHeight(), 10))
 	}
 	go func() {
 		// flush the cache periodically
+		defer types.Recover("flush-cache", "height", strconv.FormatInt(ctx.BlockHeight(), 10))
 		types.FlushCache()
 	}()
 	// delete the expired claims
@@ -1,6 +1,8 @@
 package pocketcore
 
 import (
+	nodesexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
@@ -39,3 +41,18 @@ func ProofTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, branches [2]types
 	}
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, msg)
 }
+
+// "RestakeTx" - A transaction that edits the stake of the node to the amount given (rolling the earned rewards into the stake)
+func RestakeTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, validator nodesexported.ValidatorI, amount sdk.Int) (*sdk.TxResponse, error) {
+	msg := nodesTypes.MsgStake{
+		PublicKey:  validator.GetPublicKey(),
+		Chains:     validator.GetChains(),
+		Value:      amount,
+		ServiceURL: validator.GetServiceURL(),
+	}
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, msg)
+}
@@ -15,10 +15,12 @@ var (
 
 // "AAT" - Application authentication token, used to authenticate clients for applications
 type AAT struct {
-	Version              string `json:"version"`        // what version of the token is used?
-	ApplicationPublicKey string `json:"app_pub_key"`    // the app pub key in hex
-	ClientPublicKey      string `json:"client_pub_key"` // the client pub key in hex
-	ApplicationSignature string `json:"signature"`      // the app signature in hex
+	Version              string `json:"version"`                      // what version of the token is used?
+	ApplicationPublicKey string `json:"app_pub_key"`                  // the app pub key in hex
+	ClientPublicKey      string `json:"client_pub_key"`               // the client pub key in hex
+	ApplicationSignature string `json:"signature"`                    // the app signature in hex
+	GatewayPublicKey     string `json:"gateway_pub_key,omitempty"`    // the pub key of the gateway (delegated by the app) that signed the token in hex
+	ClientRelayLimit     int64  `json:"client_relay_limit,omitempty"` // the max percent of the relays of the app session a servicer serves to the client, zero for no limit
 }
 
 // "VersionIsIncluded" - Returns if the version is included
@@ -62,6 +64,8 @@ func (a AAT) Bytes() []byte {
 		ApplicationPublicKey: a.ApplicationPublicKey,
 		ClientPublicKey:      a.ClientPublicKey,
 		Version:              a.Version,
+		GatewayPublicKey:     a.GatewayPublicKey,
+		ClientRelayLimit:     a.ClientRelayLimit,
 	})
 	if err != nil {
 		log.Fatal(fmt.Sprintf("an error occured hashing the aat:\n%v", err))
@@ -109,15 +113,38 @@ func (a AAT) ValidateMessage() error {
 	if err := PubKeyVerification(a.ClientPublicKey); err != nil {
 		return err
 	}
+	// check if the gateway public key (if any) is valid
+	if a.IsGatewaySigned() {
+		if err := PubKeyVerification(a.GatewayPublicKey); err != nil {
+			return err
+		}
+	}
+	// check the client relay limit (if any) is a percent
+	if a.ClientRelayLimit < 0 || a.ClientRelayLimit > 100 {
+		return InvalidClientRelayLimitError
+	}
 	return nil
 }
 
+// "IsGatewaySigned" - Returns if the AAT is signed by a gateway on behalf of the application
+func (a AAT) IsGatewaySigned() bool {
+	return a.GatewayPublicKey != ""
+}
+
+// "SignerPublicKey" - Returns the public key that signs the AAT (the gateway key if delegated, else the app key)
+func (a AAT) SignerPublicKey() string {
+	if a.IsGatewaySigned() {
+		return a.GatewayPublicKey
+	}
+	return a.ApplicationPublicKey
+}
+
 // "ValidateSignature" - Confirms the signature field of the AAT
 func (a AAT) ValidateSignature() error {
 	// check for valid signature
 	messageHash := a.HashString()
 	// verifies the signature with the message of the AAT
-	if err := SignatureVerification(a.ApplicationPublicKey, messageHash, a.ApplicationSignature); err != nil {
+	if err := SignatureVerification(a.SignerPublicKey(), messageHash, a.ApplicationSignature); err != nil {
 		return InvalidTokenSignatureErorr
 	}
 	return nil
@@ -271,3 +271,38 @@ func TestAAT_Validate(t *testing.T) {
 	AAT.ApplicationSignature = hex.EncodeToString(applicationSignature)
 	assert.Nil(t, AAT.Validate())
 }
+
+func TestAAT_ValidateGatewaySignature(t *testing.T) {
+	appPrivKey := GetRandomPrivateKey()
+	clientPrivKey := GetRandomPrivateKey()
+	gatewayPrivKey := GetRandomPrivateKey()
+	var AAT = AAT{
+		Version:              "0.0.1",
+		ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+		ClientPublicKey:      clientPrivKey.PublicKey().RawString(),
+		GatewayPublicKey:     gatewayPrivKey.PublicKey().RawString(),
+		ApplicationSignature: "",
+	}
+	assert.True(t, AAT.IsGatewaySigned())
+	assert.Equal(t, AAT.GatewayPublicKey, AAT.SignerPublicKey())
+	// signed by the app (invalid, the gateway is the signer)
+	appSignature, err := appPrivKey.Sign(AAT.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	AAT.ApplicationSignature = hex.EncodeToString(appSignature)
+	assert.NotNil(t, AAT.ValidateSignature())
+	// signed by the gateway
+	gatewaySignature, err := gatewayPrivKey.Sign(AAT.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	AAT.ApplicationSignature = hex.EncodeToString(gatewaySignature)
+	assert.Nil(t, AAT.Validate())
+	// the gateway key is covered by the signature
+	AAT.GatewayPublicKey = clientPrivKey.PublicKey().RawString()
+	assert.NotNil(t, AAT.ValidateSignature())
+	// invalid gateway key
+	AAT.GatewayPublicKey = "abcd"
+	assert.NotNil(t, AAT.ValidateMessage())
+}
@@ -0,0 +1,12 @@
+package types
+
+import "encoding/json"
+
+// "ACLChange" - A change of the acl or of the dao owner by a param change, recorded in the state
+type ACLChange struct {
+	ParamKey string          `json:"param_key"` // gov/acl or gov/daoOwner
+	Value    json.RawMessage `json:"param_value"`
+	Sender   string          `json:"sender"` // the owner of the param at the time
+	Height   int64           `json:"height"`
+	TxHash   string          `json:"tx_hash"`
+}
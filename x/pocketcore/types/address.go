@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/tendermint/tendermint/libs/bech32"
+)
+
+const (
+	Bech32PrefixAddress = "pokt"   // the human readable part of the bech32 addresses
+	AddressFormatHex    = "hex"    // e.g. 8e1d09ec3e8b2ee1b8e4e3cde52f1b4c1b2e6a70
+	AddressFormatBech32 = "bech32" // e.g. pokt13cwsnmp73vhwrw8yu0x72tcmfsdju6nsruusux
+)
+
+// "AddressToBech32" - Encodes the address in the bech32 format
+func AddressToBech32(addr sdk.Address) (string, error) {
+	return bech32.ConvertAndEncode(Bech32PrefixAddress, addr.Bytes())
+}
+
+// "AddressFromBech32" - Decodes a bech32 address, verifying its prefix and length
+func AddressFromBech32(address string) (sdk.Address, error) {
+	hrp, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != Bech32PrefixAddress {
+		return nil, fmt.Errorf("invalid bech32 address prefix %s, expected %s", hrp, Bech32PrefixAddress)
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// "ParseAddress" - Decodes an address in either format (hex or bech32)
+func ParseAddress(address string) (sdk.Address, error) {
+	if IsBech32Address(address) {
+		return AddressFromBech32(address)
+	}
+	return sdk.AddressFromHex(address)
+}
+
+// "IsBech32Address" - Returns whether the address is in the bech32 format (by its prefix)
+func IsBech32Address(address string) bool {
+	return strings.HasPrefix(strings.ToLower(address), Bech32PrefixAddress+"1")
+}
+
+// "FormatAddress" - Encodes the address in the format (hex or bech32)
+func FormatAddress(addr sdk.Address, format string) (string, error) {
+	switch format {
+	case AddressFormatHex, "":
+		return addr.String(), nil
+	case AddressFormatBech32:
+		return AddressToBech32(addr)
+	default:
+		return "", fmt.Errorf("invalid address format %s, expected %s or %s", format, AddressFormatHex, AddressFormatBech32)
+	}
+}
+
+// "ConvertAddress" - Converts an address in either format to the other one
+func ConvertAddress(address string) (string, error) {
+	addr, err := ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	if IsBech32Address(address) {
+		return FormatAddress(addr, AddressFormatHex)
+	}
+	return FormatAddress(addr, AddressFormatBech32)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/bech32"
+)
+
+const (
+	testHexAddress    = "8e1d09ec3e8b2ee1b8e4e3cde52f1b4c1b2e6a70"
+	testBech32Address = "pokt13cwsnmp73vhwrw8yu0x72tcmfsdju6nsruusux"
+)
+
+func TestParseAddress(t *testing.T) {
+	expected, err := sdk.AddressFromHex(testHexAddress)
+	assert.Nil(t, err)
+	wrongPrefix, err := bech32.ConvertAndEncode("cosmos", expected.Bytes())
+	assert.Nil(t, err)
+	shortBech32, err := bech32.ConvertAndEncode(Bech32PrefixAddress, expected.Bytes()[:10])
+	assert.Nil(t, err)
+	tests := []struct {
+		name     string
+		address  string
+		hasError bool
+	}{
+		{"hex", testHexAddress, false},
+		{"upper case hex", "8E1D09EC3E8B2EE1B8E4E3CDE52F1B4C1B2E6A70", false},
+		{"bech32", testBech32Address, false},
+		{"bad checksum", testBech32Address[:len(testBech32Address)-1] + "q", true},
+		{"wrong prefix", wrongPrefix, true},
+		{"wrong length", shortBech32, true},
+		{"not an address", "pocket", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := ParseAddress(tt.address)
+			if tt.hasError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.True(t, expected.Equals(addr))
+		})
+	}
+}
+
+func TestFormatAddress(t *testing.T) {
+	addr, err := sdk.AddressFromHex(testHexAddress)
+	assert.Nil(t, err)
+	res, err := FormatAddress(addr, AddressFormatHex)
+	assert.Nil(t, err)
+	assert.Equal(t, testHexAddress, res)
+	res, err = FormatAddress(addr, AddressFormatBech32)
+	assert.Nil(t, err)
+	assert.Equal(t, testBech32Address, res)
+	_, err = FormatAddress(addr, "base64")
+	assert.NotNil(t, err)
+}
+
+func TestConvertAddress(t *testing.T) {
+	res, err := ConvertAddress(testHexAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, testBech32Address, res)
+	res, err = ConvertAddress(testBech32Address)
+	assert.Nil(t, err)
+	assert.Equal(t, testHexAddress, res)
+}
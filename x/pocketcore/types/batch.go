@@ -0,0 +1,125 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+const (
+	// the default max number of requests of a batched json rpc relay
+	DefaultMaxRelayBatchSize = 100
+	// the json rpc error code of a request of the batch that could not be executed
+	jsonRPCInternalErrorCode = -32603
+)
+
+var (
+	globalMaxRelayBatchSize = DefaultMaxRelayBatchSize
+)
+
+// "InitMaxRelayBatchSize" - Sets the max number of requests of a batched json rpc relay
+func InitMaxRelayBatchSize(max int) {
+	globalMaxRelayBatchSize = max
+}
+
+// "IsBatch" - Returns whether the payload is a batch of json rpc requests (a json array)
+func (p Payload) IsBatch() bool {
+	return p.Path == "" && strings.HasPrefix(strings.TrimSpace(p.Data), "[")
+}
+
+// "Batch" - Returns the json rpc requests of the batch, validating each of them is a json object
+func (p Payload) Batch() ([]json.RawMessage, sdk.Error) {
+	var requests []json.RawMessage
+	if err := json.Unmarshal([]byte(p.Data), &requests); err != nil {
+		return nil, NewInvalidRelayBatchError(ModuleName, err.Error())
+	}
+	if len(requests) == 0 {
+		return nil, NewInvalidRelayBatchError(ModuleName, "the batch is empty")
+	}
+	if len(requests) > globalMaxRelayBatchSize {
+		return nil, NewInvalidRelayBatchError(ModuleName, fmt.Sprintf("%d requests, the max batch size is %d", len(requests), globalMaxRelayBatchSize))
+	}
+	for i, request := range requests {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(request, &obj); err != nil {
+			return nil, NewInvalidRelayBatchError(ModuleName, fmt.Sprintf("the request %d is not a json object", i))
+		}
+	}
+	return requests, nil
+}
+
+// "NumOfRelays" - Returns the number of relays metered for the payload: the number of requests of a batch, 1 otherwise
+func (p Payload) NumOfRelays() (int64, sdk.Error) {
+	if !p.IsBatch() {
+		return 1, nil
+	}
+	requests, err := p.Batch()
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(requests)), nil
+}
+
+// forwards every request of the batch to the chain (concurrently) and returns the array of their responses, in
+// order. A request that could not be executed gets a json rpc error response, the notifications get none
+func executeBatch(requests []json.RawMessage, execute func(request string) (string, error)) string {
+	responses := make([]string, len(requests))
+	var wg sync.WaitGroup
+	for i, request := range requests {
+		wg.Add(1)
+		go func(i int, request json.RawMessage) {
+			defer wg.Done()
+			res, err := execute(string(request))
+			if err != nil {
+				res = newJSONRPCErrorResponse(request, err)
+			}
+			responses[i] = strings.TrimSpace(res)
+		}(i, request)
+	}
+	wg.Wait()
+	var b bytes.Buffer
+	b.WriteString("[")
+	for _, res := range responses {
+		if res == "" {
+			continue
+		}
+		if b.Len() > 1 {
+			b.WriteString(",")
+		}
+		b.WriteString(res)
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+// the json rpc error response to a request of a batch
+type jsonRPCErrorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   jsonRPCError    `json:"error"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// returns the json rpc error response to the request (empty for a notification)
+func newJSONRPCErrorResponse(request json.RawMessage, err error) string {
+	var req struct {
+		ID json.RawMessage `json:"id"`
+	}
+	_ = json.Unmarshal(request, &req)
+	if len(req.ID) == 0 {
+		return ""
+	}
+	bz, er := json.Marshal(jsonRPCErrorResponse{JSONRPC: "2.0", ID: req.ID, Error: jsonRPCError{Code: jsonRPCInternalErrorCode, Message: err.Error()}})
+	if er != nil {
+		return ""
+	}
+	return string(bz)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestPayload_Batch(t *testing.T) {
+	single := Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`}
+	assert.False(t, single.IsBatch())
+	n, err := single.NumOfRelays()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), n)
+	batch := Payload{Data: ` [{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1},{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":2}]`}
+	assert.True(t, batch.IsBatch())
+	assert.Nil(t, batch.Validate())
+	n, err = batch.NumOfRelays()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), n)
+	// a rest path is never a batch
+	assert.False(t, Payload{Data: "[1]", Path: "/v1/foo"}.IsBatch())
+	// invalid batches
+	for _, data := range []string{`[]`, `[1,2]`, `[{"id":1}`} {
+		err := Payload{Data: data}.Validate()
+		assert.NotNil(t, err, data)
+		assert.Equal(t, CodeInvalidRelayBatchError, int(err.Code()))
+	}
+	InitMaxRelayBatchSize(1)
+	defer InitMaxRelayBatchSize(DefaultMaxRelayBatchSize)
+	assert.NotNil(t, batch.Validate())
+}
+
+func TestRelay_ExecuteBatch(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	requests := make([]string, 3)
+	for i := range requests {
+		requests[i] = fmt.Sprintf(`{"id":%d,"jsonrpc":"2.0","method":"eth_blockNumber","params":[]}`, i)
+	}
+	// the second request fails, the notification gets no response
+	notification := `{"jsonrpc":"2.0","method":"eth_subscribe","params":[]}`
+	relay := Relay{
+		Payload: Payload{Data: "[" + strings.Join(append(requests, notification), ",") + "]", Method: "POST"},
+		Proof:   RelayProof{Blockchain: ethereum},
+	}
+	defer gock.Off()
+	gock.New("https://server.com").Post("/relay").BodyString(requests[0]).Reply(200).BodyString(`{"id":0,"jsonrpc":"2.0","result":"0x1"}`)
+	gock.New("https://server.com").Post("/relay").BodyString(requests[2]).Reply(200).BodyString(`{"id":2,"jsonrpc":"2.0","result":"0x3"}`)
+	gock.New("https://server.com").Post("/relay").BodyString(notification).Reply(200).BodyString("")
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:  ethereum,
+			URL: "https://server.com/relay",
+		}},
+	}
+	response, err := relay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(response, `[{"id":0,"jsonrpc":"2.0","result":"0x1"},{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":`), response)
+	assert.True(t, strings.HasSuffix(response, `},{"id":2,"jsonrpc":"2.0","result":"0x3"}]`), response)
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+var (
+	// the app and client public keys whose relays are rejected by this node
+	globalBlocklist = blocklist{apps: make(map[string]struct{}), clients: make(map[string]struct{})}
+)
+
+// "Blocklist" - The app and client public keys whose relays are rejected by this node (local, not part of the protocol)
+type Blocklist struct {
+	Apps    []string `json:"apps"`
+	Clients []string `json:"clients"`
+}
+
+// "BlocklistUpdate" - The public keys added to/removed from the local blocklist
+type BlocklistUpdate struct {
+	BlockApps      []string `json:"block_apps,omitempty"`
+	UnblockApps    []string `json:"unblock_apps,omitempty"`
+	BlockClients   []string `json:"block_clients,omitempty"`
+	UnblockClients []string `json:"unblock_clients,omitempty"`
+}
+
+type blocklist struct {
+	l       sync.RWMutex
+	apps    map[string]struct{}
+	clients map[string]struct{}
+}
+
+// "InitBlocklist" - Initializes the blocklist with the public keys of the config
+func InitBlocklist(apps, clients []string) sdk.Error {
+	return UpdateBlocklist(BlocklistUpdate{BlockApps: apps, BlockClients: clients})
+}
+
+// "UpdateBlocklist" - Adds/removes the public keys of the update to/from the blocklist, nothing is updated if any is invalid
+func UpdateBlocklist(update BlocklistUpdate) sdk.Error {
+	for _, pks := range [][]string{update.BlockApps, update.UnblockApps, update.BlockClients, update.UnblockClients} {
+		for _, pk := range pks {
+			if err := PubKeyVerification(strings.ToLower(pk)); err != nil {
+				return err
+			}
+		}
+	}
+	globalBlocklist.l.Lock()
+	defer globalBlocklist.l.Unlock()
+	for _, pk := range update.BlockApps {
+		globalBlocklist.apps[strings.ToLower(pk)] = struct{}{}
+	}
+	for _, pk := range update.UnblockApps {
+		delete(globalBlocklist.apps, strings.ToLower(pk))
+	}
+	for _, pk := range update.BlockClients {
+		globalBlocklist.clients[strings.ToLower(pk)] = struct{}{}
+	}
+	for _, pk := range update.UnblockClients {
+		delete(globalBlocklist.clients, strings.ToLower(pk))
+	}
+	return nil
+}
+
+// "GetBlocklist" - Returns the sorted public keys of the blocklist
+func GetBlocklist() Blocklist {
+	globalBlocklist.l.RLock()
+	defer globalBlocklist.l.RUnlock()
+	bl := Blocklist{Apps: []string{}, Clients: []string{}}
+	for pk := range globalBlocklist.apps {
+		bl.Apps = append(bl.Apps, pk)
+	}
+	for pk := range globalBlocklist.clients {
+		bl.Clients = append(bl.Clients, pk)
+	}
+	sort.Strings(bl.Apps)
+	sort.Strings(bl.Clients)
+	return bl
+}
+
+// "ValidateNotBlocked" - Returns an error if the app or the client of the token is in the blocklist
+func ValidateNotBlocked(token AAT) sdk.Error {
+	globalBlocklist.l.RLock()
+	defer globalBlocklist.l.RUnlock()
+	if _, found := globalBlocklist.apps[strings.ToLower(token.ApplicationPublicKey)]; found {
+		return NewBlockedApplicationError(ModuleName)
+	}
+	if _, found := globalBlocklist.clients[strings.ToLower(token.ClientPublicKey)]; found {
+		return NewBlockedClientError(ModuleName)
+	}
+	return nil
+}
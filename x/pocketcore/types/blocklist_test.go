@@ -0,0 +1,33 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlocklist(t *testing.T) {
+	appPubKey := getRandomPubKey().RawString()
+	clientPubKey := getRandomPubKey().RawString()
+	token := AAT{ApplicationPublicKey: appPubKey, ClientPublicKey: clientPubKey}
+	assert.Nil(t, ValidateNotBlocked(token))
+	// invalid public keys update nothing
+	assert.NotNil(t, InitBlocklist([]string{appPubKey}, []string{"invalid"}))
+	assert.Empty(t, GetBlocklist().Apps)
+	// block the app (case insensitive)
+	assert.Nil(t, InitBlocklist([]string{strings.ToUpper(appPubKey)}, nil))
+	assert.Equal(t, []string{appPubKey}, GetBlocklist().Apps)
+	err := ValidateNotBlocked(token)
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeBlockedApplicationError, int(err.Code()))
+	// block the client
+	assert.Nil(t, UpdateBlocklist(BlocklistUpdate{UnblockApps: []string{appPubKey}, BlockClients: []string{clientPubKey}}))
+	assert.Equal(t, Blocklist{Apps: []string{}, Clients: []string{clientPubKey}}, GetBlocklist())
+	err = ValidateNotBlocked(token)
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeBlockedClientError, int(err.Code()))
+	// unblock the client
+	assert.Nil(t, UpdateBlocklist(BlocklistUpdate{UnblockClients: []string{clientPubKey}}))
+	assert.Nil(t, ValidateNotBlocked(token))
+}
@@ -13,8 +13,8 @@ import (
 var (
 	// cache for session objects
 	globalSessionCache *CacheStorage
-	// cache for evidence objects
-	globalEvidenceCache *CacheStorage
+	// cache for evidence objects (sharded by session)
+	globalEvidenceCache *ShardedCacheStorage
 	// sync.once to perform initialization
 	cacheOnce sync.Once
 )
@@ -249,6 +249,14 @@ func DeleteEvidence(header SessionHeader, evidenceType EvidenceType) error {
 	}
 	// delete from cache
 	globalEvidenceCache.Delete(key)
+	// its proofs from the proof store
+	if globalProofStore != nil {
+		globalProofStore.Delete(key)
+	}
+	// and its prebuilt tree
+	deleteMerkleTree(key)
+	// and its failed transactions
+	deleteTxRetries(header, evidenceType)
 	return nil
 }
 
@@ -257,6 +265,10 @@ func ClearEvidence() {
 	if globalEvidenceCache != nil {
 		globalEvidenceCache.Clear()
 	}
+	if globalProofStore != nil {
+		globalProofStore.Clear()
+	}
+	clearMerkleTrees()
 }
 
 // "EvidenceIt" - An evidence iterator instance of the globalEvidenceCache
@@ -285,6 +297,22 @@ func EvidenceIterator() EvidenceIt {
 	}
 }
 
+// "GetEvidenceSummaries" - Returns a summary of every piece of evidence held by this node (cache and db)
+func GetEvidenceSummaries() (summaries []EvidenceSummary, err error) {
+	// flush the cache so the iterator includes the in-memory evidence
+	err = globalEvidenceCache.FlushToDB()
+	if err != nil {
+		return nil, err
+	}
+	iter := EvidenceIterator()
+	defer iter.Close()
+	summaries = make([]EvidenceSummary, 0)
+	for ; iter.Valid(); iter.Next() {
+		summaries = append(summaries, iter.Value().Summary())
+	}
+	return
+}
+
 // "GetProof" - Returns the Proof object from a specific piece of evidence at a certain index
 func GetProof(header SessionHeader, evidenceType EvidenceType, index int64) Proof {
 	// retrieve the evidence
@@ -302,14 +330,41 @@ func GetProof(header SessionHeader, evidenceType EvidenceType, index int64) Proo
 
 // "SetProof" - Sets a proof object in the evidence, using the header and evidence type
 func SetProof(header SessionHeader, evidenceType EvidenceType, p Proof, max sdk.Int) {
+	SetProofOfRelays(header, evidenceType, p, 1, max)
+}
+
+// "SetProofOfRelays" - Sets the proof of the relays (the requests of a batch) in the evidence, using the header and
+// evidence type
+func SetProofOfRelays(header SessionHeader, evidenceType EvidenceType, p Proof, relays int64, max sdk.Int) {
+	// serialize the writes of the evidence shard (the writes to other sessions' shards don't wait)
+	key, err := KeyForEvidence(header, evidenceType)
+	if err != nil {
+		log.Fatalf("could not set proof object: %s", err.Error())
+	}
+	lock := globalEvidenceCache.WriteLock(key)
+	lock.Lock()
+	defer lock.Unlock()
 	// retireve the evidence
 	evidence, err := GetEvidence(header, evidenceType, max)
 	// if not found generate the evidence object
 	if err != nil {
 		log.Fatalf("could not set proof object: %s", err.Error())
 	}
+	// write ahead, so the proof survives a crash before the evidence is flushed
+	if globalProofStore != nil {
+		appended, err := globalProofStore.Append(header, evidenceType, max, evidence.NumOfProofs, relays, p)
+		if err != nil {
+			log.Fatalf("could not set proof object: %s", err.Error())
+		}
+		// already a leaf of the evidence (e.g. served again after a restart or concurrently), never counted twice
+		if !appended {
+			return
+		}
+	}
+	// replicate to the standby nodes (if any)
+	replicateProof(proofRecord{SessionHeader: header, EvidenceType: evidenceType, MaxRelays: max, Index: evidence.NumOfProofs, Relays: relays, Proof: p})
 	// add proof
-	evidence.AddProof(p)
+	evidence.AddProofOfRelays(p, relays)
 	// set evidence back
 	SetEvidence(evidence)
 }
@@ -328,3 +383,9 @@ func GetTotalProofs(h SessionHeader, et EvidenceType, maxPossibleRelays sdk.Int)
 	// return number of proofs
 	return evidence, evidence.NumOfProofs
 }
+
+// "GetTotalRelays" - Returns the relays metered for the proofs of the evidence (the requests of the batches)
+func GetTotalRelays(h SessionHeader, et EvidenceType, maxPossibleRelays sdk.Int) int64 {
+	evidence, _ := GetTotalProofs(h, et, maxPossibleRelays)
+	return evidence.TotalRelays()
+}
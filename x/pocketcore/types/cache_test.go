@@ -135,7 +135,7 @@ func TestAllEvidence_GetTotalProofs(t *testing.T) {
 		Signature: "",
 	}
 	proof2 := RelayProof{
-		Entropy:            0,
+		Entropy:            1,
 		SessionBlockHeight: 1,
 		ServicerPubKey:     servicerPubKey,
 		RequestHash:        header.HashString(), // fake
@@ -155,6 +155,48 @@ func TestAllEvidence_GetTotalProofs(t *testing.T) {
 	assert.Equal(t, totalRelays, int64(2))
 }
 
+func TestAllEvidence_GetEvidenceSummaries(t *testing.T) {
+	appPubKey := getRandomPubKey().RawString()
+	servicerPubKey := getRandomPubKey().RawString()
+	clientPubKey := getRandomPubKey().RawString()
+	ethereum := hex.EncodeToString([]byte{0001})
+	header := SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              ethereum,
+		SessionBlockHeight: 1,
+	}
+	proof := RelayProof{
+		Entropy:            0,
+		SessionBlockHeight: 1,
+		ServicerPubKey:     servicerPubKey,
+		RequestHash:        header.HashString(), // fake
+		Blockchain:         ethereum,
+		Token: AAT{
+			Version:              "0.0.1",
+			ApplicationPublicKey: appPubKey,
+			ClientPublicKey:      clientPubKey,
+			ApplicationSignature: "",
+		},
+		Signature: "",
+	}
+	proof2 := proof
+	proof2.Entropy = 1
+	SetProof(header, RelayEvidence, proof, sdk.NewInt(100000))
+	SetProof(header, RelayEvidence, proof2, sdk.NewInt(100000))
+	summaries, err := GetEvidenceSummaries()
+	assert.Nil(t, err)
+	var found bool
+	for _, s := range summaries {
+		if s.SessionHeader == header && s.EvidenceType == RelayEvidence {
+			found = true
+			assert.Equal(t, int64(2), s.NumOfProofs)
+			assert.False(t, s.FirstProofAt.IsZero())
+			assert.False(t, s.LastProofAt.Before(s.FirstProofAt))
+		}
+	}
+	assert.True(t, found)
+}
+
 func TestSetGetSession(t *testing.T) {
 	session := NewTestSession(t, hex.EncodeToString(Hash([]byte("foo"))))
 	session2 := NewTestSession(t, hex.EncodeToString(Hash([]byte("bar"))))
@@ -0,0 +1,202 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// the default interval of the health checks of the hosted chains
+	DefaultChainHealthInterval = time.Minute
+	// the timeout of a health check of a chain without a timeout
+	chainHealthTimeout = 10 * time.Second
+	// the latest health of the hosted chains
+	globalChainsHealth = &chainsHealth{m: make(map[string]ChainHealth)}
+)
+
+// "ChainHealth" - The result of the latest health check of a hosted chain
+type ChainHealth struct {
+	ID          string    `json:"id"`               // the network identifier of the chain
+	Hosted      bool      `json:"hosted"`           // in the chains of the node
+	Staked      bool      `json:"staked"`           // the node is staked for the chain
+	Healthy     bool      `json:"healthy"`          // the chain answered the health check
+	Height      int64     `json:"height,omitempty"` // the height of the chain, if answered by the health check
+	Latency     int64     `json:"latency"`          // the milliseconds of the health check
+	Error       string    `json:"error,omitempty"`  // why the chain is unhealthy
+	CheckedAt   time.Time `json:"checked_at"`       // the time of the latest health check
+	LastHealthy time.Time `json:"last_healthy"`     // the time of the latest successful health check
+}
+
+type chainsHealth struct {
+	l sync.Mutex
+	m map[string]ChainHealth
+}
+
+// "ChainsHealth" - Returns the latest health of the hosted chains (and of the staked chains not hosted), by id
+func ChainsHealth() []ChainHealth {
+	globalChainsHealth.l.Lock()
+	defer globalChainsHealth.l.Unlock()
+	res := make([]ChainHealth, 0, len(globalChainsHealth.m))
+	for _, h := range globalChainsHealth.m {
+		res = append(res, h)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+// "CheckChainsHealth" - Probes every hosted chain, records the results and returns the ones of the hosted chains and of
+// the chains the node is staked for (a staked chain not hosted is unhealthy)
+func CheckChainsHealth(hb *HostedBlockchains, staked []string) []ChainHealth {
+	stakedFor := make(map[string]bool, len(staked))
+	for _, id := range staked {
+		stakedFor[id] = true
+	}
+	results := make(map[string]ChainHealth)
+	for id := range hb.M {
+		h := hb.ProbeChain(id)
+		h.Staked = stakedFor[id]
+		results[id] = h
+	}
+	now := time.Now()
+	for _, id := range staked {
+		if _, found := results[id]; !found {
+			results[id] = ChainHealth{ID: id, Staked: true, Error: "the chain is not hosted by the node", CheckedAt: now}
+		}
+	}
+	globalChainsHealth.l.Lock()
+	for id, h := range results {
+		if !h.Healthy {
+			// keep when the chain was last seen healthy
+			h.LastHealthy = globalChainsHealth.m[id].LastHealthy
+			results[id] = h
+		}
+	}
+	globalChainsHealth.m = results
+	globalChainsHealth.l.Unlock()
+	return ChainsHealth()
+}
+
+// "ProbeChain" - Probes the health of the hosted chain with its health check request (e.g. a height query), or by
+// pinging it: any http answer but a server error for an http chain, a connection for a gRPC chain
+func (c *HostedBlockchains) ProbeChain(id string) (h ChainHealth) {
+	h = ChainHealth{ID: id, Hosted: true, CheckedAt: time.Now()}
+	start := time.Now()
+	height, err := c.probe(id)
+	h.Latency = int64(time.Since(start) / time.Millisecond)
+	if err != nil {
+		h.Error = RedactError(id, err).Error()
+		return
+	}
+	h.Healthy, h.Height, h.LastHealthy = true, height, h.CheckedAt
+	return
+}
+
+func (c *HostedBlockchains) probe(id string) (int64, error) {
+	chain, err := c.GetChain(id)
+	if err != nil {
+		return 0, err
+	}
+	timeout := durationOrDefault(chain.HTTP.Timeout, chainHealthTimeout)
+	if chain.IsGRPC() {
+		return c.probeGRPC(chain, timeout)
+	}
+	client, err := c.GetClient(id)
+	if err != nil {
+		return 0, err
+	}
+	check := chain.HealthCheck
+	if check == nil {
+		check = &Payload{Method: http.MethodGet}
+	}
+	req, er := newChainRequest(check.Data, check.URL(chain.URL), globalUserAgent, chain.GetCredentials(), check.HTTPMethod(), check.Headers)
+	if er != nil {
+		return 0, er
+	}
+	// the timeout of the chain client applies, if any
+	probeClient := *client
+	if probeClient.Timeout == 0 {
+		probeClient.Timeout = timeout
+	}
+	resp, er := probeClient.Do(req)
+	if er != nil {
+		return 0, er
+	}
+	defer resp.Body.Close()
+	body, er := ioutil.ReadAll(resp.Body)
+	if er != nil {
+		return 0, er
+	}
+	if resp.StatusCode >= http.StatusInternalServerError || (chain.HealthCheck != nil && resp.StatusCode >= http.StatusBadRequest) {
+		return 0, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return chainHeight(body), nil
+}
+
+func (c *HostedBlockchains) probeGRPC(chain HostedBlockchain, timeout time.Duration) (int64, error) {
+	if chain.HealthCheck == nil {
+		target, _, err := chain.grpcTarget()
+		if err != nil {
+			return 0, err
+		}
+		conn, er := net.DialTimeout("tcp", target, timeout)
+		if er != nil {
+			return 0, er
+		}
+		return 0, conn.Close()
+	}
+	conn, err := c.GetGRPCConn(chain.ID)
+	if err != nil {
+		return 0, err
+	}
+	method, err := chain.HealthCheck.GRPCMethod()
+	if err != nil {
+		return 0, err
+	}
+	req, err := chain.HealthCheck.GRPCRequest()
+	if err != nil {
+		return 0, err
+	}
+	res, _, er := executeGRPCRequest(conn, method, req, chain.HealthCheck.Headers, chain.GetCredentials(), int64(timeout/time.Millisecond))
+	if er != nil {
+		return 0, er
+	}
+	var response GRPCResponse
+	if er := json.Unmarshal([]byte(res), &response); er != nil {
+		return 0, er
+	}
+	if response.Code != 0 {
+		return 0, fmt.Errorf("gRPC status %d: %s", response.Code, response.Message)
+	}
+	return 0, nil
+}
+
+// "chainHeight" - Returns the height answered by a health check, 0 if none: the result of a json rpc height query (a
+// hex quantity or a number, e.g. eth_blockNumber) or a top level height
+func chainHeight(body []byte) int64 {
+	var res struct {
+		Result json.RawMessage `json:"result"`
+		Height json.RawMessage `json:"height"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return 0
+	}
+	for _, raw := range []json.RawMessage{res.Result, res.Height} {
+		v := strings.Trim(string(raw), `"`)
+		if strings.HasPrefix(v, "0x") {
+			if height, err := strconv.ParseInt(v[2:], 16, 64); err == nil {
+				return height
+			}
+		} else if height, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return height
+		}
+	}
+	return 0
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainHeight(t *testing.T) {
+	assert.Equal(t, int64(16), chainHeight([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`)))
+	assert.Equal(t, int64(42), chainHeight([]byte(`{"result":42}`)))
+	assert.Equal(t, int64(7), chainHeight([]byte(`{"height":"7"}`)))
+	assert.Equal(t, int64(0), chainHeight([]byte(`{"result":{"foo":"bar"}}`)))
+	assert.Equal(t, int64(0), chainHeight([]byte(`not json`)))
+}
+
+func TestCheckChainsHealth(t *testing.T) {
+	var body string
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bz, _ := ioutil.ReadAll(r.Body)
+		body = string(bz)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x2a"}`))
+	}))
+	defer healthy.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	// a ping accepts the client errors (e.g. a GET to a json rpc endpoint)
+	pinged := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer pinged.Close()
+	ethChain, failingChain, pingedChain, notHosted := hex.EncodeToString([]byte{01}), hex.EncodeToString([]byte{02}), hex.EncodeToString([]byte{03}), hex.EncodeToString([]byte{04})
+	check := `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{
+		ethChain:     {ID: ethChain, URL: healthy.URL, HealthCheck: &Payload{Data: check}},
+		failingChain: {ID: failingChain, URL: failing.URL},
+		pingedChain:  {ID: pingedChain, URL: pinged.URL},
+	}}
+	assert.Nil(t, hb.Validate())
+	results := CheckChainsHealth(&hb, []string{ethChain, failingChain, notHosted})
+	assert.Len(t, results, 4)
+	assert.Equal(t, results, ChainsHealth())
+	assert.Equal(t, check, body)
+	eth := results[0]
+	assert.True(t, eth.Healthy && eth.Hosted && eth.Staked)
+	assert.Equal(t, int64(42), eth.Height)
+	assert.Equal(t, eth.CheckedAt, eth.LastHealthy)
+	fail := results[1]
+	assert.False(t, fail.Healthy)
+	assert.True(t, fail.Staked)
+	assert.Contains(t, fail.Error, "502")
+	assert.True(t, fail.LastHealthy.IsZero())
+	assert.True(t, results[2].Healthy)
+	assert.False(t, results[2].Staked)
+	assert.Equal(t, notHosted, results[3].ID)
+	assert.False(t, results[3].Hosted || results[3].Healthy)
+	// the last healthy time is kept when the chain goes down
+	healthy.Close()
+	results = CheckChainsHealth(&hb, nil)
+	assert.Len(t, results, 3)
+	assert.False(t, results[0].Healthy)
+	assert.Equal(t, eth.LastHealthy, results[0].LastHealthy)
+}
+
+func TestCheckChainsHealth_GRPC(t *testing.T) {
+	url, stop := newTestGRPCChain(t)
+	defer stop()
+	chain := hex.EncodeToString([]byte{02})
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{chain: {ID: chain, URL: url}}}
+	assert.True(t, hb.ProbeChain(chain).Healthy)
+	hb.M[chain] = HostedBlockchain{ID: chain, URL: url, HealthCheck: &Payload{Path: "/test.Chain/Fail"}, Credentials: ChainCredentials{BearerToken: "foo"}}
+	h := hb.ProbeChain(chain)
+	assert.False(t, h.Healthy)
+	assert.Contains(t, h.Error, "not found")
+	stop()
+	hb.M[chain] = HostedBlockchain{ID: chain, URL: url}
+	assert.False(t, hb.ProbeChain(chain).Healthy)
+}
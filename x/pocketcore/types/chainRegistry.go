@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+// "ChainMetadata" - The human readable metadata of a network identifier
+type ChainMetadata struct {
+	ID          string `json:"id"`                    // the network identifier (hex)
+	Name        string `json:"name"`                  // the name of the blockchain, empty if not in the registry
+	Description string `json:"description,omitempty"` // a description of the blockchain (network, api, ...)
+}
+
+// "ChainRegistry" - The governance managed metadata of the network identifiers
+type ChainRegistry []ChainMetadata
+
+// "Validate" - Validates the network identifiers and names of the registry
+func (cr ChainRegistry) Validate() error {
+	ids := make(map[string]struct{}, len(cr))
+	for _, chain := range cr {
+		if err := NetworkIdentifierVerification(chain.ID); err != nil {
+			return err
+		}
+		if chain.Name == "" {
+			return fmt.Errorf("the chain %s in the registry has no name", chain.ID)
+		}
+		if _, found := ids[chain.ID]; found {
+			return fmt.Errorf("the chain %s is in the registry more than once", chain.ID)
+		}
+		ids[chain.ID] = struct{}{}
+	}
+	return nil
+}
+
+// "Metadata" - Returns the metadata of the network identifier, only the id if it is not in the registry
+func (cr ChainRegistry) Metadata(id string) ChainMetadata {
+	for _, chain := range cr {
+		if chain.ID == id {
+			return chain
+		}
+	}
+	return ChainMetadata{ID: id}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainRegistry_Validate(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	registry := ChainRegistry{{ID: ethereum, Name: "Ethereum", Description: "mainnet"}, {ID: bitcoin, Name: "Bitcoin"}}
+	assert.Nil(t, registry.Validate())
+	assert.Nil(t, ChainRegistry{}.Validate())
+	// invalid network identifier
+	assert.NotNil(t, ChainRegistry{{ID: "invalid", Name: "Invalid"}}.Validate())
+	// no name
+	assert.NotNil(t, ChainRegistry{{ID: ethereum}}.Validate())
+	// duplicate
+	assert.NotNil(t, append(registry, ChainMetadata{ID: ethereum, Name: "Ethereum"}).Validate())
+}
+
+func TestChainRegistry_Metadata(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	registry := ChainRegistry{{ID: ethereum, Name: "Ethereum", Description: "mainnet"}}
+	assert.Equal(t, ChainMetadata{ID: ethereum, Name: "Ethereum", Description: "mainnet"}, registry.Metadata(ethereum))
+	assert.Equal(t, ChainMetadata{ID: bitcoin}, registry.Metadata(bitcoin))
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// "ChallengeResult" - The outcome of a challenge built from the responses of the session nodes
+type ChallengeResult struct {
+	Servicer  string                    `json:"servicer_public_key"` // the servicer of the minority response
+	Challenge ChallengeProofInvalidData `json:"challenge"`
+	Response  string                    `json:"response,omitempty"`
+	Error     string                    `json:"error,omitempty"`
+}
+
+// "BuildChallenges" - Compares the responses of the session nodes to the same requests and builds a challenge of every
+// response disagreeing with the majority. The responses are compared by request (hash, app, chain and session) with
+// the json responses sorted, one response per servicer is kept, and a request is only challenged when more than half
+// of its servicers (and at least two) agree
+func BuildChallenges(responses []RelayResponse, reporter sdk.Address) (challenges []ChallengeProofInvalidData) {
+	type request struct {
+		hash, app, chain string
+		height           int64
+	}
+	groups := make(map[request][]RelayResponse)
+	servicers := make(map[request]map[string]struct{})
+	requests := make([]request, 0)
+	for _, r := range responses {
+		req := request{r.Proof.RequestHash, r.Proof.Token.ApplicationPublicKey, r.Proof.Blockchain, r.Proof.SessionBlockHeight}
+		if _, found := groups[req]; !found {
+			servicers[req] = make(map[string]struct{})
+			requests = append(requests, req)
+		}
+		if _, found := servicers[req][r.Proof.ServicerPubKey]; found {
+			continue
+		}
+		servicers[req][r.Proof.ServicerPubKey] = struct{}{}
+		groups[req] = append(groups[req], r)
+	}
+	for _, req := range requests {
+		group := groups[req]
+		if len(group) < 3 {
+			continue
+		}
+		// count the (sorted) responses
+		counts := make(map[string]int)
+		for _, r := range group {
+			counts[sortJSONResponse(r.Response)]++
+		}
+		majority, count := "", 0
+		for resp, c := range counts {
+			if c > count {
+				majority, count = resp, c
+			}
+		}
+		if count < 2 || count*2 <= len(group) {
+			continue
+		}
+		majorityResponses := make([]RelayResponse, 0, 2)
+		minorityResponses := make([]RelayResponse, 0)
+		for _, r := range group {
+			if sortJSONResponse(r.Response) == majority {
+				if len(majorityResponses) < 2 {
+					majorityResponses = append(majorityResponses, r)
+				}
+				continue
+			}
+			minorityResponses = append(minorityResponses, r)
+		}
+		sort.Slice(minorityResponses, func(i, j int) bool {
+			return minorityResponses[i].Proof.ServicerPubKey < minorityResponses[j].Proof.ServicerPubKey
+		})
+		for _, minority := range minorityResponses {
+			challenges = append(challenges, ChallengeProofInvalidData{
+				MajorityResponses: [2]RelayResponse{majorityResponses[0], majorityResponses[1]},
+				MinorityResponse:  minority,
+				ReporterAddress:   reporter,
+			})
+		}
+	}
+	return
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildChallenges(t *testing.T) {
+	valid, _, _, _, _, _, _ := NewValidChallengeProof(t)
+	maj1, maj2, min := valid.MajorityResponses[0], valid.MajorityResponses[1], valid.MinorityResponse
+	chains := []string{maj1.Proof.Blockchain}
+	// the responses of the same servicer are only counted once
+	challenges := BuildChallenges([]RelayResponse{min, maj1, maj1, maj2}, valid.ReporterAddress)
+	assert.Len(t, challenges, 1)
+	assert.Equal(t, min, challenges[0].MinorityResponse)
+	assert.Equal(t, valid.ReporterAddress, challenges[0].ReporterAddress)
+	assert.Nil(t, challenges[0].ValidateBasic())
+	assert.Nil(t, challenges[0].Validate(chains, 5, maj1.Proof.SessionBlockHeight))
+	// no majority
+	assert.Empty(t, BuildChallenges([]RelayResponse{maj1, min}, valid.ReporterAddress))
+	assert.Empty(t, BuildChallenges([]RelayResponse{maj1, maj1, min}, valid.ReporterAddress))
+	// the responses to other requests are not compared
+	other := min
+	other.Proof.RequestHash = maj1.Proof.Blockchain
+	assert.Empty(t, BuildChallenges([]RelayResponse{maj1, maj2, other}, valid.ReporterAddress))
+	// the agreeing responses are not challenged
+	agreeing := min
+	agreeing.Response = maj1.Response
+	assert.Empty(t, BuildChallenges([]RelayResponse{maj1, maj2, agreeing}, valid.ReporterAddress))
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	// the local claim priority of the chains, the evidence of the higher priority chains is claimed first
+	globalClaimPriority = claimPriority{m: make(map[string]int64)}
+)
+
+// "ChainClaimWindow" - The claim submission window (in sessions) of a network identifier
+type ChainClaimWindow struct {
+	Chain  string `json:"chain"`
+	Window int64  `json:"window"`
+}
+
+// "ChainClaimWindows" - The governance managed claim submission windows overriding the default one per chain
+type ChainClaimWindows []ChainClaimWindow
+
+// "Validate" - Validates the network identifiers and windows (between 2 sessions and the claim expiration)
+func (cw ChainClaimWindows) Validate(claimExpiration int64) error {
+	chains := make(map[string]struct{}, len(cw))
+	for _, w := range cw {
+		if err := NetworkIdentifierVerification(w.Chain); err != nil {
+			return err
+		}
+		if w.Window < 2 {
+			return fmt.Errorf("the claim submission window of the chain %s must be at least 2 sessions", w.Chain)
+		}
+		if w.Window > claimExpiration {
+			return errors.New("unverified Proof expiration is far too short, must be greater than the claim submission window of the chain " + w.Chain)
+		}
+		if _, found := chains[w.Chain]; found {
+			return fmt.Errorf("the chain %s has more than one claim submission window", w.Chain)
+		}
+		chains[w.Chain] = struct{}{}
+	}
+	return nil
+}
+
+// "Window" - Returns the claim submission window of the chain, the default window if not overridden
+func (cw ChainClaimWindows) Window(chain string, defaultWindow int64) int64 {
+	for _, w := range cw {
+		if w.Chain == chain {
+			return w.Window
+		}
+	}
+	return defaultWindow
+}
+
+type claimPriority struct {
+	l sync.RWMutex
+	m map[string]int64
+}
+
+// "InitClaimPriority" - Initializes the claim priority of the chains (0 if not configured)
+func InitClaimPriority(priority map[string]int64) {
+	globalClaimPriority.l.Lock()
+	defer globalClaimPriority.l.Unlock()
+	globalClaimPriority.m = make(map[string]int64, len(priority))
+	for chain, p := range priority {
+		globalClaimPriority.m[chain] = p
+	}
+}
+
+// "ClaimPriority" - Returns the claim priority of the chain
+func ClaimPriority(chain string) int64 {
+	globalClaimPriority.l.RLock()
+	defer globalClaimPriority.l.RUnlock()
+	return globalClaimPriority.m[chain]
+}
+
+// "SortByClaimPriority" - Sorts the evidence by the claim priority of their chains and then by their number of proofs
+// (the most valuable first), so the constrained balance or block space goes to the high value claims
+func SortByClaimPriority(evidence []Evidence) {
+	sort.SliceStable(evidence, func(i, j int) bool {
+		pi, pj := ClaimPriority(evidence[i].SessionHeader.Chain), ClaimPriority(evidence[j].SessionHeader.Chain)
+		if pi != pj {
+			return pi > pj
+		}
+		return evidence[i].NumOfProofs > evidence[j].NumOfProofs
+	})
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainClaimWindows_Validate(t *testing.T) {
+	assert.Nil(t, ChainClaimWindows{{Chain: "0001", Window: 3}, {Chain: "0002", Window: 100}}.Validate(100))
+	// invalid network identifier
+	assert.NotNil(t, ChainClaimWindows{{Chain: "invalid", Window: 3}}.Validate(100))
+	// below 2 sessions
+	assert.NotNil(t, ChainClaimWindows{{Chain: "0001", Window: 1}}.Validate(100))
+	// above the claim expiration
+	assert.NotNil(t, ChainClaimWindows{{Chain: "0001", Window: 101}}.Validate(100))
+	// duplicated chain
+	assert.NotNil(t, ChainClaimWindows{{Chain: "0001", Window: 3}, {Chain: "0001", Window: 4}}.Validate(100))
+}
+
+func TestSortByClaimPriority(t *testing.T) {
+	InitClaimPriority(map[string]int64{"0002": 10})
+	defer InitClaimPriority(nil)
+	evidence := []Evidence{
+		{SessionHeader: SessionHeader{Chain: "0001"}, NumOfProofs: 10},
+		{SessionHeader: SessionHeader{Chain: "0001"}, NumOfProofs: 20},
+		{SessionHeader: SessionHeader{Chain: "0002"}, NumOfProofs: 5},
+	}
+	SortByClaimPriority(evidence)
+	// the high priority chain first, then the evidence with the most proofs
+	assert.Equal(t, "0002", evidence[0].Chain)
+	assert.Equal(t, int64(20), evidence[1].NumOfProofs)
+	assert.Equal(t, int64(10), evidence[2].NumOfProofs)
+}
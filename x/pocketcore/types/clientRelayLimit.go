@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+var (
+	// the max percent of the relays of an app session this node serves to a single client, zero for no limit
+	globalClientRelayLimit int64
+)
+
+// "InitClientRelayLimit" - Sets the max percent of the relays of an app session served to a single client
+func InitClientRelayLimit(percent int64) error {
+	if percent < 0 || percent > 100 {
+		return InvalidClientRelayLimitError
+	}
+	globalClientRelayLimit = percent
+	return nil
+}
+
+// "MaxClientRelays" - Returns the max relays of the app session this node serves to the client of the token, the
+// stricter of the limit of this node and the limit signed in the token, zero for no limit
+func MaxClientRelays(token AAT, maxRelays sdk.Int) int64 {
+	percent := globalClientRelayLimit
+	if token.ClientRelayLimit != 0 && (percent == 0 || token.ClientRelayLimit < percent) {
+		percent = token.ClientRelayLimit
+	}
+	if percent == 0 {
+		return 0
+	}
+	max := maxRelays.MulRaw(percent).QuoRaw(100).Int64()
+	// a client is always served at least a relay
+	if max < 1 {
+		max = 1
+	}
+	return max
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxClientRelays(t *testing.T) {
+	defer func() { _ = InitClientRelayLimit(0) }()
+	maxRelays := sdk.NewInt(1000)
+	token := AAT{}
+	// no limit by default
+	assert.Equal(t, int64(0), MaxClientRelays(token, maxRelays))
+	// only percents are accepted
+	assert.NotNil(t, InitClientRelayLimit(101))
+	assert.NotNil(t, InitClientRelayLimit(-1))
+	// the limit of the node
+	assert.Nil(t, InitClientRelayLimit(20))
+	assert.Equal(t, int64(200), MaxClientRelays(token, maxRelays))
+	// the stricter limit of the token
+	token.ClientRelayLimit = 5
+	assert.Equal(t, int64(50), MaxClientRelays(token, maxRelays))
+	token.ClientRelayLimit = 50
+	assert.Equal(t, int64(200), MaxClientRelays(token, maxRelays))
+	// the limit of the token only
+	assert.Nil(t, InitClientRelayLimit(0))
+	assert.Equal(t, int64(500), MaxClientRelays(token, maxRelays))
+	// a client is served at least a relay
+	assert.Equal(t, int64(1), MaxClientRelays(token, sdk.NewInt(1)))
+	// the limit of the token must be a percent
+	assert.Equal(t, InvalidClientRelayLimitError, AAT{
+		Version:              "0.0.1",
+		ApplicationPublicKey: getRandomPubKey().RawString(),
+		ClientPublicKey:      getRandomPubKey().RawString(),
+		ClientRelayLimit:     101,
+	}.ValidateMessage())
+}
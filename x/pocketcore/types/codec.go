@@ -19,6 +19,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgClaim{}, "pocketcore/claim", nil)
 	cdc.RegisterConcrete(MsgProof{}, "pocketcore/proof", nil)
 	cdc.RegisterConcrete(Receipt{}, "pocketcore/receipt", nil)
+	cdc.RegisterConcrete(SettledReceipt{}, "pocketcore/settled_receipt", nil)
+	cdc.RegisterConcrete(Challenge{}, "pocketcore/challenge", nil)
 	cdc.RegisterConcrete(Relay{}, "pocketcore/relay", nil)
 	cdc.RegisterConcrete(Session{}, "pocketcore/session", nil)
 	cdc.RegisterConcrete(RelayResponse{}, "pocketcore/relay_response", nil)
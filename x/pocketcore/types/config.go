@@ -12,10 +12,19 @@ var (
 // "InitConfig" - Initializes the cache for sessions and evidence
 func InitConfig(userAgent, evidenceDir, sessionDir string, sessionDBType, evidenceDBType db.DBBackendType, maxEvidenceEntries, maxSessionEntries int, evidenceDBName, sessionDBName string) {
 	cacheOnce.Do(func() {
-		globalEvidenceCache = new(CacheStorage)
+		globalEvidenceCache = new(ShardedCacheStorage)
 		globalSessionCache = new(CacheStorage)
-		globalEvidenceCache.Init(evidenceDir, evidenceDBName, evidenceDBType, maxEvidenceEntries)
+		globalEvidenceCache.Init(evidenceDir, evidenceDBName, evidenceDBType, maxEvidenceEntries, DefaultEvidenceCacheShards)
 		globalSessionCache.Init(sessionDir, sessionDBName, sessionDBType, maxSessionEntries)
+		globalProofStore = new(ProofStore)
+		globalProofStore.Init(evidenceDir, evidenceDBName+ProofStoreDBSuffix, evidenceDBType)
+		// recover the proofs lost by a crash before the evidence was flushed
+		recovered, err := globalProofStore.Recover()
+		if err != nil {
+			fmt.Printf("unable to recover the evidence from the proof store: %s\n", err.Error())
+		} else if recovered > 0 {
+			fmt.Printf("recovered %d proofs from the proof store\n", recovered)
+		}
 	})
 	globalUserAgent = userAgent
 }
@@ -3,6 +3,7 @@ package types
 import (
 	sha "crypto"
 	"encoding/hex"
+	"fmt"
 	"strconv"
 
 	"github.com/pokt-network/posmint/crypto"
@@ -12,6 +13,29 @@ import (
 	_ "golang.org/x/crypto/sha3"
 )
 
+const (
+	HashAlgorithmSHA3_256    = "sha3_256"
+	HashAlgorithmBlake2b_256 = "blake2b_256"
+)
+
+// "HashFn" - A cryptographic hash function
+type HashFn func(b []byte) []byte
+
+// the hash functions of the algorithms
+var hashFns = map[string]HashFn{
+	HashAlgorithmSHA3_256:    Hash,
+	HashAlgorithmBlake2b_256: hash,
+}
+
+// "GetHashFn" - Returns the hash function of the algorithm
+func GetHashFn(algorithm string) (fn HashFn, err error) {
+	fn, found := hashFns[algorithm]
+	if !found {
+		return nil, fmt.Errorf("unsupported hash algorithm %s", algorithm)
+	}
+	return fn, nil
+}
+
 var (
 	Hasher                  = sha.SHA3_256
 	HashLength              = sha.SHA3_256.Size()
@@ -90,6 +90,23 @@ const (
 	CodeReplayAttackError                = 86
 	CodeInvalidNetworkIDError            = 87
 	CodeInvalidExpirationHeightErr       = 88
+	CodeInvalidChainCredentialsError     = 89
+	CodeUndelegatedGatewayError          = 90
+	CodeBlockedApplicationError          = 91
+	CodeBlockedClientError               = 92
+	CodeInvalidSubscriptionEventError    = 93
+	CodeInvalidWebhookURLError           = 94
+	CodeRelayLimitExceededError          = 95
+	CodeInvalidRelayBatchError           = 96
+	CodeReadOnlyReplicaError             = 97
+	CodeClientRelayLimitExceededError    = 98
+	CodeInvalidScheduledTxError          = 99
+	CodeScheduledTxNotFoundError         = 100
+	CodeInvalidChainHTTPConfigError      = 101
+	CodeInvalidPayloadError              = 102
+	CodeParamOutOfBoundsError            = 103
+	CodeInvalidParamBoundsError          = 104
+	CodeMismatchedClientReporterError    = 105
 )
 
 var (
@@ -180,6 +197,24 @@ var (
 	InvalidEvidenceErr               = errors.New("the evidence type passed is not valid")
 	ReplayAttackError                = errors.New("the merkle proof is flagged as a replay attack")
 	InvalidExpirationHeightErr       = errors.New("the expiration height included in the claim message is invalid (should not be set)")
+	InvalidChainCredentialsError     = errors.New("the hosted chain credentials are invalid")
+	UndelegatedGatewayError          = errors.New("the gateway that signed the AAT is not delegated by the application")
+	BlockedApplicationError          = errors.New("the application is in the blocklist of this node")
+	BlockedClientError               = errors.New("the client is in the blocklist of this node")
+	InvalidSubscriptionEventError    = errors.New("the subscription event type is not supported")
+	InvalidWebhookURLError           = errors.New("the webhook url is invalid, expected an http(s) url")
+	RelayLimitExceededError          = errors.New("the max relays of the application for this session are served by this node")
+	InvalidRelayBatchError           = errors.New("the batch of json rpc requests of the relay is invalid")
+	ReadOnlyReplicaError             = errors.New("this node is a read only replica, it serves no relays nor challenges")
+	ClientRelayLimitExceededError    = errors.New("the max relays of the client for this session are served by this node")
+	InvalidClientRelayLimitError     = errors.New("the client relay limit must be a percent between 0 and 100")
+	InvalidScheduledTxError          = errors.New("the scheduled transaction is invalid")
+	ScheduledTxNotFoundError         = errors.New("the transaction is not scheduled")
+	InvalidChainHTTPConfigError      = errors.New("the http configuration of the hosted chain is invalid")
+	InvalidPayloadError              = errors.New("the payload of the relay request is invalid")
+	ParamOutOfBoundsError            = errors.New("the value of the param change is out of the bounds of the param")
+	InvalidParamBoundsError          = errors.New("the param bounds are invalid")
+	MismatchedClientReporterError    = errors.New("the challenges of the session are already reported by another client")
 )
 
 func NewUnsupportedBlockchainError(codespace sdk.CodespaceType) sdk.Error {
@@ -461,3 +496,71 @@ func NewInvalidTokenError(codespace sdk.CodespaceType, err error) sdk.Error {
 func NewInvalidPKError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidPkFileErr, InvalidPkFileErr.Error())
 }
+
+func NewInvalidChainCredentialsError(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidChainCredentialsError, InvalidChainCredentialsError.Error()+": "+reason)
+}
+
+func NewUndelegatedGatewayError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUndelegatedGatewayError, UndelegatedGatewayError.Error())
+}
+
+func NewBlockedApplicationError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeBlockedApplicationError, BlockedApplicationError.Error())
+}
+
+func NewBlockedClientError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeBlockedClientError, BlockedClientError.Error())
+}
+
+func NewInvalidSubscriptionEventError(codespace sdk.CodespaceType, eventType string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSubscriptionEventError, InvalidSubscriptionEventError.Error()+": "+eventType)
+}
+
+func NewInvalidWebhookURLError(codespace sdk.CodespaceType, url string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidWebhookURLError, InvalidWebhookURLError.Error()+": "+url)
+}
+
+func NewRelayLimitExceededError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRelayLimitExceededError, RelayLimitExceededError.Error())
+}
+
+func NewInvalidRelayBatchError(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRelayBatchError, InvalidRelayBatchError.Error()+": "+reason)
+}
+
+func NewReadOnlyReplicaError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeReadOnlyReplicaError, ReadOnlyReplicaError.Error())
+}
+
+func NewClientRelayLimitExceededError(codespace sdk.CodespaceType, clientPubKey string) sdk.Error {
+	return sdk.NewError(codespace, CodeClientRelayLimitExceededError, ClientRelayLimitExceededError.Error()+": "+clientPubKey)
+}
+
+func NewInvalidScheduledTxError(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidScheduledTxError, InvalidScheduledTxError.Error()+": "+reason)
+}
+
+func NewScheduledTxNotFoundError(codespace sdk.CodespaceType, hash string) sdk.Error {
+	return sdk.NewError(codespace, CodeScheduledTxNotFoundError, ScheduledTxNotFoundError.Error()+": "+hash)
+}
+
+func NewInvalidChainHTTPConfigError(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidChainHTTPConfigError, InvalidChainHTTPConfigError.Error()+": "+reason)
+}
+
+func NewInvalidPayloadError(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPayloadError, InvalidPayloadError.Error()+": "+reason)
+}
+
+func NewParamOutOfBoundsError(codespace sdk.CodespaceType, key, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeParamOutOfBoundsError, ParamOutOfBoundsError.Error()+": "+key+": "+reason)
+}
+
+func NewInvalidParamBoundsError(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamBoundsError, InvalidParamBoundsError.Error()+": "+reason)
+}
+
+func NewMismatchedClientReporterError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMismatchedClientReporterError, MismatchedClientReporterError.Error())
+}
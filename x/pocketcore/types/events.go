@@ -4,4 +4,13 @@ const (
 	EventTypeClaim        = MsgClaimName // an event for emitting a claim message
 	EventTypeProof        = MsgProofName // an event for emitting a proof message
 	AttributeKeyValidator = "validator"  // a validator attribute
+	// challenge reward
+	EventTypeChallengeReward = "challenge_reward" // an event for emitting the reward of a challenge reporter
+	AttributeKeyReporter     = "reporter"         // the address of the challenge reporter
+	AttributeKeyAccused      = "accused"          // the address of the accused servicer
+	// relay reward
+	EventTypeRelayReward      = "relay_reward"         // an event for emitting the reward of a servicer for its proven relays
+	AttributeKeyChain         = "chain"                // the network identifier of the relays
+	AttributeKeySessionHeight = "session_block_height" // the session block height of the relays
+	AttributeKeyRelays        = "relays"               // the number of relays
 )
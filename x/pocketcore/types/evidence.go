@@ -1,19 +1,25 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
 	"github.com/pokt-network/posmint/types"
 	"github.com/willf/bloom"
 	"strings"
+	"time"
 )
 
 // "Evidence" - A proof of work/burn for nodes.
 type Evidence struct {
-	Bloom         bloom.BloomFilter `json:"bloom_filter"` // used to check if proof contains
-	SessionHeader `json:"evidence_header"`                // the session h serves as an identifier for the evidence
-	NumOfProofs   int64        `json:"num_of_proofs"`     // the total number of proofs in the evidence
-	Proofs        []Proof      `json:"proofs"`            // a slice of Proof objects (Proof per relay or challenge)
-	EvidenceType  EvidenceType `json:"evidence_type"`
+	Bloom            bloom.BloomFilter        `json:"bloom_filter"` // used to check if proof contains
+	SessionHeader    `json:"evidence_header"` // the session h serves as an identifier for the evidence
+	NumOfProofs      int64                    `json:"num_of_proofs"` // the total number of proofs in the evidence
+	Proofs           []Proof                  `json:"proofs"`        // a slice of Proof objects (Proof per relay or challenge)
+	EvidenceType     EvidenceType             `json:"evidence_type"`
+	FirstProofAt     time.Time                `json:"first_proof_at"`     // the local time the first proof was added
+	LastProofAt      time.Time                `json:"last_proof_at"`      // the local time the last proof was added
+	SessionBlockHash string                   `json:"session_block_hash"` // the hash of the session block the evidence was collected under
+	NumOfRelays      int64                    `json:"num_of_relays"`      // the relays metered for the proofs, a proof of a batch meters its requests
 }
 
 // "GenerateMerkleRoot" - Generates the merkle root for an evidence object
@@ -29,6 +35,22 @@ func (e *Evidence) GenerateMerkleRoot() (root HashSum) {
 
 // "AddProof" - Adds a proof obj to the evidence field
 func (e *Evidence) AddProof(p Proof) {
+	e.AddProofOfRelays(p, 1)
+}
+
+// "AddProofOfRelays" - Adds the proof of the relays (the requests of a batch, at least one) to the evidence object
+func (e *Evidence) AddProofOfRelays(p Proof, relays int64) {
+	if relays < 1 {
+		relays = 1
+	}
+	// the relays of the proofs added before they were metered count one per proof
+	e.NumOfRelays = e.TotalRelays() + relays
+	// record the local time of the proof
+	now := time.Now().UTC()
+	if e.NumOfProofs == 0 {
+		e.FirstProofAt = now
+	}
+	e.LastProofAt = now
 	// add proof to evidence
 	e.Proofs = append(e.Proofs, p)
 	// increment total proof count
@@ -37,8 +59,21 @@ func (e *Evidence) AddProof(p Proof) {
 	e.Bloom.Add(p.Hash())
 }
 
-// "GenerateMerkleProof" - Generates the merkle Proof for an evidence
+// "TotalRelays" - Returns the relays metered for the proofs of the evidence, one per proof added before the relays were
+// metered
+func (e Evidence) TotalRelays() int64 {
+	if e.NumOfRelays < e.NumOfProofs {
+		return e.NumOfProofs
+	}
+	return e.NumOfRelays
+}
+
+// "GenerateMerkleProof" - Generates the merkle Proof for an evidence (from its prebuilt tree if any)
 func (e *Evidence) GenerateMerkleProof(index int) (proofs MerkleProofs, cousinIndex int) {
+	if tree, found := GetMerkleTree(e.SessionHeader, e.EvidenceType, e.NumOfProofs); found {
+		proofs, cousinIndex = tree.GenerateProofs(index)
+		return
+	}
 	// generate the merkle proof
 	proofs, cousinIndex = GenerateProofs(e.Proofs, index)
 	// set the evidence in memory
@@ -48,11 +83,15 @@ func (e *Evidence) GenerateMerkleProof(index int) (proofs MerkleProofs, cousinIn
 
 // "Evidence" - A proof of work/burn for nodes.
 type evidence struct {
-	BloomBytes    []byte `json:"bloom_bytes"`
-	SessionHeader `json:"evidence_header"`            // the session h serves as an identifier for the evidence
-	NumOfProofs   int64        `json:"num_of_proofs"` // the total number of proofs in the evidence
-	Proofs        []Proof      `json:"proofs"`        // a slice of Proof objects (Proof per relay or challenge)
-	EvidenceType  EvidenceType `json:"evidence_type"`
+	BloomBytes       []byte                   `json:"bloom_bytes"`
+	SessionHeader    `json:"evidence_header"` // the session h serves as an identifier for the evidence
+	NumOfProofs      int64                    `json:"num_of_proofs"` // the total number of proofs in the evidence
+	Proofs           []Proof                  `json:"proofs"`        // a slice of Proof objects (Proof per relay or challenge)
+	EvidenceType     EvidenceType             `json:"evidence_type"`
+	FirstProofAt     time.Time                `json:"first_proof_at"`
+	LastProofAt      time.Time                `json:"last_proof_at"`
+	SessionBlockHash string                   `json:"session_block_hash"`
+	NumOfRelays      int64                    `json:"num_of_relays"`
 }
 
 var _ CacheObject = Evidence{} // satisfies the cache object interface
@@ -63,11 +102,15 @@ func (e Evidence) Marshal() ([]byte, error) {
 		return nil, err
 	}
 	ep := evidence{
-		BloomBytes:    encodedBloom,
-		SessionHeader: e.SessionHeader,
-		NumOfProofs:   e.NumOfProofs,
-		Proofs:        e.Proofs,
-		EvidenceType:  e.EvidenceType,
+		BloomBytes:       encodedBloom,
+		SessionHeader:    e.SessionHeader,
+		NumOfProofs:      e.NumOfProofs,
+		Proofs:           e.Proofs,
+		EvidenceType:     e.EvidenceType,
+		FirstProofAt:     e.FirstProofAt,
+		LastProofAt:      e.LastProofAt,
+		SessionBlockHash: e.SessionBlockHash,
+		NumOfRelays:      e.NumOfRelays,
 	}
 	return ModuleCdc.MarshalBinaryBare(ep)
 }
@@ -84,11 +127,15 @@ func (e Evidence) Unmarshal(b []byte) (CacheObject, error) {
 		return Evidence{}, fmt.Errorf("could not unmarshal into evidence from cache, bloom bytes gob decode: %s", err.Error())
 	}
 	evidence := Evidence{
-		Bloom:         bloomFilter,
-		SessionHeader: ep.SessionHeader,
-		NumOfProofs:   ep.NumOfProofs,
-		Proofs:        ep.Proofs,
-		EvidenceType:  ep.EvidenceType}
+		Bloom:            bloomFilter,
+		SessionHeader:    ep.SessionHeader,
+		NumOfProofs:      ep.NumOfProofs,
+		Proofs:           ep.Proofs,
+		EvidenceType:     ep.EvidenceType,
+		FirstProofAt:     ep.FirstProofAt,
+		LastProofAt:      ep.LastProofAt,
+		SessionBlockHash: ep.SessionBlockHash,
+		NumOfRelays:      ep.NumOfRelays}
 	return evidence, nil
 }
 
@@ -96,6 +143,26 @@ func (e Evidence) Key() ([]byte, error) {
 	return KeyForEvidence(e.SessionHeader, e.EvidenceType)
 }
 
+// "EvidenceSummary" - A lightweight view of local (unclaimed/unproven) evidence held by this node
+type EvidenceSummary struct {
+	SessionHeader `json:"header"` // header to identify the session
+	EvidenceType  EvidenceType    `json:"evidence_type"`  // the type (relay/challenge)
+	NumOfProofs   int64           `json:"num_of_proofs"`  // the number of proofs recorded so far
+	FirstProofAt  time.Time       `json:"first_proof_at"` // the local time the first proof was recorded
+	LastProofAt   time.Time       `json:"last_proof_at"`  // the local time the last proof was recorded
+}
+
+// "Summary" - Returns the evidence summary of the evidence object
+func (e Evidence) Summary() EvidenceSummary {
+	return EvidenceSummary{
+		SessionHeader: e.SessionHeader,
+		EvidenceType:  e.EvidenceType,
+		NumOfProofs:   e.NumOfProofs,
+		FirstProofAt:  e.FirstProofAt,
+		LastProofAt:   e.LastProofAt,
+	}
+}
+
 // "EvidenceType" type to distinguish the types of evidence (relay/challenge)
 type EvidenceType int
 
@@ -118,10 +185,63 @@ func (et EvidenceType) Byte() (byte, error) {
 
 // "Receipt" - Is a structure used to store proof of evidence after verification
 type Receipt struct {
-	SessionHeader   `json:"header"`                     // header to identify the session
-	ServicerAddress string       `json:"address"`       // the address responsible
-	Total           int64        `json:"total"`         // the number of proofs
-	EvidenceType    EvidenceType `json:"evidence_type"` // the type (relay/challenge)
+	SessionHeader   `json:"header"` // header to identify the session
+	ServicerAddress string          `json:"address"`       // the address responsible
+	Total           int64           `json:"total"`         // the number of proofs
+	EvidenceType    EvidenceType    `json:"evidence_type"` // the type (relay/challenge)
+}
+
+// "Settlement" - Is a structure used to store the economic outcome of the verified evidence (parallel to the receipt)
+type Settlement struct {
+	Minted                   types.Int `json:"minted"`                      // the total tokens minted (node reward + fee collector)
+	RelaysToTokensMultiplier types.Int `json:"relays_to_tokens_multiplier"` // the multiplier used at settlement
+	Burned                   types.Int `json:"burned"`                      // the total tokens burned (challenges)
+	ReporterReward           types.Int `json:"reporter_reward"`             // the tokens minted to the client that reported the challenges
+}
+
+// "SettledReceipt" - A receipt along with the settlement of the verified evidence (if any)
+type SettledReceipt struct {
+	SessionHeader   `json:"header"` // header to identify the session
+	ServicerAddress string          `json:"address"`              // the address responsible
+	Total           int64           `json:"total"`                // the number of proofs
+	EvidenceType    EvidenceType    `json:"evidence_type"`        // the type (relay/challenge)
+	Settlement      *Settlement     `json:"settlement,omitempty"` // the economic outcome (nil if settled before it was recorded)
+}
+
+// "Receipt" - Returns the receipt of the settled receipt
+func (sr SettledReceipt) Receipt() Receipt {
+	return Receipt{
+		SessionHeader:   sr.SessionHeader,
+		ServicerAddress: sr.ServicerAddress,
+		Total:           sr.Total,
+		EvidenceType:    sr.EvidenceType,
+	}
+}
+
+// "Challenge" - A challenge (claim of challenge evidence) reported by a session node, and its outcome once proven
+type Challenge struct {
+	SessionHeader    `json:"header"` // header to identify the session
+	Reporter         types.Address   `json:"reporter"`                    // the address of the node that reported the challenges
+	Accused          types.Address   `json:"accused,omitempty"`           // the address of the servicer burned (only known once settled)
+	TotalChallenges  int64           `json:"total_challenges"`            // the number of challenges reported
+	Settled          bool            `json:"settled"`                     // true if the challenges were proven
+	Burned           types.Int       `json:"burned"`                      // the tokens burned from the accused (zero while open)
+	ClientReporter   types.Address   `json:"client_reporter,omitempty"`   // the address of the client that reported the challenge (only known once settled)
+	ReporterReward   types.Int       `json:"reporter_reward"`             // the tokens awarded to the client reporter (zero while open)
+	ExpirationHeight int64           `json:"expiration_height,omitempty"` // the height at which the open challenge expires
+	SettlementHeight int64           `json:"settlement_height,omitempty"` // the height at which the challenge was settled
+}
+
+// "NewOpenChallenge" - Returns the open challenge of a pending challenge claim
+func NewOpenChallenge(claim MsgClaim) Challenge {
+	return Challenge{
+		SessionHeader:    claim.SessionHeader,
+		Reporter:         claim.FromAddress,
+		TotalChallenges:  claim.TotalProofs,
+		Burned:           types.ZeroInt(),
+		ReporterReward:   types.ZeroInt(),
+		ExpirationHeight: claim.ExpirationHeight,
+	}
 }
 
 func EvidenceTypeFromString(evidenceType string) (et EvidenceType, err types.Error) {
@@ -135,3 +255,89 @@ func EvidenceTypeFromString(evidenceType string) (et EvidenceType, err types.Err
 	}
 	return
 }
+
+// "EvidenceFilter" - Filters the receipts and claims of a servicer by evidence type, chain and app (any if empty)
+type EvidenceFilter struct {
+	EvidenceType EvidenceType `json:"evidence_type,omitempty"`
+	Chain        string       `json:"chain,omitempty"`
+	AppPubKey    string       `json:"app_pubkey,omitempty"`
+}
+
+// "NewEvidenceFilter" - Returns the filter of the evidence type (relay or challenge), chain and app public key
+// (any if empty)
+func NewEvidenceFilter(evidenceType, chain, appPubKey string) (filter EvidenceFilter, err types.Error) {
+	if evidenceType != "" {
+		if filter.EvidenceType, err = EvidenceTypeFromString(evidenceType); err != nil {
+			return
+		}
+	}
+	if chain != "" {
+		if err = NetworkIdentifierVerification(chain); err != nil {
+			return
+		}
+		filter.Chain = chain
+	}
+	if appPubKey != "" {
+		if err = PubKeyVerification(appPubKey); err != nil {
+			return
+		}
+		filter.AppPubKey = appPubKey
+	}
+	return
+}
+
+// "IsEmpty" - Returns true if the filter matches every evidence
+func (ef EvidenceFilter) IsEmpty() bool {
+	return ef.EvidenceType == 0 && ef.Chain == "" && ef.AppPubKey == ""
+}
+
+// "MatchIndexKey" - Returns true if the evidence indexed by the key (see KeyForReceiptIndex) matches the filter
+func (ef EvidenceFilter) MatchIndexKey(indexKey []byte) bool {
+	et, chain, app, _, ok := splitEvidenceIndexKey(indexKey)
+	if !ok {
+		return false
+	}
+	if ef.EvidenceType != 0 {
+		if b, err := ef.EvidenceType.Byte(); err != nil || b != et {
+			return false
+		}
+	}
+	if ef.Chain != "" && hex.EncodeToString(chain) != strings.ToLower(ef.Chain) {
+		return false
+	}
+	if ef.AppPubKey != "" && hex.EncodeToString(app) != strings.ToLower(ef.AppPubKey) {
+		return false
+	}
+	return true
+}
+
+// "ClaimsTally" - The number of claims and the relays (proofs) they claim
+type ClaimsTally struct {
+	Count       int64 `json:"count"`        // the number of claims
+	TotalRelays int64 `json:"total_relays"` // the total number of proofs claimed
+}
+
+// "Add" - Adds the claim of the number of proofs to the tally
+func (ct *ClaimsTally) Add(totalProofs int64) {
+	ct.Count++
+	ct.TotalRelays += totalProofs
+}
+
+// "ClaimMaturity" - A claim awaiting its proof and when it may be proved: the height it is mature at (past its claim
+// submission window) and the blocks until then, unknown (zero and -1) while a time based session of the window is in progress
+type ClaimMaturity struct {
+	SessionHeader     SessionHeader `json:"header"`
+	EvidenceType      EvidenceType  `json:"evidence_type"`
+	TotalProofs       int64         `json:"total_proofs"`
+	ExpirationHeight  int64         `json:"expiration_height"`
+	MatureHeight      int64         `json:"mature_height"`
+	BlocksUntilMature int64         `json:"blocks_until_mature"` // zero if mature
+}
+
+// "NetworkRelays" - The relays settled (proven) network wide for a chain in a session
+type NetworkRelays struct {
+	SessionBlockHeight int64  `json:"session_block_height"`
+	Chain              string `json:"chain"`
+	Proofs             int64  `json:"proofs"` // the number of settled claims (servicers proving their relays)
+	Relays             int64  `json:"relays"` // the total relays of the settled claims
+}
@@ -0,0 +1,270 @@
+package types
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+const (
+	// how often the proofs are replicated to the peers
+	EvidenceReplicationInterval = 5 * time.Second
+	// the proofs waiting to be replicated to a peer, the oldest are dropped past it (the peer is down), the peer
+	// requests the resync of an evidence missing a dropped proof
+	MaxPendingReplicatedProofs = 100000
+	// the route of the peers receiving the replicated proofs
+	EvidenceReplicationPath    = "/v1/private/replicateevidence"
+	evidenceReplicationTimeout = 10 * time.Second
+)
+
+var (
+	// replicates the proofs of this node to the trusted peers (nil when disabled)
+	globalEvidenceReplicator  *evidenceReplicator
+	evidenceReplicationClient = http.Client{Timeout: evidenceReplicationTimeout}
+)
+
+// replicates the proofs, encrypted with the shared key, to standby nodes of the same operator (sharing the validator
+// key), so a standby can claim the relays of a failed primary
+type evidenceReplicator struct {
+	l       sync.Mutex
+	aead    cipher.AEAD
+	peers   []string
+	pending map[string][]proofRecord // peer -> the proofs not yet replicated
+}
+
+// "InitEvidenceReplication" - Enables the replication of the proofs to the peers (base urls of their rpc) and the
+// reception of the proofs replicated to this node, encrypted with the shared secret. Disabled if the secret is empty
+func InitEvidenceReplication(peers []string, secret string) error {
+	if secret == "" {
+		globalEvidenceReplicator = nil
+		return nil
+	}
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	r := &evidenceReplicator{aead: aead, pending: make(map[string][]proofRecord)}
+	for _, peer := range peers {
+		r.peers = append(r.peers, strings.TrimSuffix(peer, "/"))
+	}
+	globalEvidenceReplicator = r
+	return nil
+}
+
+// queues the proof for the peers
+func replicateProof(record proofRecord) {
+	r := globalEvidenceReplicator
+	if r == nil || len(r.peers) == 0 {
+		return
+	}
+	r.l.Lock()
+	defer r.l.Unlock()
+	for _, peer := range r.peers {
+		r.queue(peer, r.pending[peer], []proofRecord{record})
+	}
+}
+
+// sets the pending proofs of the peer, dropping the oldest past the max (must hold the lock)
+func (r *evidenceReplicator) queue(peer string, first, then []proofRecord) {
+	pending := append(append(make([]proofRecord, 0, len(first)+len(then)), first...), then...)
+	if len(pending) > MaxPendingReplicatedProofs {
+		pending = pending[len(pending)-MaxPendingReplicatedProofs:]
+	}
+	r.pending[peer] = pending
+}
+
+// "ReplicateEvidence" - Sends the queued proofs to the peers, the proofs not received by a peer are retried on the
+// next replication, and the proofs of the evidences missing a proof on a peer are resent from the local evidence
+func ReplicateEvidence() {
+	r := globalEvidenceReplicator
+	if r == nil {
+		return
+	}
+	for _, peer := range r.peers {
+		r.l.Lock()
+		records := r.pending[peer]
+		r.pending[peer] = nil
+		r.l.Unlock()
+		if len(records) == 0 {
+			continue
+		}
+		res, err := r.send(peer, records)
+		if err != nil {
+			fmt.Println(fmt.Errorf("could not replicate %d proofs to %s: %s", len(records), peer, err.Error()))
+			// requeue before the proofs added since
+			r.l.Lock()
+			r.queue(peer, records, r.pending[peer])
+			r.l.Unlock()
+			continue
+		}
+		if resync := resyncRecords(res.Resync); len(resync) != 0 {
+			// before the proofs added since, which follow the resent ones
+			r.l.Lock()
+			r.queue(peer, resync, r.pending[peer])
+			r.l.Unlock()
+		}
+	}
+}
+
+// the proofs of the local evidences from the proofs held by the peer, none for an evidence no longer held. The relays
+// metered per proof are not kept in the evidence, a resent proof counts one
+func resyncRecords(resyncs []EvidenceResync) (records []proofRecord) {
+	for _, resync := range resyncs {
+		evidence, err := GetEvidence(resync.SessionHeader, resync.EvidenceType, sdk.ZeroInt())
+		if err != nil {
+			continue
+		}
+		for i := resync.NumOfProofs; i < evidence.NumOfProofs; i++ {
+			records = append(records, proofRecord{SessionHeader: resync.SessionHeader, EvidenceType: resync.EvidenceType, MaxRelays: resync.MaxRelays, Index: i, Proof: evidence.Proofs[i]})
+		}
+	}
+	return
+}
+
+// "StartEvidenceReplication" - Replicates the proofs to the peers every replication interval
+func StartEvidenceReplication() {
+	if globalEvidenceReplicator == nil {
+		return
+	}
+	for range time.Tick(EvidenceReplicationInterval) {
+		ReplicateEvidence()
+	}
+}
+
+func (r *evidenceReplicator) send(peer string, records []proofRecord) (res EvidenceReplicationResult, err error) {
+	bz, err := ModuleCdc.MarshalBinaryBare(records)
+	if err != nil {
+		return
+	}
+	resp, err := evidenceReplicationClient.Post(peer+EvidenceReplicationPath, "application/octet-stream", bytes.NewReader(r.seal(bz)))
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return res, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	// the proofs are received, the result only requests the resyncs
+	_ = json.NewDecoder(resp.Body).Decode(&res)
+	return res, nil
+}
+
+// encrypts and authenticates the data: nonce | ciphertext
+func (r *evidenceReplicator) seal(data []byte) []byte {
+	nonce := make([]byte, r.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		panic(err)
+	}
+	return r.aead.Seal(nonce, nonce, data, nil)
+}
+
+func (r *evidenceReplicator) open(data []byte) ([]byte, error) {
+	if len(data) < r.aead.NonceSize() {
+		return nil, fmt.Errorf("the replicated evidence is too short")
+	}
+	return r.aead.Open(nil, data[:r.aead.NonceSize()], data[r.aead.NonceSize():], nil)
+}
+
+// "EvidenceReplicationResult" - The outcome of the proofs replicated to this node
+type EvidenceReplicationResult struct {
+	Received int              `json:"received"`
+	Added    int              `json:"added"`            // the proofs not already in their evidence
+	Resync   []EvidenceResync `json:"resync,omitempty"` // the evidences missing a proof before the ones received
+}
+
+// "EvidenceResync" - The proofs held by this node of an evidence missing a replicated proof (dropped by the peer), the
+// peer resends the proofs of its evidence from there
+type EvidenceResync struct {
+	SessionHeader SessionHeader `json:"header"`
+	EvidenceType  EvidenceType  `json:"evidence_type"`
+	MaxRelays     sdk.Int       `json:"max_relays"`
+	NumOfProofs   int64         `json:"num_of_proofs"`
+}
+
+// "String" - Returns the json of the result
+func (res EvidenceReplicationResult) String() string {
+	bz, _ := json.Marshal(res)
+	return string(bz)
+}
+
+// "ReceiveReplicatedEvidence" - Decrypts the proofs replicated to this node and adds them to their evidence, in order:
+// a proof already in its evidence is skipped, as is a proof after a missing one (dropped by the peer), the resync of its
+// evidence being requested in the result
+func ReceiveReplicatedEvidence(data []byte) (res EvidenceReplicationResult, err error) {
+	r := globalEvidenceReplicator
+	if r == nil {
+		return res, fmt.Errorf("the evidence replication is not enabled on this node")
+	}
+	bz, err := r.open(data)
+	if err != nil {
+		return res, fmt.Errorf("could not decrypt the replicated evidence (mismatched key?): %s", err.Error())
+	}
+	var records []proofRecord
+	if err := ModuleCdc.UnmarshalBinaryBare(bz, &records); err != nil {
+		return res, err
+	}
+	res.Received = len(records)
+	resyncs := make(map[string]bool)
+	for _, record := range records {
+		added, numOfProofs, err := addReplicatedProof(record)
+		if err != nil {
+			return res, err
+		}
+		if added {
+			res.Added++
+		}
+		if record.Index <= numOfProofs {
+			continue
+		}
+		// a missing proof, the resync of the evidence is requested once
+		key, _ := KeyForEvidence(record.SessionHeader, record.EvidenceType)
+		if !resyncs[string(key)] {
+			resyncs[string(key)] = true
+			res.Resync = append(res.Resync, EvidenceResync{SessionHeader: record.SessionHeader, EvidenceType: record.EvidenceType, MaxRelays: record.MaxRelays, NumOfProofs: numOfProofs})
+		}
+	}
+	return res, nil
+}
+
+// adds the proof to its evidence if it is the next proof of the evidence, returns the proofs of the evidence before it
+func addReplicatedProof(record proofRecord) (added bool, numOfProofs int64, err error) {
+	key, err := KeyForEvidence(record.SessionHeader, record.EvidenceType)
+	if err != nil {
+		return
+	}
+	lock := globalEvidenceCache.WriteLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	evidence, err := GetEvidence(record.SessionHeader, record.EvidenceType, record.MaxRelays)
+	if err != nil {
+		return
+	}
+	numOfProofs = evidence.NumOfProofs
+	if record.Index != evidence.NumOfProofs {
+		return false, numOfProofs, nil
+	}
+	if globalProofStore != nil {
+		appended, err := globalProofStore.Append(record.SessionHeader, record.EvidenceType, record.MaxRelays, record.Index, record.Relays, record.Proof)
+		if err != nil || !appended {
+			return false, numOfProofs, err
+		}
+	}
+	evidence.AddProofOfRelays(record.Proof, record.Relays)
+	SetEvidence(evidence)
+	return true, numOfProofs, nil
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvidenceReplication(t *testing.T) {
+	var received []byte
+	fail := false
+	peer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, EvidenceReplicationPath, r.URL.Path)
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		received, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer peer.Close()
+	assert.Nil(t, InitEvidenceReplication([]string{peer.URL + "/"}, "secret"))
+	defer func() { _ = InitEvidenceReplication(nil, "") }()
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	for i := 0; i < 3; i++ {
+		SetProof(header, RelayEvidence, RelayProof{Entropy: int64(i)}, sdk.NewInt(1000))
+	}
+	// the peer is down, the proofs are kept for the next replication
+	fail = true
+	ReplicateEvidence()
+	assert.Len(t, globalEvidenceReplicator.pending[peer.URL], 3)
+	fail = false
+	ReplicateEvidence()
+	assert.Len(t, globalEvidenceReplicator.pending[peer.URL], 0)
+	assert.NotEmpty(t, received)
+	// the standby has none of the proofs
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+	res, err := ReceiveReplicatedEvidence(received)
+	assert.Nil(t, err)
+	assert.Equal(t, EvidenceReplicationResult{Received: 3, Added: 3}, res)
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), evidence.NumOfProofs)
+	assert.False(t, IsUniqueProof(RelayProof{Entropy: 2}, evidence))
+	// the proofs already in the evidence are skipped
+	res, err = ReceiveReplicatedEvidence(received)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, res.Added)
+	// another key cannot decrypt the proofs
+	assert.Nil(t, InitEvidenceReplication(nil, "another secret"))
+	_, err = ReceiveReplicatedEvidence(received)
+	assert.NotNil(t, err)
+	assert.Nil(t, InitEvidenceReplication(nil, ""))
+	_, err = ReceiveReplicatedEvidence(received)
+	assert.NotNil(t, err)
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+}
+
+func TestEvidenceReplication_Resync(t *testing.T) {
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	// the standby holds the first proof, the next one was dropped by the primary
+	assert.Nil(t, InitEvidenceReplication(nil, "secret"))
+	defer func() { _ = InitEvidenceReplication(nil, "") }()
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 0}, sdk.NewInt(1000))
+	records := []proofRecord{
+		{SessionHeader: header, EvidenceType: RelayEvidence, MaxRelays: sdk.NewInt(1000), Index: 2, Proof: RelayProof{Entropy: 2}},
+		{SessionHeader: header, EvidenceType: RelayEvidence, MaxRelays: sdk.NewInt(1000), Index: 3, Proof: RelayProof{Entropy: 3}},
+	}
+	bz, err := ModuleCdc.MarshalBinaryBare(records)
+	assert.Nil(t, err)
+	res, err := ReceiveReplicatedEvidence(globalEvidenceReplicator.seal(bz))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, res.Added)
+	assert.Equal(t, []EvidenceResync{{SessionHeader: header, EvidenceType: RelayEvidence, MaxRelays: sdk.NewInt(1000), NumOfProofs: 1}}, res.Resync)
+	// the primary resends the proofs of its evidence from the ones held by the standby
+	for i := 1; i < 4; i++ {
+		SetProof(header, RelayEvidence, RelayProof{Entropy: int64(i)}, sdk.NewInt(1000))
+	}
+	peer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = ioutil.ReadAll(r.Body)
+		_, _ = w.Write([]byte(res.String()))
+	}))
+	defer peer.Close()
+	assert.Nil(t, InitEvidenceReplication([]string{peer.URL}, "secret"))
+	globalEvidenceReplicator.pending[peer.URL] = records
+	ReplicateEvidence()
+	pending := globalEvidenceReplicator.pending[peer.URL]
+	assert.Len(t, pending, 3)
+	for i, record := range pending {
+		assert.Equal(t, int64(i+1), record.Index)
+		assert.Equal(t, RelayProof{Entropy: int64(i + 1)}, record.Proof)
+	}
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+}
@@ -0,0 +1,240 @@
+package types
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/posmint/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+const (
+	// the name of the audit log of the sealing requests (in the data directory)
+	DefaultSealerAuditLogName = "sealer_audit.log"
+	// the timeout of a sealing request to the external sealer
+	DefaultSealerTimeout = 5 * time.Second
+	// the gRPC method of the external sealer
+	SealerServiceName = "pocket.EvidenceSealer"
+	sealerSealMethod  = "/" + SealerServiceName + "/Seal"
+	// the content subtype of the (json encoded) messages of the external sealer
+	sealerCodecName = "pocketjson"
+	// the prefix of the addresses of the sealers listening on a unix socket
+	unixSocketPrefix = "unix://"
+	// the signers of the audit records
+	ExternalSigner = "external"
+	LocalSigner    = "local"
+)
+
+var (
+	// signs the auto transactions of the node outside of the node process (nil when disabled)
+	globalEvidenceSealer *sealerConfig
+)
+
+func init() {
+	encoding.RegisterCodec(sealerCodec{})
+}
+
+// "EvidenceSealer" - Signs the auto transactions (claims, proofs and restakes) of the node outside of the node process,
+// e.g. with a key held in an HSM
+type EvidenceSealer interface {
+	Seal(ctx context.Context, req SealRequest) (SealResponse, error)
+}
+
+// "SealRequest" - The sign bytes of an auto transaction and the public key expected to sign them
+type SealRequest struct {
+	PublicKey string `json:"public_key"` // hex
+	MsgType   string `json:"msg_type"`   // the message of the transaction, e.g. claim or proof
+	SignBytes []byte `json:"sign_bytes"` // base64 in json
+}
+
+// "SealResponse" - The signature of the sign bytes
+type SealResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// "SealerAuditRecord" - A sealing request, appended to the audit log whatever its outcome
+type SealerAuditRecord struct {
+	Time      time.Time `json:"time"`
+	MsgType   string    `json:"msg_type"`
+	PublicKey string    `json:"public_key"`
+	SignHash  string    `json:"sign_hash"` // the sha256 of the sign bytes
+	Signer    string    `json:"signer"`    // external or local (the fallback)
+	Error     string    `json:"error,omitempty"`
+}
+
+type sealerConfig struct {
+	sealer   EvidenceSealer
+	timeout  time.Duration
+	fallback bool // sign with the local key when the external sealer fails
+	l        sync.Mutex
+	audit    *os.File
+}
+
+// "InitEvidenceSealer" - Signs the auto transactions with the external sealer at the address (a unix:// socket or a
+// host:port), falling back to the local key if enabled, and appends every sealing request to the audit log at
+// auditPath. Disabled if the address is empty
+func InitEvidenceSealer(address string, timeout time.Duration, fallback bool, auditPath string) error {
+	if address == "" {
+		globalEvidenceSealer = nil
+		return nil
+	}
+	sealer, err := NewGRPCSealer(address)
+	if err != nil {
+		return err
+	}
+	return SetEvidenceSealer(sealer, timeout, fallback, auditPath)
+}
+
+// "SetEvidenceSealer" - Signs the auto transactions with the sealer (see InitEvidenceSealer)
+func SetEvidenceSealer(sealer EvidenceSealer, timeout time.Duration, fallback bool, auditPath string) error {
+	audit, err := os.OpenFile(auditPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		timeout = DefaultSealerTimeout
+	}
+	if globalEvidenceSealer != nil {
+		_ = globalEvidenceSealer.audit.Close()
+	}
+	globalEvidenceSealer = &sealerConfig{sealer: sealer, timeout: timeout, fallback: fallback, audit: audit}
+	return nil
+}
+
+// "SealingKey" - Returns the key signing the auto transactions of the message type: the local key, unless an external
+// sealer is enabled
+func SealingKey(pk crypto.PrivateKey, msgType string) crypto.PrivateKey {
+	s := globalEvidenceSealer
+	if s == nil {
+		return pk
+	}
+	return sealingKey{PrivateKey: pk, msgType: msgType, config: s}
+}
+
+// the local key, signing through the external sealer
+type sealingKey struct {
+	crypto.PrivateKey
+	msgType string
+	config  *sealerConfig
+}
+
+// "Sign" - Signs the bytes with the external sealer, or with the local key if the sealer fails and the fallback is
+// enabled. The signature of the sealer is verified against the public key of the node
+func (sk sealingKey) Sign(msg []byte) ([]byte, error) {
+	pubKey := sk.PublicKey()
+	req := SealRequest{PublicKey: pubKey.RawString(), MsgType: sk.msgType, SignBytes: msg}
+	ctx, cancel := context.WithTimeout(context.Background(), sk.config.timeout)
+	defer cancel()
+	res, err := sk.config.sealer.Seal(ctx, req)
+	if err == nil && !pubKey.VerifyBytes(msg, res.Signature) {
+		err = fmt.Errorf("the signature of the sealer is not of the public key %s", req.PublicKey)
+	}
+	sk.config.record(req, ExternalSigner, err)
+	if err == nil {
+		return res.Signature, nil
+	}
+	if !sk.config.fallback {
+		return nil, fmt.Errorf("the external sealer failed: %s", err.Error())
+	}
+	sig, err := sk.PrivateKey.Sign(msg)
+	sk.config.record(req, LocalSigner, err)
+	return sig, err
+}
+
+// appends the sealing request to the audit log
+func (s *sealerConfig) record(req SealRequest, signer string, err error) {
+	hash := sha256.Sum256(req.SignBytes)
+	r := SealerAuditRecord{
+		Time:      time.Now().UTC(),
+		MsgType:   req.MsgType,
+		PublicKey: req.PublicKey,
+		SignHash:  hex.EncodeToString(hash[:]),
+		Signer:    signer,
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	bz, er := json.Marshal(r)
+	if er != nil {
+		return
+	}
+	s.l.Lock()
+	defer s.l.Unlock()
+	_, _ = s.audit.Write(append(bz, '\n'))
+	_ = s.audit.Sync()
+}
+
+// the external sealer, a gRPC service
+type grpcSealer struct {
+	conn *grpc.ClientConn
+}
+
+// "NewGRPCSealer" - Returns the client of the gRPC sealer at the address: a unix:// socket or a host:port (without
+// transport security, for a sealer on the same host)
+func NewGRPCSealer(address string) (EvidenceSealer, error) {
+	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.CallContentSubtype(sealerCodecName))}
+	target := address
+	if strings.HasPrefix(address, unixSocketPrefix) {
+		path := strings.TrimPrefix(address, unixSocketPrefix)
+		if path == "" {
+			return nil, fmt.Errorf("the unix socket of the sealer is empty")
+		}
+		target = path
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}))
+	}
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return grpcSealer{conn: conn}, nil
+}
+
+// "Seal" - Requests the signature from the gRPC sealer
+func (gs grpcSealer) Seal(ctx context.Context, req SealRequest) (res SealResponse, err error) {
+	err = gs.conn.Invoke(ctx, sealerSealMethod, &req, &res)
+	return
+}
+
+// "RegisterEvidenceSealerServer" - Serves the sealer on the gRPC server, the counterpart of the gRPC sealer client
+func RegisterEvidenceSealerServer(s *grpc.Server, sealer EvidenceSealer) {
+	s.RegisterService(&grpc.ServiceDesc{
+		ServiceName: SealerServiceName,
+		HandlerType: (*EvidenceSealer)(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Seal",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				var req SealRequest
+				if err := dec(&req); err != nil {
+					return nil, err
+				}
+				return srv.(EvidenceSealer).Seal(ctx, req)
+			},
+		}},
+	}, sealer)
+}
+
+// the json encoding of the messages of the sealer (no generated protobuf code needed by the implementations)
+type sealerCodec struct{}
+
+func (sealerCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (sealerCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (sealerCodec) Name() string {
+	return sealerCodecName
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// a sealer holding the key in memory
+type testSealer struct {
+	pk crypto.PrivateKey
+}
+
+func (ts testSealer) Seal(ctx context.Context, req SealRequest) (SealResponse, error) {
+	sig, err := ts.pk.Sign(req.SignBytes)
+	return SealResponse{Signature: sig}, err
+}
+
+func readSealerAudit(t *testing.T, path string) (records []SealerAuditRecord) {
+	f, err := os.Open(path)
+	assert.Nil(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r SealerAuditRecord
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	return
+}
+
+func TestEvidenceSealer_Sign(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sealer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	auditPath := filepath.Join(dir, DefaultSealerAuditLogName)
+	pk := GetRandomPrivateKey()
+	msg := []byte("foo")
+	// no sealer, the local key
+	assert.Nil(t, InitEvidenceSealer("", 0, false, auditPath))
+	assert.Equal(t, pk, SealingKey(pk, MsgClaimName))
+	// the sealer on a unix socket
+	socket := filepath.Join(dir, "sealer.sock")
+	listener, err := net.Listen("unix", socket)
+	assert.Nil(t, err)
+	server := grpc.NewServer()
+	RegisterEvidenceSealerServer(server, testSealer{pk: pk})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+	assert.Nil(t, InitEvidenceSealer(unixSocketPrefix+socket, time.Second, false, auditPath))
+	defer func() { globalEvidenceSealer = nil }()
+	key := SealingKey(pk, MsgClaimName)
+	assert.Equal(t, pk.PublicKey(), key.PublicKey())
+	sig, err := key.Sign(msg)
+	assert.Nil(t, err)
+	assert.True(t, pk.PublicKey().VerifyBytes(msg, sig))
+	records := readSealerAudit(t, auditPath)
+	assert.Len(t, records, 1)
+	assert.Equal(t, MsgClaimName, records[0].MsgType)
+	assert.Equal(t, pk.PublicKey().RawString(), records[0].PublicKey)
+	assert.Equal(t, ExternalSigner, records[0].Signer)
+	assert.Empty(t, records[0].Error)
+	// the signature of another key is rejected
+	other := GetRandomPrivateKey()
+	_, err = SealingKey(other, MsgProofName).Sign(msg)
+	assert.NotNil(t, err)
+	records = readSealerAudit(t, auditPath)
+	assert.Len(t, records, 2)
+	assert.NotEmpty(t, records[1].Error)
+}
+
+func TestEvidenceSealer_Fallback(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sealer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	auditPath := filepath.Join(dir, DefaultSealerAuditLogName)
+	pk := GetRandomPrivateKey()
+	msg := []byte("foo")
+	// the sealer is down
+	socket := unixSocketPrefix + filepath.Join(dir, "sealer.sock")
+	assert.Nil(t, InitEvidenceSealer(socket, 100*time.Millisecond, false, auditPath))
+	defer func() { globalEvidenceSealer = nil }()
+	_, err = SealingKey(pk, MsgProofName).Sign(msg)
+	assert.NotNil(t, err)
+	// signed locally
+	assert.Nil(t, InitEvidenceSealer(socket, 100*time.Millisecond, true, auditPath))
+	sig, err := SealingKey(pk, MsgProofName).Sign(msg)
+	assert.Nil(t, err)
+	assert.True(t, pk.PublicKey().VerifyBytes(msg, sig))
+	records := readSealerAudit(t, auditPath)
+	assert.Len(t, records, 3)
+	assert.Equal(t, ExternalSigner, records[1].Signer)
+	assert.NotEmpty(t, records[1].Error)
+	assert.Equal(t, LocalSigner, records[2].Signer)
+	assert.Empty(t, records[2].Error)
+	assert.Equal(t, records[1].SignHash, records[2].SignHash)
+}
@@ -3,19 +3,26 @@ package types
 import (
 	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
 	nodesexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 )
 
 type PosKeeper interface {
-	RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.Address)
+	RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.Address) sdk.Int
+	NodeReward(ctx sdk.Ctx, reward sdk.Int) (nodeReward sdk.Int, feesCollected sdk.Int)
 	GetStakedTokens(ctx sdk.Ctx) sdk.Int
 	Validator(ctx sdk.Ctx, addr sdk.Address) nodesexported.ValidatorI
 	TotalTokens(ctx sdk.Ctx) sdk.Int
-	BurnForChallenge(ctx sdk.Ctx, challenges sdk.Int, address sdk.Address)
+	BurnForChallenge(ctx sdk.Ctx, challenges sdk.Int, address sdk.Address) sdk.Int
+	RewardForChallengeReport(ctx sdk.Ctx, amount sdk.Int, address sdk.Address) sdk.Int
+	RelaysToTokensMultiplier(ctx sdk.Ctx) sdk.Int
 	JailValidator(ctx sdk.Ctx, addr sdk.Address)
 	AllValidators(ctx sdk.Ctx) (validators []nodesexported.ValidatorI)
 	GetStakedValidators(ctx sdk.Ctx) (validators []nodesexported.ValidatorI)
 	BlocksPerSession(ctx sdk.Ctx) (res int64)
+	GetLatestSessionBlockHeight(ctx sdk.Ctx) int64
+	IsSessionBlock(ctx sdk.Ctx) bool
+	SessionBlockHeightAfter(ctx sdk.Ctx, sessionBlockHeight int64, n int64) (height int64, found bool)
 	StakeDenom(ctx sdk.Ctx) (res string)
 	GetValidatorsByChain(ctx sdk.Ctx, networkID string) (validators []nodesexported.ValidatorI)
 }
@@ -26,4 +33,5 @@ type AppsKeeper interface {
 	AllApplications(ctx sdk.Ctx) (applications []appexported.ApplicationI)
 	TotalTokens(ctx sdk.Ctx) sdk.Int
 	JailApplication(ctx sdk.Ctx, addr sdk.Address)
+	IsGatewayDelegated(ctx sdk.Ctx, appAddr sdk.Address, gatewayPubKey crypto.PublicKey) bool
 }
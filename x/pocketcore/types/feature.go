@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+const (
+	FeatureBlake2bProofLeafs = "blake2b_proof_leafs" // the leafs of the merkle trees of the proofs are hashed with blake2b
+)
+
+// "ProtocolFeature" - A protocol change activated at a height of the network, every node of the network must run a
+// version activating it at the same height
+type ProtocolFeature struct {
+	Name             string `json:"name"`
+	ActivationHeight int64  `json:"activation_height"` // the feature is active from this height, never if zero
+	TransitionBlocks int64  `json:"transition_blocks"` // the blocks after the activation the previous behavior is still accepted in
+}
+
+// "FeatureRegistry" - The protocol features of this version of pocket core
+type FeatureRegistry struct {
+	l sync.RWMutex
+	M map[string]ProtocolFeature
+}
+
+// "ProtocolFeatures" - The protocol features of this version of pocket core, scheduled by the upgrades
+var ProtocolFeatures = NewFeatureRegistry()
+
+func init() {
+	ProtocolFeatures.Register(ProtocolFeature{Name: FeatureBlake2bProofLeafs})
+}
+
+// "NewFeatureRegistry" - Returns an empty feature registry
+func NewFeatureRegistry() *FeatureRegistry {
+	return &FeatureRegistry{M: make(map[string]ProtocolFeature)}
+}
+
+// "Register" - Adds (or reschedules) the feature in the registry
+func (fr *FeatureRegistry) Register(f ProtocolFeature) {
+	if f.ActivationHeight < 0 || f.TransitionBlocks < 0 {
+		panic(fmt.Sprintf("feature %s has a negative activation height or transition", f.Name))
+	}
+	fr.l.Lock()
+	defer fr.l.Unlock()
+	fr.M[f.Name] = f
+}
+
+// "Get" - Returns the feature of the name
+func (fr *FeatureRegistry) Get(name string) (f ProtocolFeature, found bool) {
+	fr.l.RLock()
+	defer fr.l.RUnlock()
+	f, found = fr.M[name]
+	return
+}
+
+// "IsActive" - Returns whether the feature is active at the height
+func (fr *FeatureRegistry) IsActive(name string, height int64) bool {
+	f, found := fr.Get(name)
+	return found && f.ActivationHeight != 0 && height >= f.ActivationHeight
+}
+
+// "InTransition" - Returns whether the feature is active at the height and the previous behavior is still accepted
+func (fr *FeatureRegistry) InTransition(name string, height int64) bool {
+	f, found := fr.Get(name)
+	return found && f.ActivationHeight != 0 && height >= f.ActivationHeight && height < f.ActivationHeight+f.TransitionBlocks
+}
+
+// "Features" - Returns the features of the registry ordered by name
+func (fr *FeatureRegistry) Features() (features []ProtocolFeature) {
+	fr.l.RLock()
+	defer fr.l.RUnlock()
+	for _, f := range fr.M {
+		features = append(features, f)
+	}
+	sort.Slice(features, func(i, j int) bool { return features[i].Name < features[j].Name })
+	return
+}
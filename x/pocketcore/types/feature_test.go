@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeatureRegistry(t *testing.T) {
+	fr := NewFeatureRegistry()
+	// not registered
+	assert.False(t, fr.IsActive("feature", 10))
+	// not scheduled
+	fr.Register(ProtocolFeature{Name: "feature"})
+	assert.False(t, fr.IsActive("feature", 10))
+	assert.False(t, fr.InTransition("feature", 10))
+	// scheduled
+	fr.Register(ProtocolFeature{Name: "feature", ActivationHeight: 10, TransitionBlocks: 2})
+	assert.False(t, fr.IsActive("feature", 9))
+	assert.True(t, fr.IsActive("feature", 10))
+	assert.True(t, fr.InTransition("feature", 10))
+	assert.True(t, fr.InTransition("feature", 11))
+	assert.False(t, fr.InTransition("feature", 12))
+	assert.True(t, fr.IsActive("feature", 12))
+	fr.Register(ProtocolFeature{Name: "another"})
+	features := fr.Features()
+	assert.Len(t, features, 2)
+	assert.Equal(t, "another", features[0].Name)
+	assert.Panics(t, func() { fr.Register(ProtocolFeature{Name: "negative", ActivationHeight: -1}) })
+}
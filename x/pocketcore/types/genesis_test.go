@@ -211,12 +211,17 @@ func TestDefaultGenesisState(t *testing.T) {
 		}},
 	}
 	DefaultGenState := GenesisState{Params: Params{
-		SessionNodeCount:           DefaultSessionNodeCount,
-		ClaimSubmissionWindow:      DefaultClaimSubmissionWindow,
-		SupportedBlockchains:       DefaultSupportedBlockchains,
-		ClaimExpiration:            DefaultClaimExpiration,
-		ReplayAttackBurnMultiplier: DefaultReplayAttackBurnMultiplier,
-		MinimumNumberOfProofs:      DefaultMinimumNumberOfProofs,
+		SessionNodeCount:             DefaultSessionNodeCount,
+		ClaimSubmissionWindow:        DefaultClaimSubmissionWindow,
+		SupportedBlockchains:         DefaultSupportedBlockchains,
+		ClaimExpiration:              DefaultClaimExpiration,
+		ReplayAttackBurnMultiplier:   DefaultReplayAttackBurnMultiplier,
+		MinimumNumberOfProofs:        DefaultMinimumNumberOfProofs,
+		ChallengeReporterReward:      DefaultChallengeReporterReward,
+		ChainRegistry:                DefaultChainRegistry,
+		ClaimSubmissionWindowByChain: DefaultClaimSubmissionWindowByChain,
+		ReputationWeightedSessions:   DefaultReputationWeightedSessions,
+		ReputationWindow:             DefaultReputationWindow,
 	}}
 	tests := []struct {
 		name         string
@@ -0,0 +1,195 @@
+package types
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// the url schemes of the hosted chains only exposing gRPC, without and with transport security
+	GRPCScheme    = "grpc"
+	GRPCTLSScheme = "grpcs"
+	// the name of the raw codec, the content subtype of the protobuf messages expected by the chains
+	grpcRawCodecName = "proto"
+)
+
+// "GRPCResponse" - The response of a gRPC call relayed to a hosted chain: the status of the call and the serialized
+// (protobuf) response message
+type GRPCResponse struct {
+	Code    uint32 `json:"code"`    // the gRPC status code, 0 if ok
+	Message string `json:"message"` // the gRPC status message
+	Data    string `json:"data"`    // the serialized response message in base64
+}
+
+// "IsGRPC" - Returns true if the hosted chain only exposes gRPC (a grpc:// or grpcs:// url), the relays of which are
+// gRPC calls instead of http requests
+func (h HostedBlockchain) IsGRPC() bool {
+	scheme := strings.ToLower(strings.SplitN(h.URL, "://", 2)[0])
+	return scheme == GRPCScheme || scheme == GRPCTLSScheme
+}
+
+// the host:port of the gRPC chain and whether it is dialed with transport security
+func (h HostedBlockchain) grpcTarget() (target string, secure bool, err sdk.Error) {
+	u, er := url.Parse(h.URL)
+	if er != nil {
+		return "", false, NewInvalidChainHTTPConfigError(ModuleName, fmt.Sprintf("invalid gRPC url: %s", er.Error()))
+	}
+	if u.Host == "" || u.Port() == "" || strings.Trim(u.Path, "/") != "" || u.RawQuery != "" {
+		return "", false, NewInvalidChainHTTPConfigError(ModuleName, fmt.Sprintf("the gRPC url %s must be <scheme>://<host>:<port>", h.URL))
+	}
+	return u.Host, strings.EqualFold(u.Scheme, GRPCTLSScheme), nil
+}
+
+// "NewGRPCConn" - Returns a new (lazily connected) gRPC connection to the hosted chain, per its http configuration
+// (the dial timeout and the tls of a grpcs:// chain)
+func (h HostedBlockchain) NewGRPCConn() (*grpc.ClientConn, sdk.Error) {
+	if err := h.HTTP.Validate(); err != nil {
+		return nil, err
+	}
+	target, secure, err := h.grpcTarget()
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{Timeout: durationOrDefault(h.HTTP.DialTimeout, DefaultChainDialTimeout)}).DialContext(ctx, "tcp", addr)
+		}),
+	}
+	if globalUserAgent != "" {
+		opts = append(opts, grpc.WithUserAgent(globalUserAgent))
+	}
+	if secure {
+		tlsConfig, err := h.HTTP.TLS.newTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, er := grpc.Dial(target, opts...)
+	if er != nil {
+		return nil, NewInvalidChainHTTPConfigError(ModuleName, er.Error())
+	}
+	return conn, nil
+}
+
+// "GRPCMethod" - Returns the full gRPC method of the payload (its path, e.g. /cosmos.bank.v1beta1.Query/Balance)
+func (p Payload) GRPCMethod() (string, sdk.Error) {
+	method := "/" + strings.TrimLeft(p.Path, "/")
+	parts := strings.Split(method, "/")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" || strings.ContainsAny(method, "?# ") {
+		return "", NewInvalidPayloadError(ModuleName, fmt.Sprintf("the path %q is not a gRPC method (/<package>.<service>/<method>)", p.Path))
+	}
+	if p.HTTPMethod() != http.MethodPost {
+		return "", NewInvalidPayloadError(ModuleName, "the gRPC calls are POST requests")
+	}
+	return method, nil
+}
+
+// "GRPCRequest" - Returns the serialized (protobuf) request message of the payload, its data in base64 (empty for an
+// empty message)
+func (p Payload) GRPCRequest() ([]byte, sdk.Error) {
+	bz, err := base64.StdEncoding.DecodeString(p.Data)
+	if err != nil {
+		return nil, NewInvalidPayloadError(ModuleName, "the data of a gRPC call must be the request message in base64")
+	}
+	return bz, nil
+}
+
+// "executeGRPCRequest" - Calls the method on the gRPC chain with the serialized request, the headers of the payload and
+// the credentials of the chain sent as metadata, returns the (json) response and the response metadata as headers. The
+// transport failures are returned as errors (so they are retried), the other statuses are relayed in the response
+func executeGRPCRequest(conn *grpc.ClientConn, method string, req []byte, headers map[string]string, credentials ChainCredentials, timeout int64) (string, http.Header, error) {
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Millisecond)
+	}
+	defer cancel()
+	md := metadata.MD{}
+	for k, v := range headers {
+		md.Set(k, v)
+	}
+	// inject the credentials last, so they can't be overridden by the relay headers
+	credentials.applyMetadata(md)
+	var header, trailer metadata.MD
+	var res []byte
+	er := conn.Invoke(metadata.NewOutgoingContext(ctx, md), method, req, &res,
+		grpc.ForceCodec(grpcRawCodec{}), grpc.Header(&header), grpc.Trailer(&trailer))
+	s, _ := status.FromError(er)
+	switch s.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return "", nil, er
+	}
+	bz, er := json.Marshal(GRPCResponse{Code: uint32(s.Code()), Message: s.Message(), Data: base64.StdEncoding.EncodeToString(res)})
+	if er != nil {
+		return "", nil, er
+	}
+	return string(bz), grpcHeader(header, trailer), nil
+}
+
+// "applyMetadata" - Injects the credentials into the metadata of a gRPC call, as the http headers of a request
+func (cc ChainCredentials) applyMetadata(md metadata.MD) {
+	if cc.BasicAuth.Username != "" {
+		auth := base64.StdEncoding.EncodeToString([]byte(cc.BasicAuth.Username + ":" + cc.BasicAuth.Password))
+		md.Set("authorization", "Basic "+auth)
+	}
+	if cc.BearerToken != "" {
+		md.Set("authorization", "Bearer "+cc.BearerToken)
+	}
+	if cc.APIKey != "" {
+		md.Set(cc.APIKeyParam, cc.APIKey)
+	}
+}
+
+// the response metadata (headers and trailers) of a gRPC call as http headers, so they can pass through to the client
+func grpcHeader(mds ...metadata.MD) http.Header {
+	header := http.Header{}
+	for _, md := range mds {
+		for k, values := range md {
+			for _, v := range values {
+				header.Add(k, v)
+			}
+		}
+	}
+	return header
+}
+
+// the codec of the relayed gRPC calls: the messages are already serialized (protobuf) by the clients, so they are sent
+// and received as is. Forced per call, never registered, so the protobuf codec of the process is untouched
+type grpcRawCodec struct{}
+
+func (grpcRawCodec) Marshal(v interface{}) ([]byte, error) {
+	bz, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("the raw codec cannot marshal %T", v)
+	}
+	return bz, nil
+}
+
+func (grpcRawCodec) Unmarshal(data []byte, v interface{}) error {
+	bz, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("the raw codec cannot unmarshal into %T", v)
+	}
+	*bz = append((*bz)[:0], data...)
+	return nil
+}
+
+func (grpcRawCodec) Name() string {
+	return grpcRawCodecName
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// the raw codec of the test chain (the server codec needs a String method)
+type testRawServerCodec struct {
+	grpcRawCodec
+}
+
+func (testRawServerCodec) String() string {
+	return grpcRawCodecName
+}
+
+// a gRPC chain echoing the requests of any method, failing the Fail method
+func newTestGRPCChain(t *testing.T) (url string, stop func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer(grpc.CustomCodec(testRawServerCodec{}), grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+		method, _ := grpc.MethodFromServerStream(stream)
+		var req []byte
+		if err := stream.RecvMsg(&req); err != nil {
+			return err
+		}
+		if method == "/test.Chain/Fail" {
+			return status.Error(codes.NotFound, "not found")
+		}
+		md, _ := metadata.FromIncomingContext(stream.Context())
+		_ = stream.SetHeader(metadata.Pairs("x-height", "10", "x-auth", md.Get("authorization")[0]))
+		return stream.SendMsg(append([]byte(method), req...))
+	}))
+	go func() { _ = server.Serve(listener) }()
+	return "grpc://" + listener.Addr().String(), server.Stop
+}
+
+func TestPayload_GRPCMethod(t *testing.T) {
+	method, err := Payload{Path: "cosmos.bank.v1beta1.Query/Balance"}.GRPCMethod()
+	assert.Nil(t, err)
+	assert.Equal(t, "/cosmos.bank.v1beta1.Query/Balance", method)
+	for _, p := range []Payload{{Path: "/foo"}, {Path: "/foo/bar/baz"}, {Path: "/foo/bar?x=1"}, {Method: "GET", Path: "/foo/bar"}} {
+		_, err := p.GRPCMethod()
+		assert.NotNil(t, err, p.Path)
+	}
+	_, err = Payload{Data: "not base64!"}.GRPCRequest()
+	assert.NotNil(t, err)
+}
+
+func TestHostedBlockchain_IsGRPC(t *testing.T) {
+	assert.True(t, HostedBlockchain{URL: "grpc://localhost:9090"}.IsGRPC())
+	assert.True(t, HostedBlockchain{URL: "GRPCS://localhost:9090"}.IsGRPC())
+	assert.False(t, HostedBlockchain{URL: "https://localhost:9090"}.IsGRPC())
+	chain := hex.EncodeToString([]byte{02})
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{chain: {ID: chain, URL: "grpc://localhost:9090/foo"}}}
+	assert.NotNil(t, hb.Validate())
+	hb.M[chain] = HostedBlockchain{ID: chain, URL: "grpc://localhost:9090"}
+	assert.Nil(t, hb.Validate())
+}
+
+func TestRelay_ExecuteGRPC(t *testing.T) {
+	url, stop := newTestGRPCChain(t)
+	defer stop()
+	chain := hex.EncodeToString([]byte{02})
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{chain: {ID: chain, URL: url, HTTP: ChainHTTPConfig{Timeout: 1000, ResponseHeaders: []string{"X-Height"}},
+			Credentials: ChainCredentials{BearerToken: "foo"}}},
+	}
+	relay := Relay{
+		Payload: Payload{Path: "/test.Chain/Echo", Data: base64.StdEncoding.EncodeToString([]byte("bar"))},
+		Proof:   RelayProof{Blockchain: chain},
+	}
+	res, headers, err := relay.ExecuteWithHeaders(&hb)
+	assert.Nil(t, err)
+	var response GRPCResponse
+	assert.Nil(t, json.Unmarshal([]byte(res), &response))
+	assert.Equal(t, uint32(codes.OK), response.Code)
+	bz, _ := base64.StdEncoding.DecodeString(response.Data)
+	assert.Equal(t, "/test.Chain/Echobar", string(bz))
+	// the allowed response metadata passes through
+	assert.Equal(t, map[string]string{"X-Height": "10"}, headers)
+	// the connection is reused
+	conn, err := hb.GetGRPCConn(chain)
+	assert.Nil(t, err)
+	conn2, _ := hb.GetGRPCConn(chain)
+	assert.Equal(t, conn, conn2)
+	// the statuses of the chain are relayed
+	relay.Payload.Path = "/test.Chain/Fail"
+	res, err = relay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Nil(t, json.Unmarshal([]byte(res), &response))
+	assert.Equal(t, uint32(codes.NotFound), response.Code)
+	assert.Equal(t, "not found", response.Message)
+	// a chain down is an error
+	stop()
+	_, err = relay.Execute(&hb)
+	assert.NotNil(t, err)
+}
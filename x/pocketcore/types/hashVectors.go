@@ -0,0 +1,152 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+// "HashVectors" - The canonical test vectors of the hashing and signing done by the clients (sdks), computed by this
+// node with well known (insecure) keys so the sdk implementations can be validated against it
+type HashVectors struct {
+	TokenVersion string            `json:"token_version"` // the aat version of the vectors
+	Keys         HashVectorKeys    `json:"keys"`          // the keys used to sign the vectors
+	AAT          HashVector        `json:"aat"`           // the application authentication token
+	RelayRequest HashVector        `json:"relay_request"` // the request hash of the relay (payload and meta)
+	RelayProof   HashVector        `json:"relay_proof"`   // the relay proof signed by the client
+	SessionKey   SessionHashVector `json:"session_key"`   // the session key derivation
+}
+
+// "HashVectorKeys" - The well known private keys (hex) used to sign the vectors, never use them for anything else
+type HashVectorKeys struct {
+	AppPrivateKey      string `json:"app_private_key"`
+	AppPublicKey       string `json:"app_public_key"`
+	ClientPrivateKey   string `json:"client_private_key"`
+	ClientPublicKey    string `json:"client_public_key"`
+	ServicerPrivateKey string `json:"servicer_private_key"`
+	ServicerPublicKey  string `json:"servicer_public_key"`
+}
+
+// "HashVector" - An object, the exact bytes hashed (json) and the resulting hash (and signature if signed)
+type HashVector struct {
+	Input     interface{} `json:"input"`               // the object hashed
+	Preimage  string      `json:"preimage"`            // the serialization of the object that is hashed
+	Hash      string      `json:"hash"`                // the hex hash of the preimage
+	Signer    string      `json:"signer,omitempty"`    // the public key signing the hash
+	Signature string      `json:"signature,omitempty"` // the hex ed25519 signature of the hash bytes
+}
+
+// "SessionHashVector" - The inputs and the resulting session key
+type SessionHashVector struct {
+	AppPublicKey string `json:"app_public_key"`
+	Chain        string `json:"chain"`
+	BlockHash    string `json:"block_hash"`
+	Preimage     string `json:"preimage"`
+	SessionKey   string `json:"session_key"`
+}
+
+// the relay fields covered by the request hash
+type relayRequest struct {
+	Payload Payload   `json:"payload"`
+	Meta    RelayMeta `json:"meta"`
+}
+
+// the well known keys of the vectors
+func hashVectorKey(secret string) crypto.Ed25519PrivateKey {
+	return crypto.Ed25519PrivateKey(ed25519.GenPrivKeyFromSecret([]byte(secret)))
+}
+
+// "NewHashVectors" - Computes the canonical test vectors of the current protocol version
+func NewHashVectors() (HashVectors, error) {
+	appKey := hashVectorKey("pocket hash vectors app")
+	clientKey := hashVectorKey("pocket hash vectors client")
+	servicerKey := hashVectorKey("pocket hash vectors servicer")
+	chain := hex.EncodeToString([]byte{01})
+	// the aat, signed by the app
+	aat := AAT{
+		Version:              SupportedTokenVersions[len(SupportedTokenVersions)-1],
+		ApplicationPublicKey: appKey.PublicKey().RawString(),
+		ClientPublicKey:      clientKey.PublicKey().RawString(),
+	}
+	aatSig, err := appKey.Sign(aat.Hash())
+	if err != nil {
+		return HashVectors{}, err
+	}
+	aat.ApplicationSignature = hex.EncodeToString(aatSig)
+	// the relay request
+	relay := Relay{
+		Payload: Payload{
+			Data:    `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`,
+			Method:  "POST",
+			Headers: map[string]string{"Content-Type": "application/json"},
+		},
+		Meta: RelayMeta{BlockHeight: 100},
+	}
+	// the relay proof, signed by the client
+	proof := RelayProof{
+		RequestHash:        relay.RequestHashString(),
+		Entropy:            1234567890,
+		SessionBlockHeight: 97,
+		ServicerPubKey:     servicerKey.PublicKey().RawString(),
+		Blockchain:         chain,
+		Token:              aat,
+	}
+	proofSig, err := clientKey.Sign(proof.Hash())
+	if err != nil {
+		return HashVectors{}, err
+	}
+	proof.Signature = hex.EncodeToString(proofSig)
+	// the session key
+	blockHash := hex.EncodeToString(Hash([]byte("pocket hash vectors block")))
+	key, er := NewSessionKey(aat.ApplicationPublicKey, chain, blockHash)
+	if er != nil {
+		return HashVectors{}, er
+	}
+	sessionPreimage, err := json.Marshal(sessionKey{
+		AppPublicKey:   aat.ApplicationPublicKey,
+		NonNativeChain: chain,
+		BlockHash:      blockHash,
+	})
+	if err != nil {
+		return HashVectors{}, err
+	}
+	return HashVectors{
+		TokenVersion: aat.Version,
+		Keys: HashVectorKeys{
+			AppPrivateKey:      appKey.RawString(),
+			AppPublicKey:       appKey.PublicKey().RawString(),
+			ClientPrivateKey:   clientKey.RawString(),
+			ClientPublicKey:    clientKey.PublicKey().RawString(),
+			ServicerPrivateKey: servicerKey.RawString(),
+			ServicerPublicKey:  servicerKey.PublicKey().RawString(),
+		},
+		AAT: HashVector{
+			Input:     aat,
+			Preimage:  string(aat.Bytes()),
+			Hash:      aat.HashString(),
+			Signer:    aat.ApplicationPublicKey,
+			Signature: aat.ApplicationSignature,
+		},
+		RelayRequest: HashVector{
+			Input:    relayRequest{Payload: relay.Payload, Meta: relay.Meta},
+			Preimage: string(relay.Bytes()),
+			Hash:     relay.RequestHashString(),
+		},
+		RelayProof: HashVector{
+			Input:     proof,
+			Preimage:  string(proof.Bytes()),
+			Hash:      proof.HashString(),
+			Signer:    aat.ClientPublicKey,
+			Signature: proof.Signature,
+		},
+		SessionKey: SessionHashVector{
+			AppPublicKey: aat.ApplicationPublicKey,
+			Chain:        chain,
+			BlockHash:    blockHash,
+			Preimage:     string(sessionPreimage),
+			SessionKey:   hex.EncodeToString(key),
+		},
+	}, nil
+}
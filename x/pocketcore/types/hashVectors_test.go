@@ -0,0 +1,39 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHashVectors(t *testing.T) {
+	vectors, err := NewHashVectors()
+	assert.Nil(t, err)
+	// the hashes are the hashes of the preimages and the signatures are valid
+	for _, v := range []HashVector{vectors.AAT, vectors.RelayRequest, vectors.RelayProof} {
+		assert.Equal(t, hex.EncodeToString(Hash([]byte(v.Preimage))), v.Hash)
+		if v.Signer == "" {
+			continue
+		}
+		pk, err := crypto.NewPublicKey(v.Signer)
+		assert.Nil(t, err)
+		hash, _ := hex.DecodeString(v.Hash)
+		sig, _ := hex.DecodeString(v.Signature)
+		assert.True(t, pk.VerifyBytes(hash, sig))
+	}
+	assert.Equal(t, hex.EncodeToString(Hash([]byte(vectors.SessionKey.Preimage))), vectors.SessionKey.SessionKey)
+	// the vectors must never change for a token version, a change here breaks every sdk
+	assert.Equal(t, "0.0.1", vectors.TokenVersion)
+	assert.Equal(t, "b2d6d81eac154883e065e33ccfb0a8bea6028eaffc6f62be4d687af014eec14c", vectors.AAT.Hash)
+	assert.Equal(t, "7b404fc694d936879a6918a5675f03236d4e051882b83486cfabfa6ddf9a8ee47eca5e2dfc689e0097fe3666a0696418f85da46ab573693a2d5a4f64f7a3a903", vectors.AAT.Signature)
+	assert.Equal(t, "d1951ccc7a9c174299dad52a8944c414269f2c9040b5a188612c06cd768a4aa8", vectors.RelayRequest.Hash)
+	assert.Equal(t, "8936ee84efb008850036f12c48174bec835e34c1f6c4db950efb3fe818394744", vectors.RelayProof.Hash)
+	assert.Equal(t, "31e4beb1c980b55621e2fc9493a4a600e33f7fae7342d79e48072343e0ef6b2b7982ecfd3dedf9136dd172e4abc37a92cfeb6c885c885fadd0af551baef24407", vectors.RelayProof.Signature)
+	assert.Equal(t, "bc88d44a982c5b7838cf30b0f1f6a61381a58e82e603bff788c05b38e376f718", vectors.SessionKey.SessionKey)
+	// the signed relay proof is valid for the servicer
+	proof := vectors.RelayProof.Input.(RelayProof)
+	assert.Nil(t, SignatureVerification(proof.Token.ClientPublicKey, proof.HashString(), proof.Signature))
+	assert.Nil(t, proof.Token.Validate())
+}
@@ -1,15 +1,41 @@
 package types
 
 import (
-	sdk "github.com/pokt-network/posmint/types"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
 	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"google.golang.org/grpc"
+)
+
+var (
+	// the defaults of the http clients relaying to the hosted blockchains (the ones of the default transport, but with as
+	// many idle connections per host as overall, as every chain has its own client and usually a single host)
+	DefaultChainDialTimeout         = 30 * time.Second
+	DefaultChainIdleConnTimeout     = 90 * time.Second
+	DefaultChainMaxIdleConns        = 100
+	DefaultChainMaxIdleConnsPerHost = 100
+	// the timeout of the tls handshake with the hosted blockchains
+	chainTLSHandshakeTimeout = 10 * time.Second
 )
 
 // HostedBlockchain" - An object that represents a local hosted non-native blockchain
 type HostedBlockchain struct {
-	ID        string    `json:"id"`         // network identifier of the hosted blockchain
-	URL       string    `json:"url"`        // url of the hosted blockchain
-	BasicAuth BasicAuth `json:"basic_auth"` // basic http auth optinal
+	ID        string          `json:"id"`         // network identifier of the hosted blockchain
+	URL       string          `json:"url"`        // url of the hosted blockchain
+	BasicAuth BasicAuth       `json:"basic_auth"` // basic http auth optinal
+	HTTP      ChainHTTPConfig `json:"http"`       // the http client relaying to the hosted blockchain (optional)
+	// the request probing the health of the hosted blockchain, e.g. a height query (optional, a ping if empty)
+	HealthCheck *Payload `json:"health_check,omitempty"`
+	// credentials loaded from the secrets file / environment, never written to the chains file
+	Credentials ChainCredentials `json:"-"`
 }
 
 type BasicAuth struct {
@@ -17,10 +43,258 @@ type BasicAuth struct {
 	Password string `json:"password"`
 }
 
+// "ChainCredentials" - The credentials injected into every relay executed against a hosted blockchain
+type ChainCredentials struct {
+	BasicAuth   BasicAuth `json:"basic_auth"`    // basic http auth
+	BearerToken string    `json:"bearer_token"`  // sent as 'Authorization: Bearer <token>'
+	APIKeyParam string    `json:"api_key_param"` // the name of the query parameter that holds the api key
+	APIKey      string    `json:"api_key"`       // the api key
+}
+
+// "IsEmpty" - Returns true if no credentials are set
+func (cc ChainCredentials) IsEmpty() bool {
+	return cc.BasicAuth.Username == "" && cc.BearerToken == "" && cc.APIKey == ""
+}
+
+// "Validate" - Validates the chain credentials
+func (cc ChainCredentials) Validate() error {
+	// both basic auth and bearer tokens use the authorization header
+	if cc.BasicAuth.Username != "" && cc.BearerToken != "" {
+		return NewInvalidChainCredentialsError(ModuleName, "basic auth and bearer token are mutually exclusive")
+	}
+	if cc.APIKey != "" && cc.APIKeyParam == "" {
+		return NewInvalidChainCredentialsError(ModuleName, "an api key needs an api key param")
+	}
+	return nil
+}
+
+// "Apply" - Injects the credentials into the http request
+func (cc ChainCredentials) Apply(req *http.Request) {
+	if cc.BasicAuth.Username != "" {
+		req.SetBasicAuth(cc.BasicAuth.Username, cc.BasicAuth.Password)
+	}
+	if cc.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+cc.BearerToken)
+	}
+	if cc.APIKey != "" {
+		q := req.URL.Query()
+		q.Set(cc.APIKeyParam, cc.APIKey)
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// "GetCredentials" - Returns the credentials of the hosted blockchain, falling back to the basic auth of the chains file
+func (h HostedBlockchain) GetCredentials() ChainCredentials {
+	if h.Credentials.IsEmpty() {
+		return ChainCredentials{BasicAuth: h.BasicAuth}
+	}
+	return h.Credentials
+}
+
+// "ChainHTTPConfig" - The configuration of the http client relaying to a hosted blockchain, the connections of which
+// are pooled and kept alive across relays. The zero values use the defaults
+type ChainHTTPConfig struct {
+	Timeout             int64          `json:"timeout"`                 // the timeout of a request in milliseconds, none if zero
+	DialTimeout         int64          `json:"dial_timeout"`            // the timeout of a connection in milliseconds
+	IdleConnTimeout     int64          `json:"idle_conn_timeout"`       // how long an idle connection is kept alive in milliseconds
+	MaxIdleConns        int            `json:"max_idle_conns"`          // the max idle connections of the pool
+	MaxIdleConnsPerHost int            `json:"max_idle_conns_per_host"` // the max idle connections of the pool per host
+	MaxConnsPerHost     int            `json:"max_conns_per_host"`      // the max connections per host, no limit if zero
+	DisableKeepAlives   bool           `json:"disable_keep_alives"`     // a connection per relay
+	Retries             int            `json:"retries"`                 // the re-attempts of a failed (e.g. timed out) request
+	RetryBackoff        int64          `json:"retry_backoff"`           // the wait before the first re-attempt in milliseconds, doubled after
+	ResponseHeaders     []string       `json:"response_headers"`        // the response headers of the chain passed through to the clients
+	TLS                 ChainTLSConfig `json:"tls"`
+}
+
+// "ChainTLSConfig" - The tls configuration of the connections to a hosted blockchain
+type ChainTLSConfig struct {
+	CAFile             string `json:"ca_file"`              // the pem certificate authorities trusted besides the system ones
+	CertFile           string `json:"cert_file"`            // the pem client certificate (mutual tls)
+	KeyFile            string `json:"key_file"`             // the pem key of the client certificate
+	ServerName         string `json:"server_name"`          // overrides the host name verified in the server certificate
+	InsecureSkipVerify bool   `json:"insecure_skip_verify"` // skips the verification of the server certificate (testing only)
+}
+
+// "Validate" - Validates the http configuration of the hosted blockchain
+func (hc ChainHTTPConfig) Validate() sdk.Error {
+	if hc.Timeout < 0 || hc.DialTimeout < 0 || hc.IdleConnTimeout < 0 {
+		return NewInvalidChainHTTPConfigError(ModuleName, "the timeouts cannot be negative")
+	}
+	if hc.MaxIdleConns < 0 || hc.MaxIdleConnsPerHost < 0 || hc.MaxConnsPerHost < 0 {
+		return NewInvalidChainHTTPConfigError(ModuleName, "the connection limits cannot be negative")
+	}
+	if hc.Retries < 0 || hc.RetryBackoff < 0 {
+		return NewInvalidChainHTTPConfigError(ModuleName, "the retries and the retry backoff cannot be negative")
+	}
+	for _, header := range hc.ResponseHeaders {
+		if header == "" || strings.ContainsAny(header, " \t\r\n:") {
+			return NewInvalidChainHTTPConfigError(ModuleName, fmt.Sprintf("invalid response header %q", header))
+		}
+	}
+	if (hc.TLS.CertFile == "") != (hc.TLS.KeyFile == "") {
+		return NewInvalidChainHTTPConfigError(ModuleName, "a client certificate needs both a cert file and a key file")
+	}
+	return nil
+}
+
+// "NewClient" - Returns a new http client with its own connection pool, per the http configuration
+func (hc ChainHTTPConfig) NewClient() (*http.Client, sdk.Error) {
+	if err := hc.Validate(); err != nil {
+		return nil, err
+	}
+	tlsConfig, err := hc.TLS.newTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: hc.newTransport(tlsConfig), Timeout: time.Duration(hc.Timeout) * time.Millisecond}, nil
+}
+
+// the pooled transport of the client: a clone of the default transport (proxy from the environment, http2) with the
+// limits of the configuration
+func (hc ChainHTTPConfig) newTransport(tlsConfig *tls.Config) http.RoundTripper {
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		// the default transport is replaced process wide (e.g. instrumented or mocked), it is used as is
+		return http.DefaultTransport
+	}
+	transport := base.Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   durationOrDefault(hc.DialTimeout, DefaultChainDialTimeout),
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSClientConfig = tlsConfig
+	transport.TLSHandshakeTimeout = chainTLSHandshakeTimeout
+	transport.IdleConnTimeout = durationOrDefault(hc.IdleConnTimeout, DefaultChainIdleConnTimeout)
+	transport.MaxIdleConns = intOrDefault(hc.MaxIdleConns, DefaultChainMaxIdleConns)
+	transport.MaxIdleConnsPerHost = intOrDefault(hc.MaxIdleConnsPerHost, DefaultChainMaxIdleConnsPerHost)
+	transport.MaxConnsPerHost = hc.MaxConnsPerHost
+	transport.DisableKeepAlives = hc.DisableKeepAlives
+	return transport
+}
+
+// the tls configuration of the client, nil for the defaults
+func (tc ChainTLSConfig) newTLSConfig() (*tls.Config, sdk.Error) {
+	if tc == (ChainTLSConfig{}) {
+		return nil, nil
+	}
+	config := &tls.Config{ServerName: tc.ServerName, InsecureSkipVerify: tc.InsecureSkipVerify}
+	if tc.CAFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		bz, err := ioutil.ReadFile(tc.CAFile)
+		if err != nil {
+			return nil, NewInvalidChainHTTPConfigError(ModuleName, err.Error())
+		}
+		if !pool.AppendCertsFromPEM(bz) {
+			return nil, NewInvalidChainHTTPConfigError(ModuleName, fmt.Sprintf("no pem certificates in %s", tc.CAFile))
+		}
+		config.RootCAs = pool
+	}
+	if tc.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(tc.CertFile, tc.KeyFile)
+		if err != nil {
+			return nil, NewInvalidChainHTTPConfigError(ModuleName, err.Error())
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// "PassthroughHeaders" - Returns the response headers of the chain allowed to pass through to the clients (canonical
+// names, the values of a repeated header joined), nil if none
+func (hc ChainHTTPConfig) PassthroughHeaders(header http.Header) (headers map[string]string) {
+	for _, name := range hc.ResponseHeaders {
+		name = http.CanonicalHeaderKey(name)
+		values := header[name]
+		if len(values) == 0 {
+			continue
+		}
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+		headers[name] = strings.Join(values, ", ")
+	}
+	return
+}
+
+func durationOrDefault(millis int64, def time.Duration) time.Duration {
+	if millis == 0 {
+		return def
+	}
+	return time.Duration(millis) * time.Millisecond
+}
+
+func intOrDefault(i, def int) int {
+	if i == 0 {
+		return def
+	}
+	return i
+}
+
 // HostedBlockchains" - An object that represents the local hosted non-native blockchains
 type HostedBlockchains struct {
-	M map[string]HostedBlockchain // M[addr] -> addr, url
-	o sync.Once
+	M       map[string]HostedBlockchain // M[addr] -> addr, url
+	o       sync.Once
+	l       sync.Mutex
+	clients map[string]*http.Client     // the pooled http client of each hosted blockchain
+	conns   map[string]*grpc.ClientConn // the gRPC connection of each hosted blockchain only exposing gRPC
+}
+
+// "GetClient" - Returns the http client of the hosted blockchain, created on its first relay and reused after, so the
+// connections to the chain are kept alive across relays
+func (c *HostedBlockchains) GetClient(id string) (*http.Client, sdk.Error) {
+	chain, err := c.GetChain(id)
+	if err != nil {
+		return nil, err
+	}
+	c.l.Lock()
+	defer c.l.Unlock()
+	if client, found := c.clients[id]; found {
+		return client, nil
+	}
+	client, err := chain.HTTP.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	if c.clients == nil {
+		c.clients = make(map[string]*http.Client)
+	}
+	c.clients[id] = client
+	return client, nil
+}
+
+// "GetGRPCConn" - Returns the gRPC connection of the hosted blockchain, created on its first relay and reused after
+func (c *HostedBlockchains) GetGRPCConn(id string) (*grpc.ClientConn, sdk.Error) {
+	chain, err := c.GetChain(id)
+	if err != nil {
+		return nil, err
+	}
+	c.l.Lock()
+	defer c.l.Unlock()
+	if conn, found := c.conns[id]; found {
+		return conn, nil
+	}
+	conn, err := chain.NewGRPCConn()
+	if err != nil {
+		return nil, err
+	}
+	if c.conns == nil {
+		c.conns = make(map[string]*grpc.ClientConn)
+	}
+	c.conns[id] = conn
+	return conn, nil
+}
+
+// "CloseIdleConnections" - Closes the idle (kept alive) connections to the hosted blockchains
+func (c *HostedBlockchains) CloseIdleConnections() {
+	c.l.Lock()
+	defer c.l.Unlock()
+	for _, client := range c.clients {
+		client.CloseIdleConnections()
+	}
 }
 
 // "Contains" - Checks to see if the hosted chain is within the HostedBlockchains object
@@ -61,6 +335,27 @@ func (c *HostedBlockchains) Validate() error {
 		if err := NetworkIdentifierVerification(chain.ID); err != nil {
 			return err
 		}
+		// validate the http configuration
+		if err := chain.HTTP.Validate(); err != nil {
+			return err
+		}
+		// validate the host:port of a gRPC chain
+		if chain.IsGRPC() {
+			if _, _, err := chain.grpcTarget(); err != nil {
+				return err
+			}
+		}
+		// validate the health check request
+		if chain.HealthCheck != nil {
+			if err := chain.HealthCheck.validateHTTP(); err != nil {
+				return err
+			}
+			if chain.IsGRPC() {
+				if _, err := chain.HealthCheck.GRPCMethod(); err != nil {
+					return err
+				}
+			}
+		}
 	}
 	return nil
 }
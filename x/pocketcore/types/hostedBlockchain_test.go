@@ -2,9 +2,19 @@ package types
 
 import (
 	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -91,3 +101,263 @@ func TestHostedBlockchains_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestChainCredentials_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials ChainCredentials
+		hasError    bool
+	}{
+		{
+			name:        "Empty credentials",
+			credentials: ChainCredentials{},
+			hasError:    false,
+		},
+		{
+			name:        "Basic auth and bearer token",
+			credentials: ChainCredentials{BasicAuth: BasicAuth{Username: "foo", Password: "bar"}, BearerToken: "baz"},
+			hasError:    true,
+		},
+		{
+			name:        "API key without param",
+			credentials: ChainCredentials{APIKey: "baz"},
+			hasError:    true,
+		},
+		{
+			name:        "Bearer token and API key",
+			credentials: ChainCredentials{BearerToken: "baz", APIKeyParam: "key", APIKey: "baz"},
+			hasError:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.credentials.Validate() != nil, tt.hasError)
+		})
+	}
+}
+
+func TestHostedBlockchain_GetCredentials(t *testing.T) {
+	basicAuth := BasicAuth{Username: "foo", Password: "bar"}
+	// falls back to the basic auth of the chains file
+	hb := HostedBlockchain{BasicAuth: basicAuth}
+	assert.Equal(t, ChainCredentials{BasicAuth: basicAuth}, hb.GetCredentials())
+	// the secrets take precedence
+	hb.Credentials = ChainCredentials{BearerToken: "baz"}
+	assert.Equal(t, ChainCredentials{BearerToken: "baz"}, hb.GetCredentials())
+}
+
+func TestChainHTTPConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   ChainHTTPConfig
+		hasError bool
+	}{
+		{
+			name:     "Default config",
+			config:   ChainHTTPConfig{},
+			hasError: false,
+		},
+		{
+			name:     "Negative timeout",
+			config:   ChainHTTPConfig{Timeout: -1},
+			hasError: true,
+		},
+		{
+			name:     "Negative max idle connections",
+			config:   ChainHTTPConfig{MaxIdleConnsPerHost: -1},
+			hasError: true,
+		},
+		{
+			name:     "Negative retries",
+			config:   ChainHTTPConfig{Retries: -1},
+			hasError: true,
+		},
+		{
+			name:     "Invalid response header",
+			config:   ChainHTTPConfig{ResponseHeaders: []string{"X-Rate: Limit"}},
+			hasError: true,
+		},
+		{
+			name:     "Client certificate without key",
+			config:   ChainHTTPConfig{TLS: ChainTLSConfig{CertFile: "cert.pem"}},
+			hasError: true,
+		},
+		{
+			name:     "Valid config",
+			config:   ChainHTTPConfig{Timeout: 1000, MaxIdleConns: 10, TLS: ChainTLSConfig{CertFile: "cert.pem", KeyFile: "key.pem"}},
+			hasError: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.config.Validate() != nil, tt.hasError)
+		})
+	}
+}
+
+// a hosted blockchain counting the connections it accepts
+func newCountingChain(handler http.HandlerFunc) (*httptest.Server, *int64) {
+	var conns int64
+	server := httptest.NewUnstartedServer(handler)
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(&conns, 1)
+		}
+	}
+	return server, &conns
+}
+
+func TestHostedBlockchains_GetClient(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{
+		ethereum: {ID: ethereum, URL: "https://www.google.com:443"},
+		bitcoin:  {ID: bitcoin, URL: "https://www.google.com:443", HTTP: ChainHTTPConfig{TLS: ChainTLSConfig{CAFile: "missing.pem"}}},
+	}}
+	client, err := hb.GetClient(ethereum)
+	assert.Nil(t, err)
+	// the client is reused
+	client2, err := hb.GetClient(ethereum)
+	assert.Nil(t, err)
+	assert.True(t, client == client2)
+	_, err = hb.GetClient(bitcoin)
+	assert.NotNil(t, err)
+	_, err = hb.GetClient(hex.EncodeToString([]byte{03}))
+	assert.NotNil(t, err)
+}
+
+func TestRelay_ExecuteKeepAlive(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	server, conns := newCountingChain(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("bar"))
+	})
+	server.Start()
+	defer server.Close()
+	relay := Relay{Payload: Payload{Data: "foo", Method: "POST"}, Proof: RelayProof{Blockchain: ethereum}}
+	// the connection is kept alive across relays
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: server.URL}}}
+	for i := 0; i < 5; i++ {
+		res, err := relay.Execute(&hb)
+		assert.Nil(t, err)
+		assert.Equal(t, "bar", res)
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(conns))
+	hb.CloseIdleConnections()
+	// a connection per relay
+	atomic.StoreInt64(conns, 0)
+	hb = HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: server.URL, HTTP: ChainHTTPConfig{DisableKeepAlives: true}}}}
+	for i := 0; i < 5; i++ {
+		_, err := relay.Execute(&hb)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, int64(5), atomic.LoadInt64(conns))
+}
+
+func TestRelay_ExecuteTimeout(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		_, _ = w.Write([]byte("bar"))
+	}))
+	defer server.Close()
+	relay := Relay{Payload: Payload{Data: "foo", Method: "POST"}, Proof: RelayProof{Blockchain: ethereum}}
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: server.URL, HTTP: ChainHTTPConfig{Timeout: 50}}}}
+	_, err := relay.Execute(&hb)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(CodeHTTPExecutionError), err.Code())
+}
+
+func TestRelay_ExecuteRetry(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	var requests int64
+	// the chain times out on the first two requests
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt64(&requests, 1) <= 2 {
+			time.Sleep(300 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte("bar"))
+	}))
+	defer server.Close()
+	relay := Relay{Payload: Payload{Data: "foo", Method: "POST"}, Proof: RelayProof{Blockchain: ethereum}}
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: server.URL, HTTP: ChainHTTPConfig{Timeout: 50, Retries: 1, RetryBackoff: 10}}}}
+	_, err := relay.Execute(&hb)
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(2), atomic.LoadInt64(&requests))
+	// the third attempt succeeds
+	atomic.StoreInt64(&requests, 1)
+	hb = HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: server.URL, HTTP: ChainHTTPConfig{Timeout: 50, Retries: 2, RetryBackoff: 10}}}}
+	res, err := relay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", res)
+	assert.Equal(t, int64(3), atomic.LoadInt64(&requests))
+}
+
+func TestRelay_ExecuteTLS(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("bar"))
+	}))
+	defer server.Close()
+	relay := Relay{Payload: Payload{Data: "foo", Method: "POST"}, Proof: RelayProof{Blockchain: ethereum}}
+	// the certificate of the chain isn't trusted
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: server.URL}}}
+	_, err := relay.Execute(&hb)
+	assert.NotNil(t, err)
+	// unless its certificate authority is
+	dir, er := ioutil.TempDir("", "chain_tls")
+	assert.Nil(t, er)
+	defer os.RemoveAll(dir)
+	caFile := filepath.Join(dir, "ca.pem")
+	er = ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600)
+	assert.Nil(t, er)
+	hb = HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: server.URL, HTTP: ChainHTTPConfig{TLS: ChainTLSConfig{CAFile: caFile}}}}}
+	res, err := relay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", res)
+}
+
+func TestChainHTTPConfig_PassthroughHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Add("X-Ratelimit-Remaining", "10")
+	header.Add("Vary", "Accept")
+	header.Add("Vary", "Origin")
+	header.Add("X-Secret", "foo")
+	// none allowed
+	assert.Nil(t, ChainHTTPConfig{}.PassthroughHeaders(header))
+	// the allowlist is case insensitive
+	hc := ChainHTTPConfig{ResponseHeaders: []string{"x-ratelimit-remaining", "vary", "content-type"}}
+	assert.Equal(t, map[string]string{"X-Ratelimit-Remaining": "10", "Vary": "Accept, Origin"}, hc.PassthroughHeaders(header))
+	assert.Nil(t, hc.PassthroughHeaders(http.Header{}))
+}
+
+func TestRelay_ExecuteWithHeaders(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.Header().Set("X-Secret", "foo")
+		_, _ = w.Write([]byte("bar"))
+	}))
+	defer server.Close()
+	relay := Relay{Payload: Payload{Data: "foo", Method: "POST"}, Proof: RelayProof{Blockchain: ethereum}}
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: server.URL}}}
+	res, headers, err := relay.ExecuteWithHeaders(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", res)
+	assert.Nil(t, headers)
+	hb = HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: server.URL, HTTP: ChainHTTPConfig{ResponseHeaders: []string{"Content-Type", "X-RateLimit-Remaining"}}}}}
+	res, headers, err = relay.ExecuteWithHeaders(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", res)
+	assert.Equal(t, map[string]string{"Content-Type": "application/json; charset=utf-8", "X-Ratelimit-Remaining": "99"}, headers)
+	// the headers are neither hashed nor encoded
+	rr := RelayResponse{Response: res, Proof: relay.Proof}
+	hash := rr.HashString()
+	rr.Headers = headers
+	assert.Equal(t, hash, rr.HashString())
+	bz, er := ModuleCdc.MarshalBinaryBare(rr)
+	assert.Nil(t, er)
+	var decoded RelayResponse
+	assert.Nil(t, ModuleCdc.UnmarshalBinaryBare(bz, &decoded))
+	assert.Nil(t, decoded.Headers)
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/hex"
+
+	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 )
 
@@ -11,8 +14,16 @@ const (
 )
 
 var (
-	ReceiptKey = []byte{0x01} // key for the verified and stored evidence
-	ClaimKey   = []byte{0x02} // key for pending claims
+	ReceiptKey       = []byte{0x01} // key for the verified and stored evidence
+	ClaimKey         = []byte{0x02} // key for pending claims
+	SettlementKey    = []byte{0x03} // key for the economic outcome of the verified evidence
+	ChallengeKey     = []byte{0x04} // key for the settled challenges (by accused servicer)
+	ExpiredKey       = []byte{0x05} // key for the tally of the expired claims (by servicer)
+	NetworkRelaysKey = []byte{0x06} // key for the tally of the relays settled network wide (by session height and chain)
+	ReputationKey    = []byte{0x07} // key for the reputation of the servicers (by servicer)
+	ReceiptIndexKey  = []byte{0x08} // key for the index of the receipts (by servicer, evidence type, chain and app)
+	ClaimIndexKey    = []byte{0x09} // key for the index of the pending claims (by servicer, evidence type, chain and app)
+	ACLChangeKey     = []byte{0x0A} // key for the history of the acl and dao owner changes (by height and tx hash)
 )
 
 // "KeyForReceipt" - Generates a key for the receipt object for the state store
@@ -47,6 +58,195 @@ func KeyForReceipts(addr sdk.Address) ([]byte, error) {
 	return append(ReceiptKey, addr.Bytes()...), nil
 }
 
+// "KeyForSettlement" - Generates a key for the settlement object for the state store
+func KeyForSettlement(ctx sdk.Ctx, addr sdk.Address, header SessionHeader, evidenceType EvidenceType) ([]byte, error) {
+	// the settlement shares the identifiers of the receipt
+	key, err := KeyForReceipt(ctx, addr, header, evidenceType)
+	if err != nil {
+		return nil, err
+	}
+	return append(SettlementKey, key[len(ReceiptKey):]...), nil
+}
+
+// "KeyForChallenge" - Generates the key for a settled challenge against the accused servicer for the state store
+func KeyForChallenge(accused sdk.Address, header SessionHeader, reporter sdk.Address) ([]byte, error) {
+	// validate the header
+	if err := header.ValidateHeader(); err != nil {
+		return nil, err
+	}
+	// validate the addresses
+	if err := AddressVerification(accused.String()); err != nil {
+		return nil, err
+	}
+	if err := AddressVerification(reporter.String()); err != nil {
+		return nil, err
+	}
+	// return the key bz
+	return append(append(append(ChallengeKey, accused.Bytes()...), header.Hash()...), reporter.Bytes()...), nil
+}
+
+// "KeyForChallengesAgainst" - Generates the key for the settled challenges against the accused servicer
+func KeyForChallengesAgainst(accused sdk.Address) ([]byte, error) {
+	// verify the address
+	if err := AddressVerification(accused.String()); err != nil {
+		return nil, err
+	}
+	// return the key bz
+	return append(ChallengeKey, accused.Bytes()...), nil
+}
+
+// "KeyForExpiredClaims" - Generates the key for the tally of the expired claims of the servicer
+func KeyForExpiredClaims(addr sdk.Address) ([]byte, error) {
+	// verify the address
+	if err := AddressVerification(addr.String()); err != nil {
+		return nil, err
+	}
+	// return the key bz
+	return append(ExpiredKey, addr.Bytes()...), nil
+}
+
+// "KeyForReputation" - Generates the key for the reputation of the servicer
+func KeyForReputation(addr sdk.Address) ([]byte, error) {
+	// verify the address
+	if err := AddressVerification(addr.String()); err != nil {
+		return nil, err
+	}
+	// return the key bz
+	return append(ReputationKey, addr.Bytes()...), nil
+}
+
+// "KeyForNetworkRelays" - Generates the key for the tally of the relays settled for the chain in the session
+func KeyForNetworkRelays(sessionBlockHeight int64, chain string) []byte {
+	return append(KeyForNetworkRelaysAt(sessionBlockHeight), []byte(chain)...)
+}
+
+// "KeyForNetworkRelaysAt" - Generates the key for the tallies of the relays settled in the session, the keys are
+// ordered by session height
+func KeyForNetworkRelaysAt(sessionBlockHeight int64) []byte {
+	return append(append([]byte{}, NetworkRelaysKey...), sdk.Uint64ToBigEndian(uint64(sessionBlockHeight))...)
+}
+
+// "KeyForACLChange" - Generates the key for the change of the acl or of the dao owner by the tx, the keys are ordered
+// by height
+func KeyForACLChange(height int64, txHash []byte) []byte {
+	return append(append(append([]byte{}, ACLChangeKey...), sdk.Uint64ToBigEndian(uint64(height))...), txHash...)
+}
+
+// "KeyForReceiptIndex" - Generates the key indexing the receipt by evidence type, chain and app for the state store
+func KeyForReceiptIndex(addr sdk.Address, header SessionHeader, evidenceType EvidenceType) ([]byte, error) {
+	return keyForEvidenceIndex(ReceiptIndexKey, addr, header, evidenceType)
+}
+
+// "KeyForReceiptIndexes" - Generates the key for the receipt indexes of the address matching the filter
+func KeyForReceiptIndexes(addr sdk.Address, filter EvidenceFilter) ([]byte, error) {
+	return keyForEvidenceIndexes(ReceiptIndexKey, addr, filter)
+}
+
+// "ReceiptKeyFromIndex" - Returns the key of the receipt indexed by the index key (nil if malformed)
+func ReceiptKeyFromIndex(indexKey []byte) []byte {
+	return evidenceKeyFromIndex(ReceiptKey, indexKey)
+}
+
+// "KeyForClaimIndex" - Generates the key indexing the claim by evidence type, chain and app for the state store
+func KeyForClaimIndex(addr sdk.Address, header SessionHeader, evidenceType EvidenceType) ([]byte, error) {
+	return keyForEvidenceIndex(ClaimIndexKey, addr, header, evidenceType)
+}
+
+// "KeyForClaimIndexes" - Generates the key for the claim indexes of the address matching the filter
+func KeyForClaimIndexes(addr sdk.Address, filter EvidenceFilter) ([]byte, error) {
+	return keyForEvidenceIndexes(ClaimIndexKey, addr, filter)
+}
+
+// "ClaimKeyFromIndex" - Returns the key of the claim indexed by the index key (nil if malformed)
+func ClaimKeyFromIndex(indexKey []byte) []byte {
+	return evidenceKeyFromIndex(ClaimKey, indexKey)
+}
+
+// generates the evidence index key:
+// prefix | address | evidence type | chain length | chain | app public key | header hash
+func keyForEvidenceIndex(prefix []byte, addr sdk.Address, header SessionHeader, evidenceType EvidenceType) ([]byte, error) {
+	// validate the header
+	if err := header.ValidateHeader(); err != nil {
+		return nil, err
+	}
+	// verify the address
+	if err := AddressVerification(addr.String()); err != nil {
+		return nil, err
+	}
+	et, err := evidenceType.Byte()
+	if err != nil {
+		return nil, NewInvalidEvidenceErr(ModuleName)
+	}
+	// the header is valid hex
+	chain, _ := hex.DecodeString(header.Chain)
+	app, _ := hex.DecodeString(header.ApplicationPubKey)
+	key := append(append([]byte{}, prefix...), addr.Bytes()...)
+	key = append(append(key, et, byte(len(chain))), chain...)
+	return append(append(key, app...), header.Hash()...), nil
+}
+
+// generates the longest evidence index prefix of the filter, the rest of the filter is matched by
+// EvidenceFilter.MatchIndexKey
+func keyForEvidenceIndexes(prefix []byte, addr sdk.Address, filter EvidenceFilter) ([]byte, error) {
+	// verify the address
+	if err := AddressVerification(addr.String()); err != nil {
+		return nil, err
+	}
+	key := append(append([]byte{}, prefix...), addr.Bytes()...)
+	if filter.EvidenceType == 0 {
+		return key, nil
+	}
+	et, err := filter.EvidenceType.Byte()
+	if err != nil {
+		return nil, NewInvalidEvidenceErr(ModuleName)
+	}
+	key = append(key, et)
+	if filter.Chain == "" {
+		return key, nil
+	}
+	chain, err := hex.DecodeString(filter.Chain)
+	if err != nil {
+		return nil, NewHexDecodeError(ModuleName, err)
+	}
+	key = append(append(key, byte(len(chain))), chain...)
+	if filter.AppPubKey == "" {
+		return key, nil
+	}
+	app, err := hex.DecodeString(filter.AppPubKey)
+	if err != nil {
+		return nil, NewPubKeyDecodeError(ModuleName)
+	}
+	return append(key, app...), nil
+}
+
+// splits the evidence index key into its evidence type, chain, app public key and header hash
+func splitEvidenceIndexKey(indexKey []byte) (et byte, chain, app, headerHash []byte, ok bool) {
+	// skip the prefix and the address
+	i := 1 + AddrLength
+	if len(indexKey) < i+2 {
+		return
+	}
+	et, chainLength := indexKey[i], int(indexKey[i+1])
+	i += 2
+	if len(indexKey) != i+chainLength+crypto.Ed25519PubKeySize+HashLength {
+		return
+	}
+	chain = indexKey[i : i+chainLength]
+	app = indexKey[i+chainLength : i+chainLength+crypto.Ed25519PubKeySize]
+	headerHash = indexKey[len(indexKey)-HashLength:]
+	return et, chain, app, headerHash, true
+}
+
+// returns the evidence key (prefix | address | header hash | evidence type) of the evidence index key
+func evidenceKeyFromIndex(prefix []byte, indexKey []byte) []byte {
+	et, _, _, headerHash, ok := splitEvidenceIndexKey(indexKey)
+	if !ok {
+		return nil
+	}
+	key := append(append([]byte{}, prefix...), indexKey[1:1+AddrLength]...)
+	return append(append(key, headerHash...), et)
+}
+
 // "KeyForClaim" - Generates the key for the claim object for the state store
 func KeyForClaim(ctx sdk.Ctx, addr sdk.Address, header SessionHeader, evidenceType EvidenceType) ([]byte, error) {
 	// validat the header
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+const (
+	// the default name of the audit log of the uses of the node and account keys (in the data directory)
+	DefaultKeyAuditLogName = "key_audit.log"
+	// the keys of the audit records: the key of the node (its private validator key) or another account of the keybase
+	NodeKey    = "node"
+	AccountKey = "account"
+	// the sources of the audit records: the auto transactions of the node (claims, proofs, restakes) or the cli
+	KeyUseAuto = "auto"
+	KeyUseCLI  = "cli"
+)
+
+var (
+	// the append only audit log of the key uses (disabled if the path is empty)
+	globalKeyAudit = &keyAudit{}
+)
+
+// "KeyAuditRecord" - A use of the node key or of an account key to sign a transaction (the consensus votes excluded)
+type KeyAuditRecord struct {
+	Time    time.Time `json:"time"`
+	Key     string    `json:"key"`     // node or account
+	Address string    `json:"address"` // the address of the key
+	MsgType string    `json:"msg_type"`
+	TxHash  string    `json:"tx_hash,omitempty"` // empty if the tx could not be signed or broadcast
+	Source  string    `json:"source"`            // auto or cli
+	Error   string    `json:"error,omitempty"`
+}
+
+type keyAudit struct {
+	l    sync.Mutex
+	path string
+}
+
+// "InitKeyAuditLog" - Appends the key uses to the audit log at path, shared by the node and the cli
+func InitKeyAuditLog(path string) {
+	globalKeyAudit.l.Lock()
+	defer globalKeyAudit.l.Unlock()
+	globalKeyAudit.path = path
+}
+
+// "RecordKeyUse" - Appends the key use to the audit log, the log is opened per record so the node and the cli can
+// append to it concurrently
+func RecordKeyUse(r KeyAuditRecord) error {
+	globalKeyAudit.l.Lock()
+	defer globalKeyAudit.l.Unlock()
+	if globalKeyAudit.path == "" {
+		return nil
+	}
+	if r.Time.IsZero() {
+		r.Time = time.Now().UTC()
+	}
+	bz, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(globalKeyAudit.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(bz, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// "RecordNodeKeyUse" - Appends the use of the node key by an auto transaction of the message type to the audit log
+func RecordNodeKeyUse(msgType string, res *sdk.TxResponse, err error) {
+	r := KeyAuditRecord{Key: NodeKey, MsgType: msgType, Source: KeyUseAuto}
+	if pvKey, er := GetPVKeyFile(); er == nil {
+		r.Address = sdk.Address(pvKey.Address).String()
+	}
+	if res != nil {
+		r.TxHash = res.TxHash
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	_ = RecordKeyUse(r)
+}
+
+// "KeyAuditRecords" - Returns the records of the audit log, oldest first
+func KeyAuditRecords() (records []KeyAuditRecord, err error) {
+	globalKeyAudit.l.Lock()
+	path := globalKeyAudit.path
+	globalKeyAudit.l.Unlock()
+	records = make([]KeyAuditRecord, 0)
+	if path == "" {
+		return
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		var r KeyAuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, fmt.Errorf("invalid record at line %d of the key audit log: %s", line, err.Error())
+		}
+		records = append(records, r)
+	}
+	return records, scanner.Err()
+}
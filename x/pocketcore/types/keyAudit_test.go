@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestKeyAudit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyaudit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	// disabled
+	InitKeyAuditLog("")
+	assert.Nil(t, RecordKeyUse(KeyAuditRecord{Key: AccountKey, MsgType: "send"}))
+	records, err := KeyAuditRecords()
+	assert.Nil(t, err)
+	assert.Empty(t, records)
+	InitKeyAuditLog(filepath.Join(dir, DefaultKeyAuditLogName))
+	defer InitKeyAuditLog("")
+	// no log yet
+	records, err = KeyAuditRecords()
+	assert.Nil(t, err)
+	assert.Empty(t, records)
+	assert.Nil(t, RecordKeyUse(KeyAuditRecord{Key: AccountKey, Address: "foo", MsgType: "send", TxHash: "ABC", Source: KeyUseCLI}))
+	// the broadcasts of the auto transactions are recorded
+	InitTxRetry(2, 0)
+	defer InitTxRetry(DefaultTxRetries, DefaultTxRetryBackoff)
+	attempts := 0
+	_, err = BroadcastWithRetry(log.NewNopLogger(), MsgClaimName, func() (*sdk.TxResponse, error) {
+		attempts++
+		if attempts < 2 {
+			return nil, fmt.Errorf("connection refused")
+		}
+		return &sdk.TxResponse{TxHash: "DEF"}, nil
+	})
+	assert.Nil(t, err)
+	_, err = BroadcastWithRetry(log.NewNopLogger(), MsgProofName, func() (*sdk.TxResponse, error) {
+		return &sdk.TxResponse{TxHash: "GHI", Code: 4, RawLog: "unauthorized"}, nil
+	})
+	assert.NotNil(t, err)
+	records, err = KeyAuditRecords()
+	assert.Nil(t, err)
+	assert.Len(t, records, 4)
+	assert.Equal(t, "ABC", records[0].TxHash)
+	assert.Equal(t, KeyUseCLI, records[0].Source)
+	assert.False(t, records[0].Time.IsZero())
+	assert.Equal(t, NodeKey, records[1].Key)
+	assert.Equal(t, KeyUseAuto, records[1].Source)
+	assert.Equal(t, MsgClaimName, records[1].MsgType)
+	assert.Contains(t, records[1].Error, "connection refused")
+	assert.Equal(t, "DEF", records[2].TxHash)
+	assert.Empty(t, records[2].Error)
+	assert.Equal(t, MsgProofName, records[3].MsgType)
+	assert.Equal(t, "GHI", records[3].TxHash)
+	assert.Contains(t, records[3].Error, "unauthorized")
+	// the log is readable by the operator only
+	info, err := os.Stat(filepath.Join(dir, DefaultKeyAuditLogName))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
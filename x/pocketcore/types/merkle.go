@@ -25,8 +25,24 @@ type MerkleProof struct {
 // "MerkleProofs" - Two merkle proof objects (needed for replay attack)
 type MerkleProofs [2]MerkleProof
 
-// "Validate" - Verifies the Proof from the leaf/cousin node data, the merkle root, and the Proof object
+// "Validate" - Verifies the Proof from the leaf/cousin node data, the merkle root, and the Proof object, with the leaf
+// hash algorithm of the session (or the previous one during the transition to it)
 func (mp MerkleProofs) Validate(root HashSum, leaf, cousin Proof, totalRelays int64) (isValid bool, isReplay bool) {
+	for i, algorithm := range ProofLeafHashAlgorithms(leaf.SessionHeader().SessionBlockHeight) {
+		valid, replay := mp.validate(root, leaf, cousin, totalRelays, algorithm)
+		if valid {
+			return true, false
+		}
+		// the outcome of the current algorithm
+		if i == 0 {
+			isReplay = replay
+		}
+	}
+	return false, isReplay
+}
+
+// verifies the proof with the leaf hash algorithm
+func (mp MerkleProofs) validate(root HashSum, leaf, cousin Proof, totalRelays int64, algorithm string) (isValid bool, isReplay bool) {
 	// check if levels and total relays is valid
 	numOfLevels, valid := levelsIsValid(len(mp[0].HashSums), len(mp[1].HashSums), totalRelays)
 	if !valid {
@@ -35,10 +51,10 @@ func (mp MerkleProofs) Validate(root HashSum, leaf, cousin Proof, totalRelays in
 	// verifier is the opposing verification piece to the merkle proofs, with its counterpart, we will verify the tree
 	var verifier [2]HashSum
 	// convert leaf to hashsum
-	verifier[0].Hash = hash(leaf.Hash())
+	verifier[0].Hash = leafHash(leaf, algorithm)
 	verifier[0].Sum = sumFromHash(verifier[0].Hash)
 	// convert cousin to hashsum
-	verifier[1].Hash = hash(cousin.Hash())
+	verifier[1].Hash = leafHash(cousin, algorithm)
 	verifier[1].Sum = sumFromHash(verifier[1].Hash)
 	// replay attack check -> params (leaf, sibling, cousin, cousinSibling, leafIndex, cap of the tree)
 	if isReplayAttack(verifier[0], mp[0].HashSums[0], verifier[1], mp[1].HashSums[0], int64(mp[0].Index), totalRelays) {
@@ -94,7 +110,7 @@ func (mp MerkleProofs) Validate(root HashSum, leaf, cousin Proof, totalRelays in
 
 // "GenerateProofs" - Generates the merkle Proof object from the leaf node data and the index
 func GenerateProofs(p []Proof, index int) (merkleProofs MerkleProofs, cousinIndex int) {
-	data, _ := sortAndStructure(p)
+	data, _ := sortAndStructure(p, proofsLeafHashAlgorithm(p))
 	dataCopy := make([]HashSum, len(data))
 	// Copy from the original map to the target map
 	copy(dataCopy, data)
@@ -115,13 +131,13 @@ func GenerateProofs(p []Proof, index int) (merkleProofs MerkleProofs, cousinInde
 // "GenerateRoot" - generates the merkle root from leaf node data
 func GenerateRoot(data []Proof) (r HashSum, sortedData []Proof) {
 	// structure the leafs
-	d, sortedProofs := sortAndStructure(data)
+	d, sortedProofs := sortAndStructure(data, proofsLeafHashAlgorithm(data))
 	// call the root function and return
 	return root(d), sortedProofs
 }
 
 // "sortAndStructure" - takes Proof data, sorts, and structures them as a `balanced` merkle tree
-func sortAndStructure(relayProofs []Proof) (d []HashSum, sortedProofs []Proof) {
+func sortAndStructure(relayProofs []Proof, algorithm string) (d []HashSum, sortedProofs []Proof) {
 	// we need a tree of proper Length. Get the # of relayProofs
 	numberOfProofs := len(relayProofs)
 	properLength := nextPowerOfTwo(uint(numberOfProofs))
@@ -130,13 +146,13 @@ func sortAndStructure(relayProofs []Proof) (d []HashSum, sortedProofs []Proof) {
 	// first, let's tHash the data
 	for i, p := range relayProofs {
 		// save the hash and sum of the Proof in the new tree slice
-		data[i].Hash = hash(p.Hash()) // todo should this be hash with signature for RelayProofs? // todo remove double hash
+		data[i].Hash = leafHash(p, algorithm) // todo should this be hash with signature for RelayProofs? // todo remove double hash
 		data[i].Sum = sumFromHash(data[i].Hash)
 	}
 	// for the rest, add the max uint32
 	for i := numberOfProofs; i < int(properLength); i++ {
 		data[i] = HashSum{
-			Hash: Hash([]byte("0")),
+			Hash: mustGetHashFn(algorithm)([]byte("0")),
 			Sum:  uint64(math.MaxUint32),
 		}
 	}
@@ -279,6 +295,46 @@ func hash(data []byte) []byte {
 	return hash[:]
 }
 
+// "ProofLeafHashAlgorithm" - Returns the algorithm hashing the proof leafs of the sessions at the height
+func ProofLeafHashAlgorithm(sessionBlockHeight int64) string {
+	if ProtocolFeatures.IsActive(FeatureBlake2bProofLeafs, sessionBlockHeight) {
+		return HashAlgorithmBlake2b_256
+	}
+	return HashAlgorithmSHA3_256
+}
+
+// "ProofLeafHashAlgorithms" - Returns the algorithms accepted when verifying the proof leafs of the sessions at the
+// height: the algorithm of the height first, then the previous one during the transition to it
+func ProofLeafHashAlgorithms(sessionBlockHeight int64) []string {
+	algorithms := []string{ProofLeafHashAlgorithm(sessionBlockHeight)}
+	if ProtocolFeatures.InTransition(FeatureBlake2bProofLeafs, sessionBlockHeight) {
+		algorithms = append(algorithms, HashAlgorithmSHA3_256)
+	}
+	return algorithms
+}
+
+// the leaf hash algorithm of the proofs (of the same session)
+func proofsLeafHashAlgorithm(p []Proof) string {
+	if len(p) == 0 || p[0] == nil {
+		return HashAlgorithmSHA3_256
+	}
+	return ProofLeafHashAlgorithm(p[0].SessionHeader().SessionBlockHeight)
+}
+
+// "leafHash" - the hash of a proof in the merkle tree
+func leafHash(p Proof, algorithm string) []byte {
+	return hash(mustGetHashFn(algorithm)(p.Bytes()))
+}
+
+// the hash function of the (supported) algorithm
+func mustGetHashFn(algorithm string) HashFn {
+	fn, err := GetHashFn(algorithm)
+	if err != nil {
+		panic(err)
+	}
+	return fn
+}
+
 // "parentHash" - Compute the hash of the parent by hashing the hashes, sum and parent
 func parentHash(hash1, hash2 []byte, sum uint64) []byte {
 	return hash(append(append(hash1, hash2...), uint64ToBytes(sum)...))
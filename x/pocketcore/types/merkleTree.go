@@ -0,0 +1,138 @@
+package types
+
+import (
+	"encoding/hex"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+const (
+	// the evidence waiting to have its tree built, beyond it the proofs are generated synchronously
+	merkleTreeQueueSize = 1000
+)
+
+var (
+	// the trees of the claimed evidence, keyed by the evidence key
+	globalMerkleTrees = merkleTrees{trees: make(map[string]MerkleTree)}
+	// the evidence waiting to have its tree built
+	merkleTreeQueue = make(chan Evidence, merkleTreeQueueSize)
+	// starts the workers building the trees
+	merkleTreeWorkersOnce sync.Once
+)
+
+// "MerkleTree" - Every level (leafs first, root last) of the merkle sum index tree of an evidence
+type MerkleTree struct {
+	NumOfProofs int64       `json:"num_of_proofs"` // the number of proofs the tree was built from
+	Levels      [][]HashSum `json:"levels"`
+}
+
+type merkleTrees struct {
+	l     sync.RWMutex
+	trees map[string]MerkleTree
+}
+
+// "NewMerkleTree" - Builds every level of the merkle tree of the proofs (without modifying them)
+func NewMerkleTree(p []Proof) MerkleTree {
+	proofs := make([]Proof, len(p))
+	copy(proofs, p)
+	leafs, _ := sortAndStructure(proofs, proofsLeafHashAlgorithm(proofs))
+	levels := [][]HashSum{leafs}
+	for level := leafs; len(level) > 1; {
+		next := make([]HashSum, len(level)/2)
+		for i := range next {
+			left, right := level[2*i], level[2*i+1]
+			next[i].Sum = left.Sum + right.Sum
+			next[i].Hash = parentHash(left.Hash, right.Hash, next[i].Sum)
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return MerkleTree{NumOfProofs: int64(len(p)), Levels: levels}
+}
+
+// "Root" - Returns the root of the tree
+func (mt MerkleTree) Root() HashSum {
+	return mt.Levels[len(mt.Levels)-1][0]
+}
+
+// "GenerateProofs" - Generates the merkle proofs of the leaf at the index and its cousin, from the levels of the tree
+func (mt MerkleTree) GenerateProofs(index int) (merkleProofs MerkleProofs, cousinIndex int) {
+	cousinIndex = getCousinIndex(int(mt.NumOfProofs), index)
+	merkleProofs[0] = mt.merkleProof(index)
+	merkleProofs[1] = mt.merkleProof(cousinIndex)
+	return
+}
+
+// the siblings of the leaf at each level below the root
+func (mt MerkleTree) merkleProof(index int) MerkleProof {
+	p := MerkleProof{Index: index}
+	for _, level := range mt.Levels[:len(mt.Levels)-1] {
+		// odd index so sibling to the left, even index so sibling to the right
+		sibling := index + 1
+		if index%2 == 1 {
+			sibling = index - 1
+		}
+		p.HashSums = append(p.HashSums, level[sibling])
+		index /= 2
+	}
+	return p
+}
+
+// "PrebuildMerkleTree" - Queues the evidence to have its tree built by the workers (as soon as it's claimed), so the
+// proof doesn't have to build it. Never blocks: if the queue is full the proof will generate the tree itself
+func PrebuildMerkleTree(evidence Evidence) {
+	merkleTreeWorkersOnce.Do(func() {
+		for i := 0; i < runtime.NumCPU(); i++ {
+			go merkleTreeWorker()
+		}
+	})
+	select {
+	case merkleTreeQueue <- evidence:
+	default:
+	}
+}
+
+func merkleTreeWorker() {
+	for evidence := range merkleTreeQueue {
+		key, err := KeyForEvidence(evidence.SessionHeader, evidence.EvidenceType)
+		if err != nil {
+			continue
+		}
+		WithRecovery("merkle-tree", func() {
+			tree := NewMerkleTree(evidence.Proofs)
+			globalMerkleTrees.l.Lock()
+			defer globalMerkleTrees.l.Unlock()
+			globalMerkleTrees.trees[hex.EncodeToString(key)] = tree
+		}, "app", evidence.ApplicationPubKey, "height", strconv.FormatInt(evidence.SessionBlockHeight, 10))
+	}
+}
+
+// "GetMerkleTree" - Returns the prebuilt tree of the evidence, if built from the same number of proofs
+func GetMerkleTree(header SessionHeader, evidenceType EvidenceType, numOfProofs int64) (tree MerkleTree, found bool) {
+	key, err := KeyForEvidence(header, evidenceType)
+	if err != nil {
+		return
+	}
+	globalMerkleTrees.l.RLock()
+	defer globalMerkleTrees.l.RUnlock()
+	tree, found = globalMerkleTrees.trees[hex.EncodeToString(key)]
+	if found && tree.NumOfProofs != numOfProofs {
+		return MerkleTree{}, false
+	}
+	return
+}
+
+// drops the tree of the evidence (deleted)
+func deleteMerkleTree(key []byte) {
+	globalMerkleTrees.l.Lock()
+	defer globalMerkleTrees.l.Unlock()
+	delete(globalMerkleTrees.trees, hex.EncodeToString(key))
+}
+
+// drops every tree
+func clearMerkleTrees() {
+	globalMerkleTrees.l.Lock()
+	defer globalMerkleTrees.l.Unlock()
+	globalMerkleTrees.trees = make(map[string]MerkleTree)
+}
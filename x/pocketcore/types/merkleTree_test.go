@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerkleTree_GenerateProofs(t *testing.T) {
+	for _, numOfProofs := range []int{5, 8, 13, 100} {
+		proofs := make([]Proof, numOfProofs)
+		for i := range proofs {
+			proofs[i] = RelayProof{Entropy: int64(i + 1), RequestHash: hex.EncodeToString(Hash([]byte{byte(i)}))}
+		}
+		root, sortedProofs := GenerateRoot(proofs)
+		tree := NewMerkleTree(sortedProofs)
+		assert.Equal(t, int64(numOfProofs), tree.NumOfProofs)
+		assert.Equal(t, root, tree.Root())
+		// the proofs read from the tree match the ones generated from the data
+		for index := 0; index < numOfProofs; index++ {
+			expected, expectedCousin := GenerateProofs(sortedProofs, index)
+			got, cousin := tree.GenerateProofs(index)
+			assert.Equal(t, expected, got)
+			assert.Equal(t, expectedCousin, cousin)
+			isValid, _ := got.Validate(root, sortedProofs[index], sortedProofs[cousin], int64(numOfProofs))
+			assert.True(t, isValid)
+		}
+	}
+}
+
+func TestPrebuildMerkleTree(t *testing.T) {
+	header := SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 1,
+	}
+	for i := 0; i < 6; i++ {
+		SetProof(header, RelayEvidence, RelayProof{Entropy: int64(i + 1)}, sdk.NewInt(1000))
+	}
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	evidence.GenerateMerkleRoot()
+	expected, expectedCousin := GenerateProofs(evidence.Proofs, 3)
+	PrebuildMerkleTree(evidence)
+	var tree MerkleTree
+	found := false
+	for i := 0; i < 100 && !found; i++ {
+		time.Sleep(10 * time.Millisecond)
+		tree, found = GetMerkleTree(header, RelayEvidence, evidence.NumOfProofs)
+	}
+	assert.True(t, found)
+	// a tree of another number of proofs is never used
+	_, found = GetMerkleTree(header, RelayEvidence, evidence.NumOfProofs+1)
+	assert.False(t, found)
+	// the evidence consumes the prebuilt tree
+	got, cousin := evidence.GenerateMerkleProof(3)
+	assert.Equal(t, expected, got)
+	assert.Equal(t, expectedCousin, cousin)
+	assert.Equal(t, tree.Root(), evidence.GenerateMerkleRoot())
+	// deleting the evidence drops its tree
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+	_, found = GetMerkleTree(header, RelayEvidence, evidence.NumOfProofs)
+	assert.False(t, found)
+}
@@ -366,3 +366,55 @@ func TestEvidence_VerifyMerkleProof(t *testing.T) {
 	res, _ = proofs.Validate(root, i.Proofs[index], i.Proofs[cousinIndex], int64(len(i2.Proofs)))
 	assert.False(t, res)
 }
+
+func TestMerkleProofs_ValidateLeafHashAlgorithm(t *testing.T) {
+	features := ProtocolFeatures
+	defer func() { ProtocolFeatures = features }()
+	ProtocolFeatures = NewFeatureRegistry()
+	appPubKey := getRandomPubKey().RawString()
+	nodePubKey := getRandomPubKey().RawString()
+	ethereum := hex.EncodeToString([]byte{01})
+	var proofs []Proof
+	for i := 0; i < 8; i++ {
+		proofs = append(proofs, RelayProof{
+			Entropy:            int64(i * 1000),
+			RequestHash:        hex.EncodeToString(Hash([]byte{byte(i)})), // fake
+			SessionBlockHeight: 5,
+			ServicerPubKey:     nodePubKey,
+			Blockchain:         ethereum,
+			Token:              AAT{Version: "0.0.1", ApplicationPublicKey: appPubKey, ClientPublicKey: appPubKey},
+		})
+	}
+	generate := func() (HashSum, MerkleProofs, Proof, Proof) {
+		p := make([]Proof, len(proofs))
+		copy(p, proofs)
+		root, sorted := GenerateRoot(p)
+		branches, cousinIndex := GenerateProofs(sorted, 2)
+		return root, branches, sorted[2], sorted[cousinIndex]
+	}
+	// sha3 before the activation
+	assert.Equal(t, HashAlgorithmSHA3_256, ProofLeafHashAlgorithm(5))
+	sha3Root, sha3Branches, sha3Leaf, sha3Cousin := generate()
+	isValid, _ := sha3Branches.Validate(sha3Root, sha3Leaf, sha3Cousin, int64(len(proofs)))
+	assert.True(t, isValid)
+	// blake2b from the activation
+	ProtocolFeatures.Register(ProtocolFeature{Name: FeatureBlake2bProofLeafs, ActivationHeight: 5})
+	assert.Equal(t, HashAlgorithmBlake2b_256, ProofLeafHashAlgorithm(5))
+	assert.Equal(t, HashAlgorithmSHA3_256, ProofLeafHashAlgorithm(4))
+	root, branches, leaf, cousin := generate()
+	assert.NotEqual(t, sha3Root, root)
+	isValid, _ = branches.Validate(root, leaf, cousin, int64(len(proofs)))
+	assert.True(t, isValid)
+	// the sha3 tree is invalid once activated
+	isValid, _ = sha3Branches.Validate(sha3Root, sha3Leaf, sha3Cousin, int64(len(proofs)))
+	assert.False(t, isValid)
+	// both are valid during the transition
+	ProtocolFeatures.Register(ProtocolFeature{Name: FeatureBlake2bProofLeafs, ActivationHeight: 5, TransitionBlocks: 10})
+	assert.Equal(t, []string{HashAlgorithmBlake2b_256, HashAlgorithmSHA3_256}, ProofLeafHashAlgorithms(5))
+	isValid, _ = sha3Branches.Validate(sha3Root, sha3Leaf, sha3Cousin, int64(len(proofs)))
+	assert.True(t, isValid)
+	isValid, _ = branches.Validate(root, leaf, cousin, int64(len(proofs)))
+	assert.True(t, isValid)
+	_, err := GetHashFn("md5")
+	assert.NotNil(t, err)
+}
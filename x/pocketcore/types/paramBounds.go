@@ -0,0 +1,163 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// the default bounds of the params, enforced on the chains without a bounds registry
+var DefaultParamBounds = ParamBounds{
+	{Key: "pocketcore/SessionNodeCount", Min: "1", Max: "25", Step: "1"},
+	{Key: "pocketcore/ClaimSubmissionWindow", Min: "2", Step: "1"},
+	{Key: "pocketcore/ClaimExpiration", Min: "2", Step: "1"},
+	{Key: "pocketcore/ChallengeReporterReward", Min: "0", Max: "100", Step: "1"},
+	{Key: "pocketcore/ReplayAttackBurnMultiplier", Min: "0", Step: "1"},
+	{Key: "pocketcore/MinimumNumberOfProofs", Min: "1", Step: "1"},
+	{Key: "pos/BlocksPerSession", Min: "2", Step: "1"},
+	{Key: "pos/MaxValidators", Min: "1", Step: "1"},
+	{Key: "pos/DAOAllocation", Min: "0", Max: "100", Step: "1"},
+	{Key: "pos/ProposerPercentage", Min: "0", Max: "100", Step: "1"},
+	{Key: "pos/MinSignedPerWindow", Min: "0", Max: "1"},
+	{Key: "pos/SlashFractionDoubleSign", Min: "0", Max: "1"},
+	{Key: "pos/SlashFractionDowntime", Min: "0", Max: "1"},
+}
+
+// "ParamBound" - The range and the granularity of the values of a (numeric) param
+type ParamBound struct {
+	Key  string `json:"param_key"`      // the acl key of the param, e.g. pocketcore/SessionNodeCount
+	Min  string `json:"min,omitempty"`  // the min value (a decimal), unbounded if empty
+	Max  string `json:"max,omitempty"`  // the max value (a decimal), unbounded if empty
+	Step string `json:"step,omitempty"` // the value must be the min (or 0) plus a multiple of the step, any if empty
+}
+
+// "ParamBounds" - The bounds of the params enforced on the param changes, even the ones of an authorized acl key
+type ParamBounds []ParamBound
+
+// "Validate" - Validates the keys and the decimals of the bounds
+func (pb ParamBounds) Validate() error {
+	keys := make(map[string]struct{}, len(pb))
+	for _, b := range pb {
+		if parts := strings.Split(b.Key, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("the param key %q of the bounds is not an acl key (<subspace>/<key>)", b.Key)
+		}
+		if _, found := keys[b.Key]; found {
+			return fmt.Errorf("the param %s is bounded more than once", b.Key)
+		}
+		keys[b.Key] = struct{}{}
+		min, max, step, err := b.decimals()
+		if err != nil {
+			return err
+		}
+		if min != nil && max != nil && min.GT(*max) {
+			return fmt.Errorf("the min of the param %s is above its max", b.Key)
+		}
+		if step != nil && !step.IsPositive() {
+			return fmt.Errorf("the step of the param %s must be positive", b.Key)
+		}
+	}
+	return nil
+}
+
+// "OrDefault" - Returns the bounds, or the default bounds if empty (e.g. on a chain started before the param bounds)
+func (pb ParamBounds) OrDefault() ParamBounds {
+	if len(pb) == 0 {
+		return DefaultParamBounds
+	}
+	return pb
+}
+
+// "Bound" - Returns the bound of the param (acl key), if bounded
+func (pb ParamBounds) Bound(key string) (ParamBound, bool) {
+	for _, b := range pb {
+		if b.Key == key {
+			return b, true
+		}
+	}
+	return ParamBound{}, false
+}
+
+// "Check" - Checks the (json) value of a param change against the bound of the param, if bounded. The json numbers are
+// checked as the param change applies them, truncated to an integer
+func (pb ParamBounds) Check(key string, value json.RawMessage) sdk.Error {
+	b, found := pb.Bound(key)
+	if !found {
+		return nil
+	}
+	v, err := paramDecimal(value)
+	if err != nil {
+		return NewParamOutOfBoundsError(ModuleName, key, err.Error())
+	}
+	min, max, step, err := b.decimals()
+	if err != nil {
+		return NewParamOutOfBoundsError(ModuleName, key, err.Error())
+	}
+	if min != nil && v.LT(*min) {
+		return NewParamOutOfBoundsError(ModuleName, key, fmt.Sprintf("%s is below the min %s", v, min))
+	}
+	if max != nil && v.GT(*max) {
+		return NewParamOutOfBoundsError(ModuleName, key, fmt.Sprintf("%s is above the max %s", v, max))
+	}
+	if step != nil {
+		offset := v
+		if min != nil {
+			offset = v.Sub(*min)
+		}
+		if q := offset.Quo(*step); !q.Equal(q.TruncateDec()) {
+			return NewParamOutOfBoundsError(ModuleName, key, fmt.Sprintf("%s is not a multiple of the step %s", v, step))
+		}
+	}
+	return nil
+}
+
+// the decimals of the bound, nil if empty
+func (b ParamBound) decimals() (min, max, step *sdk.Dec, err error) {
+	parse := func(name, s string) (*sdk.Dec, error) {
+		if s == "" {
+			return nil, nil
+		}
+		d, err := sdk.NewDecFromStr(s)
+		if err != nil {
+			return nil, fmt.Errorf("the %s of the param %s is not a decimal: %s", name, b.Key, err.Error())
+		}
+		return &d, nil
+	}
+	if min, err = parse("min", b.Min); err != nil {
+		return
+	}
+	if max, err = parse("max", b.Max); err != nil {
+		return
+	}
+	step, err = parse("step", b.Step)
+	return
+}
+
+// the decimal of the json value of a param change: a number (truncated to an integer, as applied by the param change)
+// or a string of a decimal (e.g. a slash fraction)
+func paramDecimal(value json.RawMessage) (sdk.Dec, error) {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(value))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return sdk.Dec{}, fmt.Errorf("the value is not json: %s", err.Error())
+	}
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		return sdk.NewDec(int64(f)), nil
+	case string:
+		dec, err := sdk.NewDecFromStr(v)
+		if err != nil {
+			return sdk.Dec{}, fmt.Errorf("the value %q is not a decimal", v)
+		}
+		return dec, nil
+	default:
+		return sdk.Dec{}, fmt.Errorf("the value %s is not a number", string(value))
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamBounds_Validate(t *testing.T) {
+	assert.Nil(t, DefaultParamBounds.Validate())
+	assert.Nil(t, ParamBounds{}.Validate())
+	invalid := []ParamBounds{
+		{{Key: "SessionNodeCount", Min: "1"}},
+		{{Key: "pocketcore/SessionNodeCount", Min: "1"}, {Key: "pocketcore/SessionNodeCount", Max: "2"}},
+		{{Key: "pocketcore/SessionNodeCount", Min: "a"}},
+		{{Key: "pocketcore/SessionNodeCount", Min: "2", Max: "1"}},
+		{{Key: "pocketcore/SessionNodeCount", Step: "0"}},
+	}
+	for _, bounds := range invalid {
+		assert.NotNil(t, bounds.Validate(), bounds[0].Key)
+	}
+}
+
+func TestParamBounds_Check(t *testing.T) {
+	bounds := ParamBounds{
+		{Key: "pocketcore/SessionNodeCount", Min: "1", Max: "25", Step: "1"},
+		{Key: "pos/SlashFractionDowntime", Min: "0", Max: "1"},
+		{Key: "pos/StakeMinimum", Min: "1000", Step: "500"},
+	}
+	tests := []struct {
+		key   string
+		value string
+		valid bool
+	}{
+		{"pocketcore/SessionNodeCount", `5`, true},
+		{"pocketcore/SessionNodeCount", `25`, true},
+		{"pocketcore/SessionNodeCount", `0`, false},
+		{"pocketcore/SessionNodeCount", `26`, false},
+		{"pocketcore/SessionNodeCount", `0.5`, false}, // applied as 0
+		{"pocketcore/SessionNodeCount", `"a"`, false},
+		{"pocketcore/SessionNodeCount", `true`, false},
+		{"pos/SlashFractionDowntime", `"0.010000000000000000"`, true},
+		{"pos/SlashFractionDowntime", `"1.5"`, false},
+		{"pos/StakeMinimum", `2000`, true},
+		{"pos/StakeMinimum", `2100`, false},
+		{"pos/StakeMinimum", `500`, false},
+		// not bounded
+		{"pocketcore/ReputationWindow", `-1`, true},
+	}
+	for _, tt := range tests {
+		err := bounds.Check(tt.key, json.RawMessage(tt.value))
+		assert.Equal(t, tt.valid, err == nil, tt.key+" "+tt.value)
+		if err != nil {
+			assert.Equal(t, CodeParamOutOfBoundsError, int(err.Code()))
+		}
+	}
+}
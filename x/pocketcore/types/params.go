@@ -17,28 +17,45 @@ const (
 	DefaultClaimExpiration            = int64(100) // default sessions to exprie claims
 	DefaultReplayAttackBurnMultiplier = int64(3)   // default replay attack burn multiplier
 	DefaultMinimumNumberOfProofs      = int64(5)   // default minimum number of proofs
+	DefaultChallengeReporterReward    = int64(10)  // default percentage of the burned tokens awarded to the challenge reporter
+	DefaultReputationWeightedSessions = false      // default session selection unweighted by the reputation of the nodes
+	DefaultReputationWindow           = int64(24)  // default sessions of the reputation window
 )
 
 var (
-	DefaultSupportedBlockchains   []string
-	KeySessionNodeCount           = []byte("SessionNodeCount")
-	KeyClaimSubmissionWindow      = []byte("ClaimSubmissionWindow")
-	KeySupportedBlockchains       = []byte("SupportedBlockchains")
-	KeyClaimExpiration            = []byte("ClaimExpiration")
-	KeyReplayAttackBurnMultiplier = []byte("ReplayAttackBurnMultiplier")
-	KeyMinimumNumberOfProofs      = []byte("MinimumNumberOfProofs")
+	DefaultSupportedBlockchains         []string
+	DefaultChainRegistry                ChainRegistry
+	DefaultClaimSubmissionWindowByChain ChainClaimWindows
+	KeySessionNodeCount                 = []byte("SessionNodeCount")
+	KeyClaimSubmissionWindow            = []byte("ClaimSubmissionWindow")
+	KeySupportedBlockchains             = []byte("SupportedBlockchains")
+	KeyClaimExpiration                  = []byte("ClaimExpiration")
+	KeyReplayAttackBurnMultiplier       = []byte("ReplayAttackBurnMultiplier")
+	KeyMinimumNumberOfProofs            = []byte("MinimumNumberOfProofs")
+	KeyChallengeReporterReward          = []byte("ChallengeReporterReward")
+	KeyChainRegistry                    = []byte("ChainRegistry")
+	KeyClaimSubmissionWindowByChain     = []byte("ClaimSubmissionWindowByChain")
+	KeyReputationWeightedSessions       = []byte("ReputationWeightedSessions")
+	KeyReputationWindow                 = []byte("ReputationWindow")
+	KeyParamBounds                      = []byte("ParamBounds")
 )
 
 var _ types.ParamSet = (*Params)(nil)
 
 // "Params" - defines the governance set, high level settings for pocketcore module
 type Params struct {
-	SessionNodeCount           int64    `json:"session_node_count"`
-	ClaimSubmissionWindow      int64    `json:"proof_waiting_period"`
-	SupportedBlockchains       []string `json:"supported_blockchains"`
-	ClaimExpiration            int64    `json:"claim_expiration"` // per session
-	ReplayAttackBurnMultiplier int64    `json:"replay_attack_burn_multiplier"`
-	MinimumNumberOfProofs      int64    `json:"minimum_number_of_proofs"`
+	SessionNodeCount             int64             `json:"session_node_count"`
+	ClaimSubmissionWindow        int64             `json:"proof_waiting_period"`
+	SupportedBlockchains         []string          `json:"supported_blockchains"`
+	ClaimExpiration              int64             `json:"claim_expiration"` // per session
+	ReplayAttackBurnMultiplier   int64             `json:"replay_attack_burn_multiplier"`
+	MinimumNumberOfProofs        int64             `json:"minimum_number_of_proofs"`
+	ChallengeReporterReward      int64             `json:"challenge_reporter_reward"`        // percentage of the burned tokens
+	ChainRegistry                ChainRegistry     `json:"chain_registry"`                   // the metadata of the network identifiers
+	ClaimSubmissionWindowByChain ChainClaimWindows `json:"claim_submission_window_by_chain"` // overrides the claim submission window per chain
+	ReputationWeightedSessions   bool              `json:"reputation_weighted_sessions"`     // weights the session selection by the reputation score of the nodes
+	ReputationWindow             int64             `json:"reputation_window"`                // the sessions the reputation of a servicer is recorded over (the default if zero)
+	ParamBounds                  ParamBounds       `json:"param_bounds"`                     // the bounds of the params enforced on the param changes (the defaults if empty)
 }
 
 // "ParamSetPairs" - returns an kv params object
@@ -51,18 +68,30 @@ func (p *Params) ParamSetPairs() types.ParamSetPairs {
 		{Key: KeyClaimExpiration, Value: &p.ClaimExpiration},
 		{Key: KeyReplayAttackBurnMultiplier, Value: p.ReplayAttackBurnMultiplier},
 		{Key: KeyMinimumNumberOfProofs, Value: p.MinimumNumberOfProofs},
+		{Key: KeyChallengeReporterReward, Value: &p.ChallengeReporterReward},
+		{Key: KeyChainRegistry, Value: &p.ChainRegistry},
+		{Key: KeyClaimSubmissionWindowByChain, Value: &p.ClaimSubmissionWindowByChain},
+		{Key: KeyReputationWeightedSessions, Value: &p.ReputationWeightedSessions},
+		{Key: KeyReputationWindow, Value: &p.ReputationWindow},
+		{Key: KeyParamBounds, Value: &p.ParamBounds},
 	}
 }
 
 // "DefaultParams" - Returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		SessionNodeCount:           DefaultSessionNodeCount,
-		ClaimSubmissionWindow:      DefaultClaimSubmissionWindow,
-		SupportedBlockchains:       DefaultSupportedBlockchains,
-		ClaimExpiration:            DefaultClaimExpiration,
-		ReplayAttackBurnMultiplier: DefaultReplayAttackBurnMultiplier,
-		MinimumNumberOfProofs:      DefaultMinimumNumberOfProofs,
+		SessionNodeCount:             DefaultSessionNodeCount,
+		ClaimSubmissionWindow:        DefaultClaimSubmissionWindow,
+		SupportedBlockchains:         DefaultSupportedBlockchains,
+		ClaimExpiration:              DefaultClaimExpiration,
+		ReplayAttackBurnMultiplier:   DefaultReplayAttackBurnMultiplier,
+		MinimumNumberOfProofs:        DefaultMinimumNumberOfProofs,
+		ChallengeReporterReward:      DefaultChallengeReporterReward,
+		ChainRegistry:                DefaultChainRegistry,
+		ClaimSubmissionWindowByChain: DefaultClaimSubmissionWindowByChain,
+		ReputationWeightedSessions:   DefaultReputationWeightedSessions,
+		ReputationWindow:             DefaultReputationWindow,
+		ParamBounds:                  DefaultParamBounds,
 	}
 }
 
@@ -90,9 +119,29 @@ func (p Params) Validate() error {
 	if p.ClaimExpiration < 0 {
 		return errors.New("invalid claim expiration")
 	}
+	// ensure the challenge reporter reward is a percentage
+	if p.ChallengeReporterReward < 0 || p.ChallengeReporterReward > 100 {
+		return errors.New("invalid challenge reporter reward, must be a percentage between 0 and 100")
+	}
+	// verify the chain registry
+	if err := p.ChainRegistry.Validate(); err != nil {
+		return err
+	}
 	if p.ClaimExpiration < p.ClaimSubmissionWindow {
 		return errors.New("unverified Proof expiration is far too short, must be greater than Proof waiting period")
 	}
+	// verify the claim submission windows of the chains
+	if err := p.ClaimSubmissionWindowByChain.Validate(p.ClaimExpiration); err != nil {
+		return err
+	}
+	// ensure the reputation window (the default one if zero)
+	if p.ReputationWindow < 0 {
+		return errors.New("invalid reputation window")
+	}
+	// verify the param bounds
+	if err := p.ParamBounds.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -111,10 +160,22 @@ func (p Params) String() string {
   Supported Blockchains      %v
   ClaimExpiration            %d
   ReplayAttackBurnMultiplier %d
+  ChallengeReporterReward    %d
+  ChainRegistry              %v
+  ClaimSubmissionWindowByChain %v
+  ReputationWeightedSessions %v
+  ReputationWindow           %d
+  ParamBounds                %v
 `,
 		p.SessionNodeCount,
 		p.ClaimSubmissionWindow,
 		p.SupportedBlockchains,
 		p.ClaimExpiration,
-		p.ReplayAttackBurnMultiplier)
+		p.ReplayAttackBurnMultiplier,
+		p.ChallengeReporterReward,
+		p.ChainRegistry,
+		p.ClaimSubmissionWindowByChain,
+		p.ReputationWeightedSessions,
+		p.ReputationWindow,
+		p.ParamBounds)
 }
@@ -33,6 +33,18 @@ func TestParams_Validate(t *testing.T) {
 	// invalid claim expiration
 	invalidParamsClaims := validParams
 	invalidParamsClaims.ClaimExpiration = -1
+	// invalid challenge reporter reward
+	invalidParamsReporterReward := validParams
+	invalidParamsReporterReward.ChallengeReporterReward = 101
+	// invalid chain registry
+	invalidParamsRegistry := validParams
+	invalidParamsRegistry.ChainRegistry = ChainRegistry{{ID: ethereum}}
+	// invalid claim submission window of a chain
+	invalidParamsClaimWindow := validParams
+	invalidParamsClaimWindow.ClaimSubmissionWindowByChain = ChainClaimWindows{{Chain: ethereum, Window: 1}}
+	// invalid reputation window
+	invalidParamsReputationWindow := validParams
+	invalidParamsReputationWindow.ReputationWindow = -1
 	tests := []struct {
 		name     string
 		params   Params
@@ -58,6 +70,26 @@ func TestParams_Validate(t *testing.T) {
 			params:   invalidParamsClaims,
 			hasError: true,
 		},
+		{
+			name:     "Invalid Params, challenge reporter reward",
+			params:   invalidParamsReporterReward,
+			hasError: true,
+		},
+		{
+			name:     "Invalid Params, chain registry",
+			params:   invalidParamsRegistry,
+			hasError: true,
+		},
+		{
+			name:     "Invalid Params, claim submission window of a chain",
+			params:   invalidParamsClaimWindow,
+			hasError: true,
+		},
+		{
+			name:     "Invalid Params, reputation window",
+			params:   invalidParamsReputationWindow,
+			hasError: true,
+		},
 		{
 			name:     "Valid Params",
 			params:   validParams,
@@ -73,12 +105,18 @@ func TestParams_Validate(t *testing.T) {
 
 func TestDefaultParams(t *testing.T) {
 	assert.True(t, Params{
-		SessionNodeCount:           DefaultSessionNodeCount,
-		ClaimSubmissionWindow:      DefaultClaimSubmissionWindow,
-		SupportedBlockchains:       DefaultSupportedBlockchains,
-		ClaimExpiration:            DefaultClaimExpiration,
-		ReplayAttackBurnMultiplier: DefaultReplayAttackBurnMultiplier,
-		MinimumNumberOfProofs:      DefaultMinimumNumberOfProofs,
+		SessionNodeCount:             DefaultSessionNodeCount,
+		ClaimSubmissionWindow:        DefaultClaimSubmissionWindow,
+		SupportedBlockchains:         DefaultSupportedBlockchains,
+		ClaimExpiration:              DefaultClaimExpiration,
+		ReplayAttackBurnMultiplier:   DefaultReplayAttackBurnMultiplier,
+		MinimumNumberOfProofs:        DefaultMinimumNumberOfProofs,
+		ChallengeReporterReward:      DefaultChallengeReporterReward,
+		ChainRegistry:                DefaultChainRegistry,
+		ClaimSubmissionWindowByChain: DefaultClaimSubmissionWindowByChain,
+		ReputationWeightedSessions:   DefaultReputationWeightedSessions,
+		ReputationWindow:             DefaultReputationWindow,
+		ParamBounds:                  DefaultParamBounds,
 	}.Equal(DefaultParams()))
 }
 
@@ -11,6 +11,7 @@ import (
 
 // "Proof" - An interface representation of an economic proof of work/burn (relay or challenge)
 type Proof interface {
+	Bytes() []byte                                                                                       // returns the bz hashed
 	Hash() []byte                                                                                        // returns cryptographic hash bz
 	HashString() string                                                                                  // returns the hex string representation of the hash
 	ValidateBasic() sdk.Error                                                                            // storeless validation check for the object
@@ -185,8 +186,13 @@ func (rp RelayProof) HashStringWithSignature() string {
 
 // "Store" - Handles the relay proof object by adding it to the cache
 func (rp RelayProof) Store(maxRelays sdk.Int) {
+	rp.StoreRelays(maxRelays, 1)
+}
+
+// "StoreRelays" - Handles the relay proof of the relays (the requests of a batch) by adding it to the cache
+func (rp RelayProof) StoreRelays(maxRelays sdk.Int, relays int64) {
 	// add the Proof to the global (in memory) collection of proofs
-	SetProof(rp.SessionHeader(), RelayEvidence, rp, maxRelays)
+	SetProofOfRelays(rp.SessionHeader(), RelayEvidence, rp, relays, maxRelays)
 }
 
 func (rp RelayProof) GetSigner() sdk.Address {
@@ -229,9 +235,27 @@ func (c ChallengeProofInvalidData) ValidateLocal(h SessionHeader, maxRelays sdk.
 	if err != nil {
 		return err
 	}
+	// the claim of the challenges rewards a single client, so a session only takes the challenges of one client
+	if evidence.NumOfProofs > 0 {
+		if first, ok := evidence.Proofs[0].(ChallengeProofInvalidData); ok {
+			if first.MinorityResponse.Proof.Token.ClientPublicKey != c.MinorityResponse.Proof.Token.ClientPublicKey {
+				return NewMismatchedClientReporterError(ModuleName)
+			}
+		}
+	}
 	return nil
 }
 
+// "ClientReporter" - Returns the address of the client that reported the challenge
+// (taken from the token of the minority response, which is signed by the accused servicer)
+func (c ChallengeProofInvalidData) ClientReporter() (sdk.Address, error) {
+	pk, err := crypto.NewPublicKey(c.MinorityResponse.Proof.Token.ClientPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.Address(pk.Address()), nil
+}
+
 // "Validate" - validate is used to validate a challenge request
 func (c ChallengeProofInvalidData) Validate(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error {
 	majResponse := c.MajorityResponses[0]
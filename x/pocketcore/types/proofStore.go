@@ -0,0 +1,170 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/posmint/types"
+	db "github.com/tendermint/tm-db"
+)
+
+const (
+	// the suffix of the name of the proof store database (next to the evidence database)
+	ProofStoreDBSuffix = "_proofs"
+	// follows the evidence key in the keys of the leaves, the (big endian) index of a proof never starts with it
+	proofLeafMarker = byte(0xff)
+)
+
+var (
+	// the length of the evidence keys: the hash of the session header followed by the evidence type
+	evidenceKeyLength = HashLength + 1
+	// the write ahead log of the proofs, so the proofs not yet flushed with their evidence survive a crash
+	globalProofStore *ProofStore
+)
+
+// "ProofStore" - A write ahead log of the proofs added to the (cached) evidence: every proof is synced to disk before it
+// is added to its evidence, and the evidence is recovered from it on startup
+type ProofStore struct {
+	DB db.DB
+}
+
+// a proof and what is needed to rebuild its evidence
+type proofRecord struct {
+	SessionHeader SessionHeader `json:"header"`
+	EvidenceType  EvidenceType  `json:"evidence_type"`
+	MaxRelays     sdk.Int       `json:"max_relays"` // sizes the bloom filter of the evidence
+	Index         int64         `json:"index"`      // the index of the proof in the evidence
+	Proof         Proof         `json:"proof"`
+	Relays        int64         `json:"relays"` // the relays metered for the proof (the requests of a batch), one if zero
+}
+
+// "Init" - Initializes the database of the proof store
+func (ps *ProofStore) Init(dir, name string, dbType db.DBBackendType) {
+	ps.DB = db.NewDB(name, dbType, dir)
+}
+
+// the key of the proof: the key of the evidence followed by the (big endian) index, so the proofs iterate in order
+func proofStoreKey(evidenceKey []byte, index int64) []byte {
+	bz := make([]byte, len(evidenceKey)+8)
+	copy(bz, evidenceKey)
+	binary.BigEndian.PutUint64(bz[len(evidenceKey):], uint64(index))
+	return bz
+}
+
+// "ProofLeafKey" - Identifies the leaf of the proof in its evidence: the client signature of a relay proof (the client
+// signs every relay once, whatever its entropy), the hash of any other proof
+func ProofLeafKey(p Proof) []byte {
+	if rp, ok := p.(RelayProof); ok && rp.Signature != "" {
+		return Hash([]byte(rp.Signature))
+	}
+	return p.Hash()
+}
+
+// the key of the leaf of the proof: the key of the evidence followed by the marker and the leaf key, so the leaves are
+// deleted along with the proofs of their evidence
+func proofLeafStoreKey(evidenceKey []byte, p Proof) []byte {
+	return append(append(append(make([]byte, 0, len(evidenceKey)+1+HashLength), evidenceKey...), proofLeafMarker), ProofLeafKey(p)...)
+}
+
+// whether the key of the store is the key of a leaf rather than the key of a proof
+func isProofLeafStoreKey(key []byte) bool {
+	return len(key) > evidenceKeyLength && key[evidenceKeyLength] == proofLeafMarker
+}
+
+// "Append" - Syncs the proof (at the index of its evidence) and its leaf to disk at once. The insertion is idempotent:
+// a proof already a leaf of its evidence (e.g. a relay served again after a restart) is not appended, returns whether
+// the proof was appended
+func (ps *ProofStore) Append(header SessionHeader, evidenceType EvidenceType, max sdk.Int, index, relays int64, p Proof) (bool, error) {
+	evidenceKey, err := KeyForEvidence(header, evidenceType)
+	if err != nil {
+		return false, err
+	}
+	leafKey := proofLeafStoreKey(evidenceKey, p)
+	if ps.DB.Has(leafKey) {
+		return false, nil
+	}
+	bz, err := ModuleCdc.MarshalBinaryBare(proofRecord{
+		SessionHeader: header,
+		EvidenceType:  evidenceType,
+		MaxRelays:     max,
+		Index:         index,
+		Proof:         p,
+		Relays:        relays,
+	})
+	if err != nil {
+		return false, err
+	}
+	key := proofStoreKey(evidenceKey, index)
+	batch := ps.DB.NewBatch()
+	defer batch.Close()
+	batch.Set(key, bz)
+	// the leaf points to the proof
+	batch.Set(leafKey, key)
+	batch.WriteSync()
+	return true, nil
+}
+
+// "Delete" - Deletes the proofs of the evidence
+func (ps *ProofStore) Delete(evidenceKey []byte) {
+	iter := db.IteratePrefix(ps.DB, evidenceKey)
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		ps.DB.Delete(key)
+	}
+}
+
+// "Clear" - Deletes all of the proofs
+func (ps *ProofStore) Clear() {
+	ps.Delete(nil)
+}
+
+// "Recover" - Adds the proofs missing from their (persisted) evidence, lost when the node stopped before flushing
+// its cache, and flushes the recovered evidence to the database. Returns the number of recovered proofs
+func (ps *ProofStore) Recover() (recovered int, err error) {
+	// the leaves of the proofs appended before the leaves were stored
+	missingLeaves := make(map[string][]byte)
+	iter := ps.DB.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if isProofLeafStoreKey(key) {
+			continue
+		}
+		var record proofRecord
+		if err = ModuleCdc.UnmarshalBinaryBare(iter.Value(), &record); err != nil {
+			return recovered, fmt.Errorf("could not unmarshal the proof record: %s", err.Error())
+		}
+		leafKey := proofLeafStoreKey(key[:evidenceKeyLength], record.Proof)
+		proofKey, indexed := missingLeaves[string(leafKey)]
+		if !indexed {
+			proofKey = ps.DB.Get(leafKey)
+		}
+		switch {
+		case proofKey == nil:
+			missingLeaves[string(leafKey)] = key
+		case !bytes.Equal(proofKey, key):
+			// the same leaf at another index, never counted twice
+			continue
+		}
+		evidence, er := GetEvidence(record.SessionHeader, record.EvidenceType, record.MaxRelays)
+		if er != nil {
+			return recovered, er
+		}
+		// the proofs up to the number of proofs of the evidence were persisted with it, the proofs after a gap are unusable
+		if record.Index != evidence.NumOfProofs {
+			continue
+		}
+		evidence.AddProofOfRelays(record.Proof, record.Relays)
+		SetEvidence(evidence)
+		recovered++
+	}
+	for leafKey, proofKey := range missingLeaves {
+		ps.DB.Set([]byte(leafKey), proofKey)
+	}
+	return recovered, globalEvidenceCache.FlushToDB()
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProofStore_Recover(t *testing.T) {
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	for i := 0; i < 3; i++ {
+		SetProof(header, RelayEvidence, RelayProof{Entropy: int64(i)}, sdk.NewInt(1000))
+	}
+	// crash before the cache is flushed to the db
+	for _, shard := range globalEvidenceCache.shards {
+		shard.Cache.Purge()
+	}
+	_, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.NotNil(t, err)
+	recovered, err := globalProofStore.Recover()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, recovered)
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), evidence.NumOfProofs)
+	assert.False(t, IsUniqueProof(RelayProof{Entropy: 2}, evidence))
+	// the flushed proofs aren't recovered twice
+	recovered, err = globalProofStore.Recover()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, recovered)
+	// the proofs of the deleted evidence are deleted
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+	key, err := KeyForEvidence(header, RelayEvidence)
+	assert.Nil(t, err)
+	iter := globalProofStore.DB.Iterator(key, nil)
+	defer iter.Close()
+	if iter.Valid() {
+		assert.NotEqual(t, key, iter.Key()[:len(key)])
+	}
+}
+
+func TestProofStore_RecoverRelays(t *testing.T) {
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 0}, sdk.NewInt(1000))
+	// a batch of 5 requests
+	SetProofOfRelays(header, RelayEvidence, RelayProof{Entropy: 1}, 5, sdk.NewInt(1000))
+	// crash before the cache is flushed to the db
+	for _, shard := range globalEvidenceCache.shards {
+		shard.Cache.Purge()
+	}
+	_, err := globalProofStore.Recover()
+	assert.Nil(t, err)
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), evidence.NumOfProofs)
+	assert.Equal(t, int64(6), evidence.TotalRelays())
+	assert.Equal(t, int64(6), GetTotalRelays(header, RelayEvidence, sdk.NewInt(1000)))
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+}
+
+func TestProofStore_IdempotentInsertion(t *testing.T) {
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 1, Signature: "sig1"}, sdk.NewInt(1000))
+	// the same relay, and the same client signature with another entropy
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 1, Signature: "sig1"}, sdk.NewInt(1000))
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 2, Signature: "sig1"}, sdk.NewInt(1000))
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 3, Signature: "sig2"}, sdk.NewInt(1000))
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), evidence.NumOfProofs)
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+}
+
+func TestProofStore_RestartMidSession(t *testing.T) {
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	for i := 0; i < 3; i++ {
+		SetProof(header, RelayEvidence, RelayProof{Entropy: int64(i), Signature: fmt.Sprintf("sig%d", i)}, sdk.NewInt(1000))
+	}
+	// flush the first proofs with the evidence
+	assert.Nil(t, globalEvidenceCache.FlushToDB())
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 3, Signature: "sig3"}, sdk.NewInt(1000))
+	// crash before the cache is flushed to the db
+	for _, shard := range globalEvidenceCache.shards {
+		shard.Cache.Purge()
+	}
+	// the relays served again before the recovery are already in the proof store
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 3, Signature: "sig3"}, sdk.NewInt(1000))
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 1, Signature: "sig1"}, sdk.NewInt(1000))
+	recovered, err := globalProofStore.Recover()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, recovered)
+	// and after the recovery
+	for i := 0; i < 4; i++ {
+		SetProof(header, RelayEvidence, RelayProof{Entropy: int64(i), Signature: fmt.Sprintf("sig%d", i)}, sdk.NewInt(1000))
+	}
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), evidence.NumOfProofs)
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+}
+
+func TestProofStore_RecoverWithoutLeaves(t *testing.T) {
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	key, err := KeyForEvidence(header, RelayEvidence)
+	assert.Nil(t, err)
+	// the proofs appended before the leaves were stored, one of them twice
+	proofs := []Proof{RelayProof{Entropy: 0, Signature: "sig0"}, RelayProof{Entropy: 1, Signature: "sig1"}, RelayProof{Entropy: 0, Signature: "sig0"}}
+	for i, p := range proofs {
+		bz, err := ModuleCdc.MarshalBinaryBare(proofRecord{SessionHeader: header, EvidenceType: RelayEvidence, MaxRelays: sdk.NewInt(1000), Index: int64(i), Proof: p})
+		assert.Nil(t, err)
+		globalProofStore.DB.Set(proofStoreKey(key, int64(i)), bz)
+	}
+	recovered, err := globalProofStore.Recover()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, recovered)
+	assert.True(t, globalProofStore.DB.Has(proofLeafStoreKey(key, proofs[1])))
+	// the leaves are stored now
+	SetProof(header, RelayEvidence, proofs[0], sdk.NewInt(1000))
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), evidence.NumOfProofs)
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+	assert.False(t, globalProofStore.DB.Has(proofLeafStoreKey(key, proofs[1])))
+}
@@ -535,6 +535,32 @@ func TestChallengeProofInvalidData_ValidateLocal(t *testing.T) {
 	}
 }
 
+func TestChallengeProofInvalidData_ValidateLocalClientReporter(t *testing.T) {
+	ClearEvidence()
+	defer ClearEvidence()
+	challenge, servicer1PK, _, _, _, _, _ := NewValidChallengeProof(t)
+	selfAddr := sdk.Address(servicer1PK.PublicKey().Address())
+	sessionNodes := SessionNodes{
+		types.Validator{
+			Address:   selfAddr,
+			PublicKey: servicer1PK.PublicKey(),
+		},
+	}
+	supportedBlockchains := []string{hex.EncodeToString([]byte{01})}
+	maxRelays := sdk.NewInt(100000)
+	// the session already holds a challenge reported by another client
+	other := challenge
+	other.MinorityResponse.Proof.Token.ClientPublicKey = GetRandomPrivateKey().PublicKey().RawString()
+	other.Store(maxRelays)
+	err := challenge.ValidateLocal(challenge.SessionHeader(), maxRelays, supportedBlockchains, 5, sessionNodes, selfAddr)
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeMismatchedClientReporterError, err.Code())
+	// a challenge of the same client is accepted
+	ClearEvidence()
+	challenge.Store(maxRelays)
+	assert.Nil(t, challenge.ValidateLocal(challenge.SessionHeader(), maxRelays, supportedBlockchains, 5, sessionNodes, selfAddr))
+}
+
 func NewValidChallengeProof(t *testing.T) (challenge ChallengeProofInvalidData, ser1 crypto.PrivateKey, ser2 crypto.PrivateKey, ser3 crypto.PrivateKey, app crypto.PrivateKey, cli crypto.PrivateKey, repor crypto.PrivateKey) {
 	appPrivateKey := GetRandomPrivateKey()
 	servicerPrivKey1 := GetRandomPrivateKey()
@@ -39,5 +39,6 @@ type QueryReceiptParams struct {
 
 // "QueryReceiptsParama" - The parameters needed to retreive receipt objs for an address
 type QueryReceiptsParams struct {
-	Address sdk.Address `json:"address"`
+	Address sdk.Address    `json:"address"`
+	Filter  EvidenceFilter `json:"filter"` // every receipt if empty
 }
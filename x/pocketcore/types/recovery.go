@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	fp "path/filepath"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// the directory where the crash dumps are written (empty to only log them)
+	globalCrashDumpDir string
+	crashDumpMux       sync.Mutex
+	// the panics recovered by component, exposed through the prometheus instrumentation
+	recoveredPanics = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pocketcore",
+		Name:      "recovered_panics_total",
+		Help:      "The panics recovered in non consensus entry points (rpc, auto transactions) by component",
+	}, []string{"component"})
+)
+
+func init() {
+	prometheus.MustRegister(recoveredPanics)
+}
+
+// "CrashDump" - The structured report of a recovered panic
+type CrashDump struct {
+	Component string            `json:"component"`         // the entry point that panicked (rpc, claim-tx, ...)
+	Time      time.Time         `json:"time"`              // when the panic was recovered
+	Panic     string            `json:"panic"`             // the recovered value
+	Stack     string            `json:"stack"`             // the stack trace of the panicking goroutine
+	Context   map[string]string `json:"context,omitempty"` // key value details of the entry point (rpc path, block height, ...)
+}
+
+// "InitCrashDumps" - Sets the directory where the crash dumps are written
+func InitCrashDumps(dir string) {
+	globalCrashDumpDir = dir
+}
+
+// "Recover" - Recovers a panic of a non consensus entry point, must be deferred directly.
+// The context is a list of key value pairs added to the crash dump
+func Recover(component string, context ...string) {
+	if r := recover(); r != nil {
+		HandlePanic(component, r, context...)
+	}
+}
+
+// "WithRecovery" - Runs fn, isolating any panic into a crash dump
+func WithRecovery(component string, fn func(), context ...string) {
+	defer Recover(component, context...)
+	fn()
+}
+
+// "HandlePanic" - Logs the crash dump of the recovered value and increments the recovered panics metric
+func HandlePanic(component string, recovered interface{}, context ...string) CrashDump {
+	dump := CrashDump{
+		Component: component,
+		Time:      time.Now().UTC(),
+		Panic:     fmt.Sprintf("%v", recovered),
+		Stack:     string(debug.Stack()),
+	}
+	if len(context) > 0 {
+		dump.Context = make(map[string]string)
+		for i := 0; i+1 < len(context); i += 2 {
+			dump.Context[context[i]] = context[i+1]
+		}
+	}
+	recoveredPanics.WithLabelValues(component).Inc()
+	bz, err := json.Marshal(dump)
+	if err != nil {
+		log.Printf("recovered panic in %s: %s\n", component, dump.Panic)
+		return dump
+	}
+	log.Printf("recovered panic in %s, crash dump: %s\n", component, bz)
+	if err := writeCrashDump(dump, bz); err != nil {
+		log.Printf("unable to write the crash dump: %s\n", err.Error())
+	}
+	return dump
+}
+
+// "writeCrashDump" - Writes the crash dump to its own file in the crash dump directory
+func writeCrashDump(dump CrashDump, bz []byte) error {
+	if globalCrashDumpDir == "" {
+		return nil
+	}
+	crashDumpMux.Lock()
+	defer crashDumpMux.Unlock()
+	if err := os.MkdirAll(globalCrashDumpDir, os.ModePerm); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.json", dump.Time.Format("20060102T150405.000000000"), dump.Component)
+	return ioutil.WriteFile(fp.Join(globalCrashDumpDir, name), bz, 0600)
+}
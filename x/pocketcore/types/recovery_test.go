@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	fp "path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRecovery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "crash_dumps")
+	assert.Nil(t, err)
+	InitCrashDumps(dir)
+	defer InitCrashDumps("")
+	before := testutil.ToFloat64(recoveredPanics.WithLabelValues("test"))
+	ran := false
+	assert.NotPanics(t, func() {
+		WithRecovery("test", func() { panic("boom") }, "height", "10")
+		ran = true
+	})
+	assert.True(t, ran)
+	assert.Equal(t, before+1, testutil.ToFloat64(recoveredPanics.WithLabelValues("test")))
+	// the crash dump is written to the directory
+	files, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, files, 1)
+	bz, err := ioutil.ReadFile(fp.Join(dir, files[0].Name()))
+	assert.Nil(t, err)
+	var dump CrashDump
+	assert.Nil(t, json.Unmarshal(bz, &dump))
+	assert.Equal(t, "test", dump.Component)
+	assert.Equal(t, "boom", dump.Panic)
+	assert.Equal(t, "10", dump.Context["height"])
+	assert.NotEmpty(t, dump.Stack)
+	// no panic, no dump
+	WithRecovery("test", func() {})
+	assert.Equal(t, before+1, testutil.ToFloat64(recoveredPanics.WithLabelValues("test")))
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// "RedactionMode" - How the relay payloads of a chain appear in the logs, the audit records and the error messages
+type RedactionMode string
+
+const (
+	RedactionFull     RedactionMode = "full"     // the payload as is (default)
+	RedactionTruncate RedactionMode = "truncate" // the first RedactionTruncateLength characters of the payload
+	RedactionHash     RedactionMode = "hash"     // the hex hash of the payload only
+	// the characters of a payload kept by the truncate redaction
+	RedactionTruncateLength = 32
+)
+
+var (
+	// the local redaction modes of the chains
+	globalRelayRedaction = relayRedaction{m: make(map[string]RedactionMode)}
+)
+
+type relayRedaction struct {
+	l sync.RWMutex
+	m map[string]RedactionMode
+}
+
+// "Validate" - Validates the redaction mode
+func (rm RedactionMode) Validate() error {
+	switch rm {
+	case RedactionFull, RedactionTruncate, RedactionHash:
+		return nil
+	}
+	return fmt.Errorf("invalid redaction mode %s, must be one of full, truncate or hash", rm)
+}
+
+// "Redact" - Returns the payload redacted with the mode
+func (rm RedactionMode) Redact(payload string) string {
+	switch rm {
+	case RedactionHash:
+		return "hash:" + hex.EncodeToString(Hash([]byte(payload)))
+	case RedactionTruncate:
+		if len(payload) <= RedactionTruncateLength {
+			return payload
+		}
+		return payload[:RedactionTruncateLength] + fmt.Sprintf("...(%d characters truncated)", len(payload)-RedactionTruncateLength)
+	}
+	return payload
+}
+
+// "InitRelayRedaction" - Initializes the redaction modes of the relay payloads of the chains (full if not configured)
+func InitRelayRedaction(redaction map[string]string) error {
+	m := make(map[string]RedactionMode, len(redaction))
+	for chain, mode := range redaction {
+		if err := NetworkIdentifierVerification(chain); err != nil {
+			return err
+		}
+		rm := RedactionMode(strings.ToLower(mode))
+		if err := rm.Validate(); err != nil {
+			return fmt.Errorf("chain %s: %s", chain, err.Error())
+		}
+		m[chain] = rm
+	}
+	globalRelayRedaction.l.Lock()
+	defer globalRelayRedaction.l.Unlock()
+	globalRelayRedaction.m = m
+	return nil
+}
+
+// "RelayRedaction" - Returns the redaction mode of the relay payloads of the chain
+func RelayRedaction(chain string) RedactionMode {
+	globalRelayRedaction.l.RLock()
+	defer globalRelayRedaction.l.RUnlock()
+	if rm, ok := globalRelayRedaction.m[chain]; ok {
+		return rm
+	}
+	return RedactionFull
+}
+
+// "RedactPayload" - Returns the relay payload (or any text containing it) redacted with the mode of the chain
+func RedactPayload(chain, payload string) string {
+	return RelayRedaction(chain).Redact(payload)
+}
+
+// "RedactError" - Returns the error (containing a relay payload) redacted with the mode of the chain
+func RedactError(chain string, err error) error {
+	if err == nil || RelayRedaction(chain) == RedactionFull {
+		return err
+	}
+	return fmt.Errorf("%s", RedactPayload(chain, err.Error()))
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactionMode_Redact(t *testing.T) {
+	payload := `{"jsonrpc":"2.0","method":"eth_getBalance","params":["0xf8b7d2e5b40d1b9e4c3a5b84e1b2b4c3a5b84e1b","latest"],"id":1}`
+	assert.Equal(t, payload, RedactionFull.Redact(payload))
+	assert.Equal(t, "hash:"+hex.EncodeToString(Hash([]byte(payload))), RedactionHash.Redact(payload))
+	truncated := RedactionTruncate.Redact(payload)
+	assert.True(t, strings.HasPrefix(truncated, payload[:RedactionTruncateLength]))
+	assert.NotContains(t, truncated, "latest")
+	assert.Equal(t, "short", RedactionTruncate.Redact("short"))
+	assert.NotNil(t, RedactionMode("none").Validate())
+}
+
+func TestRelayRedaction(t *testing.T) {
+	defer func() { _ = InitRelayRedaction(nil) }()
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	// invalid configs update nothing
+	assert.NotNil(t, InitRelayRedaction(map[string]string{ethereum: "none"}))
+	assert.NotNil(t, InitRelayRedaction(map[string]string{"invalid": "hash"}))
+	assert.Equal(t, RedactionFull, RelayRedaction(ethereum))
+	assert.Nil(t, InitRelayRedaction(map[string]string{ethereum: "HASH"}))
+	assert.Equal(t, RedactionHash, RelayRedaction(ethereum))
+	assert.Equal(t, RedactionFull, RelayRedaction(bitcoin))
+	err := errors.New(`Post "http://127.0.0.1:8545/secret-path": connection refused`)
+	assert.NotContains(t, RedactError(ethereum, err).Error(), "secret-path")
+	assert.Equal(t, err, RedactError(bitcoin, err))
+	assert.Nil(t, RedactError(ethereum, nil))
+	assert.NotContains(t, Payload{Data: "secret", Method: "POST"}.Redacted(ethereum), "secret")
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+var (
+	// the sessions whose evidence was orphaned by a reorg of the local chain
+	globalQuarantine = quarantine{sessions: make(map[string]QuarantinedEvidence)}
+)
+
+// "QuarantinedEvidence" - The summary of the evidence collected under an orphaned session block hash, never claimed
+type QuarantinedEvidence struct {
+	SessionHeader    SessionHeader `json:"session_header"`
+	EvidenceType     EvidenceType  `json:"evidence_type"`
+	NumOfProofs      int64         `json:"num_of_proofs"`
+	OrphanedHash     string        `json:"orphaned_hash"`      // the session block hash the evidence was collected under
+	SessionBlockHash string        `json:"session_block_hash"` // the session block hash of the local chain after the reorg
+	QuarantinedAt    time.Time     `json:"quarantined_at"`
+}
+
+type quarantine struct {
+	l        sync.RWMutex
+	sessions map[string]QuarantinedEvidence
+}
+
+// "IsOrphaned" - Returns whether the evidence was collected under a session block hash other than the one of the local chain
+func (e Evidence) IsOrphaned(sessionBlockHash string) bool {
+	// the evidence collected before the hashes were recorded can't be checked
+	return e.SessionBlockHash != "" && e.SessionBlockHash != sessionBlockHash
+}
+
+// "SetEvidenceSessionBlockHash" - Records the session block hash the evidence is collected under, quarantining the evidence
+// previously collected under another (orphaned) hash so the relays of the new session aren't mixed with it. Returns the
+// orphaned hash if any
+func SetEvidenceSessionBlockHash(header SessionHeader, evidenceType EvidenceType, sessionBlockHash string, max sdk.Int) (orphanedHash string, err error) {
+	key, err := KeyForEvidence(header, evidenceType)
+	if err != nil {
+		return "", err
+	}
+	lock := globalEvidenceCache.WriteLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	evidence, err := GetEvidence(header, evidenceType, max)
+	if err != nil {
+		return "", err
+	}
+	if evidence.SessionBlockHash == sessionBlockHash {
+		return "", nil
+	}
+	if evidence.IsOrphaned(sessionBlockHash) {
+		orphanedHash = evidence.SessionBlockHash
+		if err = QuarantineEvidence(evidence, sessionBlockHash); err != nil {
+			return
+		}
+		if evidence, err = GetEvidence(header, evidenceType, max); err != nil {
+			return
+		}
+	}
+	evidence.SessionBlockHash = sessionBlockHash
+	SetEvidence(evidence)
+	return
+}
+
+// "QuarantineEvidence" - Removes the orphaned evidence from the stores (so it is never claimed) and records it
+func QuarantineEvidence(evidence Evidence, sessionBlockHash string) error {
+	key, err := KeyForEvidence(evidence.SessionHeader, evidence.EvidenceType)
+	if err != nil {
+		return err
+	}
+	if err := DeleteEvidence(evidence.SessionHeader, evidence.EvidenceType); err != nil {
+		return err
+	}
+	// the session of the orphaned block is stale too
+	DeleteSession(evidence.SessionHeader)
+	globalQuarantine.l.Lock()
+	defer globalQuarantine.l.Unlock()
+	globalQuarantine.sessions[hex.EncodeToString(key)] = QuarantinedEvidence{
+		SessionHeader:    evidence.SessionHeader,
+		EvidenceType:     evidence.EvidenceType,
+		NumOfProofs:      evidence.NumOfProofs,
+		OrphanedHash:     evidence.SessionBlockHash,
+		SessionBlockHash: sessionBlockHash,
+		QuarantinedAt:    time.Now(),
+	}
+	return nil
+}
+
+// "GetQuarantinedEvidence" - Returns the evidence quarantined since the node started
+func GetQuarantinedEvidence() []QuarantinedEvidence {
+	globalQuarantine.l.RLock()
+	defer globalQuarantine.l.RUnlock()
+	res := make([]QuarantinedEvidence, 0, len(globalQuarantine.sessions))
+	for _, q := range globalQuarantine.sessions {
+		res = append(res, q)
+	}
+	return res
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetEvidenceSessionBlockHash(t *testing.T) {
+	appPubKey := getRandomPubKey().RawString()
+	header := SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              hex.EncodeToString([]byte{0001}),
+		SessionBlockHeight: 1,
+	}
+	hash := hex.EncodeToString(Hash([]byte("block")))
+	reorgHash := hex.EncodeToString(Hash([]byte("reorg block")))
+	max := sdk.NewInt(100000)
+	// the hash of new evidence is recorded
+	orphaned, err := SetEvidenceSessionBlockHash(header, RelayEvidence, hash, max)
+	assert.Nil(t, err)
+	assert.Empty(t, orphaned)
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 1}, max)
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	assert.Equal(t, hash, evidence.SessionBlockHash)
+	assert.False(t, evidence.IsOrphaned(hash))
+	assert.True(t, evidence.IsOrphaned(reorgHash))
+	// the hash is persisted with the evidence
+	bz, err := evidence.Marshal()
+	assert.Nil(t, err)
+	persisted, err := evidence.Unmarshal(bz)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, persisted.(Evidence).SessionBlockHash)
+	// the same hash keeps the evidence
+	orphaned, err = SetEvidenceSessionBlockHash(header, RelayEvidence, hash, max)
+	assert.Nil(t, err)
+	assert.Empty(t, orphaned)
+	evidence, _ = GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Equal(t, int64(1), evidence.NumOfProofs)
+	// a reorg quarantines the evidence and starts over
+	orphaned, err = SetEvidenceSessionBlockHash(header, RelayEvidence, reorgHash, max)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, orphaned)
+	evidence, _ = GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Equal(t, int64(0), evidence.NumOfProofs)
+	assert.Equal(t, reorgHash, evidence.SessionBlockHash)
+	var quarantined QuarantinedEvidence
+	for _, q := range GetQuarantinedEvidence() {
+		if q.SessionHeader == header {
+			quarantined = q
+		}
+	}
+	assert.Equal(t, int64(1), quarantined.NumOfProofs)
+	assert.Equal(t, hash, quarantined.OrphanedHash)
+	assert.Equal(t, reorgHash, quarantined.SessionBlockHash)
+}
+
+func TestEvidence_IsOrphaned(t *testing.T) {
+	// the evidence collected before the hashes were recorded is never orphaned
+	assert.False(t, Evidence{}.IsOrphaned("abc"))
+	assert.False(t, Evidence{SessionBlockHash: "abc"}.IsOrphaned("abc"))
+	assert.True(t, Evidence{SessionBlockHash: "abc"}.IsOrphaned("def"))
+}
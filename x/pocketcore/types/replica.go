@@ -0,0 +1,17 @@
+package types
+
+var (
+	// whether this node is a read only replica
+	globalReplica bool
+)
+
+// "InitReplica" - Sets whether this node is a read only replica: it serves the queries and the dispatches, but no
+// relays nor challenges (no evidence is collected) and sends no claim, proof nor restake transaction
+func InitReplica(replica bool) {
+	globalReplica = replica
+}
+
+// "IsReplica" - Returns whether this node is a read only replica
+func IsReplica() bool {
+	return globalReplica
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+const (
+	MaxReputationScore = int64(100) // the score of a servicer without challenges (or without a record) in the window
+	MinReputationScore = int64(10)  // the floor of the score, so a servicer is never excluded from the sessions
+)
+
+// "Reputation" - The service record of a servicer over the reputation window: the relay claims it proved and the
+// challenges settled against it
+type Reputation struct {
+	WindowStart int64 `json:"window_start"` // the session block height the window started at
+	Proofs      int64 `json:"proofs"`       // the relay claims proven in the window
+	Challenges  int64 `json:"challenges"`   // the challenge claims settled against the servicer in the window
+}
+
+// "Score" - The reputation score of the servicer, the percentage of its proofs out of its proofs and challenges
+// (between the min and the max reputation score)
+func (r Reputation) Score() int64 {
+	total := r.Proofs + r.Challenges
+	if total == 0 {
+		return MaxReputationScore
+	}
+	score := MaxReputationScore * r.Proofs / total
+	if score < MinReputationScore {
+		return MinReputationScore
+	}
+	return score
+}
+
+// "IsExpired" - Returns true if the window of the reputation ended before the session block height
+func (r Reputation) IsExpired(sessionBlockHeight, window, blocksPerSession int64) bool {
+	return r.WindowStart+window*blocksPerSession <= sessionBlockHeight
+}
+
+// "SessionWeight" - Returns the weight (reputation score) of a node in the selection of the session nodes, at the
+// session context
+type SessionWeight func(sessionCtx sdk.Ctx, addr sdk.Address) int64
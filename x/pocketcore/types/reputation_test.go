@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReputation_Score(t *testing.T) {
+	tests := []struct {
+		name       string
+		reputation Reputation
+		score      int64
+	}{
+		{"no record", Reputation{}, MaxReputationScore},
+		{"no challenges", Reputation{Proofs: 10}, MaxReputationScore},
+		{"proofs and challenges", Reputation{Proofs: 9, Challenges: 1}, 90},
+		{"floor", Reputation{Proofs: 1, Challenges: 99}, MinReputationScore},
+		{"only challenges", Reputation{Challenges: 3}, MinReputationScore},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.score, tt.reputation.Score())
+		})
+	}
+}
+
+func TestReputation_IsExpired(t *testing.T) {
+	r := Reputation{WindowStart: 5}
+	assert.False(t, r.IsExpired(5, 2, 4))
+	assert.False(t, r.IsExpired(9, 2, 4))
+	assert.True(t, r.IsExpired(13, 2, 4))
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+var (
+	// the http methods of the relays, e.g. to the REST apis of the hosted chains (Tendermint LCD, Algod...)
+	relayHTTPMethods = map[string]struct{}{
+		http.MethodGet:     {},
+		http.MethodHead:    {},
+		http.MethodPost:    {},
+		http.MethodPut:     {},
+		http.MethodPatch:   {},
+		http.MethodDelete:  {},
+		http.MethodOptions: {},
+	}
+	// the headers of the connection of the node to the hosted chain, never taken from the payload
+	connectionHeaders = map[string]struct{}{
+		"Connection":          {},
+		"Content-Length":      {},
+		"Host":                {},
+		"Keep-Alive":          {},
+		"Proxy-Authorization": {},
+		"Proxy-Connection":    {},
+		"Te":                  {},
+		"Trailer":             {},
+		"Transfer-Encoding":   {},
+		"Upgrade":             {},
+	}
+)
+
+// "HTTPMethod" - Returns the http method of the payload, upper case, or the default (POST) if empty
+func (p Payload) HTTPMethod() string {
+	if p.Method == "" {
+		return DEFAULTHTTPMETHOD
+	}
+	return strings.ToUpper(p.Method)
+}
+
+// "URL" - Returns the url of the payload on the hosted chain at the chain url: the path of the payload (with its query
+// and trailing slash, so REST routes are relayed as requested) appended to the chain url
+func (p Payload) URL(chainURL string) string {
+	u := strings.TrimRight(chainURL, `/`)
+	if p.Path == "" {
+		return u
+	}
+	return u + "/" + strings.TrimLeft(p.Path, `/`)
+}
+
+// "validateHTTP" - Validates the http method, the path (relative to the chain url) and the headers of the payload
+func (p Payload) validateHTTP() sdk.Error {
+	if _, ok := relayHTTPMethods[p.HTTPMethod()]; !ok {
+		return NewInvalidPayloadError(ModuleName, fmt.Sprintf("unsupported http method %q", p.Method))
+	}
+	if p.Path != "" {
+		u, err := url.Parse(p.Path)
+		if err != nil {
+			return NewInvalidPayloadError(ModuleName, fmt.Sprintf("invalid path: %s", err.Error()))
+		}
+		if u.Scheme != "" || u.Host != "" || u.User != nil {
+			return NewInvalidPayloadError(ModuleName, "the path must be relative to the url of the chain")
+		}
+		for _, segment := range strings.Split(u.Path, "/") {
+			if segment == ".." {
+				return NewInvalidPayloadError(ModuleName, "the path must not leave the url of the chain")
+			}
+		}
+	}
+	for name, value := range p.Headers {
+		if name == "" || strings.ContainsAny(name, " \t\r\n:") {
+			return NewInvalidPayloadError(ModuleName, fmt.Sprintf("invalid header %q", name))
+		}
+		if _, ok := connectionHeaders[http.CanonicalHeaderKey(name)]; ok {
+			return NewInvalidPayloadError(ModuleName, fmt.Sprintf("the header %s is set by the node", name))
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return NewInvalidPayloadError(ModuleName, fmt.Sprintf("invalid value of the header %s", name))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayload_HTTPMethod(t *testing.T) {
+	assert.Equal(t, DEFAULTHTTPMETHOD, Payload{}.HTTPMethod())
+	assert.Equal(t, http.MethodGet, Payload{Method: "get"}.HTTPMethod())
+}
+
+func TestPayload_URL(t *testing.T) {
+	assert.Equal(t, "https://server.com/relay", Payload{}.URL("https://server.com/relay/"))
+	assert.Equal(t, "https://server.com/relay/v2/status", Payload{Path: "/v2/status"}.URL("https://server.com/relay/"))
+	// the query and the trailing slash are kept
+	assert.Equal(t, "https://server.com/v2/accounts/ABC?format=json", Payload{Path: "v2/accounts/ABC?format=json"}.URL("https://server.com"))
+	assert.Equal(t, "https://server.com/blocks/latest/", Payload{Path: "/blocks/latest/"}.URL("https://server.com"))
+}
+
+func TestPayload_ValidateHTTP(t *testing.T) {
+	valid := []Payload{
+		{Data: "foo"},
+		{Method: "get", Path: "/v2/status"},
+		{Method: http.MethodDelete, Path: "/v1/foo?bar=1", Headers: map[string]string{"Accept": "application/json"}},
+	}
+	for _, p := range valid {
+		assert.Nil(t, p.Validate(), p.Path)
+	}
+	invalid := []Payload{
+		{Method: "CONNECT", Path: "/v2/status"},
+		{Method: "FOO", Data: "foo"},
+		{Path: "https://other.com/v2/status"},
+		{Path: "//other.com/v2/status"},
+		{Path: "/v2/../../admin"},
+		{Path: "/v2/%2e%2e/admin"},
+		{Data: "foo", Headers: map[string]string{"Host": "other.com"}},
+		{Data: "foo", Headers: map[string]string{"content-length": "1"}},
+		{Data: "foo", Headers: map[string]string{"X Foo": "bar"}},
+		{Data: "foo", Headers: map[string]string{"X-Foo": "bar\r\nHost: other.com"}},
+	}
+	for _, p := range invalid {
+		err := p.Validate()
+		if assert.NotNil(t, err, p.Path) {
+			assert.Equal(t, CodeInvalidPayloadError, int(err.Code()))
+		}
+	}
+}
+
+func TestRelay_ExecuteREST(t *testing.T) {
+	var method, uri, accept string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, uri, accept = r.Method, r.URL.RequestURI(), r.Header.Get("Accept")
+		body, _ = ioutil.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"round":1}`))
+	}))
+	defer server.Close()
+	chain := hex.EncodeToString([]byte{02})
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{chain: {ID: chain, URL: server.URL + "/algod/"}},
+	}
+	relay := Relay{
+		Payload: Payload{Method: "get", Path: "/v2/status/?format=json", Headers: map[string]string{"Accept": "application/json"}},
+		Proof:   RelayProof{Blockchain: chain},
+	}
+	res, err := relay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"round":1}`, res)
+	assert.Equal(t, http.MethodGet, method)
+	assert.Equal(t, "/algod/v2/status/?format=json", uri)
+	assert.Equal(t, "application/json", accept)
+	assert.Empty(t, body)
+	// the data is the body of the request
+	relay.Payload = Payload{Method: http.MethodPut, Path: "/v2/foo", Data: `{"foo":"bar"}`}
+	_, err = relay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, `{"foo":"bar"}`, string(body))
+}
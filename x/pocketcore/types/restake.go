@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+var (
+	// the auto restake (compounding) configuration of this node
+	globalAutoRestake AutoRestakeConfig
+)
+
+// "AutoRestakeConfig" - The local configuration for rolling the earned rewards into the stake at session boundaries
+type AutoRestakeConfig struct {
+	Enabled   bool    // auto restake the rewards
+	Threshold sdk.Int // the minimum amount to restake (avoids a transaction per session for dust)
+	Reserve   sdk.Int // the balance always kept unstaked to pay for the claim and proof fees
+}
+
+// "InitAutoRestake" - Initializes the auto restake configuration
+func InitAutoRestake(enabled bool, threshold, reserve int64) {
+	globalAutoRestake = AutoRestakeConfig{
+		Enabled:   enabled,
+		Threshold: sdk.NewInt(threshold),
+		Reserve:   sdk.NewInt(reserve),
+	}
+}
+
+// "GetAutoRestake" - Returns the auto restake configuration
+func GetAutoRestake() AutoRestakeConfig {
+	return globalAutoRestake
+}
+
+// "RestakeAmount" - Returns the amount of the balance to roll into the stake, after the reserve and the fee of the
+// restake transaction, and whether it is above the safety threshold
+func (c AutoRestakeConfig) RestakeAmount(balance sdk.Int, fee sdk.Int) (amount sdk.Int, ok bool) {
+	if !c.Enabled {
+		return sdk.ZeroInt(), false
+	}
+	amount = balance.Sub(fee).Sub(c.Reserve)
+	if !amount.IsPositive() {
+		return sdk.ZeroInt(), false
+	}
+	if amount.LT(c.Threshold) {
+		return amount, false
+	}
+	return amount, true
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoRestakeConfig_RestakeAmount(t *testing.T) {
+	defer InitAutoRestake(false, 0, 0)
+	fee := sdk.NewInt(10)
+	// disabled
+	InitAutoRestake(false, 100, 50)
+	_, ok := GetAutoRestake().RestakeAmount(sdk.NewInt(1000), fee)
+	assert.False(t, ok)
+	InitAutoRestake(true, 100, 50)
+	// the balance minus the fee and the reserve
+	amount, ok := GetAutoRestake().RestakeAmount(sdk.NewInt(1000), fee)
+	assert.True(t, ok)
+	assert.Equal(t, sdk.NewInt(940), amount)
+	// below the threshold
+	amount, ok = GetAutoRestake().RestakeAmount(sdk.NewInt(150), fee)
+	assert.False(t, ok)
+	assert.Equal(t, sdk.NewInt(90), amount)
+	// the balance doesn't cover the reserve
+	amount, ok = GetAutoRestake().RestakeAmount(sdk.NewInt(40), fee)
+	assert.False(t, ok)
+	assert.True(t, amount.IsZero())
+}
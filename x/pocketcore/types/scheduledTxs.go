@@ -0,0 +1,182 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	sdk "github.com/pokt-network/posmint/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// the default name of the file persisting the transactions scheduled for a future height
+	DefaultScheduledTxsName = "scheduled_txs.json"
+)
+
+var (
+	globalScheduledTxs = &scheduledTxs{txs: make(map[string]ScheduledTx)}
+)
+
+// "ScheduledTx" - A signed transaction queued by the operator, broadcast by this node once the chain reaches its height
+// (local, not part of the protocol)
+type ScheduledTx struct {
+	Hash      string `json:"hash"`
+	Height    int64  `json:"height"`
+	Tx        string `json:"tx"` // the hex amino encoded signed tx
+	Attempts  int    `json:"attempts,omitempty"`
+	LastError string `json:"last_error,omitempty"`
+}
+
+// "ScheduledTxsUpdate" - The transactions scheduled (height and tx) and cancelled (hashes)
+type ScheduledTxsUpdate struct {
+	Schedule []ScheduledTx `json:"schedule,omitempty"`
+	Cancel   []string      `json:"cancel,omitempty"`
+}
+
+// the scheduled transactions by hash, persisted to their file (if any) on every change
+type scheduledTxs struct {
+	l    sync.Mutex
+	path string
+	txs  map[string]ScheduledTx
+}
+
+// "InitScheduledTxs" - Loads the scheduled transactions persisted at path
+func InitScheduledTxs(path string) error {
+	s := &scheduledTxs{path: path, txs: make(map[string]ScheduledTx)}
+	bz, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil {
+		var txs []ScheduledTx
+		if err := json.Unmarshal(bz, &txs); err != nil {
+			return fmt.Errorf("invalid scheduled txs file %s: %s", path, err.Error())
+		}
+		for _, tx := range txs {
+			s.txs[tx.Hash] = tx
+		}
+	}
+	globalScheduledTxs = s
+	return nil
+}
+
+// "UpdateScheduledTxs" - Schedules/cancels the transactions of the update, the scheduled heights must be above the
+// height, nothing is updated if any is invalid. Returns the scheduled transactions of the update (with their hash)
+func UpdateScheduledTxs(update ScheduledTxsUpdate, height int64) ([]ScheduledTx, sdk.Error) {
+	s := globalScheduledTxs
+	s.l.Lock()
+	defer s.l.Unlock()
+	scheduled := make([]ScheduledTx, 0, len(update.Schedule))
+	for _, tx := range update.Schedule {
+		if tx.Height <= height {
+			return nil, NewInvalidScheduledTxError(ModuleName, fmt.Sprintf("the height %d is not above the latest height %d", tx.Height, height))
+		}
+		bz, err := hex.DecodeString(tx.Tx)
+		if err != nil || len(bz) == 0 {
+			return nil, NewInvalidScheduledTxError(ModuleName, "expected the hex bytes of a signed tx")
+		}
+		scheduled = append(scheduled, ScheduledTx{Hash: fmt.Sprintf("%X", tmTypes.Tx(bz).Hash()), Height: tx.Height, Tx: strings.ToLower(tx.Tx)})
+	}
+	for _, hash := range update.Cancel {
+		if _, found := s.txs[strings.ToUpper(hash)]; !found {
+			return nil, NewScheduledTxNotFoundError(ModuleName, hash)
+		}
+	}
+	for _, hash := range update.Cancel {
+		delete(s.txs, strings.ToUpper(hash))
+	}
+	for _, tx := range scheduled {
+		s.txs[tx.Hash] = tx
+	}
+	if len(update.Schedule) != 0 || len(update.Cancel) != 0 {
+		s.persist()
+	}
+	return scheduled, nil
+}
+
+// "GetScheduledTxs" - Returns the scheduled transactions, the lowest heights first
+func GetScheduledTxs() []ScheduledTx {
+	s := globalScheduledTxs
+	s.l.Lock()
+	txs := make([]ScheduledTx, 0, len(s.txs))
+	for _, tx := range s.txs {
+		txs = append(txs, tx)
+	}
+	s.l.Unlock()
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].Height != txs[j].Height {
+			return txs[i].Height < txs[j].Height
+		}
+		return txs[i].Hash < txs[j].Hash
+	})
+	return txs
+}
+
+// "DueScheduledTxs" - Returns the scheduled transactions of the height or below, the lowest heights first
+func DueScheduledTxs(height int64) []ScheduledTx {
+	txs := make([]ScheduledTx, 0)
+	for _, tx := range GetScheduledTxs() {
+		if tx.Height <= height {
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
+
+// "RecordScheduledTxBroadcast" - Removes the broadcast transaction from the schedule
+func RecordScheduledTxBroadcast(hash string) {
+	s := globalScheduledTxs
+	s.l.Lock()
+	defer s.l.Unlock()
+	if _, found := s.txs[hash]; !found {
+		return
+	}
+	delete(s.txs, hash)
+	s.persist()
+}
+
+// "RecordScheduledTxFailure" - Keeps the transaction that couldn't be broadcast in the schedule, to re-attempt it at
+// the next block
+func RecordScheduledTxFailure(hash string, err error) {
+	s := globalScheduledTxs
+	s.l.Lock()
+	defer s.l.Unlock()
+	tx, found := s.txs[hash]
+	if !found {
+		return
+	}
+	tx.Attempts++
+	tx.LastError = err.Error()
+	s.txs[hash] = tx
+	s.persist()
+}
+
+// writes the schedule to its file (replacing it, so it is never half written), must hold the lock
+func (s *scheduledTxs) persist() {
+	if s.path == "" {
+		return
+	}
+	txs := make([]ScheduledTx, 0, len(s.txs))
+	for _, tx := range s.txs {
+		txs = append(txs, tx)
+	}
+	bz, err := json.Marshal(txs)
+	if err != nil {
+		fmt.Println(fmt.Errorf("unable to persist the scheduled txs: %s", err.Error()))
+		return
+	}
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0644); err != nil {
+		fmt.Println(fmt.Errorf("unable to persist the scheduled txs: %s", err.Error()))
+		return
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		fmt.Println(fmt.Errorf("unable to persist the scheduled txs: %s", err.Error()))
+	}
+}
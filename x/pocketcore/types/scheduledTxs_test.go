@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestScheduledTxs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "scheduledtxs")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := dir + string(os.PathSeparator) + DefaultScheduledTxsName
+	assert.Nil(t, InitScheduledTxs(path))
+	defer func() { _ = InitScheduledTxs("") }()
+	tx1, tx2 := []byte("tx1"), []byte("tx2")
+	hash1, hash2 := fmt.Sprintf("%X", tmTypes.Tx(tx1).Hash()), fmt.Sprintf("%X", tmTypes.Tx(tx2).Hash())
+	// a past height
+	_, err = UpdateScheduledTxs(ScheduledTxsUpdate{Schedule: []ScheduledTx{{Height: 10, Tx: hex.EncodeToString(tx1)}}}, 10)
+	assert.NotNil(t, err)
+	// not hex
+	_, err = UpdateScheduledTxs(ScheduledTxsUpdate{Schedule: []ScheduledTx{{Height: 11, Tx: "foo"}}}, 10)
+	assert.NotNil(t, err)
+	scheduled, err := UpdateScheduledTxs(ScheduledTxsUpdate{Schedule: []ScheduledTx{
+		{Height: 12, Tx: hex.EncodeToString(tx1)},
+		{Height: 11, Tx: hex.EncodeToString(tx2)},
+	}}, 10)
+	assert.Nil(t, err)
+	assert.Len(t, scheduled, 2)
+	assert.Equal(t, hash1, scheduled[0].Hash)
+	// the lowest heights first
+	txs := GetScheduledTxs()
+	assert.Len(t, txs, 2)
+	assert.Equal(t, hash2, txs[0].Hash)
+	assert.Len(t, DueScheduledTxs(10), 0)
+	assert.Len(t, DueScheduledTxs(11), 1)
+	assert.Len(t, DueScheduledTxs(12), 2)
+	// a failure keeps the tx for the next block
+	RecordScheduledTxFailure(hash2, fmt.Errorf("connection refused"))
+	// the schedule survives a restart
+	assert.Nil(t, InitScheduledTxs(path))
+	txs = GetScheduledTxs()
+	assert.Len(t, txs, 2)
+	assert.Equal(t, 1, txs[0].Attempts)
+	assert.Equal(t, "connection refused", txs[0].LastError)
+	// a broadcast tx is removed
+	RecordScheduledTxBroadcast(hash2)
+	assert.Len(t, GetScheduledTxs(), 1)
+	// an unknown tx can't be cancelled
+	_, err = UpdateScheduledTxs(ScheduledTxsUpdate{Cancel: []string{hash2}}, 10)
+	assert.NotNil(t, err)
+	assert.Len(t, GetScheduledTxs(), 1)
+	_, err = UpdateScheduledTxs(ScheduledTxsUpdate{Cancel: []string{strings.ToLower(hash1)}}, 10)
+	assert.Nil(t, err)
+	assert.Len(t, GetScheduledTxs(), 0)
+	assert.Nil(t, InitScheduledTxs(path))
+	assert.Len(t, GetScheduledTxs(), 0)
+}
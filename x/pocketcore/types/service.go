@@ -1,7 +1,6 @@
 package types
 
 import (
-	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -9,10 +8,12 @@ import (
 	nodeexported "github.com/pokt-network/pocket-core/x/nodes/exported"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"strings"
+	"time"
 )
 
 const DEFAULTHTTPMETHOD = "POST"
@@ -31,10 +32,10 @@ type Relay struct {
 
 // "Validate" - Checks the validity of a relay request using store data
 func (r *Relay) Validate(ctx sdk.Ctx, keeper PosKeeper, node nodeexported.ValidatorI, hb *HostedBlockchains, sessionBlockHeight int64,
-	sessionNodeCount int, app appexported.ApplicationI) (maxPossibleRelays sdk.Int, err sdk.Error) {
+	sessionNodeCount int, app appexported.ApplicationI, weight SessionWeight) (maxPossibleRelays sdk.Int, err sdk.Error) {
 	// validate payload
 	if err := r.Payload.Validate(); err != nil {
-		return sdk.ZeroInt(), NewEmptyPayloadDataError(ModuleName)
+		return sdk.ZeroInt(), err
 	}
 	// validate the metadata
 	if err := r.Meta.Validate(ctx); err != nil {
@@ -84,7 +85,7 @@ func (r *Relay) Validate(ctx sdk.Ctx, keeper PosKeeper, node nodeexported.Valida
 	// if not found generate the session
 	if !found {
 		var err sdk.Error
-		session, err = NewSession(sessionContext, ctx, keeper, header, BlockHash(sessionContext), sessionNodeCount)
+		session, err = NewSession(sessionContext, ctx, keeper, header, BlockHash(sessionContext), sessionNodeCount, weight)
 		if err != nil {
 			return sdk.ZeroInt(), err
 		}
@@ -105,21 +106,74 @@ func (r *Relay) Validate(ctx sdk.Ctx, keeper PosKeeper, node nodeexported.Valida
 
 // "Execute" - Attempts to do a request on the non-native blockchain specified
 func (r Relay) Execute(hostedBlockchains *HostedBlockchains) (string, sdk.Error) {
+	res, _, err := r.ExecuteWithHeaders(hostedBlockchains)
+	return res, err
+}
+
+// "ExecuteWithHeaders" - Attempts to do a request on the non-native blockchain specified, returns the response and
+// the response headers allowed to pass through to the client (none for a batch)
+func (r Relay) ExecuteWithHeaders(hostedBlockchains *HostedBlockchains) (string, map[string]string, sdk.Error) {
 	// retrieve the hosted blockchain url requested
 	chain, err := hostedBlockchains.GetChain(r.Proof.Blockchain)
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+	// relay the gRPC call to a hosted blockchain only exposing gRPC
+	if chain.IsGRPC() {
+		return r.executeGRPC(hostedBlockchains, chain)
+	}
+	// the connections to the hosted blockchain are pooled
+	client, err := hostedBlockchains.GetClient(r.Proof.Blockchain)
+	if err != nil {
+		return "", nil, err
 	}
-	url := strings.Trim(chain.URL, `/`)
-	if len(r.Payload.Path) > 0 {
-		url = url + "/" + strings.Trim(r.Payload.Path, `/`)
+	url := r.Payload.URL(chain.URL)
+	method := r.Payload.HTTPMethod()
+	// forward the requests of a batch individually
+	if r.Payload.IsBatch() {
+		requests, err := r.Payload.Batch()
+		if err != nil {
+			return "", nil, err
+		}
+		return executeBatch(requests, func(request string) (string, error) {
+			res, _, err := chain.HTTP.executeWithRetry(func() (string, http.Header, error) {
+				return executeHTTPRequest(client, request, url, globalUserAgent, chain.GetCredentials(), method, r.Payload.Headers)
+			})
+			return res, RedactError(r.Proof.Blockchain, err)
+		}), nil, nil
 	}
 	// do basic http request on the relay
-	res, er := executeHTTPRequest(r.Payload.Data, url, globalUserAgent, chain.BasicAuth, r.Payload.Method, r.Payload.Headers)
+	res, header, er := chain.HTTP.executeWithRetry(func() (string, http.Header, error) {
+		return executeHTTPRequest(client, r.Payload.Data, url, globalUserAgent, chain.GetCredentials(), method, r.Payload.Headers)
+	})
+	if er != nil {
+		// the error may contain the payload (e.g. the path of the request)
+		return res, nil, NewHTTPExecutionError(ModuleName, RedactError(r.Proof.Blockchain, er))
+	}
+	return res, chain.HTTP.PassthroughHeaders(header), nil
+}
+
+// "executeGRPC" - Calls the gRPC method of the payload on the hosted blockchain over its (pooled) gRPC connection
+func (r Relay) executeGRPC(hostedBlockchains *HostedBlockchains, chain HostedBlockchain) (string, map[string]string, sdk.Error) {
+	conn, err := hostedBlockchains.GetGRPCConn(r.Proof.Blockchain)
+	if err != nil {
+		return "", nil, err
+	}
+	method, err := r.Payload.GRPCMethod()
+	if err != nil {
+		return "", nil, err
+	}
+	req, err := r.Payload.GRPCRequest()
+	if err != nil {
+		return "", nil, err
+	}
+	res, header, er := chain.HTTP.executeWithRetry(func() (string, http.Header, error) {
+		return executeGRPCRequest(conn, method, req, r.Payload.Headers, chain.GetCredentials(), chain.HTTP.Timeout)
+	})
 	if er != nil {
-		return res, NewHTTPExecutionError(ModuleName, er)
+		return res, nil, NewHTTPExecutionError(ModuleName, RedactError(r.Proof.Blockchain, er))
 	}
-	return res, nil
+	return res, chain.HTTP.PassthroughHeaders(header), nil
 }
 
 // "Bytes" - Returns the bytes representation of the Relay
@@ -163,6 +217,11 @@ func (p Payload) Bytes() []byte {
 	return bz
 }
 
+// "Redacted" - The payload redacted with the redaction mode of the chain, for the logs and the error messages
+func (p Payload) Redacted(chain string) string {
+	return RedactPayload(chain, string(p.Bytes()))
+}
+
 // "Hash" - The cryptographic hash representation of the payload object
 func (p Payload) Hash() []byte {
 	return Hash(p.Bytes())
@@ -178,6 +237,14 @@ func (p Payload) Validate() sdk.Error {
 	if p.Data == "" && p.Path == "" {
 		return NewEmptyPayloadDataError(ModuleName)
 	}
+	if err := p.validateHTTP(); err != nil {
+		return err
+	}
+	if p.IsBatch() {
+		if _, err := p.Batch(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -223,6 +290,8 @@ type RelayResponse struct {
 	Signature string     `json:"signature"` // signature from the node in hex
 	Response  string     `json:"payload"`   // response to relay
 	Proof     RelayProof `json:"proof"`     // to be signed by the client
+	// the response headers of the chain passed through to the client, neither hashed (signed) nor encoded
+	Headers map[string]string `json:"-"`
 }
 
 // "Validate" - The node validates the response after signing
@@ -256,6 +325,28 @@ func (rr RelayResponse) HashString() string {
 	return hex.EncodeToString(rr.Hash())
 }
 
+// "Verify" - Verifies the response was signed by the servicer and its proof (signed by the client) is the one of the
+// servicer, so the clients can validate the responses against the node identity
+func (rr RelayResponse) Verify(servicerPubKey string) sdk.Error {
+	if err := rr.Validate(); err != nil {
+		return err
+	}
+	// validate the servicer public key format
+	if err := PubKeyVerification(servicerPubKey); err != nil {
+		return NewInvalidNodePubKeyError(ModuleName)
+	}
+	// the proof must name the servicer, or the response is of another node
+	if rr.Proof.ServicerPubKey != servicerPubKey {
+		return NewInvalidNodePubKeyError(ModuleName)
+	}
+	// validate the proof and the client signature on it
+	if err := rr.Proof.ValidateBasic(); err != nil {
+		return err
+	}
+	// verify the servicer signature on the response (the hash includes the proof)
+	return SignatureVerification(servicerPubKey, rr.HashString(), rr.Signature)
+}
+
 // "relayResponse" - a structure used for custom json
 type relayResponse struct {
 	Signature string `json:"signature"`
@@ -274,43 +365,74 @@ type DispatchResponse struct {
 	BlockHeight int64   `json:"block_height"`
 }
 
-// "executeHTTPRequest" takes in the raw json string and forwards it to the RPC endpoint
-func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string) (string, error) {
-	// generate an http request
-	req, err := http.NewRequest(method, url, bytes.NewBuffer([]byte(payload)))
-	if err != nil {
-		return "", err
-	}
-	if basicAuth.Username != "" {
-		req.SetBasicAuth(basicAuth.Username, basicAuth.Password)
-	}
-	if userAgent == "" {
-		req.Header.Set("User-Agent", userAgent)
-	}
-	// add headers if needed
-	if len(headers) == 0 {
-		req.Header.Set("Content-Type", "application/json")
-	} else {
-		for k, v := range headers {
-			req.Header.Set(k, v)
+// "executeWithRetry" - Executes a request against the hosted blockchain, re-attempting it on failure (e.g. the timeout of
+// the chain) with an exponential backoff, up to the retries of the chain
+func (hc ChainHTTPConfig) executeWithRetry(execute func() (string, http.Header, error)) (res string, header http.Header, err error) {
+	backoff := time.Duration(hc.RetryBackoff) * time.Millisecond
+	for attempt := 0; attempt <= hc.Retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		if res, header, err = execute(); err == nil {
+			return res, header, nil
 		}
 	}
+	return res, header, err
+}
+
+// "executeHTTPRequest" takes in the raw json string and forwards it to the RPC endpoint with the (pooled) client,
+// returns the response and its headers
+func executeHTTPRequest(client *http.Client, payload, url, userAgent string, credentials ChainCredentials, method string, headers map[string]string) (string, http.Header, error) {
+	req, err := newChainRequest(payload, url, userAgent, credentials, method, headers)
+	if err != nil {
+		return "", nil, err
+	}
 	// execute the request
-	resp, err := (&http.Client{}).Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
+	// the body is read in full and closed, so the connection is reused
+	defer resp.Body.Close()
 	// read all bz
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	defer resp.Body.Close()
 	if globalSortJSONResponses {
 		body = []byte(sortJSONResponse(string(body)))
 	}
 	// return
-	return string(body), nil
+	return string(body), resp.Header, nil
+}
+
+// "newChainRequest" - Returns the http request of the payload to the hosted chain, with the headers of the payload and
+// the credentials of the chain
+func newChainRequest(payload, url, userAgent string, credentials ChainCredentials, method string, headers map[string]string) (*http.Request, error) {
+	// generate an http request, without a body if the payload has no data (e.g. a GET to a REST api)
+	var reqBody io.Reader
+	if payload != "" {
+		reqBody = strings.NewReader(payload)
+	}
+	req, err := http.NewRequest(method, url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	if userAgent == "" {
+		req.Header.Set("User-Agent", userAgent)
+	}
+	// add headers if needed
+	if len(headers) == 0 && payload != "" {
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+	}
+	// inject the credentials last, so they can't be overridden by the relay headers
+	credentials.Apply(req)
+	return req, nil
 }
 
 func InitJSONSorting(doSorting bool) {
@@ -126,7 +126,7 @@ func TestRelay_Validate(t *testing.T) { // TODO add overservice, and not unique
 
 			k := MockPosKeeper{Validators: tt.allNodes}
 			_, err := tt.relay.Validate(newContext(t, false).WithAppVersion("0.0.0"), k, tt.node,
-				tt.hb, 1, 5, tt.app)
+				tt.hb, 1, 5, tt.app, nil)
 			assert.Equal(t, err != nil, tt.hasError)
 		})
 		ClearSessionCache()
@@ -182,6 +182,41 @@ func TestRelay_Execute(t *testing.T) {
 	assert.Equal(t, response, "bar")
 }
 
+func TestRelay_ExecuteWithCredentials(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	validRelay := Relay{
+		Payload: Payload{
+			Data:    "foo",
+			Method:  "POST",
+			Headers: map[string]string{"Authorization": "Bearer client"},
+		},
+		Proof: RelayProof{Blockchain: ethereum},
+	}
+	defer gock.Off() // Flush pending mocks after test execution
+
+	gock.New("https://server.com").
+		Post("/relay").
+		MatchHeader("Authorization", "^Bearer secret$").
+		MatchParam("api-key", "^key$").
+		Reply(200).
+		BodyString("bar")
+
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:  ethereum,
+			URL: "https://server.com/relay/",
+			Credentials: ChainCredentials{
+				BearerToken: "secret",
+				APIKeyParam: "api-key",
+				APIKey:      "key",
+			},
+		}},
+	}
+	response, err := validRelay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, response, "bar")
+}
+
 func TestRelay_HandleProof(t *testing.T) {
 	clientPrivateKey := GetRandomPrivateKey()
 	clientPubKey := clientPrivateKey.PublicKey().RawString()
@@ -263,6 +298,74 @@ func TestRelayResponse_BytesAndHash(t *testing.T) {
 	assert.Equal(t, storedHashString, relayResp.HashString())
 }
 
+func TestRelayResponse_Verify(t *testing.T) {
+	nodePrivKey := GetRandomPrivateKey()
+	nodePubKey := nodePrivKey.PublicKey().RawString()
+	appPrivKey := GetRandomPrivateKey()
+	cliPrivKey := GetRandomPrivateKey()
+	relayResp := RelayResponse{
+		Response: "foo",
+		Proof: RelayProof{
+			Entropy:            230942034,
+			SessionBlockHeight: 1,
+			RequestHash:        hex.EncodeToString(hash([]byte("request"))),
+			ServicerPubKey:     nodePubKey,
+			Blockchain:         "0001",
+			Token: AAT{
+				Version:              "0.0.1",
+				ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+				ClientPublicKey:      cliPrivKey.PublicKey().RawString(),
+			},
+		},
+	}
+	appSig, err := appPrivKey.Sign(relayResp.Proof.Token.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	relayResp.Proof.Token.ApplicationSignature = hex.EncodeToString(appSig)
+	cliSig, err := cliPrivKey.Sign(relayResp.Proof.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	relayResp.Proof.Signature = hex.EncodeToString(cliSig)
+	nodeSig, err := nodePrivKey.Sign(relayResp.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	relayResp.Signature = hex.EncodeToString(nodeSig)
+	// a response signed by another node
+	otherResp := relayResp
+	otherSig, err := GetRandomPrivateKey().Sign(relayResp.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	otherResp.Signature = hex.EncodeToString(otherSig)
+	// a tampered response
+	tamperedResp := relayResp
+	tamperedResp.Response = "bar"
+	// a proof not signed by the client
+	unsignedProofResp := relayResp
+	unsignedProofResp.Proof.Signature = relayResp.Signature
+	tests := []struct {
+		name           string
+		response       RelayResponse
+		servicerPubKey string
+		hasError       bool
+	}{
+		{"valid response", relayResp, nodePubKey, false},
+		{"signed by another node", otherResp, nodePubKey, true},
+		{"proof of another node", relayResp, getRandomPubKey().RawString(), true},
+		{"invalid servicer public key", relayResp, "foo", true},
+		{"tampered response", tamperedResp, nodePubKey, true},
+		{"proof not signed by the client", unsignedProofResp, nodePubKey, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.hasError, tt.response.Verify(tt.servicerPubKey) != nil)
+		})
+	}
+}
+
 func TestSortJSON(t *testing.T) {
 	// out of order json arrays
 	j1 := `{"foo":0,"bar":1}`
@@ -290,7 +393,11 @@ func (m MockPosKeeper) GetValidatorsByChain(ctx sdk.Ctx, networkID string) (vali
 	return
 }
 
-func (m MockPosKeeper) RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.Address) {
+func (m MockPosKeeper) RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.Address) sdk.Int {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) NodeReward(ctx sdk.Ctx, reward sdk.Int) (nodeReward sdk.Int, feesCollected sdk.Int) {
 	panic("implement me")
 }
 
@@ -311,7 +418,15 @@ func (m MockPosKeeper) TotalTokens(ctx sdk.Ctx) sdk.Int {
 	panic("implement me")
 }
 
-func (m MockPosKeeper) BurnForChallenge(ctx sdk.Ctx, challenges sdk.Int, address sdk.Address) {
+func (m MockPosKeeper) BurnForChallenge(ctx sdk.Ctx, challenges sdk.Int, address sdk.Address) sdk.Int {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) RewardForChallengeReport(ctx sdk.Ctx, amount sdk.Int, address sdk.Address) sdk.Int {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) RelaysToTokensMultiplier(ctx sdk.Ctx) sdk.Int {
 	panic("implement me")
 }
 
@@ -331,6 +446,18 @@ func (m MockPosKeeper) BlocksPerSession(ctx sdk.Ctx) (res int64) {
 	panic("implement me")
 }
 
+func (m MockPosKeeper) GetLatestSessionBlockHeight(ctx sdk.Ctx) int64 {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) IsSessionBlock(ctx sdk.Ctx) bool {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) SessionBlockHeightAfter(ctx sdk.Ctx, sessionBlockHeight int64, n int64) (height int64, found bool) {
+	panic("implement me")
+}
+
 func (m MockPosKeeper) StakeDenom(ctx sdk.Ctx) (res string) {
 	panic("implement me")
 }
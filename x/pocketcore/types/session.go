@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -18,14 +19,14 @@ type Session struct {
 }
 
 // "NewSession" - create a new session from seed data
-func NewSession(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, sessionHeader SessionHeader, blockHash string, sessionNodesCount int) (Session, sdk.Error) {
+func NewSession(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, sessionHeader SessionHeader, blockHash string, sessionNodesCount int, weight SessionWeight) (Session, sdk.Error) {
 	// first generate session key
 	sessionKey, err := NewSessionKey(sessionHeader.ApplicationPubKey, sessionHeader.Chain, blockHash)
 	if err != nil {
 		return Session{}, err
 	}
 	// then generate the service nodes for that session
-	sessionNodes, err := NewSessionNodes(sessionCtx, ctx, keeper, sessionHeader.Chain, sessionKey, sessionNodesCount)
+	sessionNodes, err := NewSessionNodes(sessionCtx, ctx, keeper, sessionHeader.Chain, sessionKey, sessionNodesCount, weight)
 	if err != nil {
 		return Session{}, err
 	}
@@ -100,8 +101,9 @@ func (s Session) Key() ([]byte, error) {
 // "SessionNodes" - Service nodes in a session
 type SessionNodes []nodeexported.ValidatorI
 
-// "NewSessionNodes" - Generates nodes for the session
-func NewSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, sessionKey SessionKey, sessionNodesCount int) (sessionNodes SessionNodes, err sdk.Error) {
+// "NewSessionNodes" - Generates nodes for the session, the selection of a node is weighted by its reputation score if
+// the weight is not nil
+func NewSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, sessionKey SessionKey, sessionNodesCount int, weight SessionWeight) (sessionNodes SessionNodes, err sdk.Error) {
 	// validate chain
 	if len(chain) == 0 {
 		return nil, NewEmptyNonNativeChainError(ModuleName)
@@ -139,6 +141,15 @@ func NewSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, se
 		if sessionNodes.ContainsAddress(res.GetAddress()) {
 			continue
 		}
+		// keep the node with a probability of its reputation score (drawn from the session key and the node address)
+		if weight != nil {
+			if w := weight(sessionCtx, n.GetAddress()); w < MaxReputationScore {
+				draw := binary.BigEndian.Uint64(Hash(append(append([]byte{}, sessionKey...), n.GetAddress().Bytes()...))[:8])
+				if int64(draw%uint64(MaxReputationScore)) >= w {
+					continue
+				}
+			}
+		}
 		// else add the node to the session
 		sessionNodes[numOfNodes] = n
 		// increment the number of nodes in the sessionNodes slice
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+const (
+	// the timeout of the delivery of a session notification
+	SessionWebhookTimeout = 5 * time.Second
+)
+
+var (
+	// the callback urls notified of the new sessions of the apps (by public key) that include this node
+	globalSessionWebhooks = sessionWebhooks{hooks: make(map[string]map[string]struct{})}
+	sessionWebhookClient  = http.Client{Timeout: SessionWebhookTimeout}
+)
+
+// "SessionWebhook" - A callback url notified when a new session of the app includes this node
+type SessionWebhook struct {
+	ApplicationPubKey string `json:"app_public_key"`
+	URL               string `json:"url"`
+}
+
+// "SessionNotification" - The body posted to the webhooks of the app when a new session includes this node
+type SessionNotification struct {
+	Header      SessionHeader `json:"header"`
+	Nodes       []string      `json:"nodes"`
+	Servicer    string        `json:"servicer"`
+	BlockHeight int64         `json:"block_height"`
+}
+
+// "SessionWebhooksUpdate" - The webhooks registered to/unregistered from this node
+type SessionWebhooksUpdate struct {
+	Register   []SessionWebhook `json:"register,omitempty"`
+	Unregister []SessionWebhook `json:"unregister,omitempty"`
+}
+
+type sessionWebhooks struct {
+	l     sync.RWMutex
+	hooks map[string]map[string]struct{} // app public key -> urls
+}
+
+// "UpdateSessionWebhooks" - Registers/unregisters the webhooks of the update, nothing is updated if any registered
+// webhook is invalid
+func UpdateSessionWebhooks(update SessionWebhooksUpdate) sdk.Error {
+	for _, hook := range update.Register {
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, hook := range update.Register {
+		_ = RegisterSessionWebhook(hook)
+	}
+	for _, hook := range update.Unregister {
+		UnregisterSessionWebhook(hook)
+	}
+	return nil
+}
+
+// "Validate" - Validates the public key of the app and the (http or https) callback url
+func (hook SessionWebhook) Validate() sdk.Error {
+	if err := PubKeyVerification(strings.ToLower(hook.ApplicationPubKey)); err != nil {
+		return err
+	}
+	u, err := url.ParseRequestURI(hook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return NewInvalidWebhookURLError(ModuleName, hook.URL)
+	}
+	return nil
+}
+
+// "RegisterSessionWebhook" - Registers the callback url for the new sessions of the app (local, not persisted)
+func RegisterSessionWebhook(hook SessionWebhook) sdk.Error {
+	if err := hook.Validate(); err != nil {
+		return err
+	}
+	pk := strings.ToLower(hook.ApplicationPubKey)
+	globalSessionWebhooks.l.Lock()
+	defer globalSessionWebhooks.l.Unlock()
+	if _, found := globalSessionWebhooks.hooks[pk]; !found {
+		globalSessionWebhooks.hooks[pk] = make(map[string]struct{})
+	}
+	globalSessionWebhooks.hooks[pk][hook.URL] = struct{}{}
+	return nil
+}
+
+// "UnregisterSessionWebhook" - Removes the callback url of the app (all of the urls of the app if empty)
+func UnregisterSessionWebhook(hook SessionWebhook) {
+	pk := strings.ToLower(hook.ApplicationPubKey)
+	globalSessionWebhooks.l.Lock()
+	defer globalSessionWebhooks.l.Unlock()
+	if hook.URL == "" {
+		delete(globalSessionWebhooks.hooks, pk)
+		return
+	}
+	delete(globalSessionWebhooks.hooks[pk], hook.URL)
+	if len(globalSessionWebhooks.hooks[pk]) == 0 {
+		delete(globalSessionWebhooks.hooks, pk)
+	}
+}
+
+// "GetSessionWebhooks" - Returns the registered webhooks sorted by app public key and url
+func GetSessionWebhooks() []SessionWebhook {
+	globalSessionWebhooks.l.RLock()
+	defer globalSessionWebhooks.l.RUnlock()
+	hooks := make([]SessionWebhook, 0)
+	for pk, urls := range globalSessionWebhooks.hooks {
+		for u := range urls {
+			hooks = append(hooks, SessionWebhook{ApplicationPubKey: pk, URL: u})
+		}
+	}
+	sort.Slice(hooks, func(i, j int) bool {
+		if hooks[i].ApplicationPubKey != hooks[j].ApplicationPubKey {
+			return hooks[i].ApplicationPubKey < hooks[j].ApplicationPubKey
+		}
+		return hooks[i].URL < hooks[j].URL
+	})
+	return hooks
+}
+
+// "SessionWebhookApps" - Returns the public keys of the apps with a registered webhook
+func SessionWebhookApps() []string {
+	globalSessionWebhooks.l.RLock()
+	defer globalSessionWebhooks.l.RUnlock()
+	pks := make([]string, 0, len(globalSessionWebhooks.hooks))
+	for pk := range globalSessionWebhooks.hooks {
+		pks = append(pks, pk)
+	}
+	sort.Strings(pks)
+	return pks
+}
+
+// "NotifySessionWebhooks" - Posts the notification to the webhooks of its app, without blocking (a failed delivery is
+// logged and not retried)
+func NotifySessionWebhooks(notification SessionNotification) {
+	globalSessionWebhooks.l.RLock()
+	urls := make([]string, 0, len(globalSessionWebhooks.hooks[notification.Header.ApplicationPubKey]))
+	for u := range globalSessionWebhooks.hooks[notification.Header.ApplicationPubKey] {
+		urls = append(urls, u)
+	}
+	globalSessionWebhooks.l.RUnlock()
+	if len(urls) == 0 {
+		return
+	}
+	bz, err := json.Marshal(notification)
+	if err != nil {
+		fmt.Println(fmt.Errorf("could not marshal the session notification: %s", err.Error()))
+		return
+	}
+	for _, u := range urls {
+		go func(u string) {
+			if err := postSessionNotification(u, bz); err != nil {
+				fmt.Println(fmt.Errorf("could not notify the session webhook %s: %s", u, err.Error()))
+			}
+		}(u)
+	}
+}
+
+func postSessionNotification(u string, bz []byte) error {
+	resp, err := sessionWebhookClient.Post(u, "application/json", bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
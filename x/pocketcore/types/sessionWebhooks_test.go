@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionWebhooks(t *testing.T) {
+	appPubKey := getRandomPubKey().RawString()
+	received := make(chan SessionNotification, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var n SessionNotification
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&n))
+		received <- n
+	}))
+	defer server.Close()
+	// invalid webhooks are not registered
+	assert.NotNil(t, RegisterSessionWebhook(SessionWebhook{ApplicationPubKey: "invalid", URL: server.URL}))
+	err := RegisterSessionWebhook(SessionWebhook{ApplicationPubKey: appPubKey, URL: "ftp://localhost"})
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeInvalidWebhookURLError, int(err.Code()))
+	assert.Empty(t, GetSessionWebhooks())
+	// register (case insensitive public key)
+	assert.Nil(t, RegisterSessionWebhook(SessionWebhook{ApplicationPubKey: strings.ToUpper(appPubKey), URL: server.URL}))
+	assert.Equal(t, []SessionWebhook{{ApplicationPubKey: appPubKey, URL: server.URL}}, GetSessionWebhooks())
+	assert.Equal(t, []string{appPubKey}, SessionWebhookApps())
+	// notify
+	notification := SessionNotification{
+		Header:      SessionHeader{ApplicationPubKey: appPubKey, Chain: "0001", SessionBlockHeight: 5},
+		Nodes:       []string{"node"},
+		Servicer:    "node",
+		BlockHeight: 5,
+	}
+	NotifySessionWebhooks(notification)
+	select {
+	case n := <-received:
+		assert.Equal(t, notification, n)
+	case <-time.After(SessionWebhookTimeout):
+		t.Fatal("the session notification was not delivered")
+	}
+	// unregister
+	UnregisterSessionWebhook(SessionWebhook{ApplicationPubKey: appPubKey, URL: server.URL})
+	assert.Empty(t, GetSessionWebhooks())
+	assert.Empty(t, SessionWebhookApps())
+}
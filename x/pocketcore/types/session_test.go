@@ -229,7 +229,7 @@ func TestNewSessionNodes(t *testing.T) {
 	allNodes[10] = node10
 	allNodes[11] = node11
 	k := MockPosKeeper{Validators: allNodes}
-	sessionNodes, err := NewSessionNodes(newContext(t, false).WithAppVersion("0.0.0"), newContext(t, false).WithAppVersion("0.0.0"), k, ethereum, fakeSessionKey, 5)
+	sessionNodes, err := NewSessionNodes(newContext(t, false).WithAppVersion("0.0.0"), newContext(t, false).WithAppVersion("0.0.0"), k, ethereum, fakeSessionKey, 5, nil)
 	assert.Nil(t, err)
 	assert.Len(t, sessionNodes, 5)
 	assert.Contains(t, sessionNodes, allNodes[0].(nodesTypes.Validator))
@@ -252,4 +252,19 @@ func TestNewSessionNodes(t *testing.T) {
 	assert.False(t, sessionNodes.Contains(node2))
 	assert.Nil(t, sessionNodes.Validate(5))
 	assert.NotNil(t, SessionNodes(make([]exported.ValidatorI, 5)).Validate(5))
+	// weighted by the reputation score of the nodes
+	maxWeight := func(sdk.Ctx, sdk.Address) int64 { return MaxReputationScore }
+	weighted, err := NewSessionNodes(newContext(t, false).WithAppVersion("0.0.0"), newContext(t, false).WithAppVersion("0.0.0"), k, ethereum, fakeSessionKey, 5, maxWeight)
+	assert.Nil(t, err)
+	assert.Equal(t, sessionNodes, weighted)
+	lowWeight := func(_ sdk.Ctx, addr sdk.Address) int64 {
+		if addr.Equals(node12.Address) {
+			return MinReputationScore
+		}
+		return MaxReputationScore
+	}
+	weighted, err = NewSessionNodes(newContext(t, false).WithAppVersion("0.0.0"), newContext(t, false).WithAppVersion("0.0.0"), k, ethereum, fakeSessionKey, 5, lowWeight)
+	assert.Nil(t, err)
+	assert.Nil(t, weighted.Validate(5))
+	assert.False(t, weighted.Contains(node12))
 }
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+	"sync"
+
+	db "github.com/tendermint/tm-db"
+)
+
+const (
+	// the number of shards of the evidence storage
+	DefaultEvidenceCacheShards = 16
+)
+
+// "ShardedCacheStorage" - A cache storage sharded by key (session header) hash: each shard has its own lru cache and lock
+// over the same database, so the writes of different sessions don't serialize under one lock
+type ShardedCacheStorage struct {
+	DB         db.DB           // persisted (shared by the shards)
+	shards     []*CacheStorage // the lru caches
+	writeLocks []sync.Mutex    // serialize the read-modify-writes of a shard (e.g. adding a proof to the evidence)
+}
+
+// "Init" - Initializes the shards of the storage, splitting the max entries among them
+func (scs *ShardedCacheStorage) Init(dir, name string, dbType db.DBBackendType, maxEntries, numOfShards int) {
+	if numOfShards < 1 {
+		numOfShards = 1
+	}
+	maxShardEntries := maxEntries / numOfShards
+	if maxShardEntries < 1 {
+		maxShardEntries = 1
+	}
+	scs.DB = db.NewDB(name, dbType, dir)
+	scs.shards = make([]*CacheStorage, numOfShards)
+	scs.writeLocks = make([]sync.Mutex, numOfShards)
+	for i := range scs.shards {
+		cache, err := New(maxShardEntries)
+		if err != nil {
+			log.Fatal(fmt.Errorf("could not initialize cache storage: " + err.Error()))
+		}
+		scs.shards[i] = &CacheStorage{Cache: cache, DB: scs.DB}
+	}
+}
+
+// the index of the shard of the key
+func (scs *ShardedCacheStorage) shardIndex(key []byte) int {
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	return int(h.Sum32() % uint32(len(scs.shards)))
+}
+
+// "WriteLock" - Returns the lock serializing the read-modify-writes of the shard of the key
+func (scs *ShardedCacheStorage) WriteLock(key []byte) *sync.Mutex {
+	return &scs.writeLocks[scs.shardIndex(key)]
+}
+
+// "Get" - Returns the value from a key
+func (scs *ShardedCacheStorage) Get(key []byte, object CacheObject) (interface{}, bool) {
+	return scs.shards[scs.shardIndex(key)].Get(key, object)
+}
+
+// "Set" - Sets the KV pair in the cache of the shard (flushed to the db)
+func (scs *ShardedCacheStorage) Set(key []byte, val CacheObject) {
+	scs.shards[scs.shardIndex(key)].Set(key, val)
+}
+
+// "Delete" - Deletes the item from stores
+func (scs *ShardedCacheStorage) Delete(key []byte) {
+	scs.shards[scs.shardIndex(key)].Delete(key)
+}
+
+// "FlushToDB" - Flushes the caches of every shard to the db
+func (scs *ShardedCacheStorage) FlushToDB() error {
+	for _, shard := range scs.shards {
+		if err := shard.FlushToDB(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "Clear" - Deletes all items from stores
+func (scs *ShardedCacheStorage) Clear() {
+	for _, shard := range scs.shards {
+		shard.l.Lock()
+		shard.Cache.Purge()
+		shard.l.Unlock()
+	}
+	iter := scs.DB.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		scs.DB.Delete(iter.Key())
+	}
+}
+
+// "Iterator" - Returns an iterator for all of the (flushed) items in the stores
+func (scs *ShardedCacheStorage) Iterator() db.Iterator {
+	return scs.DB.Iterator(nil, nil)
+}
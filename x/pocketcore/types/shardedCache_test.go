@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestShardedCacheStorage(t *testing.T) {
+	scs := new(ShardedCacheStorage)
+	scs.Init("", "sharded", db.MemDBBackend, 4, 4)
+	headers := make([]SessionHeader, 10)
+	for i := range headers {
+		headers[i] = SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+		key := headers[i].Hash()
+		scs.Set(key, Session{SessionHeader: headers[i]})
+		val, found := scs.Get(key, Session{})
+		assert.True(t, found)
+		assert.Equal(t, headers[i], val.(Session).SessionHeader)
+		assert.Equal(t, scs.WriteLock(key), scs.WriteLock(key))
+	}
+	// every item is in the db once flushed
+	assert.Nil(t, scs.FlushToDB())
+	iter := scs.Iterator()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	assert.Equal(t, len(headers), count)
+	scs.Delete(headers[0].Hash())
+	_, found := scs.Get(headers[0].Hash(), Session{})
+	assert.False(t, found)
+	scs.Clear()
+	for _, h := range headers {
+		_, found := scs.Get(h.Hash(), Session{})
+		assert.False(t, found)
+	}
+}
+
+func TestSetProof_Concurrent(t *testing.T) {
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			SetProof(header, RelayEvidence, RelayProof{Entropy: int64(i)}, sdk.NewInt(1000))
+		}(i)
+	}
+	wg.Wait()
+	// no proof is lost to a concurrent write of the same evidence
+	evidence, err := GetEvidence(header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), evidence.NumOfProofs)
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+}
+
+// relays of concurrent sessions, sharded vs a single lock
+func BenchmarkSetProof(b *testing.B) {
+	for _, shards := range []int{1, DefaultEvidenceCacheShards} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			defer func(c *ShardedCacheStorage) { globalEvidenceCache = c }(globalEvidenceCache)
+			globalEvidenceCache = new(ShardedCacheStorage)
+			globalEvidenceCache.Init("", "bench", db.MemDBBackend, 10000, shards)
+			var sessions int64
+			b.RunParallel(func(pb *testing.PB) {
+				header := SessionHeader{
+					ApplicationPubKey:  getRandomPubKey().RawString(),
+					Chain:              hex.EncodeToString([]byte{01}),
+					SessionBlockHeight: atomic.AddInt64(&sessions, 1),
+				}
+				var entropy int64
+				for pb.Next() {
+					entropy++
+					SetProof(header, RelayEvidence, RelayProof{Entropy: entropy}, sdk.NewInt(1000000))
+				}
+			})
+		})
+	}
+}
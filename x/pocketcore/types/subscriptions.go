@@ -0,0 +1,112 @@
+package types
+
+import (
+	"sync"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+const (
+	SubscriptionEventRelayServed         = "relay_served"         // a relay was served by this node
+	SubscriptionEventClaimSubmitted      = "claim_submitted"      // a claim was committed to the chain
+	SubscriptionEventProofVerified       = "proof_verified"       // a proof was verified and committed to the chain
+	SubscriptionEventValidatorJailed     = "validator_jailed"     // a validator was jailed
+	SubscriptionEventValidatorSlashed    = "validator_slashed"    // a validator was slashed
+	SubscriptionEventValidatorTombstoned = "validator_tombstoned" // the whole stake of a validator was burned
+	// the topic of all of the slashing events of the validators
+	SubscriptionTopicSlashing = "slashing"
+	// the events buffered per subscriber before they are dropped
+	SubscriptionBufferSize = 100
+)
+
+var (
+	// the supported subscription event types
+	SubscriptionEventTypes = []string{SubscriptionEventRelayServed, SubscriptionEventClaimSubmitted,
+		SubscriptionEventProofVerified, SubscriptionEventValidatorJailed, SubscriptionEventValidatorSlashed,
+		SubscriptionEventValidatorTombstoned}
+	// the event types of the subscription topics
+	SubscriptionTopics = map[string][]string{
+		SubscriptionTopicSlashing: {SubscriptionEventValidatorSlashed, SubscriptionEventValidatorJailed, SubscriptionEventValidatorTombstoned},
+	}
+	// the local subscribers of the events
+	globalSubscriptions = subscriptions{subs: make(map[*Subscription]struct{})}
+)
+
+// "SubscriptionEvent" - An event delivered to the subscribers as it occurs
+type SubscriptionEvent struct {
+	Type       string            `json:"type"`
+	Height     int64             `json:"height"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// "Subscription" - A subscriber of the events of this node
+type Subscription struct {
+	events map[string]struct{}
+	out    chan SubscriptionEvent
+}
+
+// "Events" - The channel the subscribed events are delivered through, closed on unsubscribe
+func (s *Subscription) Events() <-chan SubscriptionEvent {
+	return s.out
+}
+
+type subscriptions struct {
+	l    sync.RWMutex
+	subs map[*Subscription]struct{}
+}
+
+// "Subscribe" - Subscribes to the event types or topics (all of the event types if none)
+func Subscribe(eventTypes []string) (*Subscription, sdk.Error) {
+	sub := &Subscription{events: make(map[string]struct{}), out: make(chan SubscriptionEvent, SubscriptionBufferSize)}
+	for _, eventType := range eventTypes {
+		if topic, found := SubscriptionTopics[eventType]; found {
+			for _, t := range topic {
+				sub.events[t] = struct{}{}
+			}
+			continue
+		}
+		if !isSubscriptionEventType(eventType) {
+			return nil, NewInvalidSubscriptionEventError(ModuleName, eventType)
+		}
+		sub.events[eventType] = struct{}{}
+	}
+	globalSubscriptions.l.Lock()
+	defer globalSubscriptions.l.Unlock()
+	globalSubscriptions.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// "Unsubscribe" - Removes the subscription and closes its channel
+func Unsubscribe(sub *Subscription) {
+	globalSubscriptions.l.Lock()
+	defer globalSubscriptions.l.Unlock()
+	if _, found := globalSubscriptions.subs[sub]; !found {
+		return
+	}
+	delete(globalSubscriptions.subs, sub)
+	close(sub.out)
+}
+
+// "PublishEvent" - Delivers the event to its subscribers, never blocks (dropped for the subscribers that fall behind)
+func PublishEvent(event SubscriptionEvent) {
+	globalSubscriptions.l.RLock()
+	defer globalSubscriptions.l.RUnlock()
+	for sub := range globalSubscriptions.subs {
+		if _, found := sub.events[event.Type]; !found && len(sub.events) != 0 {
+			continue
+		}
+		select {
+		case sub.out <- event:
+		default:
+		}
+	}
+}
+
+func isSubscriptionEventType(eventType string) bool {
+	for _, t := range SubscriptionEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptions(t *testing.T) {
+	_, err := Subscribe([]string{"invalid"})
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeInvalidSubscriptionEventError, int(err.Code()))
+	claims, err := Subscribe([]string{SubscriptionEventClaimSubmitted})
+	assert.Nil(t, err)
+	all, err := Subscribe(nil)
+	assert.Nil(t, err)
+	claim := SubscriptionEvent{Type: SubscriptionEventClaimSubmitted, Height: 1, Attributes: map[string]string{"validator": "abc"}}
+	jail := SubscriptionEvent{Type: SubscriptionEventValidatorJailed, Height: 2}
+	PublishEvent(claim)
+	PublishEvent(jail)
+	// only the subscribed events are delivered
+	assert.Equal(t, claim, <-claims.Events())
+	assert.Len(t, claims.Events(), 0)
+	assert.Equal(t, claim, <-all.Events())
+	assert.Equal(t, jail, <-all.Events())
+	// the events of the subscribers falling behind are dropped instead of blocking
+	for i := 0; i < SubscriptionBufferSize+1; i++ {
+		PublishEvent(claim)
+	}
+	assert.Len(t, claims.Events(), SubscriptionBufferSize)
+	// unsubscribing closes the channel
+	Unsubscribe(claims)
+	Unsubscribe(claims)
+	Unsubscribe(all)
+	for range claims.Events() {
+	}
+	_, open := <-claims.Events()
+	assert.False(t, open)
+}
+
+func TestSubscriptions_Topic(t *testing.T) {
+	slashing, err := Subscribe([]string{SubscriptionTopicSlashing})
+	assert.Nil(t, err)
+	defer Unsubscribe(slashing)
+	slash := SubscriptionEvent{Type: SubscriptionEventValidatorSlashed, Height: 1, Attributes: map[string]string{"burned": "10"}}
+	tombstone := SubscriptionEvent{Type: SubscriptionEventValidatorTombstoned, Height: 1}
+	PublishEvent(SubscriptionEvent{Type: SubscriptionEventClaimSubmitted, Height: 1})
+	PublishEvent(slash)
+	PublishEvent(tombstone)
+	// only the events of the topic are delivered
+	assert.Equal(t, slash, <-slashing.Events())
+	assert.Equal(t, tombstone, <-slashing.Events())
+	assert.Len(t, slashing.Events(), 0)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// the default number of broadcasts of an auto transaction (claim, proof) before giving up until the next session
+	DefaultTxRetries = 3
+	// the default wait before the first rebroadcast of a failed auto transaction, doubled at every retry
+	DefaultTxRetryBackoff = 2 * time.Second
+)
+
+var (
+	globalTxRetries      = DefaultTxRetries
+	globalTxRetryBackoff = DefaultTxRetryBackoff
+)
+
+// "InitTxRetry" - Sets the number of broadcasts of a failed auto transaction and the wait before the first retry
+func InitTxRetry(retries int, backoff time.Duration) {
+	if retries < 1 {
+		retries = 1
+	}
+	globalTxRetries = retries
+	globalTxRetryBackoff = backoff
+}
+
+// "BroadcastWithRetry" - Broadcasts an auto transaction, rebroadcasting it (rebuilt by the broadcast function, e.g. signed
+// with a new entropy) on a failed broadcast. A transaction rejected by the chain (non zero code) is not retried, as the
+// rejection is deterministic. Every failure is logged with the keyvals of the transaction
+func BroadcastWithRetry(logger log.Logger, tx string, broadcast func() (*sdk.TxResponse, error), keyvals ...interface{}) (*sdk.TxResponse, error) {
+	backoff := globalTxRetryBackoff
+	var err error
+	for attempt := 1; attempt <= globalTxRetries; attempt++ {
+		var res *sdk.TxResponse
+		res, err = broadcast()
+		if err == nil {
+			if res != nil && res.Code != 0 {
+				logger.Error(fmt.Sprintf("the %s transaction was rejected", tx), append(keyvals, "code", res.Code, "codespace", res.Codespace, "log", res.RawLog, "txhash", res.TxHash)...)
+				err = fmt.Errorf("the %s transaction was rejected with code %d: %s", tx, res.Code, res.RawLog)
+				RecordNodeKeyUse(tx, res, err)
+				return res, err
+			}
+			RecordNodeKeyUse(tx, res, nil)
+			return res, nil
+		}
+		RecordNodeKeyUse(tx, res, err)
+		logger.Error(fmt.Sprintf("the %s transaction failed", tx), append(keyvals, "attempt", attempt, "retries", globalTxRetries, "err", err.Error())...)
+		if attempt < globalTxRetries {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// the default name of the file persisting the retry queue of the failed claim and proof transactions
+	DefaultTxRetryQueueName = "tx_retry_queue.json"
+	// the wait before the first re-attempt of a failed transaction of the queue, doubled at every failure
+	TxRetryQueueBackoff = time.Minute
+	// the longest wait between two attempts of a failed transaction of the queue
+	MaxTxRetryQueueBackoff = time.Hour
+)
+
+var (
+	globalTxRetryQueue = &txRetryQueue{entries: make(map[string]TxRetryEntry)}
+)
+
+// "TxRetryEntry" - A claim or proof transaction of a session that failed, re-attempted with an exponential backoff until
+// it succeeds or its evidence is deleted (proven, expired, ...)
+type TxRetryEntry struct {
+	SessionHeader `json:"header"`
+	EvidenceType  EvidenceType `json:"evidence_type"`
+	Tx            string       `json:"tx"` // claim or proof
+	Attempts      int          `json:"attempts"`
+	NextAttempt   time.Time    `json:"next_attempt"`
+	LastError     string       `json:"last_error"`
+}
+
+// the retry queue, persisted to its file (if any) on every change
+type txRetryQueue struct {
+	l       sync.Mutex
+	path    string
+	entries map[string]TxRetryEntry
+}
+
+// "InitTxRetryQueue" - Loads the retry queue of the failed transactions persisted at path
+func InitTxRetryQueue(path string) error {
+	q := &txRetryQueue{path: path, entries: make(map[string]TxRetryEntry)}
+	bz, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil {
+		var entries []TxRetryEntry
+		if err := json.Unmarshal(bz, &entries); err != nil {
+			return fmt.Errorf("invalid tx retry queue file %s: %s", path, err.Error())
+		}
+		for _, e := range entries {
+			q.entries[txRetryKey(e.SessionHeader, e.EvidenceType, e.Tx)] = e
+		}
+	}
+	globalTxRetryQueue = q
+	return nil
+}
+
+func txRetryKey(header SessionHeader, evidenceType EvidenceType, tx string) string {
+	return fmt.Sprintf("%s/%d/%s", hex.EncodeToString(header.Hash()), evidenceType, tx)
+}
+
+// "RecordTxFailure" - Queues the failed transaction of the session, or reschedules it with a doubled backoff
+func RecordTxFailure(header SessionHeader, evidenceType EvidenceType, tx string, err error) TxRetryEntry {
+	q := globalTxRetryQueue
+	q.l.Lock()
+	defer q.l.Unlock()
+	key := txRetryKey(header, evidenceType, tx)
+	e, found := q.entries[key]
+	if !found {
+		e = TxRetryEntry{SessionHeader: header, EvidenceType: evidenceType, Tx: tx}
+	}
+	backoff := TxRetryQueueBackoff
+	for i := 0; i < e.Attempts && backoff < MaxTxRetryQueueBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > MaxTxRetryQueueBackoff {
+		backoff = MaxTxRetryQueueBackoff
+	}
+	e.Attempts++
+	e.NextAttempt = time.Now().Add(backoff).UTC()
+	e.LastError = err.Error()
+	q.entries[key] = e
+	q.persist()
+	return e
+}
+
+// "RecordTxSuccess" - Removes the transaction of the session from the queue
+func RecordTxSuccess(header SessionHeader, evidenceType EvidenceType, tx string) {
+	q := globalTxRetryQueue
+	q.l.Lock()
+	defer q.l.Unlock()
+	key := txRetryKey(header, evidenceType, tx)
+	if _, found := q.entries[key]; !found {
+		return
+	}
+	delete(q.entries, key)
+	q.persist()
+}
+
+// "IsTxRetryDue" - Returns whether the transaction of the session can be attempted: not queued or past its backoff
+func IsTxRetryDue(header SessionHeader, evidenceType EvidenceType, tx string, now time.Time) bool {
+	q := globalTxRetryQueue
+	q.l.Lock()
+	defer q.l.Unlock()
+	e, found := q.entries[txRetryKey(header, evidenceType, tx)]
+	return !found || !now.Before(e.NextAttempt)
+}
+
+// "DueTxRetries" - Returns the queued transactions past their backoff, the oldest sessions first
+func DueTxRetries(now time.Time) []TxRetryEntry {
+	entries := make([]TxRetryEntry, 0)
+	for _, e := range GetTxRetries() {
+		if !now.Before(e.NextAttempt) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// "GetTxRetries" - Returns the queued transactions, the oldest sessions first
+func GetTxRetries() []TxRetryEntry {
+	q := globalTxRetryQueue
+	q.l.Lock()
+	entries := make([]TxRetryEntry, 0, len(q.entries))
+	for _, e := range q.entries {
+		entries = append(entries, e)
+	}
+	q.l.Unlock()
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].SessionBlockHeight != entries[j].SessionBlockHeight {
+			return entries[i].SessionBlockHeight < entries[j].SessionBlockHeight
+		}
+		return txRetryKey(entries[i].SessionHeader, entries[i].EvidenceType, entries[i].Tx) < txRetryKey(entries[j].SessionHeader, entries[j].EvidenceType, entries[j].Tx)
+	})
+	return entries
+}
+
+// removes the transactions of the deleted evidence
+func deleteTxRetries(header SessionHeader, evidenceType EvidenceType) {
+	q := globalTxRetryQueue
+	q.l.Lock()
+	defer q.l.Unlock()
+	deleted := false
+	for _, tx := range []string{MsgClaimName, MsgProofName} {
+		key := txRetryKey(header, evidenceType, tx)
+		if _, found := q.entries[key]; found {
+			delete(q.entries, key)
+			deleted = true
+		}
+	}
+	if deleted {
+		q.persist()
+	}
+}
+
+// writes the queue to its file (replacing it, so it is never half written), must hold the lock
+func (q *txRetryQueue) persist() {
+	if q.path == "" {
+		return
+	}
+	entries := make([]TxRetryEntry, 0, len(q.entries))
+	for _, e := range q.entries {
+		entries = append(entries, e)
+	}
+	bz, err := json.Marshal(entries)
+	if err != nil {
+		fmt.Println(fmt.Errorf("unable to persist the tx retry queue: %s", err.Error()))
+		return
+	}
+	tmp := q.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0644); err != nil {
+		fmt.Println(fmt.Errorf("unable to persist the tx retry queue: %s", err.Error()))
+		return
+	}
+	if err := os.Rename(tmp, q.path); err != nil {
+		fmt.Println(fmt.Errorf("unable to persist the tx retry queue: %s", err.Error()))
+	}
+}
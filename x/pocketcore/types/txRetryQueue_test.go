@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxRetryQueue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txretry")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := dir + string(os.PathSeparator) + DefaultTxRetryQueueName
+	assert.Nil(t, InitTxRetryQueue(path))
+	defer func() { _ = InitTxRetryQueue("") }()
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	now := time.Now()
+	assert.True(t, IsTxRetryDue(header, RelayEvidence, MsgClaimName, now))
+	// the backoff doubles at every failure
+	e := RecordTxFailure(header, RelayEvidence, MsgClaimName, fmt.Errorf("connection refused"))
+	assert.Equal(t, 1, e.Attempts)
+	assert.False(t, IsTxRetryDue(header, RelayEvidence, MsgClaimName, now))
+	assert.True(t, IsTxRetryDue(header, RelayEvidence, MsgClaimName, now.Add(TxRetryQueueBackoff+time.Second)))
+	e = RecordTxFailure(header, RelayEvidence, MsgClaimName, fmt.Errorf("connection refused"))
+	assert.Equal(t, 2, e.Attempts)
+	assert.False(t, IsTxRetryDue(header, RelayEvidence, MsgClaimName, now.Add(TxRetryQueueBackoff+time.Second)))
+	assert.True(t, IsTxRetryDue(header, RelayEvidence, MsgClaimName, now.Add(2*TxRetryQueueBackoff+time.Second)))
+	// capped
+	for i := 0; i < 10; i++ {
+		e = RecordTxFailure(header, RelayEvidence, MsgClaimName, fmt.Errorf("connection refused"))
+	}
+	assert.True(t, IsTxRetryDue(header, RelayEvidence, MsgClaimName, now.Add(MaxTxRetryQueueBackoff+time.Second)))
+	assert.Len(t, DueTxRetries(now), 0)
+	assert.Len(t, DueTxRetries(now.Add(MaxTxRetryQueueBackoff+time.Second)), 1)
+	// the queue survives a restart
+	assert.Nil(t, InitTxRetryQueue(path))
+	retries := GetTxRetries()
+	assert.Len(t, retries, 1)
+	assert.Equal(t, 12, retries[0].Attempts)
+	assert.Equal(t, header, retries[0].SessionHeader)
+	// a successful transaction is removed
+	RecordTxSuccess(header, RelayEvidence, MsgClaimName)
+	assert.Len(t, GetTxRetries(), 0)
+	// the transactions of the deleted evidence are removed
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 1}, sdk.NewInt(1000))
+	RecordTxFailure(header, RelayEvidence, MsgProofName, fmt.Errorf("connection refused"))
+	assert.Nil(t, DeleteEvidence(header, RelayEvidence))
+	assert.Len(t, GetTxRetries(), 0)
+	assert.Nil(t, InitTxRetryQueue(path))
+	assert.Len(t, GetTxRetries(), 0)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestBroadcastWithRetry(t *testing.T) {
+	InitTxRetry(3, 0)
+	defer InitTxRetry(DefaultTxRetries, DefaultTxRetryBackoff)
+	// succeeds on the last retry
+	attempts := 0
+	res, err := BroadcastWithRetry(log.NewNopLogger(), MsgClaimName, func() (*sdk.TxResponse, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, fmt.Errorf("connection refused")
+		}
+		return &sdk.TxResponse{TxHash: "hash"}, nil
+	}, "chain", "0001")
+	assert.Nil(t, err)
+	assert.Equal(t, "hash", res.TxHash)
+	assert.Equal(t, 3, attempts)
+	// fails every retry
+	attempts = 0
+	_, err = BroadcastWithRetry(log.NewNopLogger(), MsgClaimName, func() (*sdk.TxResponse, error) {
+		attempts++
+		return nil, fmt.Errorf("connection refused")
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, attempts)
+	// a rejected transaction is not retried
+	attempts = 0
+	_, err = BroadcastWithRetry(log.NewNopLogger(), MsgProofName, func() (*sdk.TxResponse, error) {
+		attempts++
+		return &sdk.TxResponse{Code: 4, RawLog: "unauthorized"}, nil
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts)
+}